@@ -1,5 +1,6 @@
 package auctionhouse
 
 const (
-	Ver = "0.0.1"
+	Ver                     = "0.0.1"
+	SupportedGuidServiceVer = "0.0.1"
 )
\ No newline at end of file
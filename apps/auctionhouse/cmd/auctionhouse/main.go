@@ -23,9 +23,11 @@ import (
 	"github.com/walkline/ToCloud9/apps/auctionhouse/server"
 	"github.com/walkline/ToCloud9/apps/auctionhouse/service"
 	"github.com/walkline/ToCloud9/gen/auctionhouse/pb"
+	pbGuid "github.com/walkline/ToCloud9/gen/guid/pb"
 	pbMail "github.com/walkline/ToCloud9/gen/mail/pb"
 	shrepo "github.com/walkline/ToCloud9/shared/repo"
 	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 func main() {
@@ -53,6 +55,11 @@ func main() {
 		log.Fatal().Err(err).Msg("can't create auction repo")
 	}
 
+	salesRepo, err := repo.NewSalesMySQLRepo(charDB)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't create sales repo")
+	}
+
 	// Connect to world database for item templates
 	worldDB, err := sql.Open("mysql", cfg.WorldDBConnection)
 	if err != nil {
@@ -75,6 +82,17 @@ func main() {
 	defer mailConn.Close()
 	mailClient := pbMail.NewMailServiceClient(mailConn)
 
+	var itemRepo repo.ItemRepo
+	if cfg.AHBot.Enabled {
+		itemRepo, err = repo.NewItemMySQLRepo(charDB)
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't create item repo")
+		}
+
+		// Nobody reads bot mails, skip them instead of letting them accumulate.
+		mailClient = service.NewAuctionBotMailFilter(mailClient, itemRepo, cfg.AHBot.CharacterGUIDs)
+	}
+
 	// Connect to NATS
 	nc, err := nats.Connect(cfg.NatsURL)
 	if err != nil {
@@ -83,32 +101,71 @@ func main() {
 	defer nc.Close()
 
 	eventsProducer := events.NewAuctionHouseProducer(nc)
-	auctionService := service.NewAuctionService(auctionRepo, mailClient, eventsProducer, itemTemplates)
+	auctionService := service.NewAuctionService(auctionRepo, salesRepo, mailClient, eventsProducer, itemTemplates)
 
-	// Subscribe to auction events from other instances
-	subscribeToAuctionEvents(nc, auctionService)
+	ownershipCtx, stopOwnership := context.WithCancel(context.Background())
+	ownership, ownershipStopped := realmOwnership(ownershipCtx, cfg, realmIDs, auctionService)
 
-	// Load auctions from DB for all realms
-	for _, realmID := range realmIDs {
-		if err := auctionService.LoadAuctions(context.Background(), realmID); err != nil {
-			log.Fatal().Err(err).Uint32("realmID", realmID).Msg("can't load auctions")
-		}
-	}
+	// Subscribe to auction events from other instances
+	subscribeToAuctionEvents(nc, auctionService, ownership)
 
-	// Start expiration ticker
+	// Start expiration ticker, expired auctions are processed only by the realm owner
 	go func() {
 		ticker := time.NewTicker(time.Duration(cfg.ExpiredAuctionsCheckSecsDelay) * time.Second)
 		defer ticker.Stop()
 		for {
 			select {
 			case <-ticker.C:
-				for _, realmID := range realmIDs {
+				service.ServeOwnedRealms(ownership, func(realmID uint32) {
 					auctionService.ProcessExpiredAuctions(context.Background(), realmID)
-				}
+				})
 			}
 		}
 	}()
 
+	// Start sales history cleanup ticker
+	if cfg.SalesHistoryRetentionDays > 0 {
+		go func() {
+			ticker := time.NewTicker(time.Hour)
+			defer ticker.Stop()
+			for range ticker.C {
+				before := time.Now().Add(-time.Duration(cfg.SalesHistoryRetentionDays) * 24 * time.Hour).Unix()
+				service.ServeOwnedRealms(ownership, func(realmID uint32) {
+					if err := auctionService.CleanupSalesHistory(context.Background(), realmID, before); err != nil {
+						log.Error().Err(err).Uint32("realmID", realmID).Msg("can't cleanup sales history")
+					}
+				})
+			}
+		}()
+	}
+
+	if cfg.AHBot.Enabled {
+		guidConn, err := grpc.Dial(cfg.GuidProviderServiceAddress, grpc.WithInsecure())
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't connect to guid service")
+		}
+		defer guidConn.Close()
+
+		bot := service.NewAuctionBot(
+			auctionService,
+			itemRepo,
+			service.NewGuidServiceItemGuidProvider(pbGuid.NewGuidServiceClient(guidConn)),
+			itemTemplates,
+			auctionBotConfig(cfg.AHBot),
+		)
+
+		// Start auction bot ticker
+		go func() {
+			ticker := time.NewTicker(time.Duration(cfg.AHBot.TickSecsDelay) * time.Second)
+			defer ticker.Stop()
+			for range ticker.C {
+				service.ServeOwnedRealms(ownership, func(realmID uint32) {
+					bot.Tick(context.Background(), realmID)
+				})
+			}
+		}()
+	}
+
 	// gRPC setup
 	lis, err := net.Listen("tcp4", ":"+cfg.Port)
 	if err != nil {
@@ -116,7 +173,8 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	ahServer := server.NewAuctionHouseServer(auctionService)
+	ahServer := server.NewAuctionHouseServer(auctionService, ownership)
+	ahServer = server.NewAuctionHouseRealmOwnershipMiddleware(ahServer, ownership)
 	if cfg.LogLevel == zerolog.DebugLevel {
 		ahServer = server.NewAuctionHouseDebugLoggerMiddleware(ahServer, log.Logger)
 	}
@@ -132,6 +190,11 @@ func main() {
 		fmt.Println("")
 		log.Info().Msgf("Got signal %v, attempting graceful shutdown...", sig)
 		grpcServer.GracefulStop()
+
+		// Hand over owned realms to other instances.
+		stopOwnership()
+		<-ownershipStopped
+
 		wg.Done()
 	}()
 
@@ -153,7 +216,85 @@ func configureDBConn(db *sql.DB) {
 	db.SetConnMaxIdleTime(time.Minute * 8)
 }
 
-func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService) {
+// realmOwnership returns ownership of the realms served by the instance and loads auctions of owned realms.
+// Returned channel is closed when ctx is done and owned realms are released.
+func realmOwnership(ctx context.Context, cfg *config.Config, realmIDs []uint32, auctionService *service.AuctionService) (service.RealmOwnership, <-chan struct{}) {
+	stopped := make(chan struct{})
+
+	if cfg.RedisConnection == "" {
+		for _, realmID := range realmIDs {
+			if err := auctionService.LoadAuctions(ctx, realmID); err != nil {
+				log.Fatal().Err(err).Uint32("realmID", realmID).Msg("can't load auctions")
+			}
+		}
+		close(stopped)
+		return service.NewStaticRealmOwnership(realmIDs), stopped
+	}
+
+	rdb, err := redisclient.NewClient(cfg.RedisConnection, cfg.Redis)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to the redis")
+	}
+
+	address := cfg.AdvertisedAddress
+	if address == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't get hostname for advertised address")
+		}
+		address = net.JoinHostPort(hostname, cfg.Port)
+	}
+
+	ownership := service.NewLeasedRealmOwnership(
+		repo.NewRealmOwnershipRedisRepo(rdb),
+		address,
+		realmIDs,
+		time.Duration(cfg.RealmLeaseSecs)*time.Second,
+		auctionService.LoadAuctions,
+		auctionService.UnloadAuctions,
+	)
+
+	go func() {
+		ownership.Run(ctx)
+		close(stopped)
+	}()
+
+	// Try to serve realms before the gRPC server is started.
+	select {
+	case <-ownership.Ready():
+	case <-ctx.Done():
+	}
+
+	log.Info().Str("address", address).Uints32("ownedRealms", ownership.OwnedRealms()).Msg("Realms are partitioned with redis leases")
+
+	return ownership, stopped
+}
+
+func auctionBotConfig(cfg config.AHBot) service.AuctionBotConfig {
+	houses := make(map[uint8]service.AuctionBotHouseConfig, len(cfg.Houses))
+	for houseID, h := range cfg.Houses {
+		houses[houseID] = service.AuctionBotHouseConfig{
+			MaxAuctions:         h.MaxAuctions,
+			QualityWeights:      h.QualityWeights,
+			PriceMultipliers:    h.PriceMultipliers,
+			PriceVariancePct:    h.PriceVariancePct,
+			BuyoutBelowPct:      h.BuyoutBelowPct,
+			BidBelowPct:         h.BidBelowPct,
+			MaxPurchasesPerTick: h.MaxPurchasesPerTick,
+		}
+	}
+
+	return service.AuctionBotConfig{
+		CharacterGUIDs: cfg.CharacterGUIDs,
+		ItemsPerTick:   cfg.ItemsPerTick,
+		Houses:         houses,
+	}
+}
+
+// subscribeToAuctionEvents keeps cache of the owned realms in sync with events of other instances.
+// Events of the realms owned by other instances are ignored.
+func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService, ownership service.RealmOwnership) {
+
 	// Subscribe to auction created events
 	_, err := nc.Subscribe(events.AuctionHouseEventAuctionCreated, func(msg *nats.Msg) {
 		var payload events.AuctionHouseEventAuctionCreatedPayload
@@ -161,7 +302,10 @@ func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService) {
 			log.Error().Err(err).Msg("failed to unmarshal auction created event")
 			return
 		}
-		svc.HandleAuctionCreated(&payload)
+		if release, owned := ownership.AcquireServing(payload.RealmID); owned {
+			svc.HandleAuctionCreated(&payload)
+			release()
+		}
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to subscribe to auction created events")
@@ -174,7 +318,10 @@ func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService) {
 			log.Error().Err(err).Msg("failed to unmarshal bid placed event")
 			return
 		}
-		svc.HandleBidPlaced(&payload)
+		if release, owned := ownership.AcquireServing(payload.RealmID); owned {
+			svc.HandleBidPlaced(&payload)
+			release()
+		}
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to subscribe to bid placed events")
@@ -187,7 +334,10 @@ func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService) {
 			log.Error().Err(err).Msg("failed to unmarshal auction canceled event")
 			return
 		}
-		svc.HandleAuctionCanceled(&payload)
+		if release, owned := ownership.AcquireServing(payload.RealmID); owned {
+			svc.HandleAuctionCanceled(&payload)
+			release()
+		}
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to subscribe to auction canceled events")
@@ -200,7 +350,10 @@ func subscribeToAuctionEvents(nc *nats.Conn, svc *service.AuctionService) {
 			log.Error().Err(err).Msg("failed to unmarshal auction expired event")
 			return
 		}
-		svc.HandleAuctionExpired(&payload)
+		if release, owned := ownership.AcquireServing(payload.RealmID); owned {
+			svc.HandleAuctionExpired(&payload)
+			release()
+		}
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to subscribe to auction expired events")
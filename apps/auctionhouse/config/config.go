@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/walkline/ToCloud9/shared/config"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 // Config is config of application
@@ -25,6 +26,95 @@ type Config struct {
 
 	// ExpiredAuctionsCheckSecsDelay delay between auction expiration checks.
 	ExpiredAuctionsCheckSecsDelay int64 `yaml:"expiredAuctionsCheckSecsDelay" env:"EXPIRED_AUCTIONS_CHECK_SECS_DELAY" env-default:"60"`
+
+	// SalesHistoryRetentionDays is the amount of days completed sales are kept for market stats. 0 keeps them forever.
+	SalesHistoryRetentionDays int64 `yaml:"salesHistoryRetentionDays" env:"SALES_HISTORY_RETENTION_DAYS" env-default:"90"`
+
+	// GuidProviderServiceAddress is address of service that provides guids for the items created by the auction bot
+	GuidProviderServiceAddress string `yaml:"guidProviderServiceAddress" env:"GUID_PROVIDER_SERVICE_ADDRESS" env-default:"localhost:8996"`
+
+	// AHBot is configuration of the auction house bot
+	AHBot AHBot `yaml:"ahbot"`
+
+	// RedisConnection is redis connection url used to partition realms between auction house instances.
+	// If empty, the instance serves all realms, so only one instance should be running.
+	RedisConnection string `yaml:"redisUrl" env:"REDIS_URL"`
+
+	// Redis is config of the redis sentinel or cluster connection and retries on failover.
+	Redis redisclient.Config `yaml:"redis"`
+
+	// AdvertisedAddress is address of the instance that gateways use to reach realms owned by the instance.
+	// Defaults to the hostname and port.
+	AdvertisedAddress string `yaml:"advertisedAddress" env:"ADVERTISED_ADDRESS"`
+
+	// RealmLeaseSecs is ttl of realm ownership lease, realms of dead instance are taken over after it.
+	RealmLeaseSecs int64 `yaml:"realmLeaseSecs" env:"REALM_LEASE_SECS" env-default:"15"`
+}
+
+// AHBot is configuration of the auction house bot that lists items and buys cheap player auctions.
+// With several instances every instance runs the bot only for the realms it owns.
+type AHBot struct {
+	// Enabled enables the auction house bot
+	Enabled bool `yaml:"enabled" env:"AHBOT_ENABLED" env-default:"false"`
+
+	// CharacterGUIDs maps realm ID to the low guid of existing character that owns bot auctions.
+	// Realms without character are not processed by the bot.
+	CharacterGUIDs map[uint32]uint32 `yaml:"characterGUIDs" env:"AHBOT_CHARACTER_GUIDS" env-separator:";"`
+
+	// TickSecsDelay is delay between bot ticks
+	TickSecsDelay int64 `yaml:"tickSecsDelay" env:"AHBOT_TICK_SECS_DELAY" env-default:"60"`
+
+	// ItemsPerTick is max amount of items listed in every house per tick
+	ItemsPerTick int `yaml:"itemsPerTick" env:"AHBOT_ITEMS_PER_TICK" env-default:"50"`
+
+	// Houses maps auction house ID to the bot configuration of the house, DefaultAHBotHouses is used if empty
+	Houses map[uint8]AHBotHouse `yaml:"houses"`
+}
+
+// AHBotHouse is configuration of the auction house bot for a single auction house.
+type AHBotHouse struct {
+	// MaxAuctions is the amount of auctions the bot keeps listed in the house
+	MaxAuctions int `yaml:"maxAuctions"`
+
+	// QualityWeights are relative chances to list an item of the quality, indexed by item quality (0 - poor, 6 - artifact)
+	QualityWeights []uint32 `yaml:"qualityWeights"`
+
+	// PriceMultipliers multiply vendor price of the item, indexed by item quality
+	PriceMultipliers []float64 `yaml:"priceMultipliers"`
+
+	// PriceVariancePct is max random deviation of the listing price in percents
+	PriceVariancePct uint32 `yaml:"priceVariancePct"`
+
+	// BuyoutBelowPct makes bot buy out player auctions cheaper than this percent of the bot price, 0 disables buyouts
+	BuyoutBelowPct uint32 `yaml:"buyoutBelowPct"`
+
+	// BidBelowPct makes bot bid on player auctions if the next bid is below this percent of the bot price, 0 disables bids
+	BidBelowPct uint32 `yaml:"bidBelowPct"`
+
+	// MaxPurchasesPerTick limits the amount of bids and buyouts per tick
+	MaxPurchasesPerTick int `yaml:"maxPurchasesPerTick"`
+}
+
+// DefaultAHBotHouses returns bot configuration for alliance, horde and neutral houses.
+func DefaultAHBotHouses() map[uint8]AHBotHouse {
+	house := AHBotHouse{
+		MaxAuctions:         1000,
+		QualityWeights:      []uint32{0, 60, 25, 12, 3, 0, 0},
+		PriceMultipliers:    []float64{1, 1.5, 3, 5, 8, 1, 1},
+		PriceVariancePct:    20,
+		BuyoutBelowPct:      50,
+		BidBelowPct:         30,
+		MaxPurchasesPerTick: 5,
+	}
+
+	neutral := house
+	neutral.MaxAuctions = 300
+
+	return map[uint8]AHBotHouse{
+		2: house,
+		6: house,
+		7: neutral,
+	}
 }
 
 // LoadConfig loads config from env variables
@@ -38,5 +128,9 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if len(c.Root.AHBot.Houses) == 0 {
+		c.Root.AHBot.Houses = DefaultAHBotHouses()
+	}
+
 	return &c.Root, nil
 }
\ No newline at end of file
@@ -0,0 +1,21 @@
+package repo
+
+import "context"
+
+// ItemInstance is a single item in the item_instance table.
+type ItemInstance struct {
+	GUID       uint32
+	Entry      uint32
+	OwnerGUID  uint32
+	Count      uint32
+	Durability uint32
+}
+
+// ItemRepo is the interface for item instances persistence.
+type ItemRepo interface {
+	// CreateItem inserts a new item instance.
+	CreateItem(ctx context.Context, realmID uint32, item *ItemInstance) error
+
+	// DeleteItem deletes item instance with the given guid.
+	DeleteItem(ctx context.Context, realmID uint32, guid uint32) error
+}
@@ -0,0 +1,37 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+type itemMySQLRepo struct {
+	db shrepo.CharactersDB
+}
+
+func NewItemMySQLRepo(db shrepo.CharactersDB) (ItemRepo, error) {
+	db.SetPreparedStatement(StmtInsertItemInstance)
+	db.SetPreparedStatement(StmtDeleteItemInstance)
+
+	return &itemMySQLRepo{db: db}, nil
+}
+
+func (r *itemMySQLRepo) CreateItem(ctx context.Context, realmID uint32, item *ItemInstance) error {
+	_, err := r.db.PreparedStatement(realmID, StmtInsertItemInstance).ExecContext(ctx,
+		item.GUID, item.Entry, item.OwnerGUID, item.Count, item.Durability,
+	)
+	if err != nil {
+		return fmt.Errorf("can't insert item instance: %w", err)
+	}
+	return nil
+}
+
+func (r *itemMySQLRepo) DeleteItem(ctx context.Context, realmID uint32, guid uint32) error {
+	_, err := r.db.PreparedStatement(realmID, StmtDeleteItemInstance).ExecContext(ctx, guid)
+	if err != nil {
+		return fmt.Errorf("can't delete item instance: %w", err)
+	}
+	return nil
+}
@@ -19,6 +19,11 @@ type ItemTemplate struct {
 	ItemLevel     uint32
 	RequiredLevel uint32
 	Name          string
+	BuyPrice      uint32
+	SellPrice     uint32
+	Stackable     uint32
+	MaxDurability uint32
+	Bonding       uint32
 }
 
 // ItemTemplateCache caches item templates for filtering
@@ -29,7 +34,8 @@ type ItemTemplateCache struct {
 
 // NewItemTemplateCache loads item templates from world database
 func NewItemTemplateCache(worldDB *sql.DB) (*ItemTemplateCache, error) {
-	query := `SELECT entry, class, subclass, Quality, InventoryType, ItemLevel, RequiredLevel, name
+	query := `SELECT entry, class, subclass, Quality, InventoryType, ItemLevel, RequiredLevel, name,
+	          BuyPrice, SellPrice, stackable, MaxDurability, bonding
 	          FROM item_template`
 
 	rows, err := worldDB.Query(query)
@@ -42,7 +48,11 @@ func NewItemTemplateCache(worldDB *sql.DB) (*ItemTemplateCache, error) {
 	count := 0
 
 	for rows.Next() {
-		var tmpl ItemTemplate
+		var (
+			tmpl ItemTemplate
+			// stackable column is signed, some DBs have negative values in it.
+			stackable int32
+		)
 		err := rows.Scan(
 			&tmpl.Entry,
 			&tmpl.Class,
@@ -52,11 +62,17 @@ func NewItemTemplateCache(worldDB *sql.DB) (*ItemTemplateCache, error) {
 			&tmpl.ItemLevel,
 			&tmpl.RequiredLevel,
 			&tmpl.Name,
+			&tmpl.BuyPrice,
+			&tmpl.SellPrice,
+			&stackable,
+			&tmpl.MaxDurability,
+			&tmpl.Bonding,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan item template: %w", err)
 		}
 
+		tmpl.Stackable = stackSize(stackable)
 		templates[tmpl.Entry] = &tmpl
 		count++
 	}
@@ -72,6 +88,25 @@ func NewItemTemplateCache(worldDB *sql.DB) (*ItemTemplateCache, error) {
 	}, nil
 }
 
+// stackSize converts stackable column value to the max stack size, not positive values mean not stackable item.
+func stackSize(stackable int32) uint32 {
+	if stackable < 1 {
+		return 1
+	}
+	return uint32(stackable)
+}
+
+// NewItemTemplateCacheWithTemplates creates cache with the given templates
+func NewItemTemplateCacheWithTemplates(templates []ItemTemplate) *ItemTemplateCache {
+	c := &ItemTemplateCache{
+		templates: make(map[uint32]*ItemTemplate, len(templates)),
+	}
+	for i := range templates {
+		c.templates[templates[i].Entry] = &templates[i]
+	}
+	return c
+}
+
 // Get returns an item template by entry ID
 func (c *ItemTemplateCache) Get(entry uint32) *ItemTemplate {
 	c.mu.RLock()
@@ -79,6 +114,20 @@ func (c *ItemTemplateCache) Get(entry uint32) *ItemTemplate {
 	return c.templates[entry]
 }
 
+// Templates returns all cached item templates that match the given predicate
+func (c *ItemTemplateCache) Templates(predicate func(*ItemTemplate) bool) []*ItemTemplate {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var result []*ItemTemplate
+	for _, tmpl := range c.templates {
+		if predicate(tmpl) {
+			result = append(result, tmpl)
+		}
+	}
+	return result
+}
+
 // MatchesFilters checks if an item matches the given auction search filters
 func (c *ItemTemplateCache) MatchesFilters(
 	entry uint32,
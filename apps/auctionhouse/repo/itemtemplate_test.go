@@ -450,3 +450,12 @@ func TestItemTemplateCache_NameSearching(t *testing.T) {
 		})
 	}
 }
+
+func TestStackSize(t *testing.T) {
+	tests := map[int32]uint32{-1: 1, 0: 1, 1: 1, 20: 20, 1000: 1000}
+	for stackable, expected := range tests {
+		if got := stackSize(stackable); got != expected {
+			t.Errorf("stackSize(%d) = %d, expected %d", stackable, got, expected)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// RealmOwnershipRepo stores alive auction house instances and realm ownership leases.
+// Instances are identified by their addresses.
+type RealmOwnershipRepo interface {
+	// Heartbeat marks the instance alive for the ttl.
+	Heartbeat(ctx context.Context, address string, ttl time.Duration) error
+
+	// RemoveInstance removes the instance from alive instances.
+	RemoveInstance(ctx context.Context, address string) error
+
+	// AliveInstances returns addresses of alive instances.
+	AliveInstances(ctx context.Context) ([]string, error)
+
+	// AcquireRealm acquires or prolongs realm lease for the instance.
+	// Returns false if the realm is owned by another instance.
+	AcquireRealm(ctx context.Context, realmID uint32, address string, ttl time.Duration) (bool, error)
+
+	// ReleaseRealm releases realm lease if it's owned by the instance.
+	ReleaseRealm(ctx context.Context, realmID uint32, address string) error
+
+	// RealmOwner returns address of the realm owner or empty string if the realm isn't owned.
+	RealmOwner(ctx context.Context, realmID uint32) (string, error)
+}
@@ -0,0 +1,88 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const redisAliveInstancesKey = "auctionhouse:instances"
+
+// acquireRealmScript sets lease owner if the lease is free and prolongs it if it's already owned by the instance.
+var acquireRealmScript = redis.NewScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner == false then
+	redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+	return 1
+end
+if owner == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseRealmScript removes the lease only if it's owned by the instance.
+var releaseRealmScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+type realmOwnershipRedisRepo struct {
+	rdb redis.UniversalClient
+}
+
+// NewRealmOwnershipRedisRepo returns realm ownership repo that keeps leases in redis keys with expiration.
+// Every script touches a single key, so it works with redis cluster too.
+func NewRealmOwnershipRedisRepo(rdb redis.UniversalClient) RealmOwnershipRepo {
+	return &realmOwnershipRedisRepo{rdb: rdb}
+}
+
+func (r *realmOwnershipRedisRepo) Heartbeat(ctx context.Context, address string, ttl time.Duration) error {
+	return r.rdb.ZAdd(ctx, redisAliveInstancesKey, redis.Z{
+		Score:  float64(time.Now().Add(ttl).UnixMilli()),
+		Member: address,
+	}).Err()
+}
+
+func (r *realmOwnershipRedisRepo) RemoveInstance(ctx context.Context, address string) error {
+	return r.rdb.ZRem(ctx, redisAliveInstancesKey, address).Err()
+}
+
+func (r *realmOwnershipRedisRepo) AliveInstances(ctx context.Context) ([]string, error) {
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	err := r.rdb.ZRemRangeByScore(ctx, redisAliveInstancesKey, "-inf", "("+now).Err()
+	if err != nil {
+		return nil, err
+	}
+	return r.rdb.ZRange(ctx, redisAliveInstancesKey, 0, -1).Result()
+}
+
+func (r *realmOwnershipRedisRepo) AcquireRealm(ctx context.Context, realmID uint32, address string, ttl time.Duration) (bool, error) {
+	res, err := acquireRealmScript.Run(ctx, r.rdb, []string{r.realmKey(realmID)}, address, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+func (r *realmOwnershipRedisRepo) ReleaseRealm(ctx context.Context, realmID uint32, address string) error {
+	return releaseRealmScript.Run(ctx, r.rdb, []string{r.realmKey(realmID)}, address).Err()
+}
+
+func (r *realmOwnershipRedisRepo) RealmOwner(ctx context.Context, realmID uint32) (string, error) {
+	owner, err := r.rdb.Get(ctx, r.realmKey(realmID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return owner, err
+}
+
+func (r *realmOwnershipRedisRepo) realmKey(realmID uint32) string {
+	return fmt.Sprintf("auctionhouse:realm:%d:owner", realmID)
+}
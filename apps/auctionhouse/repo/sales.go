@@ -0,0 +1,34 @@
+package repo
+
+import "context"
+
+// SaleEntry is a single completed sale in the auctionhouse_sales table.
+type SaleEntry struct {
+	HouseID   uint8
+	ItemEntry uint32
+	ItemCount uint32
+	Price     uint32 // price of the whole stack
+	IsBuyout  bool
+	SoldAt    int64 // unix timestamp
+}
+
+// UnitPrice returns price of one item in the stack.
+func (s SaleEntry) UnitPrice() uint32 {
+	if s.ItemCount == 0 {
+		return s.Price
+	}
+	return s.Price / s.ItemCount
+}
+
+// SalesRepo is the interface for completed sales persistence.
+type SalesRepo interface {
+	// AddSale records completed sale.
+	AddSale(ctx context.Context, realmID uint32, sale *SaleEntry) error
+
+	// SalesForItem returns sales of the item entry in the given period ordered by sale time.
+	// Returns sales of all houses if houseID is 0.
+	SalesForItem(ctx context.Context, realmID uint32, houseID uint8, itemEntry uint32, from, to int64) ([]SaleEntry, error)
+
+	// DeleteSalesBefore removes sales that are older than the given timestamp.
+	DeleteSalesBefore(ctx context.Context, realmID uint32, before int64) error
+}
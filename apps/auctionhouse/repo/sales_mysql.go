@@ -0,0 +1,64 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+type salesMySQLRepo struct {
+	db shrepo.CharactersDB
+}
+
+func NewSalesMySQLRepo(db shrepo.CharactersDB) (SalesRepo, error) {
+	db.SetPreparedStatement(StmtInsertSale)
+	db.SetPreparedStatement(StmtLoadSalesForItem)
+	db.SetPreparedStatement(StmtLoadSalesForItemInHouse)
+	db.SetPreparedStatement(StmtDeleteSalesBefore)
+
+	return &salesMySQLRepo{db: db}, nil
+}
+
+func (r *salesMySQLRepo) AddSale(ctx context.Context, realmID uint32, s *SaleEntry) error {
+	_, err := r.db.PreparedStatement(realmID, StmtInsertSale).ExecContext(ctx,
+		s.HouseID, s.ItemEntry, s.ItemCount, s.Price, s.IsBuyout, s.SoldAt,
+	)
+	if err != nil {
+		return fmt.Errorf("can't insert sale: %w", err)
+	}
+	return nil
+}
+
+func (r *salesMySQLRepo) SalesForItem(ctx context.Context, realmID uint32, houseID uint8, itemEntry uint32, from, to int64) ([]SaleEntry, error) {
+	stmt, args := StmtLoadSalesForItem, []interface{}{itemEntry, from, to}
+	if houseID != 0 {
+		stmt, args = StmtLoadSalesForItemInHouse, []interface{}{itemEntry, from, to, houseID}
+	}
+
+	rows, err := r.db.PreparedStatement(realmID, stmt).QueryContext(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can't load sales: %w", err)
+	}
+	defer rows.Close()
+
+	var sales []SaleEntry
+	for rows.Next() {
+		var s SaleEntry
+		err = rows.Scan(&s.HouseID, &s.ItemEntry, &s.ItemCount, &s.Price, &s.IsBuyout, &s.SoldAt)
+		if err != nil {
+			return nil, fmt.Errorf("can't scan sale: %w", err)
+		}
+		sales = append(sales, s)
+	}
+
+	return sales, rows.Err()
+}
+
+func (r *salesMySQLRepo) DeleteSalesBefore(ctx context.Context, realmID uint32, before int64) error {
+	_, err := r.db.PreparedStatement(realmID, StmtDeleteSalesBefore).ExecContext(ctx, before)
+	if err != nil {
+		return fmt.Errorf("can't delete sales: %w", err)
+	}
+	return nil
+}
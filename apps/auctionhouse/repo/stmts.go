@@ -8,6 +8,12 @@ const (
 	StmtUpdateBid
 	StmtDeleteAuction
 	StmtLoadAuctionByID
+	StmtInsertSale
+	StmtLoadSalesForItem
+	StmtLoadSalesForItemInHouse
+	StmtDeleteSalesBefore
+	StmtInsertItemInstance
+	StmtDeleteItemInstance
 )
 
 // CharsPreparedStatements represents prepared statements for the characters database.
@@ -48,6 +54,20 @@ func (s CharsPreparedStatements) Stmt() string {
 			FROM auctionhouse ah
 			LEFT JOIN item_instance ii ON ah.itemguid = ii.guid
 			WHERE ah.id = ?`
+	case StmtInsertSale:
+		return `INSERT INTO auctionhouse_sales (houseId, itemEntry, itemCount, price, isBuyout, soldAt) VALUES (?, ?, ?, ?, ?, ?)`
+	case StmtLoadSalesForItem:
+		return `SELECT houseId, itemEntry, itemCount, price, isBuyout, soldAt FROM auctionhouse_sales
+			WHERE itemEntry = ? AND soldAt >= ? AND soldAt <= ? ORDER BY soldAt`
+	case StmtLoadSalesForItemInHouse:
+		return `SELECT houseId, itemEntry, itemCount, price, isBuyout, soldAt FROM auctionhouse_sales
+			WHERE itemEntry = ? AND soldAt >= ? AND soldAt <= ? AND houseId = ? ORDER BY soldAt`
+	case StmtDeleteSalesBefore:
+		return `DELETE FROM auctionhouse_sales WHERE soldAt < ?`
+	case StmtInsertItemInstance:
+		return `INSERT INTO item_instance (guid, itemEntry, owner_guid, count, durability, enchantments) VALUES (?, ?, ?, ?, ?, '')`
+	case StmtDeleteItemInstance:
+		return `DELETE FROM item_instance WHERE guid = ?`
 	}
 	panic(fmt.Errorf("unk stmt %d", s))
 }
\ No newline at end of file
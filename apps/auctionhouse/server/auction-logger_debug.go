@@ -211,3 +211,45 @@ func (m *auctionHouseDebugLoggerMiddleware) ListPendingSales(ctx context.Context
 	resp, err = m.realService.ListPendingSales(ctx, req)
 	return
 }
+
+func (m *auctionHouseDebugLoggerMiddleware) MarketStats(ctx context.Context, req *pb.AuctionMarketStatsRequest) (resp *pb.AuctionMarketStatsResponse, err error) {
+	defer func(t time.Time) {
+		event := m.logger.Debug().
+			Uint32("realmID", req.RealmID).
+			Uint32("houseID", req.HouseID).
+			Uint32("itemEntry", req.ItemEntry).
+			Int64("fromTime", req.FromTime).
+			Int64("toTime", req.ToTime).
+			Uint32("bucketSecs", req.BucketSecs).
+			Str("timeTook", time.Since(t).String())
+
+		if resp != nil && resp.Total != nil {
+			event = event.
+				Uint32("salesCount", resp.Total.SalesCount).
+				Uint32("medianPrice", resp.Total.MedianPrice).
+				Int("seriesLen", len(resp.Series))
+		}
+
+		event.Msg("Handled MarketStats")
+	}(time.Now())
+
+	resp, err = m.realService.MarketStats(ctx, req)
+	return
+}
+
+func (m *auctionHouseDebugLoggerMiddleware) RealmOwner(ctx context.Context, req *pb.AuctionRealmOwnerRequest) (resp *pb.AuctionRealmOwnerResponse, err error) {
+	defer func(t time.Time) {
+		event := m.logger.Debug().
+			Uint32("realmID", req.RealmID).
+			Str("timeTook", time.Since(t).String())
+
+		if resp != nil {
+			event = event.Str("address", resp.Address)
+		}
+
+		event.Msg("Handled RealmOwner")
+	}(time.Now())
+
+	resp, err = m.realService.RealmOwner(ctx, req)
+	return
+}
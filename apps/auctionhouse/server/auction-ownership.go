@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/service"
+	"github.com/walkline/ToCloud9/gen/auctionhouse/pb"
+)
+
+// auctionHouseRealmOwnershipMiddleware middleware that rejects requests to the realms not owned by the instance.
+type auctionHouseRealmOwnershipMiddleware struct {
+	pb.UnimplementedAuctionHouseServiceServer
+	realService pb.AuctionHouseServiceServer
+	ownership   service.RealmOwnership
+}
+
+// NewAuctionHouseRealmOwnershipMiddleware returns middleware for pb.AuctionHouseServiceServer that serves only
+// the realms owned by the instance. Requests to other realms fail with codes.FailedPrecondition,
+// so the client can ask for the current owner with RealmOwner and retry.
+func NewAuctionHouseRealmOwnershipMiddleware(realService pb.AuctionHouseServiceServer, ownership service.RealmOwnership) pb.AuctionHouseServiceServer {
+	return &auctionHouseRealmOwnershipMiddleware{
+		realService: realService,
+		ownership:   ownership,
+	}
+}
+
+type realmRequest interface {
+	GetRealmID() uint32
+}
+
+// serveOwnedRealm calls handler while ownership of the realm of the request can't be handed over.
+func serveOwnedRealm[Req realmRequest, Resp any](ctx context.Context, ownership service.RealmOwnership, req Req, handler func(context.Context, Req) (Resp, error)) (Resp, error) {
+	release, owned := ownership.AcquireServing(req.GetRealmID())
+	if !owned {
+		var empty Resp
+		return empty, status.Errorf(codes.FailedPrecondition, "realm %d is not served by the instance", req.GetRealmID())
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) Hello(ctx context.Context, req *pb.AuctionHelloRequest) (*pb.AuctionHelloResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.Hello)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) SellItem(ctx context.Context, req *pb.AuctionSellItemRequest) (*pb.AuctionSellItemResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.SellItem)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) PlaceBid(ctx context.Context, req *pb.AuctionPlaceBidRequest) (*pb.AuctionPlaceBidResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.PlaceBid)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) CancelAuction(ctx context.Context, req *pb.AuctionCancelRequest) (*pb.AuctionCancelResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.CancelAuction)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) ListItems(ctx context.Context, req *pb.AuctionListItemsRequest) (*pb.AuctionListItemsResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.ListItems)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) ListOwnerItems(ctx context.Context, req *pb.AuctionListOwnerItemsRequest) (*pb.AuctionListOwnerItemsResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.ListOwnerItems)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) ListBidderItems(ctx context.Context, req *pb.AuctionListBidderItemsRequest) (*pb.AuctionListBidderItemsResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.ListBidderItems)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) ListPendingSales(ctx context.Context, req *pb.AuctionListPendingSalesRequest) (*pb.AuctionListPendingSalesResponse, error) {
+	return serveOwnedRealm(ctx, m.ownership, req, m.realService.ListPendingSales)
+}
+
+// MarketStats reads sales history from the database, so any instance can serve it.
+func (m *auctionHouseRealmOwnershipMiddleware) MarketStats(ctx context.Context, req *pb.AuctionMarketStatsRequest) (*pb.AuctionMarketStatsResponse, error) {
+	return m.realService.MarketStats(ctx, req)
+}
+
+func (m *auctionHouseRealmOwnershipMiddleware) RealmOwner(ctx context.Context, req *pb.AuctionRealmOwnerRequest) (*pb.AuctionRealmOwnerResponse, error) {
+	return m.realService.RealmOwner(ctx, req)
+}
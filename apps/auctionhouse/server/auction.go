@@ -6,6 +6,9 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
 	"github.com/walkline/ToCloud9/apps/auctionhouse/service"
 	"github.com/walkline/ToCloud9/gen/auctionhouse/pb"
@@ -13,11 +16,12 @@ import (
 
 type AuctionHouseServer struct {
 	pb.UnimplementedAuctionHouseServiceServer
-	service *service.AuctionService
+	service   *service.AuctionService
+	ownership service.RealmOwnership
 }
 
-func NewAuctionHouseServer(svc *service.AuctionService) pb.AuctionHouseServiceServer {
-	return &AuctionHouseServer{service: svc}
+func NewAuctionHouseServer(svc *service.AuctionService, ownership service.RealmOwnership) pb.AuctionHouseServiceServer {
+	return &AuctionHouseServer{service: svc, ownership: ownership}
 }
 
 func (s *AuctionHouseServer) Hello(_ context.Context, req *pb.AuctionHelloRequest) (*pb.AuctionHelloResponse, error) {
@@ -152,6 +156,55 @@ func (s *AuctionHouseServer) ListPendingSales(_ context.Context, _ *pb.AuctionLi
 	}, nil
 }
 
+func (s *AuctionHouseServer) MarketStats(ctx context.Context, req *pb.AuctionMarketStatsRequest) (*pb.AuctionMarketStatsResponse, error) {
+	total, series, err := s.service.MarketStats(
+		ctx, req.RealmID, uint8(req.HouseID), req.ItemEntry,
+		req.FromTime, req.ToTime, int64(req.BucketSecs),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AuctionMarketStatsResponse{
+		ItemEntry: req.ItemEntry,
+		HouseID:   req.HouseID,
+		Total:     marketStatsToProto(total),
+		Series:    make([]*pb.AuctionMarketStatsPoint, len(series)),
+	}
+	for i, point := range series {
+		resp.Series[i] = &pb.AuctionMarketStatsPoint{
+			Time:  point.Time,
+			Stats: marketStatsToProto(point.MarketStats),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *AuctionHouseServer) RealmOwner(ctx context.Context, req *pb.AuctionRealmOwnerRequest) (*pb.AuctionRealmOwnerResponse, error) {
+	address, err := s.ownership.RealmOwner(ctx, req.RealmID)
+	if err != nil {
+		if errors.Is(err, service.ErrRealmNotOwned) {
+			return nil, status.Errorf(codes.Unavailable, "realm %d has no owner yet", req.RealmID)
+		}
+		return nil, err
+	}
+
+	return &pb.AuctionRealmOwnerResponse{
+		Address: address,
+	}, nil
+}
+
+func marketStatsToProto(stats service.MarketStats) *pb.AuctionMarketStats {
+	return &pb.AuctionMarketStats{
+		SalesCount:  stats.SalesCount,
+		Volume:      stats.Volume,
+		MinPrice:    stats.MinPrice,
+		MedianPrice: stats.MedianPrice,
+		MeanPrice:   stats.MeanPrice,
+	}
+}
+
 func cachedAuctionsToProto(auctions []*service.CachedAuction) []*pb.AuctionItem {
 	result := make([]*pb.AuctionItem, len(auctions))
 	for i, a := range auctions {
@@ -3,7 +3,6 @@ package service
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -75,24 +74,26 @@ type CachedAuction struct {
 
 type AuctionService struct {
 	repo           repo.AuctionRepo
+	salesRepo      repo.SalesRepo
 	mailClient     pbMail.MailServiceClient
 	eventsProducer events.AuctionHouseProducer
 	itemTemplates  *repo.ItemTemplateCache
 
-	mu       sync.RWMutex
-	auctions map[uint32]map[uint32]*CachedAuction // realmID -> auctionID -> entry
+	mu     sync.RWMutex
+	houses map[uint32]map[uint8]*auctionHouseIndex // realmID -> houseID -> auctions
 
 	nextID   uint32
 	nextIDMu sync.Mutex
 }
 
-func NewAuctionService(r repo.AuctionRepo, mailClient pbMail.MailServiceClient, eventsProducer events.AuctionHouseProducer, itemTemplates *repo.ItemTemplateCache) *AuctionService {
+func NewAuctionService(r repo.AuctionRepo, salesRepo repo.SalesRepo, mailClient pbMail.MailServiceClient, eventsProducer events.AuctionHouseProducer, itemTemplates *repo.ItemTemplateCache) *AuctionService {
 	return &AuctionService{
 		repo:           r,
+		salesRepo:      salesRepo,
 		mailClient:     mailClient,
 		eventsProducer: eventsProducer,
 		itemTemplates:  itemTemplates,
-		auctions:       make(map[uint32]map[uint32]*CachedAuction),
+		houses:         make(map[uint32]map[uint8]*auctionHouseIndex),
 	}
 }
 
@@ -102,26 +103,96 @@ func (s *AuctionService) LoadAuctions(ctx context.Context, realmID uint32) error
 		return fmt.Errorf("can't load auctions: %w", err)
 	}
 
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	if s.auctions[realmID] == nil {
-		s.auctions[realmID] = make(map[uint32]*CachedAuction)
-	}
-
 	maxID := uint32(0)
 	for i := range entries {
-		s.auctions[realmID][entries[i].ID] = &CachedAuction{AuctionEntry: entries[i]}
+		s.cacheAuction(realmID, &entries[i])
 		if entries[i].ID > maxID {
 			maxID = entries[i].ID
 		}
 	}
-	s.nextID = maxID + 1
+
+	// Realm could be served by another instance before, so ID only moves forward.
+	s.nextIDMu.Lock()
+	if maxID+1 > s.nextID {
+		s.nextID = maxID + 1
+	}
+	s.nextIDMu.Unlock()
 
 	log.Info().Uint32("realmID", realmID).Int("count", len(entries)).Msg("Loaded auctions into memory cache")
 	return nil
 }
 
+// auctionHouse returns auctions of the house, creates empty house if create is true.
+func (s *AuctionService) auctionHouse(realmID uint32, houseID uint8, create bool) *auctionHouseIndex {
+	s.mu.RLock()
+	h := s.houses[realmID][houseID]
+	s.mu.RUnlock()
+	if h != nil || !create {
+		return h
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.houses[realmID] == nil {
+		s.houses[realmID] = make(map[uint8]*auctionHouseIndex)
+	}
+	if h = s.houses[realmID][houseID]; h == nil {
+		h = newAuctionHouseIndex()
+		s.houses[realmID][houseID] = h
+	}
+	return h
+}
+
+// auctionHouseOf returns auctions of the house that contains the auction.
+func (s *AuctionService) auctionHouseOf(realmID, auctionID uint32) *auctionHouseIndex {
+	s.mu.RLock()
+	houses := make([]*auctionHouseIndex, 0, len(s.houses[realmID]))
+	for _, h := range s.houses[realmID] {
+		houses = append(houses, h)
+	}
+	s.mu.RUnlock()
+
+	for _, h := range houses {
+		if h.has(auctionID) {
+			return h
+		}
+	}
+	return nil
+}
+
+// cacheAuction adds the auction to the in-memory cache or replaces existing one.
+func (s *AuctionService) cacheAuction(realmID uint32, entry *repo.AuctionEntry) {
+	s.auctionHouse(realmID, entry.HouseID, true).add(CachedAuction{AuctionEntry: *entry}, s.itemTemplates.Get(entry.ItemEntry))
+}
+
+// uncacheAuction removes the auction from the in-memory cache.
+func (s *AuctionService) uncacheAuction(realmID, auctionID uint32) (*CachedAuction, bool) {
+	h := s.auctionHouseOf(realmID, auctionID)
+	if h == nil {
+		return nil, false
+	}
+	return h.remove(auctionID)
+}
+
+// cachedAuction returns copy of the cached auction.
+func (s *AuctionService) cachedAuction(realmID, auctionID uint32) (*CachedAuction, bool) {
+	h := s.auctionHouseOf(realmID, auctionID)
+	if h == nil {
+		return nil, false
+	}
+	return h.get(auctionID)
+}
+
+// UnloadAuctions removes auctions of the realm from the memory cache.
+func (s *AuctionService) UnloadAuctions(realmID uint32) {
+	s.mu.Lock()
+	delete(s.houses, realmID)
+	s.mu.Unlock()
+
+	log.Info().Uint32("realmID", realmID).Msg("Unloaded auctions from memory cache")
+}
+
 func (s *AuctionService) generateAuctionID() uint32 {
 	s.nextIDMu.Lock()
 	defer s.nextIDMu.Unlock()
@@ -140,12 +211,7 @@ func (s *AuctionService) SellItem(ctx context.Context, realmID uint32, playerGUI
 		return 0, err
 	}
 
-	s.mu.Lock()
-	if s.auctions[realmID] == nil {
-		s.auctions[realmID] = make(map[uint32]*CachedAuction)
-	}
-	s.auctions[realmID][auctionID] = &CachedAuction{AuctionEntry: *entry}
-	s.mu.Unlock()
+	s.cacheAuction(realmID, entry)
 
 	// Publish event for other instances to update their cache
 	_ = s.eventsProducer.PublishAuctionCreated(&events.AuctionHouseEventAuctionCreatedPayload{
@@ -171,32 +237,32 @@ func (s *AuctionService) SellItem(ctx context.Context, realmID uint32, playerGUI
 }
 
 func (s *AuctionService) PlaceBid(ctx context.Context, realmID uint32, playerGUID uint64, auctionID, price uint32) (isBuyout bool, moneyToDeduct uint32, err error) {
-	// Validate and copy auction data under lock
-	s.mu.Lock()
-	realmAuctions, ok := s.auctions[realmID]
-	if !ok {
-		s.mu.Unlock()
+	house := s.auctionHouseOf(realmID, auctionID)
+	if house == nil {
 		return false, 0, ErrAuctionNotFound
 	}
-	auction, ok := realmAuctions[auctionID]
+
+	// Validate and copy auction data under lock
+	house.mu.Lock()
+	auction, ok := house.auctions[auctionID]
 	if !ok {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return false, 0, ErrAuctionNotFound
 	}
 
 	if auction.ItemOwner == uint32(playerGUID) {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return false, 0, ErrBidOwnAuction
 	}
 
 	if price <= auction.LastBid || price < auction.StartBid {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return false, 0, ErrBidTooLow
 	}
 
 	outBid := calculateOutBid(auction.LastBid)
 	if (price < auction.BuyoutPrice || auction.BuyoutPrice == 0) && price < auction.LastBid+outBid {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return false, 0, ErrBidIncrementTooLow
 	}
 
@@ -212,12 +278,11 @@ func (s *AuctionService) PlaceBid(ctx context.Context, realmID uint32, playerGUI
 			moneyToDeduct = price
 		}
 
-		auction.BuyGUID = uint32(playerGUID)
-		auction.LastBid = price
+		house.setBidLocked(auction, uint32(playerGUID), price)
 
 		// Copy data for mail sending outside lock
-		auctionCopy := *auction
-		s.mu.Unlock()
+		auctionCopy := auction.CachedAuction
+		house.mu.Unlock()
 
 		// DB update (outside lock)
 		err = s.repo.UpdateBid(ctx, realmID, auctionID, uint32(playerGUID), price)
@@ -255,7 +320,7 @@ func (s *AuctionService) PlaceBid(ctx context.Context, realmID uint32, playerGUI
 	auctionCopy := auction.AuctionEntry
 	auctionCopy.BuyGUID = uint32(playerGUID)
 	auctionCopy.LastBid = auction.BuyoutPrice
-	s.mu.Unlock()
+	house.mu.Unlock()
 
 	// Send mails first (before DB delete, so we can still see auction in DB if mails fail)
 	if err = s.sendAuctionSuccessfulMail(ctx, realmID, &auctionCopy); err != nil {
@@ -281,11 +346,9 @@ func (s *AuctionService) PlaceBid(ctx context.Context, realmID uint32, playerGUI
 	}
 
 	// Now remove from cache (after all I/O succeeded)
-	s.mu.Lock()
-	if s.auctions[realmID] != nil {
-		delete(s.auctions[realmID], auctionID)
-	}
-	s.mu.Unlock()
+	house.remove(auctionID)
+
+	s.recordSale(ctx, realmID, &auctionCopy, true)
 
 	// Publish buyout event for other instances
 	_ = s.eventsProducer.PublishBidPlaced(&events.AuctionHouseEventBidPlacedPayload{
@@ -302,26 +365,26 @@ func (s *AuctionService) PlaceBid(ctx context.Context, realmID uint32, playerGUI
 }
 
 func (s *AuctionService) CancelAuction(ctx context.Context, realmID uint32, playerGUID uint64, auctionID uint32) (auctionCut uint32, err error) {
-	s.mu.Lock()
-	realmAuctions, ok := s.auctions[realmID]
-	if !ok {
-		s.mu.Unlock()
+	house := s.auctionHouseOf(realmID, auctionID)
+	if house == nil {
 		return 0, ErrAuctionNotFound
 	}
-	auction, ok := realmAuctions[auctionID]
+
+	house.mu.Lock()
+	auction, ok := house.auctions[auctionID]
 	if !ok {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return 0, ErrAuctionNotFound
 	}
 
 	if auction.ItemOwner != uint32(playerGUID) {
-		s.mu.Unlock()
+		house.mu.Unlock()
 		return 0, fmt.Errorf("not auction owner")
 	}
 
 	auctionCopy := auction.AuctionEntry
-	delete(realmAuctions, auctionID)
-	s.mu.Unlock()
+	house.removeLocked(auction)
+	house.mu.Unlock()
 
 	if auctionCopy.BuyGUID != 0 {
 		auctionCut = calculateAuctionCut(auctionCopy.HouseID, auctionCopy.LastBid)
@@ -344,136 +407,61 @@ func (s *AuctionService) CancelAuction(ctx context.Context, realmID uint32, play
 	return auctionCut, err
 }
 
+// ListItems returns copies of auctions on the requested page and the total amount of matched auctions.
 func (s *AuctionService) ListItems(realmID uint32, houseID uint32, listFrom uint32, searchedName string,
 	levelMin, levelMax, inventoryType, itemClass, itemSubClass, quality uint32,
 	getAll bool, sorting []AuctionSortInfo,
 ) ([]*CachedAuction, uint32) {
-
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	realmAuctions := s.auctions[realmID]
-	if realmAuctions == nil {
+	house := s.auctionHouse(realmID, uint8(houseID), false)
+	if house == nil {
 		return nil, 0
 	}
 
-	lowerSearch := strings.ToLower(searchedName)
-
 	if getAll {
-		var result []*CachedAuction
-		for _, a := range realmAuctions {
-			if uint32(a.HouseID) != houseID {
-				continue
-			}
-			result = append(result, a)
-			if uint32(len(result)) >= MaxGetAllReturn {
-				break
-			}
-		}
-		return result, uint32(len(realmAuctions))
-	}
-
-	// Collect matching auctions
-	var matched []*CachedAuction
-	for _, a := range realmAuctions {
-		if uint32(a.HouseID) != houseID {
-			continue
-		}
-
-		// Apply item template filters
-		if !s.itemTemplates.MatchesFilters(
-			a.ItemEntry,
-			lowerSearch,
-			levelMin, levelMax,
-			inventoryType,
-			itemClass, itemSubClass,
-			quality,
-		) {
-			continue
-		}
-
-		matched = append(matched, a)
-	}
-
-	totalCount := uint32(len(matched))
-
-	if len(sorting) > 0 && len(matched) > MaxAuctionsPerPage {
-		sort.Slice(matched, func(i, j int) bool {
-			for _, s := range sorting {
-				cmp := compareAuctions(matched[i], matched[j], s.Column)
-				if cmp == 0 {
-					continue
-				}
-				if s.IsDesc {
-					return cmp < 0
-				}
-				return cmp > 0
-			}
-			return false
-		})
-	}
-
-	// Pagination
-	if listFrom >= uint32(len(matched)) {
-		return nil, totalCount
-	}
-	matched = matched[listFrom:]
-	if uint32(len(matched)) > MaxAuctionsPerPage {
-		matched = matched[:MaxAuctionsPerPage]
-	}
-
-	return matched, totalCount
+		return house.all(MaxGetAllReturn)
+	}
+
+	return house.search(&auctionFilter{
+		name:          strings.ToLower(searchedName),
+		levelMin:      levelMin,
+		levelMax:      levelMax,
+		inventoryType: inventoryType,
+		itemClass:     itemClass,
+		itemSubClass:  itemSubClass,
+		quality:       quality,
+	}, listFrom, sorting)
 }
 
+// ListOwnerItems returns copies of the player auctions.
 func (s *AuctionService) ListOwnerItems(realmID uint32, playerGUID uint64, houseID uint32) []*CachedAuction {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	realmAuctions := s.auctions[realmID]
-	if realmAuctions == nil {
+	house := s.auctionHouse(realmID, uint8(houseID), false)
+	if house == nil {
 		return nil
 	}
-
-	var result []*CachedAuction
-	ownerLow := uint32(playerGUID)
-	for _, a := range realmAuctions {
-		if uint32(a.HouseID) != houseID {
-			continue
-		}
-		if a.ItemOwner == ownerLow {
-			result = append(result, a)
-		}
-	}
-	return result
+	return house.ownedBy(uint32(playerGUID))
 }
 
+// ListBidderItems returns copies of outbidded auctions followed by auctions where the player is the highest bidder.
 func (s *AuctionService) ListBidderItems(realmID uint32, playerGUID uint64, houseID uint32, outbiddedIDs []uint32) []*CachedAuction {
-	s.mu.RLock()
-	defer s.mu.RUnlock()
-
-	realmAuctions := s.auctions[realmID]
-	if realmAuctions == nil {
+	house := s.auctionHouse(realmID, uint8(houseID), false)
+	if house == nil {
 		return nil
 	}
 
-	bidderLow := uint32(playerGUID)
 	seen := make(map[uint32]bool)
 	var result []*CachedAuction
 
 	// First add outbidded auctions
 	for _, id := range outbiddedIDs {
-		if a, ok := realmAuctions[id]; ok && uint32(a.HouseID) == houseID {
+		if a, ok := house.get(id); ok {
 			result = append(result, a)
 			seen[id] = true
 		}
 	}
 
 	// Then add current bids
-	for _, a := range realmAuctions {
-		if uint32(a.HouseID) != houseID {
-			continue
-		}
-		if a.BuyGUID == bidderLow && !seen[a.ID] {
+	for _, a := range house.bidBy(uint32(playerGUID)) {
+		if !seen[a.ID] {
 			result = append(result, a)
 		}
 	}
@@ -485,17 +473,17 @@ func (s *AuctionService) ProcessExpiredAuctions(ctx context.Context, realmID uin
 
 	// Get candidate expired auctions from cache (eventually consistent)
 	s.mu.RLock()
-	realmAuctions := s.auctions[realmID]
-	var candidates []uint32
-	if realmAuctions != nil {
-		for _, a := range realmAuctions {
-			if a.Time <= now {
-				candidates = append(candidates, a.ID)
-			}
-		}
+	var houses []*auctionHouseIndex
+	for _, h := range s.houses[realmID] {
+		houses = append(houses, h)
 	}
 	s.mu.RUnlock()
 
+	var candidates []uint32
+	for _, h := range houses {
+		candidates = append(candidates, h.expiredIDs(now)...)
+	}
+
 	// Process each candidate auction
 	// The database delete will act as a distributed lock - only one instance succeeds
 	processedCount := 0
@@ -505,11 +493,7 @@ func (s *AuctionService) ProcessExpiredAuctions(ctx context.Context, realmID uin
 		if !deleted {
 			// Another instance already processed this auction
 			// Remove from local cache anyway
-			s.mu.Lock()
-			if s.auctions[realmID] != nil {
-				delete(s.auctions[realmID], auctionID)
-			}
-			s.mu.Unlock()
+			s.uncacheAuction(realmID, auctionID)
 			continue
 		}
 
@@ -517,11 +501,7 @@ func (s *AuctionService) ProcessExpiredAuctions(ctx context.Context, realmID uin
 		processedCount++
 
 		// Remove from cache
-		s.mu.Lock()
-		if s.auctions[realmID] != nil {
-			delete(s.auctions[realmID], auctionID)
-		}
-		s.mu.Unlock()
+		s.uncacheAuction(realmID, auctionID)
 
 		// Send mails
 		if auction.BuyGUID == 0 {
@@ -540,6 +520,8 @@ func (s *AuctionService) ProcessExpiredAuctions(ctx context.Context, realmID uin
 				log.Error().Err(err).Uint32("auctionID", auctionID).Msg("Failed to send buyer item for expired auction - item lost")
 				// Continue processing - auction is already deleted from DB
 			}
+
+			s.recordSale(ctx, realmID, auction, false)
 		}
 
 		// Publish expiration event for other instances
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse"
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+	guidPB "github.com/walkline/ToCloud9/gen/guid/pb"
+)
+
+// Item qualities
+const (
+	ItemQualityPoor      = 0
+	ItemQualityNormal    = 1
+	ItemQualityUncommon  = 2
+	ItemQualityRare      = 3
+	ItemQualityEpic      = 4
+	ItemQualityLegendary = 5
+	ItemQualityArtifact  = 6
+
+	itemQualitiesCount = 7
+)
+
+const (
+	itemClassQuest = 12
+	itemClassKey   = 13
+
+	itemBondingPickedUp = 1
+	itemBondingQuest    = 4
+)
+
+// AuctionBotStartBidPct is the start bid of bot auctions in percents of the buyout price.
+const AuctionBotStartBidPct = 80
+
+// auctionBotDurations are auction durations the bot chooses from (12h, 24h, 48h).
+var auctionBotDurations = []uint32{MinAuctionTime, MinAuctionTime * 2, MinAuctionTime * 4}
+
+// AuctionBotHouseConfig is configuration of the auction bot for a single auction house.
+type AuctionBotHouseConfig struct {
+	// MaxAuctions is the amount of auctions the bot keeps listed in the house.
+	MaxAuctions int
+
+	// QualityWeights are relative chances to list an item of the quality, indexed by item quality.
+	QualityWeights []uint32
+
+	// PriceMultipliers multiply vendor price of the item to get the bot price, indexed by item quality.
+	// Missing or zero multipliers are treated as 1.
+	PriceMultipliers []float64
+
+	// PriceVariancePct is max random deviation of the listing price from the bot price in percents.
+	PriceVariancePct uint32
+
+	// BuyoutBelowPct is the threshold in percents of the bot price, player auctions
+	// with buyout below the threshold are bought out. 0 disables buyouts.
+	BuyoutBelowPct uint32
+
+	// BidBelowPct is the threshold in percents of the bot price, the bot bids on player auctions
+	// if the next bid is below the threshold. 0 disables bids.
+	BidBelowPct uint32
+
+	// MaxPurchasesPerTick limits the amount of bids and buyouts the bot makes in the house per tick.
+	MaxPurchasesPerTick int
+}
+
+// AuctionBotConfig is configuration of the auction bot.
+type AuctionBotConfig struct {
+	// CharacterGUIDs maps realm ID to the low guid of the character that owns bot auctions.
+	CharacterGUIDs map[uint32]uint32
+
+	// ItemsPerTick limits the amount of items the bot lists in the house per tick.
+	ItemsPerTick int
+
+	// Houses maps auction house ID to its bot configuration. Houses without configuration are ignored.
+	Houses map[uint8]AuctionBotHouseConfig
+}
+
+// ItemGuidProvider provides guids for the items created by the bot.
+type ItemGuidProvider interface {
+	ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint32, error)
+}
+
+// AuctionBot keeps auction houses stocked with items from the item templates cache and buys
+// cheap player auctions. It works through the same SellItem and PlaceBid flows as players do,
+// so items and money are addressed to the bot character by mail. Such mails are expected to be
+// skipped by the mail client created with NewAuctionBotMailFilter, otherwise they accumulate.
+type AuctionBot struct {
+	service   *AuctionService
+	itemRepo  repo.ItemRepo
+	guids     ItemGuidProvider
+	templates *repo.ItemTemplateCache
+	cfg       AuctionBotConfig
+
+	rnd        *rand.Rand
+	candidates [itemQualitiesCount][]*repo.ItemTemplate
+
+	// spareGuids are item guids per realm that were allocated, but not used because of errors.
+	// They are used by the next ticks before new guids are requested.
+	spareGuids map[uint32][]uint32
+}
+
+func NewAuctionBot(svc *AuctionService, itemRepo repo.ItemRepo, guids ItemGuidProvider, templates *repo.ItemTemplateCache, cfg AuctionBotConfig) *AuctionBot {
+	b := &AuctionBot{
+		service:    svc,
+		itemRepo:   itemRepo,
+		guids:      guids,
+		templates:  templates,
+		cfg:        cfg,
+		rnd:        rand.New(rand.NewSource(time.Now().UnixNano())),
+		spareGuids: map[uint32][]uint32{},
+	}
+
+	for _, tmpl := range templates.Templates(isAuctionBotCandidate) {
+		b.candidates[tmpl.Quality] = append(b.candidates[tmpl.Quality], tmpl)
+	}
+
+	return b
+}
+
+// Tick lists new items and makes purchases in every configured house of the realm.
+// Realms without bot character are skipped. Tick is not safe for concurrent use.
+func (b *AuctionBot) Tick(ctx context.Context, realmID uint32) {
+	botGUID, ok := b.cfg.CharacterGUIDs[realmID]
+	if !ok {
+		return
+	}
+
+	for houseID, house := range b.cfg.Houses {
+		if err := b.sellItems(ctx, realmID, botGUID, houseID, house); err != nil {
+			log.Error().Err(err).Uint32("realmID", realmID).Uint8("houseID", houseID).Msg("auction bot can't sell items")
+		}
+
+		b.buyItems(ctx, realmID, botGUID, houseID, house)
+	}
+}
+
+func (b *AuctionBot) sellItems(ctx context.Context, realmID, botGUID uint32, houseID uint8, house AuctionBotHouseConfig) error {
+	toList := house.MaxAuctions - len(b.service.ListOwnerItems(realmID, uint64(botGUID), uint32(houseID)))
+	if toList > b.cfg.ItemsPerTick {
+		toList = b.cfg.ItemsPerTick
+	}
+	if toList <= 0 || b.totalWeight(house) == 0 {
+		return nil
+	}
+
+	guids, err := b.itemGuids(ctx, realmID, toList)
+	if err != nil {
+		return fmt.Errorf("can't get item guids: %w", err)
+	}
+
+	now := uint32(time.Now().Unix())
+	for i, guid := range guids {
+		tmpl := b.pickTemplate(house)
+
+		count := uint32(1)
+		if tmpl.Stackable > 1 {
+			count += uint32(b.rnd.Intn(int(tmpl.Stackable)))
+		}
+
+		variance := (b.rnd.Float64()*2 - 1) * float64(house.PriceVariancePct) / 100
+		buyout := clampPrice(float64(auctionBotPrice(tmpl, house)) * (1 + variance) * float64(count))
+		if buyout == 0 {
+			buyout = 1
+		}
+
+		err = b.itemRepo.CreateItem(ctx, realmID, &repo.ItemInstance{
+			GUID:       guid,
+			Entry:      tmpl.Entry,
+			OwnerGUID:  botGUID,
+			Count:      count,
+			Durability: tmpl.MaxDurability,
+		})
+		if err != nil {
+			b.keepSpareGuids(realmID, guids[i:])
+			return err
+		}
+
+		_, err = b.service.SellItem(ctx, realmID, uint64(botGUID), &repo.AuctionEntry{
+			HouseID:     houseID,
+			ItemGUID:    guid,
+			BuyoutPrice: buyout,
+			Time:        now + auctionBotDurations[b.rnd.Intn(len(auctionBotDurations))],
+			StartBid:    clampPrice(float64(buyout) * AuctionBotStartBidPct / 100),
+			ItemEntry:   tmpl.Entry,
+			ItemCount:   count,
+		})
+		if err != nil {
+			// Item that isn't listed is deleted, so its guid can be used again.
+			if deleteErr := b.itemRepo.DeleteItem(ctx, realmID, guid); deleteErr != nil {
+				log.Error().Err(deleteErr).Uint32("realmID", realmID).Uint32("itemGUID", guid).Msg("auction bot can't delete not listed item")
+				b.keepSpareGuids(realmID, guids[i+1:])
+			} else {
+				b.keepSpareGuids(realmID, guids[i:])
+			}
+			return fmt.Errorf("can't sell item: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// itemGuids returns count guids for the new items, spare guids are used first.
+func (b *AuctionBot) itemGuids(ctx context.Context, realmID uint32, count int) ([]uint32, error) {
+	spare := b.spareGuids[realmID]
+	if len(spare) >= count {
+		b.spareGuids[realmID] = spare[count:]
+		return spare[:count], nil
+	}
+
+	guids, err := b.guids.ItemGuids(ctx, realmID, count-len(spare))
+	if err != nil {
+		return nil, err
+	}
+
+	delete(b.spareGuids, realmID)
+	return append(spare, guids...), nil
+}
+
+// keepSpareGuids keeps allocated guids that were not used for the next ticks.
+func (b *AuctionBot) keepSpareGuids(realmID uint32, guids []uint32) {
+	b.spareGuids[realmID] = append(append([]uint32(nil), b.spareGuids[realmID]...), guids...)
+}
+
+func (b *AuctionBot) buyItems(ctx context.Context, realmID, botGUID uint32, houseID uint8, house AuctionBotHouseConfig) {
+	if house.BuyoutBelowPct == 0 && house.BidBelowPct == 0 {
+		return
+	}
+
+	auctions, _ := b.service.ListItems(realmID, uint32(houseID), 0, "", 0, 0, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, true, nil)
+
+	purchases := 0
+	for _, a := range auctions {
+		if purchases >= house.MaxPurchasesPerTick {
+			return
+		}
+
+		if a.ItemOwner == botGUID || a.BuyGUID == botGUID {
+			continue
+		}
+
+		tmpl := b.templates.Get(a.ItemEntry)
+		if tmpl == nil || auctionBotVendorPrice(tmpl) == 0 {
+			continue
+		}
+
+		price, ok := auctionBotPurchasePrice(&a.AuctionEntry, uint64(auctionBotPrice(tmpl, house))*uint64(a.ItemCount), house)
+		if !ok {
+			continue
+		}
+
+		isBuyout, _, err := b.service.PlaceBid(ctx, realmID, uint64(botGUID), a.ID, price)
+		if err != nil {
+			// Auction can be changed by players or other instances in the meantime.
+			log.Debug().Err(err).Uint32("realmID", realmID).Uint32("auctionID", a.ID).Msg("auction bot can't place bid")
+			continue
+		}
+
+		log.Debug().
+			Uint32("realmID", realmID).
+			Uint32("auctionID", a.ID).
+			Uint32("price", price).
+			Bool("isBuyout", isBuyout).
+			Msg("auction bot placed bid")
+
+		purchases++
+	}
+}
+
+func (b *AuctionBot) totalWeight(house AuctionBotHouseConfig) uint32 {
+	total := uint32(0)
+	for quality, weight := range house.QualityWeights {
+		if quality < itemQualitiesCount && len(b.candidates[quality]) > 0 {
+			total += weight
+		}
+	}
+	return total
+}
+
+// pickTemplate returns random template according to the quality weights of the house.
+// Total weight of the house should be positive.
+func (b *AuctionBot) pickTemplate(house AuctionBotHouseConfig) *repo.ItemTemplate {
+	roll := uint32(b.rnd.Int63n(int64(b.totalWeight(house))))
+	for quality, weight := range house.QualityWeights {
+		if quality >= itemQualitiesCount || len(b.candidates[quality]) == 0 {
+			continue
+		}
+		if roll < weight {
+			candidates := b.candidates[quality]
+			return candidates[b.rnd.Intn(len(candidates))]
+		}
+		roll -= weight
+	}
+	return nil
+}
+
+// auctionBotPurchasePrice returns price the bot is ready to pay for the auction with the given bot value.
+func auctionBotPurchasePrice(a *repo.AuctionEntry, value uint64, house AuctionBotHouseConfig) (uint32, bool) {
+	if house.BuyoutBelowPct > 0 && a.BuyoutPrice > 0 && uint64(a.BuyoutPrice)*100 <= value*uint64(house.BuyoutBelowPct) {
+		return a.BuyoutPrice, true
+	}
+
+	if house.BidBelowPct == 0 {
+		return 0, false
+	}
+
+	nextBid := a.StartBid
+	if a.LastBid > 0 {
+		nextBid = a.LastBid + calculateOutBid(a.LastBid)
+	}
+
+	if a.BuyoutPrice > 0 && nextBid >= a.BuyoutPrice {
+		return 0, false
+	}
+
+	if uint64(nextBid)*100 > value*uint64(house.BidBelowPct) {
+		return 0, false
+	}
+
+	return nextBid, true
+}
+
+// auctionBotPrice returns the price of a single item the bot considers fair.
+func auctionBotPrice(tmpl *repo.ItemTemplate, house AuctionBotHouseConfig) uint32 {
+	multiplier := 1.0
+	if int(tmpl.Quality) < len(house.PriceMultipliers) && house.PriceMultipliers[tmpl.Quality] > 0 {
+		multiplier = house.PriceMultipliers[tmpl.Quality]
+	}
+	return clampPrice(float64(auctionBotVendorPrice(tmpl)) * multiplier)
+}
+
+func auctionBotVendorPrice(tmpl *repo.ItemTemplate) uint32 {
+	if tmpl.BuyPrice > 0 {
+		return tmpl.BuyPrice
+	}
+	return tmpl.SellPrice * 4
+}
+
+func isAuctionBotCandidate(tmpl *repo.ItemTemplate) bool {
+	if tmpl.Quality >= itemQualitiesCount || auctionBotVendorPrice(tmpl) == 0 {
+		return false
+	}
+	if tmpl.Class == itemClassQuest || tmpl.Class == itemClassKey {
+		return false
+	}
+	return tmpl.Bonding != itemBondingPickedUp && tmpl.Bonding != itemBondingQuest
+}
+
+func clampPrice(price float64) uint32 {
+	if price >= math.MaxUint32 {
+		return math.MaxUint32
+	}
+	if price < 0 {
+		return 0
+	}
+	return uint32(price)
+}
+
+// guidServiceItemGuidProvider requests item guids from the guid service.
+type guidServiceItemGuidProvider struct {
+	client guidPB.GuidServiceClient
+}
+
+// NewGuidServiceItemGuidProvider returns ItemGuidProvider that uses guid service.
+func NewGuidServiceItemGuidProvider(client guidPB.GuidServiceClient) ItemGuidProvider {
+	return &guidServiceItemGuidProvider{client: client}
+}
+
+func (p *guidServiceItemGuidProvider) ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint32, error) {
+	resp, err := p.client.GetGUIDPool(ctx, &guidPB.GetGUIDPoolRequest{
+		Api:             auctionhouse.SupportedGuidServiceVer,
+		RealmID:         realmID,
+		GuidType:        guidPB.GuidType_Item,
+		DesiredPoolSize: uint64(count),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	guids := make([]uint32, 0, count)
+	for _, d := range resp.ReceiverGUID {
+		for guid := d.Start; guid <= d.End && len(guids) < count; guid++ {
+			guids = append(guids, uint32(guid))
+		}
+	}
+	return guids, nil
+}
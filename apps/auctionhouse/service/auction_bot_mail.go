@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+	pbMail "github.com/walkline/ToCloud9/gen/mail/pb"
+)
+
+// auctionBotMailFilter is mail client that doesn't deliver mails to the bot characters.
+// Nobody reads bot mail, so items sent to the bot are deleted and money is discarded.
+type auctionBotMailFilter struct {
+	pbMail.MailServiceClient
+
+	itemRepo       repo.ItemRepo
+	characterGUIDs map[uint32]uint32
+}
+
+// NewAuctionBotMailFilter wraps mail client to skip mails (expired auctions, won items, money) sent to the bot characters.
+func NewAuctionBotMailFilter(client pbMail.MailServiceClient, itemRepo repo.ItemRepo, characterGUIDs map[uint32]uint32) pbMail.MailServiceClient {
+	return &auctionBotMailFilter{
+		MailServiceClient: client,
+		itemRepo:          itemRepo,
+		characterGUIDs:    characterGUIDs,
+	}
+}
+
+func (f *auctionBotMailFilter) Send(ctx context.Context, in *pbMail.SendRequest, opts ...grpc.CallOption) (*pbMail.SendResponse, error) {
+	botGUID, ok := f.characterGUIDs[in.RealmID]
+	if !ok || in.ReceiverGuid != uint64(botGUID) {
+		return f.MailServiceClient.Send(ctx, in, opts...)
+	}
+
+	for _, attachment := range in.Attachments {
+		if err := f.itemRepo.DeleteItem(ctx, in.RealmID, uint32(attachment.Guid)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debug().
+		Uint32("realmID", in.RealmID).
+		Uint64("receiver", in.ReceiverGuid).
+		Int("attachments", len(in.Attachments)).
+		Msg("Skipped mail to auction bot character")
+
+	return &pbMail.SendResponse{}, nil
+}
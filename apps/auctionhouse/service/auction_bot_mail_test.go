@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pbMail "github.com/walkline/ToCloud9/gen/mail/pb"
+)
+
+type countingMailClient struct {
+	mockMailClient
+	sent []*pbMail.SendRequest
+}
+
+func (m *countingMailClient) Send(ctx context.Context, req *pbMail.SendRequest, opts ...grpc.CallOption) (*pbMail.SendResponse, error) {
+	m.sent = append(m.sent, req)
+	return &pbMail.SendResponse{}, nil
+}
+
+func TestAuctionBotMailFilter(t *testing.T) {
+	ctx := context.Background()
+	client := &countingMailClient{}
+	itemRepo := &mockItemRepo{}
+	filter := NewAuctionBotMailFilter(client, itemRepo, map[uint32]uint32{1: 50})
+
+	// Mail to the bot character is skipped and its items are deleted.
+	_, err := filter.Send(ctx, &pbMail.SendRequest{
+		RealmID:      1,
+		ReceiverGuid: 50,
+		MoneyToSend:  100,
+		Attachments:  []*pbMail.ItemAttachment{{Guid: 7}, {Guid: 8}},
+	})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if len(client.sent) != 0 {
+		t.Errorf("mail to bot was sent, got %d mails", len(client.sent))
+	}
+	if len(itemRepo.deleted) != 2 || itemRepo.deleted[0] != 7 || itemRepo.deleted[1] != 8 {
+		t.Errorf("expected items 7 and 8 to be deleted, got %v", itemRepo.deleted)
+	}
+
+	// Same guid on another realm is a player.
+	if _, err = filter.Send(ctx, &pbMail.SendRequest{RealmID: 2, ReceiverGuid: 50}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	// Player on the bot realm.
+	if _, err = filter.Send(ctx, &pbMail.SendRequest{RealmID: 1, ReceiverGuid: 51}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	if len(client.sent) != 2 {
+		t.Errorf("expected 2 player mails to be sent, got %d", len(client.sent))
+	}
+}
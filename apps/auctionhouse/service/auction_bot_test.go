@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+type mockItemRepo struct {
+	items     []repo.ItemInstance
+	deleted   []uint32
+	createErr error
+}
+
+func (m *mockItemRepo) CreateItem(ctx context.Context, realmID uint32, item *repo.ItemInstance) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	m.items = append(m.items, *item)
+	return nil
+}
+
+func (m *mockItemRepo) DeleteItem(ctx context.Context, realmID uint32, guid uint32) error {
+	m.deleted = append(m.deleted, guid)
+	return nil
+}
+
+type mockItemGuidProvider struct {
+	next uint32
+}
+
+func (m *mockItemGuidProvider) ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint32, error) {
+	guids := make([]uint32, count)
+	for i := range guids {
+		m.next++
+		guids[i] = m.next
+	}
+	return guids, nil
+}
+
+type mockItemGuidProviderWithCalls struct {
+	mockItemGuidProvider
+	requested []int
+}
+
+func (m *mockItemGuidProviderWithCalls) ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint32, error) {
+	m.requested = append(m.requested, count)
+	return m.mockItemGuidProvider.ItemGuids(ctx, realmID, count)
+}
+
+func newTestAuctionBot(svc *AuctionService, house AuctionBotHouseConfig) (*AuctionBot, *mockItemRepo) {
+	templates := repo.NewItemTemplateCacheWithTemplates([]repo.ItemTemplate{
+		{Entry: 2589, Quality: ItemQualityNormal, BuyPrice: 100, Stackable: 20, Name: "Linen Cloth"},
+		{Entry: 2840, Quality: ItemQualityNormal, BuyPrice: 0, SellPrice: 10, Stackable: 1, Name: "Copper Bar"},
+		{Entry: 12640, Quality: ItemQualityEpic, BuyPrice: 1000, MaxDurability: 100, Name: "Lionheart Helm"},
+		{Entry: 19364, Quality: ItemQualityEpic, BuyPrice: 5000, Bonding: itemBondingPickedUp, Name: "Ashkandi"},
+		{Entry: 5000, Quality: ItemQualityNormal, BuyPrice: 100, Class: itemClassQuest, Name: "Quest Item"},
+		{Entry: 5001, Quality: ItemQualityNormal, Name: "No Price Item"},
+	})
+
+	itemRepo := &mockItemRepo{}
+	bot := NewAuctionBot(svc, itemRepo, &mockItemGuidProvider{next: 100}, templates, AuctionBotConfig{
+		CharacterGUIDs: map[uint32]uint32{1: 50},
+		ItemsPerTick:   10,
+		Houses:         map[uint8]AuctionBotHouseConfig{AuctionHouseHorde: house},
+	})
+	return bot, itemRepo
+}
+
+func TestAuctionBot_SellItems(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	bot, itemRepo := newTestAuctionBot(svc, AuctionBotHouseConfig{
+		MaxAuctions:      15,
+		QualityWeights:   []uint32{0, 1, 0, 0, 1},
+		PriceMultipliers: []float64{1, 2, 1, 1, 3},
+		PriceVariancePct: 10,
+	})
+
+	bot.Tick(ctx, 1)
+
+	if len(itemRepo.items) != 10 {
+		t.Fatalf("Expected 10 items created, got %d", len(itemRepo.items))
+	}
+
+	auctions := svc.ListOwnerItems(1, 50, AuctionHouseHorde)
+	if len(auctions) != 10 {
+		t.Fatalf("Expected 10 bot auctions, got %d", len(auctions))
+	}
+
+	for _, a := range auctions {
+		var unitMin, unitMax float64
+		switch a.ItemEntry {
+		case 2589:
+			unitMin, unitMax = 180, 220
+		case 2840:
+			unitMin, unitMax = 72, 88
+		case 12640:
+			unitMin, unitMax = 2700, 3300
+		default:
+			t.Fatalf("Unexpected item listed: %d", a.ItemEntry)
+		}
+
+		unitPrice := float64(a.BuyoutPrice) / float64(a.ItemCount)
+		if unitPrice < unitMin-1 || unitPrice > unitMax+1 {
+			t.Errorf("Item %d unit price %v is out of [%v, %v]", a.ItemEntry, unitPrice, unitMin, unitMax)
+		}
+
+		if a.StartBid > a.BuyoutPrice {
+			t.Errorf("Start bid %d is bigger than buyout %d", a.StartBid, a.BuyoutPrice)
+		}
+
+		if a.Time < uint32(time.Now().Unix())+MinAuctionTime-1 {
+			t.Errorf("Auction time %d is too short", a.Time)
+		}
+	}
+
+	// Only 5 auctions left to reach max auctions.
+	bot.Tick(ctx, 1)
+
+	if len(svc.ListOwnerItems(1, 50, AuctionHouseHorde)) != 15 {
+		t.Errorf("Expected 15 bot auctions, got %d", len(svc.ListOwnerItems(1, 50, AuctionHouseHorde)))
+	}
+
+	if len(svc.ListOwnerItems(1, 50, AuctionHouseAlliance)) != 0 {
+		t.Errorf("Expected no bot auctions in not configured house")
+	}
+}
+
+func TestAuctionBot_ReusesGuidsAfterFailure(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+
+	bot, itemRepo := newTestAuctionBot(svc, AuctionBotHouseConfig{
+		MaxAuctions:    10,
+		QualityWeights: []uint32{0, 1},
+	})
+	guids := &mockItemGuidProviderWithCalls{mockItemGuidProvider: mockItemGuidProvider{next: 100}}
+	bot.guids = guids
+
+	itemRepo.createErr = errors.New("db is down")
+	bot.Tick(ctx, 1)
+
+	if len(svc.ListOwnerItems(1, 50, AuctionHouseHorde)) != 0 {
+		t.Fatalf("Expected no bot auctions after failure")
+	}
+
+	itemRepo.createErr = nil
+	bot.Tick(ctx, 1)
+
+	if len(itemRepo.items) != 10 {
+		t.Fatalf("Expected 10 items created, got %d", len(itemRepo.items))
+	}
+	for i, item := range itemRepo.items {
+		if item.GUID != uint32(101+i) {
+			t.Errorf("Expected item guid %d, got %d", 101+i, item.GUID)
+		}
+	}
+
+	if len(guids.requested) != 1 {
+		t.Errorf("Expected guids to be requested once, got %v", guids.requested)
+	}
+}
+
+func TestAuctionBot_BuyItems(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+	realmID := uint32(1)
+
+	bot, _ := newTestAuctionBot(svc, AuctionBotHouseConfig{
+		QualityWeights:      []uint32{0, 1},
+		BuyoutBelowPct:      50,
+		BidBelowPct:         30,
+		MaxPurchasesPerTick: 10,
+	})
+
+	expiresAt := uint32(time.Now().Unix()) + 3600
+	cheapBuyout := &repo.AuctionEntry{HouseID: AuctionHouseHorde, ItemGUID: 1, StartBid: 100, BuyoutPrice: 500, Time: expiresAt, ItemEntry: 2589, ItemCount: 10}
+	cheapBid := &repo.AuctionEntry{HouseID: AuctionHouseHorde, ItemGUID: 2, StartBid: 200, BuyoutPrice: 900, Time: expiresAt, ItemEntry: 2589, ItemCount: 10}
+	expensive := &repo.AuctionEntry{HouseID: AuctionHouseHorde, ItemGUID: 3, StartBid: 800, BuyoutPrice: 1000, Time: expiresAt, ItemEntry: 2589, ItemCount: 10}
+	unknown := &repo.AuctionEntry{HouseID: AuctionHouseHorde, ItemGUID: 4, StartBid: 1, BuyoutPrice: 1, Time: expiresAt, ItemEntry: 99999, ItemCount: 1}
+
+	for _, a := range []*repo.AuctionEntry{cheapBuyout, cheapBid, expensive, unknown} {
+		if _, err := svc.SellItem(ctx, realmID, 1000, a); err != nil {
+			t.Fatalf("Failed to create auction: %v", err)
+		}
+	}
+
+	bot.Tick(ctx, 1)
+
+	auctions, _ := svc.ListItems(realmID, AuctionHouseHorde, 0, "", 0, 0, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, 0xFFFFFFFF, true, nil)
+	byItemGUID := map[uint32]*CachedAuction{}
+	for _, a := range auctions {
+		byItemGUID[a.ItemGUID] = a
+	}
+
+	if _, ok := byItemGUID[cheapBuyout.ItemGUID]; ok {
+		t.Errorf("Expected cheap auction to be bought out")
+	}
+
+	if a := byItemGUID[cheapBid.ItemGUID]; a == nil || a.BuyGUID != 50 || a.LastBid != 200 {
+		t.Errorf("Expected bot bid on cheap auction, got %+v", a)
+	}
+
+	if a := byItemGUID[expensive.ItemGUID]; a == nil || a.BuyGUID != 0 {
+		t.Errorf("Expected expensive auction to be untouched, got %+v", a)
+	}
+
+	if a := byItemGUID[unknown.ItemGUID]; a == nil || a.BuyGUID != 0 {
+		t.Errorf("Expected auction of unknown item to be untouched, got %+v", a)
+	}
+
+	// Bot doesn't outbid itself.
+	bot.Tick(ctx, 1)
+	if a, _ := svc.cachedAuction(realmID, byItemGUID[cheapBid.ItemGUID].ID); a.LastBid != 200 {
+		t.Errorf("Expected bot to keep its bid, got %d", a.LastBid)
+	}
+}
+
+func TestAuctionBotPurchasePrice(t *testing.T) {
+	house := AuctionBotHouseConfig{BuyoutBelowPct: 50, BidBelowPct: 30}
+
+	tests := []struct {
+		name    string
+		auction repo.AuctionEntry
+		value   uint64
+		expOK   bool
+		expBid  uint32
+	}{
+		{"buyout below threshold", repo.AuctionEntry{StartBid: 10, BuyoutPrice: 50}, 100, true, 50},
+		{"start bid below threshold", repo.AuctionEntry{StartBid: 30, BuyoutPrice: 90}, 100, true, 30},
+		{"outbid below threshold", repo.AuctionEntry{StartBid: 10, LastBid: 20, BuyoutPrice: 90}, 100, true, 21},
+		{"bid above threshold", repo.AuctionEntry{StartBid: 40, BuyoutPrice: 90}, 100, false, 0},
+		{"next bid reaches buyout", repo.AuctionEntry{StartBid: 10, LastBid: 60, BuyoutPrice: 61}, 100, false, 0},
+		{"no buyout", repo.AuctionEntry{StartBid: 10}, 100, true, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bid, ok := auctionBotPurchasePrice(&tt.auction, tt.value, house)
+			if ok != tt.expOK || bid != tt.expBid {
+				t.Errorf("Expected (%d, %v), got (%d, %v)", tt.expBid, tt.expOK, bid, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+package service
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+// AuctionNoFilter is the value of inventory type, class, subclass and quality filters that disables the filter.
+const AuctionNoFilter = 0xFFFFFFFF
+
+// auctionLevelBandSize is the size of item level band in the level index.
+const auctionLevelBandSize = 10
+
+// auctionSortedViews are the sort columns that have pre-sorted views.
+// Alternative buyout column uses the buyout view.
+var auctionSortedViews = []uint32{
+	AuctionSortColumnLevel,
+	AuctionSortColumnBuyout,
+	AuctionSortColumnTimeLeft,
+	AuctionSortColumnBid,
+	AuctionSortColumnStackCount,
+}
+
+// auctionFilter is a set of auction search filters.
+type auctionFilter struct {
+	name          string // lower case
+	levelMin      uint32
+	levelMax      uint32
+	inventoryType uint32
+	itemClass     uint32
+	itemSubClass  uint32
+	quality       uint32
+}
+
+// empty checks if the filter doesn't filter out any auction with item template.
+func (f *auctionFilter) empty() bool {
+	return f.name == "" && f.levelMin == 0 && f.levelMax == 0 &&
+		f.inventoryType == AuctionNoFilter && f.itemClass == AuctionNoFilter &&
+		f.itemSubClass == AuctionNoFilter && f.quality == AuctionNoFilter
+}
+
+// indexedAuction is an auction with the item template data used by the filters.
+type indexedAuction struct {
+	CachedAuction
+
+	hasTemplate   bool
+	class         uint32
+	subClass      uint32
+	quality       uint32
+	inventoryType uint32
+	itemLevel     uint32
+	name          string // lower case
+	tokens        []string
+}
+
+// matches checks if the auction matches the filter, works the same way as ItemTemplateCache.MatchesFilters.
+func (a *indexedAuction) matches(f *auctionFilter) bool {
+	if !a.hasTemplate {
+		return false
+	}
+	if f.name != "" && !strings.Contains(a.name, f.name) {
+		return false
+	}
+	if f.levelMin > 0 && a.itemLevel < f.levelMin {
+		return false
+	}
+	if f.levelMax > 0 && a.itemLevel > f.levelMax {
+		return false
+	}
+	if f.inventoryType != AuctionNoFilter && a.inventoryType != f.inventoryType {
+		return false
+	}
+	if f.itemClass != AuctionNoFilter && a.class != f.itemClass {
+		return false
+	}
+	if f.itemSubClass != AuctionNoFilter && a.subClass != f.itemSubClass {
+		return false
+	}
+	if f.quality != AuctionNoFilter && a.quality != f.quality {
+		return false
+	}
+	return true
+}
+
+func (a *indexedAuction) copyAuction() *CachedAuction {
+	c := a.CachedAuction
+	return &c
+}
+
+// auctionSortKey returns key of the auction in the sorted view of the column.
+// Keys order is the same as compareAuctions order.
+func auctionSortKey(a *CachedAuction, column uint32) uint64 {
+	switch column {
+	case AuctionSortColumnLevel:
+		return uint64(a.ItemEntry)
+	case AuctionSortColumnBuyout, AuctionSortColumnBuyoutAlt:
+		return uint64(a.BuyoutPrice)<<32 | uint64(a.LastBid)
+	case AuctionSortColumnTimeLeft:
+		return uint64(a.Time)
+	case AuctionSortColumnBid:
+		if a.LastBid == 0 {
+			return uint64(a.StartBid)
+		}
+		return uint64(a.LastBid)
+	case AuctionSortColumnStackCount:
+		return uint64(a.ItemCount)
+	}
+	return 0
+}
+
+// sortedAuctions is a view of auctions sorted by the column key and auction ID.
+type sortedAuctions struct {
+	column uint32
+	items  []*indexedAuction
+}
+
+func (v *sortedAuctions) position(a *indexedAuction) int {
+	key := auctionSortKey(&a.CachedAuction, v.column)
+	return sort.Search(len(v.items), func(i int) bool {
+		k := auctionSortKey(&v.items[i].CachedAuction, v.column)
+		return k > key || (k == key && v.items[i].ID >= a.ID)
+	})
+}
+
+func (v *sortedAuctions) insert(a *indexedAuction) {
+	i := v.position(a)
+	v.items = append(v.items, nil)
+	copy(v.items[i+1:], v.items[i:])
+	v.items[i] = a
+}
+
+// remove removes the auction from the view. Should be called before the sort key of the auction changes.
+func (v *sortedAuctions) remove(a *indexedAuction) {
+	i := v.position(a)
+	if i >= len(v.items) || v.items[i] != a {
+		return
+	}
+	copy(v.items[i:], v.items[i+1:])
+	v.items[len(v.items)-1] = nil
+	v.items = v.items[:len(v.items)-1]
+}
+
+type auctionSet map[uint32]*indexedAuction
+
+func addToSet[K comparable](sets map[K]auctionSet, key K, a *indexedAuction) {
+	set := sets[key]
+	if set == nil {
+		set = auctionSet{}
+		sets[key] = set
+	}
+	set[a.ID] = a
+}
+
+func removeFromSet[K comparable](sets map[K]auctionSet, key K, a *indexedAuction) {
+	set := sets[key]
+	delete(set, a.ID)
+	if len(set) == 0 {
+		delete(sets, key)
+	}
+}
+
+// auctionHouseIndex keeps auctions of a single auction house with secondary indexes
+// and pre-sorted views. Every auction house has its own lock, so searches in one house
+// don't block bids and sells in others.
+type auctionHouseIndex struct {
+	mu sync.RWMutex
+
+	auctions        map[uint32]*indexedAuction
+	withTemplate    int
+	byClass         map[uint32]auctionSet
+	bySubClass      map[[2]uint32]auctionSet
+	byQuality       map[uint32]auctionSet
+	byInventoryType map[uint32]auctionSet
+	byLevelBand     map[uint32]auctionSet
+	byToken         map[string]auctionSet
+	byOwner         map[uint32]auctionSet
+	byBidder        map[uint32]auctionSet
+	views           map[uint32]*sortedAuctions
+}
+
+func newAuctionHouseIndex() *auctionHouseIndex {
+	h := &auctionHouseIndex{
+		auctions:        map[uint32]*indexedAuction{},
+		byClass:         map[uint32]auctionSet{},
+		bySubClass:      map[[2]uint32]auctionSet{},
+		byQuality:       map[uint32]auctionSet{},
+		byInventoryType: map[uint32]auctionSet{},
+		byLevelBand:     map[uint32]auctionSet{},
+		byToken:         map[string]auctionSet{},
+		byOwner:         map[uint32]auctionSet{},
+		byBidder:        map[uint32]auctionSet{},
+		views:           map[uint32]*sortedAuctions{},
+	}
+	for _, column := range auctionSortedViews {
+		h.views[column] = &sortedAuctions{column: column}
+	}
+	h.views[AuctionSortColumnBuyoutAlt] = h.views[AuctionSortColumnBuyout]
+	return h
+}
+
+// add adds the auction or replaces existing one with the same ID.
+func (h *auctionHouseIndex) add(a CachedAuction, tmpl *repo.ItemTemplate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.addLocked(a, tmpl)
+}
+
+func (h *auctionHouseIndex) addLocked(a CachedAuction, tmpl *repo.ItemTemplate) {
+	if existing, ok := h.auctions[a.ID]; ok {
+		h.removeLocked(existing)
+	}
+
+	ia := &indexedAuction{CachedAuction: a}
+	h.auctions[a.ID] = ia
+
+	if tmpl != nil {
+		ia.hasTemplate = true
+		ia.class = tmpl.Class
+		ia.subClass = tmpl.SubClass
+		ia.quality = tmpl.Quality
+		ia.inventoryType = tmpl.InventoryType
+		ia.itemLevel = tmpl.ItemLevel
+		ia.name = strings.ToLower(tmpl.Name)
+		ia.tokens = strings.Fields(ia.name)
+		h.withTemplate++
+
+		addToSet(h.byClass, ia.class, ia)
+		addToSet(h.bySubClass, [2]uint32{ia.class, ia.subClass}, ia)
+		addToSet(h.byQuality, ia.quality, ia)
+		addToSet(h.byInventoryType, ia.inventoryType, ia)
+		addToSet(h.byLevelBand, ia.itemLevel/auctionLevelBandSize, ia)
+		for _, token := range ia.tokens {
+			addToSet(h.byToken, token, ia)
+		}
+	}
+
+	addToSet(h.byOwner, ia.ItemOwner, ia)
+	if ia.BuyGUID != 0 {
+		addToSet(h.byBidder, ia.BuyGUID, ia)
+	}
+
+	for _, column := range auctionSortedViews {
+		h.views[column].insert(ia)
+	}
+}
+
+// remove removes the auction and returns its copy.
+func (h *auctionHouseIndex) remove(auctionID uint32) (*CachedAuction, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ia, ok := h.auctions[auctionID]
+	if !ok {
+		return nil, false
+	}
+	h.removeLocked(ia)
+	return ia.copyAuction(), true
+}
+
+func (h *auctionHouseIndex) removeLocked(ia *indexedAuction) {
+	delete(h.auctions, ia.ID)
+
+	if ia.hasTemplate {
+		h.withTemplate--
+		removeFromSet(h.byClass, ia.class, ia)
+		removeFromSet(h.bySubClass, [2]uint32{ia.class, ia.subClass}, ia)
+		removeFromSet(h.byQuality, ia.quality, ia)
+		removeFromSet(h.byInventoryType, ia.inventoryType, ia)
+		removeFromSet(h.byLevelBand, ia.itemLevel/auctionLevelBandSize, ia)
+		for _, token := range ia.tokens {
+			removeFromSet(h.byToken, token, ia)
+		}
+	}
+
+	removeFromSet(h.byOwner, ia.ItemOwner, ia)
+	if ia.BuyGUID != 0 {
+		removeFromSet(h.byBidder, ia.BuyGUID, ia)
+	}
+
+	for _, column := range auctionSortedViews {
+		h.views[column].remove(ia)
+	}
+}
+
+// setBidLocked updates bidder and bid of the auction keeping indexes in sync. Requires write lock.
+func (h *auctionHouseIndex) setBidLocked(ia *indexedAuction, buyGUID, lastBid uint32) {
+	bidViews := []*sortedAuctions{h.views[AuctionSortColumnBuyout], h.views[AuctionSortColumnBid]}
+	for _, v := range bidViews {
+		v.remove(ia)
+	}
+	if ia.BuyGUID != 0 {
+		removeFromSet(h.byBidder, ia.BuyGUID, ia)
+	}
+
+	ia.BuyGUID = buyGUID
+	ia.LastBid = lastBid
+
+	if ia.BuyGUID != 0 {
+		addToSet(h.byBidder, ia.BuyGUID, ia)
+	}
+	for _, v := range bidViews {
+		v.insert(ia)
+	}
+}
+
+// get returns copy of the auction.
+func (h *auctionHouseIndex) get(auctionID uint32) (*CachedAuction, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ia, ok := h.auctions[auctionID]
+	if !ok {
+		return nil, false
+	}
+	return ia.copyAuction(), true
+}
+
+func (h *auctionHouseIndex) has(auctionID uint32) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	_, ok := h.auctions[auctionID]
+	return ok
+}
+
+// all returns copies of up to limit auctions and the total amount of auctions in the house.
+func (h *auctionHouseIndex) all(limit int) ([]*CachedAuction, uint32) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	result := make([]*CachedAuction, 0, min(limit, len(h.auctions)))
+	for _, ia := range h.auctions {
+		if len(result) >= limit {
+			break
+		}
+		result = append(result, ia.copyAuction())
+	}
+	return result, uint32(len(h.auctions))
+}
+
+// ownedBy returns copies of auctions of the owner.
+func (h *auctionHouseIndex) ownedBy(owner uint32) []*CachedAuction {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return copyAuctionSet(h.byOwner[owner])
+}
+
+// bidBy returns copies of auctions where the player is the highest bidder.
+func (h *auctionHouseIndex) bidBy(bidder uint32) []*CachedAuction {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return copyAuctionSet(h.byBidder[bidder])
+}
+
+// expiredIDs returns IDs of auctions that end before the given time.
+func (h *auctionHouseIndex) expiredIDs(before uint32) []uint32 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	var ids []uint32
+	for _, ia := range h.views[AuctionSortColumnTimeLeft].items {
+		if ia.Time > before {
+			break
+		}
+		ids = append(ids, ia.ID)
+	}
+	return ids
+}
+
+func copyAuctionSet(set auctionSet) []*CachedAuction {
+	if len(set) == 0 {
+		return nil
+	}
+	result := make([]*CachedAuction, 0, len(set))
+	for _, ia := range set {
+		result = append(result, ia.copyAuction())
+	}
+	return result
+}
+
+// search returns copies of auctions on the requested page and the total amount of matched auctions.
+func (h *auctionHouseIndex) search(f *auctionFilter, listFrom uint32, sorting []AuctionSortInfo) ([]*CachedAuction, uint32) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	pageEnd := int(listFrom) + MaxAuctionsPerPage
+
+	var view *sortedAuctions
+	if len(sorting) > 0 {
+		view = h.views[sorting[0].Column]
+	}
+
+	var matched []*indexedAuction
+	var totalCount uint32
+	if f.empty() && (len(sorting) == 0 || view != nil) {
+		// Every auction with item template matches, so it's enough to walk the view up to the page end.
+		totalCount = uint32(h.withTemplate)
+		if view == nil {
+			view, sorting = h.views[AuctionSortColumnTimeLeft], []AuctionSortInfo{{Column: AuctionSortColumnTimeLeft, IsDesc: true}}
+		}
+		if listFrom < totalCount {
+			matched = h.walkView(view, f, sorting, pageEnd)
+		}
+	} else {
+		h.forEachCandidate(f, func(ia *indexedAuction) {
+			if ia.matches(f) {
+				matched = append(matched, ia)
+			}
+		})
+
+		totalCount = uint32(len(matched))
+		if len(sorting) > 0 && len(matched) > MaxAuctionsPerPage && listFrom < totalCount {
+			if view != nil && len(matched)*8 >= len(h.auctions) {
+				// Big result, walking pre-sorted view is cheaper than sorting.
+				matched = h.walkView(view, f, sorting, pageEnd)
+			} else {
+				sort.Slice(matched, func(i, j int) bool {
+					return auctionLess(&matched[i].CachedAuction, &matched[j].CachedAuction, sorting)
+				})
+			}
+		}
+	}
+
+	if int(listFrom) >= len(matched) {
+		return nil, totalCount
+	}
+	if pageEnd > len(matched) {
+		pageEnd = len(matched)
+	}
+
+	result := make([]*CachedAuction, 0, pageEnd-int(listFrom))
+	for _, ia := range matched[listFrom:pageEnd] {
+		result = append(result, ia.copyAuction())
+	}
+	return result, totalCount
+}
+
+// walkView collects at least limit matched auctions in the order of the sorting.
+// Auctions with equal primary column are ordered by the rest of the sorting columns.
+func (h *auctionHouseIndex) walkView(view *sortedAuctions, f *auctionFilter, sorting []AuctionSortInfo, limit int) []*indexedAuction {
+	var result []*indexedAuction
+	var lastKey uint64
+
+	for i := range view.items {
+		// Same as auctionLess, sorting without IsDesc flag starts from the biggest keys.
+		ia := view.items[i]
+		if !sorting[0].IsDesc {
+			ia = view.items[len(view.items)-1-i]
+		}
+
+		if !ia.matches(f) {
+			continue
+		}
+
+		key := auctionSortKey(&ia.CachedAuction, view.column)
+		if len(result) >= limit && key != lastKey {
+			break
+		}
+		lastKey = key
+		result = append(result, ia)
+	}
+
+	if len(sorting) > 1 {
+		for start := 0; start < len(result); {
+			end := start + 1
+			key := auctionSortKey(&result[start].CachedAuction, view.column)
+			for end < len(result) && auctionSortKey(&result[end].CachedAuction, view.column) == key {
+				end++
+			}
+			run := result[start:end]
+			sort.SliceStable(run, func(i, j int) bool {
+				return auctionLess(&run[i].CachedAuction, &run[j].CachedAuction, sorting[1:])
+			})
+			start = end
+		}
+	}
+
+	return result
+}
+
+// forEachCandidate calls fn for every auction from the most selective index that applies to the filter.
+func (h *auctionHouseIndex) forEachCandidate(f *auctionFilter, fn func(*indexedAuction)) {
+	var best []auctionSet
+	bestSize := len(h.auctions)
+	useIndex := func(sets []auctionSet) {
+		size := 0
+		for _, set := range sets {
+			size += len(set)
+		}
+		if best == nil || size < bestSize {
+			best, bestSize = sets, size
+		}
+	}
+
+	if f.quality != AuctionNoFilter {
+		useIndex([]auctionSet{h.byQuality[f.quality]})
+	}
+	if f.itemClass != AuctionNoFilter && f.itemSubClass != AuctionNoFilter {
+		useIndex([]auctionSet{h.bySubClass[[2]uint32{f.itemClass, f.itemSubClass}]})
+	} else if f.itemClass != AuctionNoFilter {
+		useIndex([]auctionSet{h.byClass[f.itemClass]})
+	}
+	if f.inventoryType != AuctionNoFilter {
+		useIndex([]auctionSet{h.byInventoryType[f.inventoryType]})
+	}
+	if f.levelMin > 0 || f.levelMax > 0 {
+		var sets []auctionSet
+		for band, set := range h.byLevelBand {
+			if (f.levelMin == 0 || band >= f.levelMin/auctionLevelBandSize) && (f.levelMax == 0 || band <= f.levelMax/auctionLevelBandSize) {
+				sets = append(sets, set)
+			}
+		}
+		useIndex(sets)
+	}
+	if f.name != "" {
+		// Any substring of the name contains whole piece of the searched name inside one of the name tokens.
+		piece := ""
+		for _, p := range strings.Fields(f.name) {
+			if len(p) > len(piece) {
+				piece = p
+			}
+		}
+		if piece != "" {
+			var sets []auctionSet
+			for token, set := range h.byToken {
+				if strings.Contains(token, piece) {
+					sets = append(sets, set)
+				}
+			}
+			useIndex(sets)
+		}
+	}
+
+	if best == nil {
+		for _, ia := range h.auctions {
+			fn(ia)
+		}
+		return
+	}
+
+	if len(best) == 1 {
+		for _, ia := range best[0] {
+			fn(ia)
+		}
+		return
+	}
+
+	// Sets of name tokens can intersect.
+	seen := make(map[uint32]struct{}, bestSize)
+	for _, set := range best {
+		for id, ia := range set {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			fn(ia)
+		}
+	}
+}
+
+// auctionLess reports whether auction a goes before b with the given sorting.
+func auctionLess(a, b *CachedAuction, sorting []AuctionSortInfo) bool {
+	for _, s := range sorting {
+		cmp := compareAuctions(a, b, s.Column)
+		if cmp == 0 {
+			continue
+		}
+		if s.IsDesc {
+			return cmp < 0
+		}
+		return cmp > 0
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+var testItemNameWords = []string{"flask", "of", "the", "titans", "linen", "cloth", "sword", "helm", "lionheart", "ashkandi", "greatsword", "brotherhood", "potion", "mana"}
+
+func newTestItemTemplates(count int, rnd *rand.Rand) []repo.ItemTemplate {
+	templates := make([]repo.ItemTemplate, count)
+	for i := range templates {
+		words := make([]string, 1+rnd.Intn(3))
+		for w := range words {
+			words[w] = testItemNameWords[rnd.Intn(len(testItemNameWords))]
+		}
+		templates[i] = repo.ItemTemplate{
+			Entry:         uint32(i + 1),
+			Class:         uint32(rnd.Intn(16)),
+			SubClass:      uint32(rnd.Intn(8)),
+			Quality:       uint32(rnd.Intn(7)),
+			InventoryType: uint32(rnd.Intn(28)),
+			ItemLevel:     uint32(1 + rnd.Intn(280)),
+			Name:          strings.ToUpper(words[0][:1]) + strings.Join(words, " ")[1:],
+		}
+	}
+	return templates
+}
+
+// newTestIndexedService creates service with auctions of random items in the horde house and
+// the same auctions in the map for the linear scan implementation.
+func newTestIndexedService(auctionsCount int) (*AuctionService, map[uint32]*CachedAuction) {
+	rnd := rand.New(rand.NewSource(1))
+	templates := newTestItemTemplates(2000, rnd)
+
+	svc := newTestService()
+	svc.itemTemplates = repo.NewItemTemplateCacheWithTemplates(templates)
+
+	linear := make(map[uint32]*CachedAuction, auctionsCount)
+	for i := 0; i < auctionsCount; i++ {
+		buyout := uint32(rnd.Intn(100000))
+		entry := &repo.AuctionEntry{
+			HouseID:     AuctionHouseHorde,
+			ItemGUID:    uint32(i + 1),
+			BuyoutPrice: buyout,
+			StartBid:    buyout / 2,
+			Time:        uint32(1000000 + rnd.Intn(100000)),
+			ItemEntry:   uint32(1 + rnd.Intn(len(templates)+10)), // some items don't have templates
+			ItemCount:   uint32(1 + rnd.Intn(20)),
+		}
+		if rnd.Intn(4) == 0 {
+			entry.BuyGUID = uint32(1 + rnd.Intn(100))
+			entry.LastBid = entry.StartBid + uint32(rnd.Intn(100))
+		}
+
+		if _, err := svc.SellItem(context.Background(), 1, uint64(1+rnd.Intn(1000)), entry); err != nil {
+			panic(err)
+		}
+		linear[entry.ID] = &CachedAuction{AuctionEntry: *entry}
+	}
+
+	return svc, linear
+}
+
+// linearListItems is the linear scan implementation of ListItems the index is compared with.
+func linearListItems(auctions map[uint32]*CachedAuction, templates *repo.ItemTemplateCache, houseID uint32, listFrom uint32,
+	searchedName string, levelMin, levelMax, inventoryType, itemClass, itemSubClass, quality uint32, sorting []AuctionSortInfo,
+) ([]*CachedAuction, uint32) {
+	lowerSearch := strings.ToLower(searchedName)
+
+	var matched []*CachedAuction
+	for _, a := range auctions {
+		if uint32(a.HouseID) != houseID {
+			continue
+		}
+		if !templates.MatchesFilters(a.ItemEntry, lowerSearch, levelMin, levelMax, inventoryType, itemClass, itemSubClass, quality) {
+			continue
+		}
+		matched = append(matched, a)
+	}
+
+	totalCount := uint32(len(matched))
+
+	if len(sorting) > 0 && len(matched) > MaxAuctionsPerPage {
+		sort.Slice(matched, func(i, j int) bool {
+			return auctionLess(matched[i], matched[j], sorting)
+		})
+	}
+
+	if listFrom >= uint32(len(matched)) {
+		return nil, totalCount
+	}
+	matched = matched[listFrom:]
+	if uint32(len(matched)) > MaxAuctionsPerPage {
+		matched = matched[:MaxAuctionsPerPage]
+	}
+
+	return matched, totalCount
+}
+
+type testAuctionQuery struct {
+	name          string
+	listFrom      uint32
+	searchedName  string
+	levelMin      uint32
+	levelMax      uint32
+	inventoryType uint32
+	itemClass     uint32
+	itemSubClass  uint32
+	quality       uint32
+	sorting       []AuctionSortInfo
+}
+
+var testAuctionQueries = []testAuctionQuery{
+	{name: "no filters", inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter},
+	{name: "name", searchedName: "Lionheart", inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter},
+	{name: "name substring", searchedName: "sk of th", inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter},
+	{name: "class and subclass", inventoryType: AuctionNoFilter, itemClass: 2, itemSubClass: 7, quality: AuctionNoFilter},
+	{name: "quality and level", levelMin: 60, levelMax: 80, inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: 4},
+	{name: "sorted by buyout", listFrom: 100, inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter,
+		sorting: []AuctionSortInfo{{Column: AuctionSortColumnBuyout}}},
+	{name: "sorted by stack and time", inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter,
+		sorting: []AuctionSortInfo{{Column: AuctionSortColumnStackCount, IsDesc: true}, {Column: AuctionSortColumnTimeLeft}}},
+	{name: "class sorted by bid", inventoryType: AuctionNoFilter, itemClass: 4, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter,
+		sorting: []AuctionSortInfo{{Column: AuctionSortColumnBid, IsDesc: true}}},
+	{name: "name sorted by level", searchedName: "potion", inventoryType: AuctionNoFilter, itemClass: AuctionNoFilter, itemSubClass: AuctionNoFilter, quality: AuctionNoFilter,
+		sorting: []AuctionSortInfo{{Column: AuctionSortColumnLevel}, {Column: AuctionSortColumnBuyout, IsDesc: true}}},
+}
+
+func TestListItems_MatchesLinearScan(t *testing.T) {
+	svc, linear := newTestIndexedService(5000)
+
+	for _, q := range testAuctionQueries {
+		t.Run(q.name, func(t *testing.T) {
+			got, gotTotal := svc.ListItems(1, AuctionHouseHorde, q.listFrom, q.searchedName, q.levelMin, q.levelMax,
+				q.inventoryType, q.itemClass, q.itemSubClass, q.quality, false, q.sorting)
+			exp, expTotal := linearListItems(linear, svc.itemTemplates, AuctionHouseHorde, q.listFrom, q.searchedName, q.levelMin, q.levelMax,
+				q.inventoryType, q.itemClass, q.itemSubClass, q.quality, q.sorting)
+
+			if gotTotal != expTotal {
+				t.Fatalf("Expected total count %d, got %d", expTotal, gotTotal)
+			}
+
+			if len(got) != len(exp) {
+				t.Fatalf("Expected %d auctions on page, got %d", len(exp), len(got))
+			}
+
+			if len(q.sorting) == 0 || expTotal <= MaxAuctionsPerPage {
+				return
+			}
+
+			// Auctions with equal sort columns can go in any order.
+			for i := range got {
+				if auctionLess(got[i], exp[i], q.sorting) || auctionLess(exp[i], got[i], q.sorting) {
+					t.Fatalf("Auction %d on position %d doesn't match expected auction %d", got[i].ID, i, exp[i].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestListItems_SortedViewsFollowBids(t *testing.T) {
+	svc, _ := newTestIndexedService(500)
+	ctx := context.Background()
+
+	all, _ := svc.ListItems(1, AuctionHouseHorde, 0, "", 0, 0, AuctionNoFilter, AuctionNoFilter, AuctionNoFilter, AuctionNoFilter, true, nil)
+
+	// The most expensive auction without bids.
+	var target *CachedAuction
+	for _, a := range all {
+		if a.LastBid != 0 || svc.itemTemplates.Get(a.ItemEntry) == nil {
+			continue
+		}
+		if target == nil || a.BuyoutPrice > target.BuyoutPrice {
+			target = a
+		}
+	}
+
+	price := target.BuyoutPrice - 1
+	if _, _, err := svc.PlaceBid(ctx, 1, 5000, target.ID, price); err != nil {
+		t.Fatalf("Failed to place bid: %v", err)
+	}
+
+	// Bid is bigger than any other bid, so the auction goes first.
+	sorting := []AuctionSortInfo{{Column: AuctionSortColumnBid}}
+	page, _ := svc.ListItems(1, AuctionHouseHorde, 0, "", 0, 0, AuctionNoFilter, AuctionNoFilter, AuctionNoFilter, AuctionNoFilter, false, sorting)
+	if len(page) == 0 || page[0].ID != target.ID {
+		t.Fatalf("Expected auction %d to go first after bid", target.ID)
+	}
+	for i := 1; i < len(page); i++ {
+		if auctionLess(page[i], page[i-1], sorting) {
+			t.Fatalf("Auctions on positions %d and %d are not sorted", i-1, i)
+		}
+	}
+
+	bids := svc.ListBidderItems(1, 5000, AuctionHouseHorde, nil)
+	if len(bids) != 1 || bids[0].ID != target.ID || bids[0].LastBid != price {
+		t.Errorf("Expected bid on auction %d, got %+v", target.ID, bids)
+	}
+
+	if _, ok := svc.uncacheAuction(1, target.ID); !ok {
+		t.Fatalf("Expected auction to be removed")
+	}
+
+	if bids = svc.ListBidderItems(1, 5000, AuctionHouseHorde, nil); len(bids) != 0 {
+		t.Errorf("Expected no bids after auction removal, got %d", len(bids))
+	}
+}
+
+func BenchmarkListItems(b *testing.B) {
+	for _, auctionsCount := range []int{10000, 100000} {
+		svc, linear := newTestIndexedService(auctionsCount)
+
+		for _, q := range testAuctionQueries {
+			b.Run(fmt.Sprintf("%d/%s/indexed", auctionsCount, q.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					svc.ListItems(1, AuctionHouseHorde, q.listFrom, q.searchedName, q.levelMin, q.levelMax,
+						q.inventoryType, q.itemClass, q.itemSubClass, q.quality, false, q.sorting)
+				}
+			})
+
+			b.Run(fmt.Sprintf("%d/%s/linear", auctionsCount, q.name), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					linearListItems(linear, svc.itemTemplates, AuctionHouseHorde, q.listFrom, q.searchedName, q.levelMin, q.levelMax,
+						q.inventoryType, q.itemClass, q.itemSubClass, q.quality, q.sorting)
+				}
+			})
+		}
+	}
+}
@@ -52,6 +52,40 @@ func (m *mockAuctionRepo) DeleteAuctionIfExists(ctx context.Context, realmID uin
 	return nil, false
 }
 
+type mockSalesRepo struct {
+	sales []repo.SaleEntry
+}
+
+func (m *mockSalesRepo) AddSale(ctx context.Context, realmID uint32, sale *repo.SaleEntry) error {
+	m.sales = append(m.sales, *sale)
+	return nil
+}
+
+func (m *mockSalesRepo) SalesForItem(ctx context.Context, realmID uint32, houseID uint8, itemEntry uint32, from, to int64) ([]repo.SaleEntry, error) {
+	var result []repo.SaleEntry
+	for _, sale := range m.sales {
+		if sale.ItemEntry != itemEntry || sale.SoldAt < from || sale.SoldAt > to {
+			continue
+		}
+		if houseID != 0 && sale.HouseID != houseID {
+			continue
+		}
+		result = append(result, sale)
+	}
+	return result, nil
+}
+
+func (m *mockSalesRepo) DeleteSalesBefore(ctx context.Context, realmID uint32, before int64) error {
+	var kept []repo.SaleEntry
+	for _, sale := range m.sales {
+		if sale.SoldAt >= before {
+			kept = append(kept, sale)
+		}
+	}
+	m.sales = kept
+	return nil
+}
+
 type mockMailClient struct{}
 
 func (m *mockMailClient) Send(ctx context.Context, req *pbMail.SendRequest, opts ...grpc.CallOption) (*pbMail.SendResponse, error) {
@@ -82,6 +116,18 @@ func (m *mockMailClient) RemoveMailMoney(ctx context.Context, req *pbMail.Remove
 	return &pbMail.RemoveMailMoneyResponse{}, nil
 }
 
+func (m *mockMailClient) ReturnMailToSender(ctx context.Context, req *pbMail.ReturnMailToSenderRequest, opts ...grpc.CallOption) (*pbMail.ReturnMailToSenderResponse, error) {
+	return &pbMail.ReturnMailToSenderResponse{}, nil
+}
+
+func (m *mockMailClient) SendSystemMail(ctx context.Context, req *pbMail.SendSystemMailRequest, opts ...grpc.CallOption) (*pbMail.SendSystemMailResponse, error) {
+	return &pbMail.SendSystemMailResponse{}, nil
+}
+
+func (m *mockMailClient) SystemMailProgress(ctx context.Context, req *pbMail.SystemMailProgressRequest, opts ...grpc.CallOption) (*pbMail.SystemMailProgressResponse, error) {
+	return &pbMail.SystemMailProgressResponse{}, nil
+}
+
 type mockEventsProducer struct{}
 
 func (m *mockEventsProducer) PublishAuctionCreated(payload *events.AuctionHouseEventAuctionCreatedPayload) error {
@@ -106,7 +152,7 @@ func newTestService() *AuctionService {
 	mockEvents := &mockEventsProducer{}
 	mockTemplates := &repo.ItemTemplateCache{}
 
-	return NewAuctionService(mockRepo, mockMail, mockEvents, mockTemplates)
+	return NewAuctionService(mockRepo, &mockSalesRepo{}, mockMail, mockEvents, mockTemplates)
 }
 
 // Test PlaceBid - Normal Bid
@@ -150,9 +196,7 @@ func TestPlaceBid_NormalBid(t *testing.T) {
 	}
 
 	// Verify auction updated
-	svc.mu.RLock()
-	a := svc.auctions[realmID][auctionID]
-	svc.mu.RUnlock()
+	a, _ := svc.cachedAuction(realmID, auctionID)
 
 	if a.BuyGUID != uint32(bidderGUID) {
 		t.Errorf("Expected bidder GUID %d, got %d", bidderGUID, a.BuyGUID)
@@ -204,9 +248,7 @@ func TestPlaceBid_Buyout(t *testing.T) {
 	}
 
 	// Verify auction removed from cache
-	svc.mu.RLock()
-	_, exists := svc.auctions[realmID][auctionID]
-	svc.mu.RUnlock()
+	_, exists := svc.cachedAuction(realmID, auctionID)
 
 	if exists {
 		t.Error("Expected auction to be removed after buyout")
@@ -358,9 +400,7 @@ func TestCancelAuction(t *testing.T) {
 	}
 
 	// Verify auction removed
-	svc.mu.RLock()
-	_, exists := svc.auctions[realmID][auctionID]
-	svc.mu.RUnlock()
+	_, exists := svc.cachedAuction(realmID, auctionID)
 
 	if exists {
 		t.Error("Expected auction to be removed after cancellation")
@@ -7,4 +7,5 @@ var (
 	ErrBidOwnAuction        = errors.New("cannot bid on own auction")
 	ErrBidTooLow            = errors.New("bid too low")
 	ErrBidIncrementTooLow   = errors.New("bid increment too low")
+	ErrRealmNotOwned        = errors.New("realm is not owned by any instance")
 )
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+const (
+	// DefaultMarketStatsBucketSecs is the default size of the market stats time series bucket.
+	DefaultMarketStatsBucketSecs = 24 * 60 * 60
+
+	// MaxMarketStatsBuckets limits the amount of buckets in the market stats time series.
+	MaxMarketStatsBuckets = 1000
+)
+
+// MarketStats is the sales statistics of the item. Prices are per one item in copper.
+type MarketStats struct {
+	SalesCount  uint32
+	Volume      uint64 // amount of sold items
+	MinPrice    uint32
+	MedianPrice uint32
+	MeanPrice   uint32
+}
+
+// MarketStatsPoint is the sales statistics of the item in the time series bucket.
+type MarketStatsPoint struct {
+	Time int64 // start of the bucket, unix timestamp
+	MarketStats
+}
+
+// MarketStats returns sales statistics of the item for the given period and its time series.
+// Returns stats of all houses if houseID is 0. Buckets without sales are not included in the time series.
+func (s *AuctionService) MarketStats(ctx context.Context, realmID uint32, houseID uint8, itemEntry uint32, from, to, bucketSecs int64) (MarketStats, []MarketStatsPoint, error) {
+	if to == 0 {
+		to = time.Now().Unix()
+	}
+
+	if bucketSecs <= 0 {
+		bucketSecs = DefaultMarketStatsBucketSecs
+	}
+
+	if from > to {
+		return MarketStats{}, nil, fmt.Errorf("invalid period %d-%d", from, to)
+	}
+
+	// Makes buckets bigger instead of returning too long series.
+	if (to-from)/bucketSecs >= MaxMarketStatsBuckets {
+		bucketSecs = (to-from)/MaxMarketStatsBuckets + 1
+	}
+
+	sales, err := s.salesRepo.SalesForItem(ctx, realmID, houseID, itemEntry, from, to)
+	if err != nil {
+		return MarketStats{}, nil, err
+	}
+
+	return calculateMarketStats(sales), calculateMarketStatsSeries(sales, from, bucketSecs), nil
+}
+
+// CleanupSalesHistory removes sales that are older than the given timestamp.
+func (s *AuctionService) CleanupSalesHistory(ctx context.Context, realmID uint32, before int64) error {
+	return s.salesRepo.DeleteSalesBefore(ctx, realmID, before)
+}
+
+// recordSale saves completed sale for the market stats. Failure doesn't affect the sale itself.
+func (s *AuctionService) recordSale(ctx context.Context, realmID uint32, a *repo.AuctionEntry, isBuyout bool) {
+	price := a.LastBid
+	if isBuyout {
+		price = a.BuyoutPrice
+	}
+
+	err := s.salesRepo.AddSale(ctx, realmID, &repo.SaleEntry{
+		HouseID:   a.HouseID,
+		ItemEntry: a.ItemEntry,
+		ItemCount: a.ItemCount,
+		Price:     price,
+		IsBuyout:  isBuyout,
+		SoldAt:    time.Now().Unix(),
+	})
+	if err != nil {
+		log.Error().
+			Err(err).
+			Uint32("realmID", realmID).
+			Uint32("auctionID", a.ID).
+			Uint32("itemEntry", a.ItemEntry).
+			Msg("failed to record auction sale")
+	}
+}
+
+// calculateMarketStats calculates stats of the given sales.
+// Median and mean are weighted by items count, so selling a stack counts as selling every item of it.
+func calculateMarketStats(sales []repo.SaleEntry) MarketStats {
+	if len(sales) == 0 {
+		return MarketStats{}
+	}
+
+	sorted := make([]repo.SaleEntry, len(sales))
+	copy(sorted, sales)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].UnitPrice() < sorted[j].UnitPrice()
+	})
+
+	stats := MarketStats{
+		SalesCount: uint32(len(sorted)),
+		MinPrice:   sorted[0].UnitPrice(),
+	}
+
+	var total uint64
+	for _, sale := range sorted {
+		stats.Volume += uint64(itemsInSale(sale))
+		total += uint64(sale.Price)
+	}
+	stats.MeanPrice = uint32(total / stats.Volume)
+
+	medianPos := (stats.Volume + 1) / 2
+	var passed uint64
+	for _, sale := range sorted {
+		passed += uint64(itemsInSale(sale))
+		if passed >= medianPos {
+			stats.MedianPrice = sale.UnitPrice()
+			break
+		}
+	}
+
+	return stats
+}
+
+// calculateMarketStatsSeries splits sales into buckets starting from the given time and calculates stats of each bucket.
+// Sales should be ordered by sale time.
+func calculateMarketStatsSeries(sales []repo.SaleEntry, from, bucketSecs int64) []MarketStatsPoint {
+	var series []MarketStatsPoint
+	for start := 0; start < len(sales); {
+		bucketStart := from + (sales[start].SoldAt-from)/bucketSecs*bucketSecs
+
+		end := start + 1
+		for end < len(sales) && sales[end].SoldAt < bucketStart+bucketSecs {
+			end++
+		}
+
+		series = append(series, MarketStatsPoint{
+			Time:        bucketStart,
+			MarketStats: calculateMarketStats(sales[start:end]),
+		})
+		start = end
+	}
+	return series
+}
+
+// itemsInSale returns the amount of items in the sale, treating empty stack as one item.
+func itemsInSale(sale repo.SaleEntry) uint32 {
+	if sale.ItemCount == 0 {
+		return 1
+	}
+	return sale.ItemCount
+}
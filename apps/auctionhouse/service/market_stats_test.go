@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+func TestCalculateMarketStats(t *testing.T) {
+	sales := []repo.SaleEntry{
+		{ItemCount: 1, Price: 100},
+		{ItemCount: 10, Price: 500}, // 50 per item
+		{ItemCount: 2, Price: 400},  // 200 per item
+	}
+
+	stats := calculateMarketStats(sales)
+	expected := MarketStats{
+		SalesCount:  3,
+		Volume:      13,
+		MinPrice:    50,
+		MedianPrice: 50,
+		MeanPrice:   76,
+	}
+
+	if stats != expected {
+		t.Errorf("Expected stats %+v, got %+v", expected, stats)
+	}
+
+	if empty := calculateMarketStats(nil); empty != (MarketStats{}) {
+		t.Errorf("Expected empty stats, got %+v", empty)
+	}
+}
+
+func TestCalculateMarketStatsSeries(t *testing.T) {
+	sales := []repo.SaleEntry{
+		{ItemCount: 1, Price: 100, SoldAt: 1010},
+		{ItemCount: 1, Price: 300, SoldAt: 1090},
+		{ItemCount: 1, Price: 200, SoldAt: 1350},
+	}
+
+	series := calculateMarketStatsSeries(sales, 1000, 100)
+	expected := []MarketStatsPoint{
+		{Time: 1000, MarketStats: MarketStats{SalesCount: 2, Volume: 2, MinPrice: 100, MedianPrice: 100, MeanPrice: 200}},
+		{Time: 1300, MarketStats: MarketStats{SalesCount: 1, Volume: 1, MinPrice: 200, MedianPrice: 200, MeanPrice: 200}},
+	}
+
+	if !reflect.DeepEqual(series, expected) {
+		t.Errorf("Expected series %+v, got %+v", expected, series)
+	}
+}
+
+func TestMarketStats_RecordsBuyout(t *testing.T) {
+	svc := newTestService()
+	ctx := context.Background()
+	realmID := uint32(1)
+
+	auction := &repo.AuctionEntry{
+		HouseID:     AuctionHouseHorde,
+		ItemGUID:    100,
+		ItemOwner:   1000,
+		StartBid:    100,
+		BuyoutPrice: 1000,
+		Time:        uint32(time.Now().Unix()) + 3600,
+		ItemEntry:   12345,
+		ItemCount:   5,
+	}
+
+	auctionID, err := svc.SellItem(ctx, realmID, uint64(auction.ItemOwner), auction)
+	if err != nil {
+		t.Fatalf("Failed to create auction: %v", err)
+	}
+
+	if _, _, err = svc.PlaceBid(ctx, realmID, 2000, auctionID, auction.BuyoutPrice); err != nil {
+		t.Fatalf("Failed to place buyout: %v", err)
+	}
+
+	from := time.Now().Add(-time.Hour).Unix()
+	total, series, err := svc.MarketStats(ctx, realmID, AuctionHouseHorde, 12345, from, 0, 0)
+	if err != nil {
+		t.Fatalf("Failed to get market stats: %v", err)
+	}
+
+	expected := MarketStats{SalesCount: 1, Volume: 5, MinPrice: 200, MedianPrice: 200, MeanPrice: 200}
+	if total != expected {
+		t.Errorf("Expected stats %+v, got %+v", expected, total)
+	}
+
+	if len(series) != 1 {
+		t.Errorf("Expected 1 point in series, got %d", len(series))
+	}
+
+	// Other house has no sales.
+	total, _, err = svc.MarketStats(ctx, realmID, AuctionHouseAlliance, 12345, from, 0, 0)
+	if err != nil {
+		t.Fatalf("Failed to get market stats: %v", err)
+	}
+
+	if total.SalesCount != 0 {
+		t.Errorf("Expected no sales in alliance house, got %d", total.SalesCount)
+	}
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/auctionhouse/repo"
+)
+
+// RealmOwnership tells which realms are served by the instance.
+type RealmOwnership interface {
+	// AcquireServing checks that the realm is owned by the instance and prevents ownership
+	// handover until release is called. Returns false if the realm isn't owned.
+	AcquireServing(realmID uint32) (release func(), owned bool)
+
+	// OwnedRealms returns realms owned by the instance.
+	OwnedRealms() []uint32
+
+	// RealmOwner returns address of the instance that owns the realm.
+	// Empty address means that the realm is served by any instance.
+	RealmOwner(ctx context.Context, realmID uint32) (string, error)
+}
+
+// ServeOwnedRealms calls fn for every owned realm while ownership of the realm can't be handed over.
+func ServeOwnedRealms(ownership RealmOwnership, fn func(realmID uint32)) {
+	for _, realmID := range ownership.OwnedRealms() {
+		release, owned := ownership.AcquireServing(realmID)
+		if !owned {
+			continue
+		}
+		fn(realmID)
+		release()
+	}
+}
+
+// staticRealmOwnership owns all realms, used when there is a single auction house instance.
+type staticRealmOwnership struct {
+	realmIDs []uint32
+}
+
+// NewStaticRealmOwnership returns ownership of all the given realms.
+func NewStaticRealmOwnership(realmIDs []uint32) RealmOwnership {
+	return &staticRealmOwnership{realmIDs: realmIDs}
+}
+
+func (o *staticRealmOwnership) AcquireServing(realmID uint32) (func(), bool) {
+	for _, id := range o.realmIDs {
+		if id == realmID {
+			return func() {}, true
+		}
+	}
+	return nil, false
+}
+
+func (o *staticRealmOwnership) OwnedRealms() []uint32 {
+	return o.realmIDs
+}
+
+func (o *staticRealmOwnership) RealmOwner(context.Context, uint32) (string, error) {
+	return "", nil
+}
+
+type ownedRealm struct {
+	// serving is read locked by the requests to the realm and write locked on handover.
+	serving sync.RWMutex
+
+	// renewedAt is unix nano time of the last lease renewal.
+	renewedAt atomic.Int64
+
+	// loaded is set when auctions are loaded and the realm can be served, changed only under LeasedRealmOwnership.mu.
+	loaded bool
+
+	// cancelLoad cancels loading of auctions if the lease is lost.
+	cancelLoad context.CancelFunc
+}
+
+// LeasedRealmOwnership partitions realms between auction house instances with leases.
+// Every realm is assigned to one of alive instances with rendezvous hashing, so adding or removing
+// instance moves only a part of realms. Instance that is not preferred for the realm anymore
+// drains in-flight requests and releases the lease, preferred instance acquires the lease and
+// loads auctions. Leases of the dead instances expire after lease ttl.
+//
+// Heartbeat and held leases are renewed in the separate goroutine, so loading auctions of the big realm
+// doesn't let leases of other realms expire.
+type LeasedRealmOwnership struct {
+	repo     repo.RealmOwnershipRepo
+	address  string
+	realmIDs []uint32
+	leaseTTL time.Duration
+
+	onAcquired func(ctx context.Context, realmID uint32) error
+	onReleased func(realmID uint32)
+
+	mu     sync.RWMutex
+	realms map[uint32]*ownedRealm
+
+	ready     chan struct{}
+	readyOnce sync.Once
+}
+
+// NewLeasedRealmOwnership creates ownership of the instance with the given address.
+// onAcquired is called before the instance starts serving the realm, onReleased after it stops.
+func NewLeasedRealmOwnership(
+	r repo.RealmOwnershipRepo, address string, realmIDs []uint32, leaseTTL time.Duration,
+	onAcquired func(ctx context.Context, realmID uint32) error, onReleased func(realmID uint32),
+) *LeasedRealmOwnership {
+	return &LeasedRealmOwnership{
+		repo:       r,
+		address:    address,
+		realmIDs:   realmIDs,
+		leaseTTL:   leaseTTL,
+		onAcquired: onAcquired,
+		onReleased: onReleased,
+		realms:     map[uint32]*ownedRealm{},
+		ready:      make(chan struct{}),
+	}
+}
+
+// AcquireServing returns false if the realm isn't loaded yet or its lease wasn't renewed recently,
+// so nothing is written to the realm that another instance could own already.
+func (o *LeasedRealmOwnership) AcquireServing(realmID uint32) (func(), bool) {
+	o.mu.RLock()
+	r := o.realms[realmID]
+	o.mu.RUnlock()
+	if r == nil {
+		return nil, false
+	}
+
+	r.serving.RLock()
+
+	// Realm could be dropped while we were waiting for the lock.
+	o.mu.RLock()
+	owned := o.realms[realmID] == r && r.loaded
+	o.mu.RUnlock()
+	if !owned || !o.leaseFresh(r) {
+		r.serving.RUnlock()
+		return nil, false
+	}
+
+	return r.serving.RUnlock, true
+}
+
+func (o *LeasedRealmOwnership) OwnedRealms() []uint32 {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	result := make([]uint32, 0, len(o.realms))
+	for realmID, r := range o.realms {
+		if r.loaded {
+			result = append(result, realmID)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func (o *LeasedRealmOwnership) RealmOwner(ctx context.Context, realmID uint32) (string, error) {
+	owner, err := o.repo.RealmOwner(ctx, realmID)
+	if err != nil {
+		return "", err
+	}
+	if owner == "" {
+		return "", ErrRealmNotOwned
+	}
+	return owner, nil
+}
+
+// Ready returns channel that is closed when Run finished the first ownership update.
+func (o *LeasedRealmOwnership) Ready() <-chan struct{} {
+	return o.ready
+}
+
+// Run renews leases every third of lease ttl in the separate goroutine and updates ownership
+// with the same interval until the context is done, then releases all owned realms.
+func (o *LeasedRealmOwnership) Run(ctx context.Context) {
+	renewStopped := make(chan struct{})
+	go func() {
+		defer close(renewStopped)
+
+		ticker := time.NewTicker(o.leaseTTL / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				o.Renew(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	o.Update(ctx)
+	o.readyOnce.Do(func() { close(o.ready) })
+
+	ticker := time.NewTicker(o.leaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			o.Update(ctx)
+		case <-ctx.Done():
+			// Renewal is stopped first, so it doesn't prolong released leases.
+			<-renewStopped
+			o.ReleaseAll(context.Background())
+			return
+		}
+	}
+}
+
+// Renew sends heartbeat and prolongs leases of owned and loading realms.
+// Realms which leases are lost or can't be prolonged in time are dropped.
+func (o *LeasedRealmOwnership) Renew(ctx context.Context) {
+	if err := o.repo.Heartbeat(ctx, o.address, o.leaseTTL); err != nil {
+		log.Error().Err(err).Msg("can't send auction house instance heartbeat")
+	}
+
+	o.mu.RLock()
+	held := make(map[uint32]*ownedRealm, len(o.realms))
+	for realmID, r := range o.realms {
+		held[realmID] = r
+	}
+	o.mu.RUnlock()
+
+	for realmID, r := range held {
+		ok, err := o.repo.AcquireRealm(ctx, realmID, o.address, o.leaseTTL)
+		if err != nil {
+			log.Error().Err(err).Uint32("realmID", realmID).Msg("can't prolong realm lease")
+			// Lease could expire soon, stop serving before somebody else acquires it.
+			if !o.leaseFresh(r) {
+				o.drop(ctx, realmID, false)
+			}
+			continue
+		}
+		if !ok {
+			log.Warn().Uint32("realmID", realmID).Msg("Realm lease was taken by another instance")
+			o.drop(ctx, realmID, false)
+			continue
+		}
+		r.renewedAt.Store(time.Now().UnixNano())
+	}
+}
+
+// Update renews leases, releases realms preferred by other instances and acquires preferred free realms.
+// Auctions of acquired realms are loaded before Update returns.
+func (o *LeasedRealmOwnership) Update(ctx context.Context) {
+	o.Renew(ctx)
+
+	alive, err := o.repo.AliveInstances(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("can't get alive auction house instances")
+		alive = nil
+	}
+
+	for _, realmID := range o.realmIDs {
+		o.mu.RLock()
+		r := o.realms[realmID]
+		o.mu.RUnlock()
+
+		preferred := preferredRealmOwner(alive, o.address, realmID)
+
+		if r != nil {
+			if preferred != o.address {
+				log.Info().Uint32("realmID", realmID).Str("newOwner", preferred).Msg("Handing over realm auctions")
+				o.drop(ctx, realmID, true)
+			}
+			continue
+		}
+
+		if preferred != o.address {
+			continue
+		}
+
+		ok, err := o.repo.AcquireRealm(ctx, realmID, o.address, o.leaseTTL)
+		if err != nil {
+			log.Error().Err(err).Uint32("realmID", realmID).Msg("can't acquire realm lease")
+			continue
+		}
+		if !ok {
+			// Previous owner didn't release the realm yet.
+			continue
+		}
+
+		if err = o.load(ctx, realmID); err != nil {
+			log.Error().Err(err).Uint32("realmID", realmID).Msg("can't start serving realm")
+			continue
+		}
+
+		log.Info().Uint32("realmID", realmID).Msg("Acquired realm auctions")
+	}
+}
+
+// load calls onAcquired for the realm which lease is just acquired. While auctions are loading
+// the lease is renewed by Renew, loading is canceled if the lease is lost.
+func (o *LeasedRealmOwnership) load(ctx context.Context, realmID uint32) error {
+	loadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	r := &ownedRealm{cancelLoad: cancel}
+	r.renewedAt.Store(time.Now().UnixNano())
+
+	o.mu.Lock()
+	o.realms[realmID] = r
+	o.mu.Unlock()
+
+	err := o.onAcquired(loadCtx, realmID)
+
+	o.mu.Lock()
+	owned := o.realms[realmID] == r
+	if owned && err == nil {
+		r.loaded = true
+	}
+	if owned && err != nil {
+		delete(o.realms, realmID)
+	}
+	o.mu.Unlock()
+
+	if !owned {
+		if err == nil {
+			o.onReleased(realmID)
+		}
+		return fmt.Errorf("%w: lease was lost while loading auctions", ErrRealmNotOwned)
+	}
+
+	if err != nil {
+		if releaseErr := o.repo.ReleaseRealm(ctx, realmID, o.address); releaseErr != nil {
+			log.Error().Err(releaseErr).Uint32("realmID", realmID).Msg("can't release realm lease")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// leaseFresh returns true if the lease of the realm was renewed recently enough to be still held.
+func (o *LeasedRealmOwnership) leaseFresh(r *ownedRealm) bool {
+	return time.Since(time.Unix(0, r.renewedAt.Load())) <= o.leaseTTL/2
+}
+
+// ReleaseAll releases all owned realms and removes the instance from alive instances.
+func (o *LeasedRealmOwnership) ReleaseAll(ctx context.Context) {
+	if err := o.repo.RemoveInstance(ctx, o.address); err != nil {
+		log.Error().Err(err).Msg("can't remove auction house instance")
+	}
+
+	o.mu.RLock()
+	held := make([]uint32, 0, len(o.realms))
+	for realmID := range o.realms {
+		held = append(held, realmID)
+	}
+	o.mu.RUnlock()
+
+	for _, realmID := range held {
+		o.drop(ctx, realmID, true)
+	}
+}
+
+// drop stops serving the realm after in-flight requests are finished.
+// Loading of the realm is canceled, loaded auctions are unloaded by the loader then.
+func (o *LeasedRealmOwnership) drop(ctx context.Context, realmID uint32, releaseLease bool) {
+	o.mu.Lock()
+	r := o.realms[realmID]
+	delete(o.realms, realmID)
+	loaded := r != nil && r.loaded
+	o.mu.Unlock()
+
+	if r == nil {
+		return
+	}
+
+	if loaded {
+		r.serving.Lock()
+		o.onReleased(realmID)
+		r.serving.Unlock()
+	} else {
+		r.cancelLoad()
+	}
+
+	if releaseLease {
+		if err := o.repo.ReleaseRealm(ctx, realmID, o.address); err != nil {
+			log.Error().Err(err).Uint32("realmID", realmID).Msg("can't release realm lease")
+		}
+	}
+}
+
+// preferredRealmOwner returns instance with the highest rendezvous hash for the realm.
+// The instance itself is always considered alive.
+func preferredRealmOwner(alive []string, self string, realmID uint32) string {
+	best, bestScore := self, realmOwnerScore(self, realmID)
+	for _, address := range alive {
+		if score := realmOwnerScore(address, realmID); score > bestScore || (score == bestScore && address < best) {
+			best, bestScore = address, score
+		}
+	}
+	return best
+}
+
+func realmOwnerScore(address string, realmID uint32) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(address))
+	h.Write([]byte{':'})
+	h.Write([]byte(strconv.FormatUint(uint64(realmID), 10)))
+
+	// FNV of similar addresses differs mostly in low bits, mix them to spread realms evenly (splitmix64 finalizer).
+	x := h.Sum64()
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testLease struct {
+	owner     string
+	expiresAt time.Time
+}
+
+// mockRealmOwnershipRepo is in-memory RealmOwnershipRepo with controllable clock.
+type mockRealmOwnershipRepo struct {
+	mu        sync.Mutex
+	now       time.Time
+	instances map[string]time.Time
+	leases    map[uint32]testLease
+}
+
+func newMockRealmOwnershipRepo() *mockRealmOwnershipRepo {
+	return &mockRealmOwnershipRepo{
+		now:       time.Now(),
+		instances: map[string]time.Time{},
+		leases:    map[uint32]testLease{},
+	}
+}
+
+func (m *mockRealmOwnershipRepo) advance(d time.Duration) {
+	m.mu.Lock()
+	m.now = m.now.Add(d)
+	m.mu.Unlock()
+}
+
+func (m *mockRealmOwnershipRepo) Heartbeat(ctx context.Context, address string, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.instances[address] = m.now.Add(ttl)
+	return nil
+}
+
+func (m *mockRealmOwnershipRepo) RemoveInstance(ctx context.Context, address string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.instances, address)
+	return nil
+}
+
+func (m *mockRealmOwnershipRepo) AliveInstances(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var result []string
+	for address, expiresAt := range m.instances {
+		if expiresAt.After(m.now) {
+			result = append(result, address)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockRealmOwnershipRepo) AcquireRealm(ctx context.Context, realmID uint32, address string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if l, ok := m.leases[realmID]; ok && l.owner != address && l.expiresAt.After(m.now) {
+		return false, nil
+	}
+	m.leases[realmID] = testLease{owner: address, expiresAt: m.now.Add(ttl)}
+	return true, nil
+}
+
+func (m *mockRealmOwnershipRepo) ReleaseRealm(ctx context.Context, realmID uint32, address string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.leases[realmID].owner == address {
+		delete(m.leases, realmID)
+	}
+	return nil
+}
+
+func (m *mockRealmOwnershipRepo) RealmOwner(ctx context.Context, realmID uint32) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if l, ok := m.leases[realmID]; ok && l.expiresAt.After(m.now) {
+		return l.owner, nil
+	}
+	return "", nil
+}
+
+type testOwnershipInstance struct {
+	*LeasedRealmOwnership
+	loaded map[uint32]bool
+}
+
+func newTestOwnershipInstance(r *mockRealmOwnershipRepo, address string, realmIDs []uint32) *testOwnershipInstance {
+	inst := &testOwnershipInstance{loaded: map[uint32]bool{}}
+	inst.LeasedRealmOwnership = NewLeasedRealmOwnership(r, address, realmIDs, 15*time.Second,
+		func(ctx context.Context, realmID uint32) error {
+			inst.loaded[realmID] = true
+			return nil
+		},
+		func(realmID uint32) {
+			delete(inst.loaded, realmID)
+		},
+	)
+	return inst
+}
+
+// checkPartitioned checks that every realm is owned by exactly one instance and loaded only by the owner.
+func checkPartitioned(t *testing.T, r *mockRealmOwnershipRepo, realmIDs []uint32, instances ...*testOwnershipInstance) {
+	t.Helper()
+	for _, realmID := range realmIDs {
+		owners := 0
+		for _, inst := range instances {
+			release, owned := inst.AcquireServing(realmID)
+			if owned {
+				release()
+				owners++
+
+				if owner, _ := r.RealmOwner(context.Background(), realmID); owner != inst.address {
+					t.Errorf("Realm %d is served by %s, but lease is owned by %s", realmID, inst.address, owner)
+				}
+			}
+			if owned != inst.loaded[realmID] {
+				t.Errorf("Realm %d is served by %s = %v, but loaded = %v", realmID, inst.address, owned, inst.loaded[realmID])
+			}
+		}
+		if owners != 1 {
+			t.Errorf("Expected realm %d to have 1 owner, got %d", realmID, owners)
+		}
+	}
+}
+
+func TestLeasedRealmOwnership_Handover(t *testing.T) {
+	ctx := context.Background()
+	realmIDs := []uint32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	r := newMockRealmOwnershipRepo()
+
+	a := newTestOwnershipInstance(r, "ah-a:8993", realmIDs)
+	a.Update(ctx)
+	if len(a.OwnedRealms()) != len(realmIDs) {
+		t.Fatalf("Expected single instance to own all realms, got %v", a.OwnedRealms())
+	}
+
+	b := newTestOwnershipInstance(r, "ah-b:8993", realmIDs)
+	b.Update(ctx)
+	if len(b.OwnedRealms()) != 0 {
+		t.Fatalf("Expected new instance to wait until realms are released, got %v", b.OwnedRealms())
+	}
+	checkPartitioned(t, r, realmIDs, a, b)
+
+	a.Update(ctx)
+	b.Update(ctx)
+	checkPartitioned(t, r, realmIDs, a, b)
+	if len(a.OwnedRealms()) == 0 || len(b.OwnedRealms()) == 0 {
+		t.Fatalf("Expected realms to be split between instances, got %v and %v", a.OwnedRealms(), b.OwnedRealms())
+	}
+
+	for _, realmID := range b.OwnedRealms() {
+		if owner, err := a.RealmOwner(ctx, realmID); err != nil || owner != b.address {
+			t.Errorf("Expected %s to be owner of realm %d, got %q, %v", b.address, realmID, owner, err)
+		}
+	}
+
+	// Graceful shutdown hands over realms right away.
+	b.ReleaseAll(ctx)
+	if len(b.loaded) != 0 {
+		t.Errorf("Expected stopped instance to unload realms, got %v", b.loaded)
+	}
+	a.Update(ctx)
+	checkPartitioned(t, r, realmIDs, a)
+}
+
+func TestLeasedRealmOwnership_DeadInstance(t *testing.T) {
+	ctx := context.Background()
+	realmIDs := []uint32{1, 2, 3, 4, 5, 6, 7, 8}
+	r := newMockRealmOwnershipRepo()
+
+	a := newTestOwnershipInstance(r, "ah-a:8993", realmIDs)
+	b := newTestOwnershipInstance(r, "ah-b:8993", realmIDs)
+	a.Update(ctx)
+	b.Update(ctx)
+	a.Update(ctx)
+	b.Update(ctx)
+	checkPartitioned(t, r, realmIDs, a, b)
+
+	deadRealms := b.OwnedRealms()
+
+	// b dies without releasing leases, its realms stay unowned until leases expire.
+	r.advance(5 * time.Second)
+	a.Update(ctx)
+	for _, realmID := range deadRealms {
+		if release, owned := a.AcquireServing(realmID); owned {
+			release()
+			t.Fatalf("Realm %d is taken over before lease expired", realmID)
+		}
+	}
+
+	r.advance(11 * time.Second)
+	a.Update(ctx)
+	checkPartitioned(t, r, realmIDs, a)
+}
+
+func TestLeasedRealmOwnership_DrainsBeforeHandover(t *testing.T) {
+	ctx := context.Background()
+	r := newMockRealmOwnershipRepo()
+
+	a := newTestOwnershipInstance(r, "ah-a:8993", []uint32{1})
+	a.Update(ctx)
+
+	release, owned := a.AcquireServing(1)
+	if !owned {
+		t.Fatalf("Expected realm to be owned")
+	}
+
+	released := make(chan struct{})
+	go func() {
+		a.ReleaseAll(ctx)
+		close(released)
+	}()
+
+	select {
+	case <-released:
+		t.Fatalf("Realm released while request is in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if owner, _ := r.RealmOwner(ctx, 1); owner != a.address {
+		t.Errorf("Expected lease to be kept while request is in flight, owner is %q", owner)
+	}
+
+	release()
+	<-released
+
+	if owner, _ := r.RealmOwner(ctx, 1); owner != "" {
+		t.Errorf("Expected lease to be released, owner is %q", owner)
+	}
+	if _, owned = a.AcquireServing(1); owned {
+		t.Errorf("Expected realm not to be served after release")
+	}
+}
+
+func TestLeasedRealmOwnership_KeepsOtherLeasesWhileLoading(t *testing.T) {
+	r := newMockRealmOwnershipRepo()
+	leaseTTL := 300 * time.Millisecond
+
+	var (
+		loadedMu     sync.Mutex
+		loaded       = map[uint32]bool{}
+		stolen       bool
+		otherHeld    bool
+		instanceSeen bool
+	)
+	a := NewLeasedRealmOwnership(r, "ah-a:8993", []uint32{2, 1}, leaseTTL,
+		func(ctx context.Context, realmID uint32) error {
+			if realmID == 1 {
+				// Loading of the big realm takes longer than lease ttl.
+				for i := 0; i < 10; i++ {
+					time.Sleep(leaseTTL / 6)
+					r.advance(leaseTTL / 6)
+				}
+
+				stolen, _ = r.AcquireRealm(ctx, 2, "ah-b:8993", leaseTTL)
+				owner, _ := r.RealmOwner(ctx, 2)
+				otherHeld = owner == "ah-a:8993"
+				alive, _ := r.AliveInstances(ctx)
+				instanceSeen = len(alive) == 1 && alive[0] == "ah-a:8993"
+			}
+
+			loadedMu.Lock()
+			loaded[realmID] = true
+			loadedMu.Unlock()
+			return nil
+		},
+		func(realmID uint32) {
+			loadedMu.Lock()
+			delete(loaded, realmID)
+			loadedMu.Unlock()
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		a.Run(ctx)
+		close(stopped)
+	}()
+	<-a.Ready()
+
+	if stolen || !otherHeld {
+		t.Errorf("Lease of realm 2 expired while realm 1 was loading")
+	}
+	if !instanceSeen {
+		t.Errorf("Instance heartbeat expired while realm 1 was loading")
+	}
+	for _, realmID := range []uint32{1, 2} {
+		release, owned := a.AcquireServing(realmID)
+		if !owned {
+			t.Errorf("Expected realm %d to be served", realmID)
+			continue
+		}
+		release()
+	}
+
+	cancel()
+	<-stopped
+	loadedMu.Lock()
+	defer loadedMu.Unlock()
+	if len(loaded) != 0 {
+		t.Errorf("Expected realms to be unloaded after stop, got %v", loaded)
+	}
+}
+
+func TestLeasedRealmOwnership_LeaseLostWhileLoading(t *testing.T) {
+	r := newMockRealmOwnershipRepo()
+	leaseTTL := 300 * time.Millisecond
+
+	var (
+		loadedMu sync.Mutex
+		loaded   = map[uint32]bool{}
+	)
+	a := NewLeasedRealmOwnership(r, "ah-a:8993", []uint32{1}, leaseTTL,
+		func(ctx context.Context, realmID uint32) error {
+			r.mu.Lock()
+			r.leases[realmID] = testLease{owner: "ah-b:8993", expiresAt: r.now.Add(time.Hour)}
+			r.mu.Unlock()
+
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Second):
+				t.Errorf("Expected loading to be canceled")
+			}
+
+			// Auctions are loaded anyway, they should be unloaded.
+			loadedMu.Lock()
+			loaded[realmID] = true
+			loadedMu.Unlock()
+			return nil
+		},
+		func(realmID uint32) {
+			loadedMu.Lock()
+			delete(loaded, realmID)
+			loadedMu.Unlock()
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go a.Run(ctx)
+	<-a.Ready()
+
+	if _, owned := a.AcquireServing(1); owned {
+		t.Errorf("Expected realm not to be served after lease is lost")
+	}
+	loadedMu.Lock()
+	if loaded[1] {
+		t.Errorf("Expected auctions to be unloaded after lease is lost")
+	}
+	loadedMu.Unlock()
+	if owner, _ := r.RealmOwner(context.Background(), 1); owner != "ah-b:8993" {
+		t.Errorf("Expected lease of another instance to be kept, owner is %q", owner)
+	}
+}
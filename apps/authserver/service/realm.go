@@ -28,7 +28,8 @@ type RealmListItem struct {
 }
 
 type RealmService interface {
-	RealmListForAccount(ctx context.Context, account *repo.Account) ([]RealmListItem, error)
+	// RealmListForAccount returns realms with addresses of the gateways selected for the client with the given IP.
+	RealmListForAccount(ctx context.Context, account *repo.Account, clientIP string) ([]RealmListItem, error)
 }
 
 type realmServiceImpl struct {
@@ -43,7 +44,7 @@ func NewRealmService(realmRepo repo.RealmRepo, servRegistry pb.ServersRegistrySe
 	}
 }
 
-func (r *realmServiceImpl) RealmListForAccount(ctx context.Context, account *repo.Account) ([]RealmListItem, error) {
+func (r *realmServiceImpl) RealmListForAccount(ctx context.Context, account *repo.Account, clientIP string) ([]RealmListItem, error) {
 	realms, err := r.realmRepo.LoadRealms(ctx)
 	if err != nil {
 		return nil, err
@@ -57,6 +58,7 @@ func (r *realmServiceImpl) RealmListForAccount(ctx context.Context, account *rep
 	gatewaysResp, err := r.servRegistry.GatewaysForRealms(ctx, &pb.GatewaysForRealmsRequest{
 		Api:      "v1.0",
 		RealmIDs: realmIDs,
+		ClientIP: clientIP,
 	})
 	if err != nil {
 		return nil, err
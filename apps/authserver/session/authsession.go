@@ -391,7 +391,8 @@ func (s *AuthSession) HandleRealmList() error {
 		return err
 	}
 
-	realmList, err = s.realmService.RealmListForAccount(context.TODO(), s.account)
+	clientIP, _, _ := net.SplitHostPort(s.conn.RemoteAddr().String())
+	realmList, err = s.realmService.RealmListForAccount(context.TODO(), s.account, clientIP)
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/walkline/ToCloud9/gen/characters/pb"
 	pbGuild "github.com/walkline/ToCloud9/gen/guilds/pb"
 	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 	shrepo "github.com/walkline/ToCloud9/shared/repo"
 )
 
@@ -72,48 +73,106 @@ func main() {
 
 	charRepo := repo.NewCharactersMYSQL(charDB)
 
-	onlineCharsRepo := repo.NewCharactersOnlineInMem()
+	onlineCharsRepo := onlineCharactersRepo(conf)
+
+	realmLinks, err := service.NewRealmLinks(conf.CrossRealmSocial, conf.BattleGroups)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't parse battle groups")
+	}
 
 	// Friends initialization
 	friendsOnlineCache := service.NewOnlinePlayersCache()
 	friendsEventsProducer := events.NewFriendsServiceProducerNatsJSON(nc, charserver.Ver)
-	friendsService := service.NewFriendsService(charRepo, friendsOnlineCache, friendsEventsProducer)
-	friendsOnlineCache.SetFriendsService(friendsService)
+	friendsService := service.NewFriendsService(charRepo, friendsOnlineCache, friendsEventsProducer, onlineCharsRepo, realmLinks)
 
-	// Composite handlers to call both onlineCharsRepo and friendsOnlineCache
-	compositeLoggedInHandler := &compositeLoggedInHandler{
-		handlers: []events.GWCharacterLoggedInHandler{onlineCharsRepo, friendsOnlineCache},
+	// Shared storage should be updated only by one of characters services.
+	onlineCharsQueueGroup := ""
+	if conf.RedisConnection != "" {
+		onlineCharsQueueGroup = "char_online_group"
 	}
-	compositeLoggedOutHandler := &compositeLoggedOutHandler{
-		handlers: []events.GWCharacterLoggedOutHandler{onlineCharsRepo, friendsOnlineCache},
+
+	onlineCharsConsumerOptions := []events.GatewayConsumerOption{
+		events.WithGWConsumerLoggedInHandler(onlineCharsRepo),
+		events.WithGWConsumerLoggedOutHandler(onlineCharsRepo),
+		events.WithGWConsumerCharsUpdatesHandler(onlineCharsRepo),
+		events.WithGWConsumerOnlineCharactersHandler(onlineCharsRepo),
 	}
-	compositeUpdatesHandler := &compositeCharsUpdatesHandler{
-		handlers: []events.GWCharactersUpdatesHandler{onlineCharsRepo, friendsOnlineCache},
+	if onlineCharsQueueGroup != "" {
+		onlineCharsConsumerOptions = append(onlineCharsConsumerOptions, events.WithGWConsumerQueueGroup(onlineCharsQueueGroup))
+	}
+
+	onlineCharsConsumer := events.NewGatewayConsumer(nc, onlineCharsConsumerOptions...)
+	err = onlineCharsConsumer.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to gateway updates")
+	}
+	defer onlineCharsConsumer.Stop()
+
+	guildListener := service.NewGuildMembershipListener(onlineCharsRepo, nc, onlineCharsQueueGroup)
+	err = guildListener.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to guild updates")
+	}
+	defer guildListener.Stop()
+
+	// Friends cache is in memory, so every characters service needs all events.
+	friendsEventsConsumer := events.NewGatewayConsumer(
+		nc,
+		events.WithGWConsumerLoggedInHandler(friendsOnlineCache),
+		events.WithGWConsumerLoggedOutHandler(friendsOnlineCache),
+		events.WithGWConsumerCharsUpdatesHandler(friendsOnlineCache),
+	)
+	err = friendsEventsConsumer.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to gateway updates")
 	}
+	defer friendsEventsConsumer.Stop()
 
-	gwEventsConsumer := events.NewGatewayConsumer(
+	// Friends should get status notification once, so only one of characters services sends it.
+	friendsNotifier := service.NewFriendsStatusNotifier(friendsService)
+	friendsNotifyConsumer := events.NewGatewayConsumer(
 		nc,
-		events.WithGWConsumerLoggedInHandler(compositeLoggedInHandler),
-		events.WithGWConsumerLoggedOutHandler(compositeLoggedOutHandler),
-		events.WithGWConsumerCharsUpdatesHandler(compositeUpdatesHandler),
+		events.WithGWConsumerLoggedInHandler(friendsNotifier),
+		events.WithGWConsumerLoggedOutHandler(friendsNotifier),
+		events.WithGWConsumerQueueGroup("char_friends_notify_group"),
 	)
-	err = gwEventsConsumer.Listen()
+	err = friendsNotifyConsumer.Listen()
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't listen to gateway updates")
 	}
-	defer gwEventsConsumer.Stop()
+	defer friendsNotifyConsumer.Stop()
 
-	srHandler := service.NewServersRegistryListener(onlineCharsRepo, events.NewCharactersServiceProducerNatsJSON(nc, charserver.Ver), nc)
+	charEventsProducer := events.NewCharactersServiceProducerNatsJSON(nc, charserver.Ver)
+
+	srHandler := service.NewServersRegistryListener(onlineCharsRepo, charEventsProducer, nc)
 	err = srHandler.Listen()
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't listen to servers registry updates")
 	}
 	defer srHandler.Stop()
 
+	// Online characters could be missed while the service was down, gateways send characters they have.
+	realmIDs := make([]uint32, 0, len(conf.CharDBConnection))
+	for realmID := range conf.CharDBConnection {
+		realmIDs = append(realmIDs, realmID)
+	}
+	err = charEventsProducer.OnlineCharactersResyncRequested(&events.CharEventOnlineCharactersResyncRequestedPayload{
+		RealmIDs: realmIDs,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("can't request online characters resync")
+	}
+
+	// Characters that logged in before this service started aren't in the friends cache yet.
+	err = service.SeedOnlinePlayersCache(context.Background(), friendsOnlineCache, onlineCharsRepo, realmIDs)
+	if err != nil {
+		log.Error().Err(err).Msg("can't seed friends online cache")
+	}
+
 	guildNames := service.NewGuildNamesService(guildServiceClient(conf))
 
 	grpcServer := grpc.NewServer()
-	pb.RegisterCharactersServiceServer(grpcServer, server.NewCharServer(charRepo, onlineCharsRepo, onlineCharsRepo, itemsTemplate, friendsService, guildNames))
+	pb.RegisterCharactersServiceServer(grpcServer, server.NewCharServer(charRepo, onlineCharsRepo, onlineCharsRepo, itemsTemplate, friendsService, guildNames, realmLinks))
 
 	log.Info().Str("address", lis.Addr().String()).Msg("🚀 Characters Server Started!")
 
@@ -122,6 +181,19 @@ func main() {
 	}
 }
 
+func onlineCharactersRepo(cnf *config.Config) repo.CharactersOnline {
+	if cnf.RedisConnection == "" {
+		return repo.NewCharactersOnlineInMem()
+	}
+
+	rdb, err := redisclient.NewClient(cnf.RedisConnection, cnf.Redis)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to the redis")
+	}
+
+	return repo.NewCharactersOnlineRedis(rdb)
+}
+
 func guildServiceClient(cnf *config.Config) pbGuild.GuildServiceClient {
 	conn, err := grpc.Dial(cnf.GuildsServiceAddress, grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
 		dialer := net.Dialer{Timeout: time.Second * 5}
@@ -140,45 +212,3 @@ func configureDBConn(db *sql.DB) {
 	db.SetConnMaxLifetime(time.Minute * 4)
 	db.SetConnMaxIdleTime(time.Minute * 8)
 }
-
-// Composite handlers to call multiple event handlers
-// Needed because GatewayConsumer only supports one handler per event type
-
-type compositeLoggedInHandler struct {
-	handlers []events.GWCharacterLoggedInHandler
-}
-
-func (c *compositeLoggedInHandler) HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error {
-	for _, h := range c.handlers {
-		if err := h.HandleCharacterLoggedIn(payload); err != nil {
-			log.Error().Err(err).Msg("composite handler: error in HandleCharacterLoggedIn")
-		}
-	}
-	return nil
-}
-
-type compositeLoggedOutHandler struct {
-	handlers []events.GWCharacterLoggedOutHandler
-}
-
-func (c *compositeLoggedOutHandler) HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error {
-	for _, h := range c.handlers {
-		if err := h.HandleCharacterLoggedOut(payload); err != nil {
-			log.Error().Err(err).Msg("composite handler: error in HandleCharacterLoggedOut")
-		}
-	}
-	return nil
-}
-
-type compositeCharsUpdatesHandler struct {
-	handlers []events.GWCharactersUpdatesHandler
-}
-
-func (c *compositeCharsUpdatesHandler) HandleCharactersUpdates(payload events.GWEventCharactersUpdatesPayload) error {
-	for _, h := range c.handlers {
-		if err := h.HandleCharactersUpdates(payload); err != nil {
-			log.Error().Err(err).Msg("composite handler: error in HandleCharactersUpdates")
-		}
-	}
-	return nil
-}
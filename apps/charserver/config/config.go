@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/walkline/ToCloud9/shared/config"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 // Config is config of application
@@ -22,6 +23,21 @@ type Config struct {
 
 	// GuildsServiceAddress is address of guilds service
 	GuildsServiceAddress string `yaml:"guildsServiceAddress" env:"GUILDS_SERVICE_ADDRESS" env-default:"localhost:8995"`
+
+	// RedisConnection is redis connection url used to share online characters between characters services.
+	// If empty, online characters are stored in memory, so only one characters service should be running.
+	RedisConnection string `yaml:"redisUrl" env:"REDIS_URL"`
+
+	// Redis is config of the redis sentinel or cluster connection and retries on failover.
+	Redis redisclient.Config `yaml:"redis"`
+
+	// CrossRealmSocial enables who, whispers and friends between realms of the same battle group.
+	// Characters of other realms are addressed as Name-Realm.
+	CrossRealmSocial bool `yaml:"crossRealmSocial" env:"CROSS_REALM_SOCIAL"`
+
+	// BattleGroups are unions of realms, the same as in matchmaking service config.
+	// Used only if CrossRealmSocial is enabled.
+	BattleGroups map[uint32]string `yaml:"battleGroups" env:"BATTLE_GROUPS" env-separator:";" env-default:"1:1"`
 }
 
 // LoadConfig loads config from env variables
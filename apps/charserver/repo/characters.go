@@ -57,8 +57,12 @@ type FriendEntry struct {
 	FriendGUID uint64
 	Flags      uint8
 	Note       string
+
+	// FriendRealmID is realm of the friend, set for cross-realm friends.
+	FriendRealmID uint32
 }
 
+//go:generate mockery --name=Characters
 type Characters interface {
 	ListCharactersToLogIn(ctx context.Context, realmID, accountID uint32) ([]LogInCharacter, error)
 	CharacterToLogInByGUID(ctx context.Context, realmID uint32, charGUID uint64) (*LogInCharacter, error)
@@ -74,4 +78,11 @@ type Characters interface {
 	AddIgnore(ctx context.Context, realmID uint32, playerGUID, ignoredGUID uint64) error
 	RemoveIgnore(ctx context.Context, realmID uint32, playerGUID, ignoredGUID uint64) error
 	GetPlayersWhoHaveAsFriend(ctx context.Context, realmID uint32, playerGUID uint64) ([]uint64, error)
+
+	// Cross-realm friends are stored in the realm of the player, realmID is realm of the player.
+	GetCrossRealmFriendsForPlayer(ctx context.Context, realmID uint32, playerGUID uint64) ([]*FriendEntry, error)
+	AddCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error
+	RemoveCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error
+	UpdateCrossRealmFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error
+	GetPlayersWhoHaveAsCrossRealmFriend(ctx context.Context, realmID, friendRealmID uint32, friendGUID uint64) ([]uint64, error)
 }
@@ -44,6 +44,16 @@ func (s CharactersPreparedStatements) Stmt() string {
 		return "DELETE FROM character_social WHERE guid = ? AND friend = ? AND flags = ?"
 	case StmtGetPlayersWhoHaveAsFriend:
 		return "SELECT guid FROM character_social WHERE friend = ? AND flags = ?"
+	case StmtGetCrossRealmFriendsForPlayer:
+		return "SELECT friendRealm, friend, note FROM character_social_crossrealm WHERE guid = ?"
+	case StmtAddCrossRealmFriend:
+		return "INSERT INTO character_social_crossrealm (guid, friendRealm, friend, note) VALUES (?, ?, ?, ?)"
+	case StmtRemoveCrossRealmFriend:
+		return "DELETE FROM character_social_crossrealm WHERE guid = ? AND friendRealm = ? AND friend = ?"
+	case StmtUpdateCrossRealmFriendNote:
+		return "UPDATE character_social_crossrealm SET note = ? WHERE guid = ? AND friendRealm = ? AND friend = ?"
+	case StmtGetPlayersWhoHaveAsCrossRealmFriend:
+		return "SELECT guid FROM character_social_crossrealm WHERE friendRealm = ? AND friend = ?"
 	}
 
 	panic(fmt.Errorf("unk stmt %d", s))
@@ -66,6 +76,11 @@ const (
 	StmtAddIgnore
 	StmtRemoveIgnore
 	StmtGetPlayersWhoHaveAsFriend
+	StmtGetCrossRealmFriendsForPlayer
+	StmtAddCrossRealmFriend
+	StmtRemoveCrossRealmFriend
+	StmtUpdateCrossRealmFriendNote
+	StmtGetPlayersWhoHaveAsCrossRealmFriend
 )
 
 type CharactersMYSQL struct {
@@ -85,6 +100,11 @@ func NewCharactersMYSQL(db shrepo.CharactersDB) Characters {
 	db.SetPreparedStatement(StmtAddIgnore)
 	db.SetPreparedStatement(StmtRemoveIgnore)
 	db.SetPreparedStatement(StmtGetPlayersWhoHaveAsFriend)
+	db.SetPreparedStatement(StmtGetCrossRealmFriendsForPlayer)
+	db.SetPreparedStatement(StmtAddCrossRealmFriend)
+	db.SetPreparedStatement(StmtRemoveCrossRealmFriend)
+	db.SetPreparedStatement(StmtUpdateCrossRealmFriendNote)
+	db.SetPreparedStatement(StmtGetPlayersWhoHaveAsCrossRealmFriend)
 
 	return &CharactersMYSQL{
 		db: db,
@@ -319,3 +339,58 @@ func (c CharactersMYSQL) GetPlayersWhoHaveAsFriend(ctx context.Context, realmID
 
 	return result, rows.Err()
 }
+
+func (c CharactersMYSQL) GetCrossRealmFriendsForPlayer(ctx context.Context, realmID uint32, playerGUID uint64) ([]*FriendEntry, error) {
+	rows, err := c.db.PreparedStatement(realmID, StmtGetCrossRealmFriendsForPlayer).QueryContext(ctx, playerGUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*FriendEntry
+	for rows.Next() {
+		entry := &FriendEntry{PlayerGUID: playerGUID, Flags: SocialFlagFriend}
+		err = rows.Scan(&entry.FriendRealmID, &entry.FriendGUID, &entry.Note)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+
+	return result, rows.Err()
+}
+
+func (c CharactersMYSQL) AddCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	_, err := c.db.PreparedStatement(realmID, StmtAddCrossRealmFriend).ExecContext(ctx, playerGUID, friendRealmID, friendGUID, note)
+	return err
+}
+
+func (c CharactersMYSQL) RemoveCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error {
+	_, err := c.db.PreparedStatement(realmID, StmtRemoveCrossRealmFriend).ExecContext(ctx, playerGUID, friendRealmID, friendGUID)
+	return err
+}
+
+func (c CharactersMYSQL) UpdateCrossRealmFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	_, err := c.db.PreparedStatement(realmID, StmtUpdateCrossRealmFriendNote).ExecContext(ctx, note, playerGUID, friendRealmID, friendGUID)
+	return err
+}
+
+func (c CharactersMYSQL) GetPlayersWhoHaveAsCrossRealmFriend(ctx context.Context, realmID, friendRealmID uint32, friendGUID uint64) ([]uint64, error) {
+	rows, err := c.db.PreparedStatement(realmID, StmtGetPlayersWhoHaveAsCrossRealmFriend).QueryContext(ctx, friendRealmID, friendGUID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []uint64
+	for rows.Next() {
+		var guid uint64
+		err = rows.Scan(&guid)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, guid)
+	}
+
+	return result, rows.Err()
+}
@@ -0,0 +1,431 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	repo "github.com/walkline/ToCloud9/apps/charserver/repo"
+)
+
+// Characters is an autogenerated mock type for the Characters type
+type Characters struct {
+	mock.Mock
+}
+
+// AccountDataForAccountID provides a mock function with given fields: ctx, realmID, accountID
+func (_m *Characters) AccountDataForAccountID(ctx context.Context, realmID uint32, accountID uint32) ([]repo.AccountData, error) {
+	ret := _m.Called(ctx, realmID, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountDataForAccountID")
+	}
+
+	var r0 []repo.AccountData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) ([]repo.AccountData, error)); ok {
+		return rf(ctx, realmID, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) []repo.AccountData); ok {
+		r0 = rf(ctx, realmID, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repo.AccountData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32) error); ok {
+		r1 = rf(ctx, realmID, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddCrossRealmFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID, note
+func (_m *Characters) AddCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCrossRealmFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64, string) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID, note
+func (_m *Characters) AddFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64, note string) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendGUID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, string) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendGUID, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddIgnore provides a mock function with given fields: ctx, realmID, playerGUID, ignoredGUID
+func (_m *Characters) AddIgnore(ctx context.Context, realmID uint32, playerGUID uint64, ignoredGUID uint64) error {
+	ret := _m.Called(ctx, realmID, playerGUID, ignoredGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIgnore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, ignoredGUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CharacterByName provides a mock function with given fields: ctx, realmID, name
+func (_m *Characters) CharacterByName(ctx context.Context, realmID uint32, name string) (*repo.Character, error) {
+	ret := _m.Called(ctx, realmID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CharacterByName")
+	}
+
+	var r0 *repo.Character
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, string) (*repo.Character, error)); ok {
+		return rf(ctx, realmID, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, string) *repo.Character); ok {
+		r0 = rf(ctx, realmID, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repo.Character)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, string) error); ok {
+		r1 = rf(ctx, realmID, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CharacterToLogInByGUID provides a mock function with given fields: ctx, realmID, charGUID
+func (_m *Characters) CharacterToLogInByGUID(ctx context.Context, realmID uint32, charGUID uint64) (*repo.LogInCharacter, error) {
+	ret := _m.Called(ctx, realmID, charGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CharacterToLogInByGUID")
+	}
+
+	var r0 *repo.LogInCharacter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) (*repo.LogInCharacter, error)); ok {
+		return rf(ctx, realmID, charGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) *repo.LogInCharacter); ok {
+		r0 = rf(ctx, realmID, charGUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*repo.LogInCharacter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, charGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCrossRealmFriendsForPlayer provides a mock function with given fields: ctx, realmID, playerGUID
+func (_m *Characters) GetCrossRealmFriendsForPlayer(ctx context.Context, realmID uint32, playerGUID uint64) ([]*repo.FriendEntry, error) {
+	ret := _m.Called(ctx, realmID, playerGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCrossRealmFriendsForPlayer")
+	}
+
+	var r0 []*repo.FriendEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) ([]*repo.FriendEntry, error)); ok {
+		return rf(ctx, realmID, playerGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) []*repo.FriendEntry); ok {
+		r0 = rf(ctx, realmID, playerGUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repo.FriendEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, playerGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFriendsForPlayer provides a mock function with given fields: ctx, realmID, playerGUID
+func (_m *Characters) GetFriendsForPlayer(ctx context.Context, realmID uint32, playerGUID uint64) ([]*repo.FriendEntry, error) {
+	ret := _m.Called(ctx, realmID, playerGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFriendsForPlayer")
+	}
+
+	var r0 []*repo.FriendEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) ([]*repo.FriendEntry, error)); ok {
+		return rf(ctx, realmID, playerGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) []*repo.FriendEntry); ok {
+		r0 = rf(ctx, realmID, playerGUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*repo.FriendEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, playerGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlayersWhoHaveAsCrossRealmFriend provides a mock function with given fields: ctx, realmID, friendRealmID, friendGUID
+func (_m *Characters) GetPlayersWhoHaveAsCrossRealmFriend(ctx context.Context, realmID uint32, friendRealmID uint32, friendGUID uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, realmID, friendRealmID, friendGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlayersWhoHaveAsCrossRealmFriend")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32, uint64) ([]uint64, error)); ok {
+		return rf(ctx, realmID, friendRealmID, friendGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32, uint64) []uint64); ok {
+		r0 = rf(ctx, realmID, friendRealmID, friendGUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, friendRealmID, friendGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlayersWhoHaveAsFriend provides a mock function with given fields: ctx, realmID, playerGUID
+func (_m *Characters) GetPlayersWhoHaveAsFriend(ctx context.Context, realmID uint32, playerGUID uint64) ([]uint64, error) {
+	ret := _m.Called(ctx, realmID, playerGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlayersWhoHaveAsFriend")
+	}
+
+	var r0 []uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) ([]uint64, error)); ok {
+		return rf(ctx, realmID, playerGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) []uint64); ok {
+		r0 = rf(ctx, realmID, playerGUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, playerGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListCharactersToLogIn provides a mock function with given fields: ctx, realmID, accountID
+func (_m *Characters) ListCharactersToLogIn(ctx context.Context, realmID uint32, accountID uint32) ([]repo.LogInCharacter, error) {
+	ret := _m.Called(ctx, realmID, accountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCharactersToLogIn")
+	}
+
+	var r0 []repo.LogInCharacter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) ([]repo.LogInCharacter, error)); ok {
+		return rf(ctx, realmID, accountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint32) []repo.LogInCharacter); ok {
+		r0 = rf(ctx, realmID, accountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repo.LogInCharacter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint32) error); ok {
+		r1 = rf(ctx, realmID, accountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveCrossRealmFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID
+func (_m *Characters) RemoveCrossRealmFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveCrossRealmFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID
+func (_m *Characters) RemoveFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveFriend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendGUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveIgnore provides a mock function with given fields: ctx, realmID, playerGUID, ignoredGUID
+func (_m *Characters) RemoveIgnore(ctx context.Context, realmID uint32, playerGUID uint64, ignoredGUID uint64) error {
+	ret := _m.Called(ctx, realmID, playerGUID, ignoredGUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveIgnore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, ignoredGUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveCharacterPosition provides a mock function with given fields: ctx, realmID, charGUID, mapID, x, y, z, o
+func (_m *Characters) SaveCharacterPosition(ctx context.Context, realmID uint32, charGUID uint64, mapID uint32, x float32, y float32, z float32, o float32) error {
+	ret := _m.Called(ctx, realmID, charGUID, mapID, x, y, z, o)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveCharacterPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, float32, float32, float32, float32) error); ok {
+		r0 = rf(ctx, realmID, charGUID, mapID, x, y, z, o)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCrossRealmFriendNote provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID, note
+func (_m *Characters) UpdateCrossRealmFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCrossRealmFriendNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64, string) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateFriendNote provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID, note
+func (_m *Characters) UpdateFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64, note string) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendGUID, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateFriendNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, string) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendGUID, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCharacters creates a new instance of Characters. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCharacters(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Characters {
+	mock := &Characters{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
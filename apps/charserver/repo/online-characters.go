@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"strings"
 
 	"github.com/walkline/ToCloud9/shared/events"
 )
@@ -22,6 +23,10 @@ type Character struct {
 	CharPosZ    float32
 	CharGuildID uint32
 	AccountID   uint32
+
+	// CharChatFlags is AFK/DND status of the character, see events.CharacterChatFlagAFK.
+	CharChatFlags   uint8
+	CharAwayMessage string
 }
 
 type CharactersOnline interface {
@@ -40,6 +45,8 @@ type CharactersOnline interface {
 	events.GWCharacterLoggedOutHandler
 	// GWCharactersUpdatesHandler updates cache with pack of characters updates.
 	events.GWCharactersUpdatesHandler
+	// GWOnlineCharactersHandler replaces characters of the gateway with the gateway snapshot.
+	events.GWOnlineCharactersHandler
 
 	WhoHandler
 }
@@ -52,9 +59,95 @@ type CharactersWhoQuery struct {
 	RaceMask  uint32
 	Zones     []uint32
 	Strings   []string
+
+	// Limit is max amount of characters to return, 0 means no limit.
+	Limit int
+}
+
+// Matches checks that the character passes filters of the query.
+func (query *CharactersWhoQuery) Matches(char *Character) bool {
+	if query.LvlMax < char.CharLevel || query.LvlMin > char.CharLevel {
+		return false
+	}
+
+	race := uint32(char.CharRace)
+	if query.RaceMask&(1<<race) == 0 {
+		return false
+	}
+
+	class := uint32(char.CharClass)
+	if query.ClassMask&(1<<class) == 0 {
+		return false
+	}
+
+	showZones := true
+	for _, zone := range query.Zones {
+		if char.CharZone == zone {
+			showZones = true
+			break
+		}
+		showZones = false
+	}
+
+	if !showZones {
+		return false
+	}
+
+	if len(query.Strings) > 0 {
+		for _, s := range query.Strings {
+			if strings.Contains(strings.ToLower(char.CharName), strings.ToLower(s)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
 }
 
 // WhoHandler represents handler for SMsgWho packet.
 type WhoHandler interface {
 	WhoRequest(ctx context.Context, requesterRealmID uint32, requesterGUID uint64, query CharactersWhoQuery) ([]Character, error)
 }
+
+// characterFromLoggedInPayload converts payload of GWEventCharacterLoggedIn to the online character.
+func characterFromLoggedInPayload(payload *events.GWEventCharacterLoggedInPayload) *Character {
+	return &Character{
+		RealmID:     payload.RealmID,
+		GatewayID:   payload.GatewayID,
+		CharGUID:    payload.CharGUID,
+		CharName:    payload.CharName,
+		CharRace:    payload.CharRace,
+		CharClass:   payload.CharClass,
+		CharGender:  payload.CharGender,
+		CharLevel:   payload.CharLevel,
+		CharZone:    payload.CharZone,
+		CharMap:     payload.CharMap,
+		CharPosX:    payload.CharPosX,
+		CharPosY:    payload.CharPosY,
+		CharPosZ:    payload.CharPosZ,
+		CharGuildID: payload.CharGuildID,
+		AccountID:   payload.AccountID,
+
+		CharChatFlags:   payload.CharChatFlags,
+		CharAwayMessage: payload.CharAwayMessage,
+	}
+}
+
+// replaceGatewayCharacters replaces characters of the gateway in the storage with the gateway snapshot.
+func replaceGatewayCharacters(ctx context.Context, c CharactersOnline, payload events.GWEventOnlineCharactersPayload) error {
+	if _, err := c.RemoveAllWithGatewayID(ctx, payload.RealmID, payload.GatewayID); err != nil {
+		return err
+	}
+
+	for i := range payload.Characters {
+		char := characterFromLoggedInPayload(&payload.Characters[i])
+		char.RealmID = payload.RealmID
+		char.GatewayID = payload.GatewayID
+		if err := c.Add(ctx, char); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
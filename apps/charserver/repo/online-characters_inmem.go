@@ -112,23 +112,7 @@ func (c *charactersOnlineInMem) AllGUIDsByRealm(ctx context.Context, realmID uin
 }
 
 func (c *charactersOnlineInMem) HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error {
-	return c.Add(context.TODO(), &Character{
-		RealmID:     payload.RealmID,
-		GatewayID:   payload.GatewayID,
-		CharGUID:    payload.CharGUID,
-		CharName:    payload.CharName,
-		CharRace:    payload.CharRace,
-		CharClass:   payload.CharClass,
-		CharGender:  payload.CharGender,
-		CharLevel:   payload.CharLevel,
-		CharZone:    payload.CharZone,
-		CharMap:     payload.CharMap,
-		CharPosX:    payload.CharPosX,
-		CharPosY:    payload.CharPosY,
-		CharPosZ:    payload.CharPosZ,
-		CharGuildID: payload.CharGuildID,
-		AccountID:   payload.AccountID,
-	})
+	return c.Add(context.TODO(), characterFromLoggedInPayload(&payload))
 }
 
 func (c *charactersOnlineInMem) HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error {
@@ -147,6 +131,10 @@ func (c *charactersOnlineInMem) HandleCharactersUpdates(payload events.GWEventCh
 	return nil
 }
 
+func (c *charactersOnlineInMem) HandleOnlineCharacters(payload events.GWEventOnlineCharactersPayload) error {
+	return replaceGatewayCharacters(context.TODO(), c, payload)
+}
+
 func (c *charactersOnlineInMem) RemoveAllWithGatewayID(ctx context.Context, realmID uint32, gatewayID string) ([]uint64, error) {
 	charsToDelete := make([]uint64, 0, 20)
 
@@ -181,6 +169,18 @@ func applyCharUpdate(c *Character, upd *events.CharacterUpdate) {
 	if upd.Map != nil {
 		c.CharMap = *upd.Map
 	}
+
+	if upd.GuildID != nil {
+		c.CharGuildID = *upd.GuildID
+	}
+
+	if upd.ChatFlags != nil {
+		c.CharChatFlags = *upd.ChatFlags
+	}
+
+	if upd.AwayMsg != nil {
+		c.CharAwayMessage = *upd.AwayMsg
+	}
 }
 
 func (c *charactersOnlineInMem) WhoRequest(_ context.Context, requesterRealmID uint32, requesterGUID uint64, query CharactersWhoQuery) ([]Character, error) {
@@ -200,47 +200,12 @@ func (c *charactersOnlineInMem) WhoRequest(_ context.Context, requesterRealmID u
 			continue
 		}
 
-		if query.LvlMax < char.CharLevel || query.LvlMin > char.CharLevel {
-			continue
-		}
-
-		race := uint32(char.CharRace)
-		if query.RaceMask&(1<<race) == 0 {
-			continue
-		}
-
-		class := uint32(char.CharClass)
-		if query.ClassMask&(1<<class) == 0 {
-			continue
-		}
-
-		showZones := true
-		for _, zone := range query.Zones {
-			if char.CharZone == zone {
-				showZones = true
+		if query.Matches(&char) {
+			result = append(result, char)
+			if query.Limit > 0 && len(result) >= query.Limit {
 				break
 			}
-			showZones = false
-		}
-
-		if !showZones {
-			continue
-		}
-
-		if len(query.Strings) > 0 {
-			showName := false
-			for _, s := range query.Strings {
-				if strings.Contains(strings.ToLower(char.CharName), strings.ToLower(s)) {
-					showName = true
-					break
-				}
-			}
-			if !showName {
-				continue
-			}
 		}
-
-		result = append(result, char)
 	}
 
 	return result, nil
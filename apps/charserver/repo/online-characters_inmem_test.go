@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/shared/events"
 )
 
 func TestWhoRequestFiltersByZone(t *testing.T) {
@@ -51,3 +53,60 @@ func TestWhoRequestFiltersByZone(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Len(t, chars, 0)
 }
+
+func TestHandleOnlineCharactersReplacesGatewayCharacters(t *testing.T) {
+	r := NewCharactersOnlineInMem()
+	ctx := context.Background()
+
+	for _, char := range []*Character{
+		{RealmID: 1, GatewayID: "gw1", CharGUID: 1, CharName: "Stale"},
+		{RealmID: 1, GatewayID: "gw1", CharGUID: 2, CharName: "Kept"},
+		{RealmID: 1, GatewayID: "gw2", CharGUID: 3, CharName: "Other"},
+	} {
+		assert.NoError(t, r.Add(ctx, char))
+	}
+
+	assert.NoError(t, r.HandleOnlineCharacters(events.GWEventOnlineCharactersPayload{
+		RealmID:   1,
+		GatewayID: "gw1",
+		Characters: []events.GWEventCharacterLoggedInPayload{
+			{CharGUID: 2, CharName: "Kept", CharLevel: 10},
+			{CharGUID: 4, CharName: "Missed", CharLevel: 20},
+		},
+	}))
+
+	guids, err := r.AllGUIDsByRealm(ctx, 1)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{2, 3, 4}, guids)
+
+	char, err := r.OneByRealmAndName(ctx, 1, "missed")
+	assert.NoError(t, err)
+	if assert.NotNil(t, char) {
+		assert.Equal(t, "gw1", char.GatewayID)
+		assert.Equal(t, uint8(20), char.CharLevel)
+	}
+
+	char, err = r.OneByRealmAndGUID(ctx, 1, 1)
+	assert.NoError(t, err)
+	assert.Nil(t, char)
+}
+
+func TestHandleCharactersUpdatesAppliesChatStatus(t *testing.T) {
+	r := NewCharactersOnlineInMem()
+	ctx := context.Background()
+
+	assert.NoError(t, r.Add(ctx, &Character{RealmID: 1, GatewayID: "gw1", CharGUID: 1, CharName: "Away"}))
+
+	flags, msg := events.CharacterChatFlagAFK, "brb"
+	assert.NoError(t, r.HandleCharactersUpdates(events.GWEventCharactersUpdatesPayload{
+		RealmID: 1,
+		Updates: []*events.CharacterUpdate{{ID: 1, ChatFlags: &flags, AwayMsg: &msg}},
+	}))
+
+	char, err := r.OneByRealmAndGUID(ctx, 1, 1)
+	assert.NoError(t, err)
+	if assert.NotNil(t, char) {
+		assert.Equal(t, events.CharacterChatFlagAFK, char.CharChatFlags)
+		assert.Equal(t, "brb", char.CharAwayMessage)
+	}
+}
@@ -0,0 +1,367 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	redis "github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/redisclient"
+)
+
+const (
+	// optimisticLockRetriesCount is count of the transaction retries if watched keys were changed by another characters service.
+	optimisticLockRetriesCount = 10
+
+	// failoverRetriesCount is count of the transaction retries while redis master is switching.
+	failoverRetriesCount = 5
+
+	// whoScanBatchSize is count of the characters that are read from redis at once by who request.
+	whoScanBatchSize = 500
+)
+
+// charactersOnlineRedis stores online characters in redis, so several characters services can share them.
+// Every character is stored as json under its own key, realm and gateway sets index character keys
+// and realm hash maps upper case names to guids. All keys of the realm share the hash tag,
+// so they are stored in the same slot of redis cluster and can be changed in one transaction.
+type charactersOnlineRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewCharactersOnlineRedis(rdb redis.UniversalClient) CharactersOnline {
+	return &charactersOnlineRedis{rdb: rdb}
+}
+
+func (c *charactersOnlineRedis) Add(ctx context.Context, character *Character) error {
+	d, err := json.Marshal(character)
+	if err != nil {
+		return err
+	}
+
+	key := c.key(character.RealmID, character.CharGUID)
+	return c.watch(ctx, func(tx *redis.Tx) error {
+		// Character could relog with another name or to another gateway without logout event.
+		old, err := c.characterByKey(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			if old != nil {
+				c.unindex(ctx, pipe, old)
+			}
+			pipe.Set(ctx, key, d, 0)
+			pipe.SAdd(ctx, c.realmIndexKey(character.RealmID), key)
+			pipe.SAdd(ctx, c.gatewayIndexKey(character.RealmID, character.GatewayID), key)
+			pipe.HSet(ctx, c.namesKey(character.RealmID), strings.ToUpper(character.CharName), character.CharGUID)
+			return nil
+		})
+		return err
+	}, key)
+}
+
+func (c *charactersOnlineRedis) Remove(ctx context.Context, realmID uint32, guid uint64) error {
+	key := c.key(realmID, guid)
+	return c.watch(ctx, func(tx *redis.Tx) error {
+		char, err := c.characterByKey(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if char == nil {
+			return nil
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			c.unindex(ctx, pipe, char)
+			pipe.Del(ctx, key)
+			return nil
+		})
+		return err
+	}, key)
+}
+
+func (c *charactersOnlineRedis) RemoveAllWithGatewayID(ctx context.Context, realmID uint32, gatewayID string) ([]uint64, error) {
+	gwKey := c.gatewayIndexKey(realmID, gatewayID)
+
+	var removed []uint64
+	err := c.watch(ctx, func(tx *redis.Tx) error {
+		keys, err := tx.SMembers(ctx, gwKey).Result()
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			// Characters could log out or move to another gateway before transaction is committed.
+			if err = tx.Watch(ctx, keys...).Err(); err != nil {
+				return err
+			}
+		}
+
+		chars, err := c.charactersByKeys(ctx, tx, keys)
+		if err != nil {
+			return err
+		}
+
+		removed = make([]uint64, 0, len(chars))
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i := range chars {
+				// Character could move to another gateway.
+				if chars[i].GatewayID != gatewayID {
+					continue
+				}
+				c.unindex(ctx, pipe, &chars[i])
+				pipe.Del(ctx, c.key(realmID, chars[i].CharGUID))
+				removed = append(removed, chars[i].CharGUID)
+			}
+			pipe.Del(ctx, gwKey)
+			return nil
+		})
+		return err
+	}, gwKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return removed, nil
+}
+
+func (c *charactersOnlineRedis) OneByRealmAndGUID(ctx context.Context, realmID uint32, guid uint64) (*Character, error) {
+	return c.characterByKey(ctx, c.rdb, c.key(realmID, guid))
+}
+
+func (c *charactersOnlineRedis) OneByRealmAndName(ctx context.Context, realmID uint32, name string) (*Character, error) {
+	res := c.rdb.HGet(ctx, c.namesKey(realmID), strings.ToUpper(name))
+	if res.Err() != nil {
+		if res.Err() == redis.Nil {
+			return nil, nil
+		}
+		return nil, res.Err()
+	}
+
+	guid, err := strconv.ParseUint(res.Val(), 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.OneByRealmAndGUID(ctx, realmID, guid)
+}
+
+func (c *charactersOnlineRedis) CharactersByRealmAndGUIDs(ctx context.Context, realmID uint32, guids []uint64) ([]Character, error) {
+	keys := make([]string, len(guids))
+	for i, guid := range guids {
+		keys[i] = c.key(realmID, guid)
+	}
+	return c.charactersByKeys(ctx, c.rdb, keys)
+}
+
+func (c *charactersOnlineRedis) AllGUIDsByRealm(ctx context.Context, realmID uint32) ([]uint64, error) {
+	res := c.rdb.SMembers(ctx, c.realmIndexKey(realmID))
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	guids := make([]uint64, 0, len(res.Val()))
+	for _, key := range res.Val() {
+		guid, err := strconv.ParseUint(key[strings.LastIndexByte(key, ':')+1:], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		guids = append(guids, guid)
+	}
+	return guids, nil
+}
+
+func (c *charactersOnlineRedis) HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error {
+	return c.Add(context.TODO(), characterFromLoggedInPayload(&payload))
+}
+
+func (c *charactersOnlineRedis) HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error {
+	return c.Remove(context.TODO(), payload.RealmID, payload.CharGUID)
+}
+
+func (c *charactersOnlineRedis) HandleCharactersUpdates(payload events.GWEventCharactersUpdatesPayload) error {
+	ctx := context.TODO()
+
+	keys := make([]string, len(payload.Updates))
+	updates := make(map[uint64]*events.CharacterUpdate, len(payload.Updates))
+	for i, update := range payload.Updates {
+		keys[i] = c.key(payload.RealmID, update.ID)
+		updates[update.ID] = update
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return c.watch(ctx, func(tx *redis.Tx) error {
+		// Characters that logged out in the meantime are skipped, watch fails the transaction if they log out later.
+		chars, err := c.charactersByKeys(ctx, tx, keys)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i := range chars {
+				applyCharUpdate(&chars[i], updates[chars[i].CharGUID])
+
+				d, err := json.Marshal(&chars[i])
+				if err != nil {
+					return err
+				}
+
+				pipe.Set(ctx, c.key(payload.RealmID, chars[i].CharGUID), d, redis.KeepTTL)
+			}
+			return nil
+		})
+		return err
+	}, keys...)
+}
+
+func (c *charactersOnlineRedis) HandleOnlineCharacters(payload events.GWEventOnlineCharactersPayload) error {
+	return replaceGatewayCharacters(context.TODO(), c, payload)
+}
+
+// WhoRequest scans characters of the realm by batches and stops when query limit is reached.
+func (c *charactersOnlineRedis) WhoRequest(ctx context.Context, requesterRealmID uint32, requesterGUID uint64, query CharactersWhoQuery) ([]Character, error) {
+	var (
+		result []Character
+		cursor uint64
+	)
+
+	// SSCAN can return the same key several times.
+	seen := map[uint64]struct{}{}
+	for {
+		keys, nextCursor, err := c.rdb.SScan(ctx, c.realmIndexKey(requesterRealmID), cursor, "", whoScanBatchSize).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		chars, err := c.charactersByKeys(ctx, c.rdb, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range chars {
+			if chars[i].RealmID != requesterRealmID {
+				continue
+			}
+
+			if _, found := seen[chars[i].CharGUID]; found {
+				continue
+			}
+			seen[chars[i].CharGUID] = struct{}{}
+
+			if !query.Matches(&chars[i]) {
+				continue
+			}
+
+			result = append(result, chars[i])
+			if query.Limit > 0 && len(result) >= query.Limit {
+				return result, nil
+			}
+		}
+
+		if nextCursor == 0 {
+			return result, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// watch runs transaction f with watched keys, f is retried if the keys were changed before transaction is committed.
+func (c *charactersOnlineRedis) watch(ctx context.Context, f func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < optimisticLockRetriesCount; i++ {
+		// Transaction is not retried by the client, so retry it here if redis master is switching.
+		err := redisclient.RetryOnFailover(ctx, failoverRetriesCount, func() error {
+			return c.rdb.Watch(ctx, f, keys...)
+		})
+		if err == redis.TxFailedErr {
+			// Optimistic lock lost. Retry.
+			continue
+		}
+		return err
+	}
+
+	return errors.New("reached maximum number of retries")
+}
+
+// characterByKey returns character stored under the key or nil if it's missing.
+func (c *charactersOnlineRedis) characterByKey(ctx context.Context, rdb redis.Cmdable, key string) (*Character, error) {
+	res := rdb.Get(ctx, key)
+	if res.Err() != nil {
+		if res.Err() == redis.Nil {
+			return nil, nil
+		}
+		return nil, res.Err()
+	}
+
+	char := &Character{}
+	if err := json.Unmarshal([]byte(res.Val()), char); err != nil {
+		return nil, err
+	}
+	return char, nil
+}
+
+// charactersByKeys returns characters stored under the keys, missing characters are skipped.
+// Keys must belong to the same realm.
+func (c *charactersOnlineRedis) charactersByKeys(ctx context.Context, rdb redis.Cmdable, keys []string) ([]Character, error) {
+	if len(keys) == 0 {
+		return []Character{}, nil
+	}
+
+	mGetRes := rdb.MGet(ctx, keys...)
+	if mGetRes.Err() != nil {
+		return nil, mGetRes.Err()
+	}
+
+	result := make([]Character, 0, len(keys))
+	for i, v := range mGetRes.Val() {
+		if v == nil {
+			continue
+		}
+
+		char := Character{}
+		if err := json.Unmarshal([]byte(v.(string)), &char); err != nil {
+			log.Warn().Err(err).Str("key", keys[i]).Msg("can't unmarshal online character")
+			continue
+		}
+		result = append(result, char)
+	}
+
+	return result, nil
+}
+
+// unindex removes character from realm, gateway and names indexes.
+func (c *charactersOnlineRedis) unindex(ctx context.Context, pipe redis.Pipeliner, char *Character) {
+	key := c.key(char.RealmID, char.CharGUID)
+	pipe.SRem(ctx, c.realmIndexKey(char.RealmID), key)
+	pipe.SRem(ctx, c.gatewayIndexKey(char.RealmID, char.GatewayID), key)
+	pipe.HDel(ctx, c.namesKey(char.RealmID), strings.ToUpper(char.CharName))
+}
+
+func (c *charactersOnlineRedis) key(realmID uint32, guid uint64) string {
+	return fmt.Sprintf("charserver:online:%s:char:%d", c.realmTag(realmID), guid)
+}
+
+func (c *charactersOnlineRedis) realmIndexKey(realmID uint32) string {
+	return fmt.Sprintf("charserver:online:%s:chars", c.realmTag(realmID))
+}
+
+func (c *charactersOnlineRedis) gatewayIndexKey(realmID uint32, gatewayID string) string {
+	return fmt.Sprintf("charserver:online:%s:gw:%s", c.realmTag(realmID), gatewayID)
+}
+
+func (c *charactersOnlineRedis) namesKey(realmID uint32) string {
+	return fmt.Sprintf("charserver:online:%s:names", c.realmTag(realmID))
+}
+
+// realmTag returns hash tag of the realm keys.
+func (c *charactersOnlineRedis) realmTag(realmID uint32) string {
+	return redisclient.HashTag(strconv.FormatUint(uint64(realmID), 10))
+}
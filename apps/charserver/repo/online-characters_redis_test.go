@@ -0,0 +1,86 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/redisclient"
+)
+
+func TestCharactersOnlineRedis(t *testing.T) {
+	for _, mode := range []string{redisclient.ModeStandalone, redisclient.ModeCluster} {
+		t.Run(mode, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{Mode: mode, MaxRetries: 1})
+			require.NoError(t, err)
+			defer rdb.Close()
+
+			ctx := context.Background()
+			r := NewCharactersOnlineRedis(rdb)
+
+			for guid := uint64(1); guid <= 3; guid++ {
+				require.NoError(t, r.Add(ctx, &Character{
+					RealmID:   1,
+					GatewayID: "gw1",
+					CharGUID:  guid,
+					CharName:  "Char" + string(rune('A'+guid)),
+					CharRace:  1,
+					CharClass: 1,
+					CharLevel: 5,
+				}))
+			}
+
+			// Relog with another name to another gateway.
+			require.NoError(t, r.Add(ctx, &Character{RealmID: 1, GatewayID: "gw2", CharGUID: 1, CharName: "Renamed", CharLevel: 5}))
+
+			char, err := r.OneByRealmAndName(ctx, 1, "CharB")
+			require.NoError(t, err)
+			require.Nil(t, char, "old name is unindexed")
+
+			char, err = r.OneByRealmAndName(ctx, 1, "renamed")
+			require.NoError(t, err)
+			require.Equal(t, uint64(1), char.CharGUID)
+
+			lvl := uint8(10)
+			require.NoError(t, r.HandleCharactersUpdates(events.GWEventCharactersUpdatesPayload{
+				RealmID: 1,
+				Updates: []*events.CharacterUpdate{{ID: 2, Lvl: &lvl}, {ID: 42, Lvl: &lvl}},
+			}))
+
+			char, err = r.OneByRealmAndGUID(ctx, 1, 2)
+			require.NoError(t, err)
+			require.Equal(t, lvl, char.CharLevel)
+
+			char, err = r.OneByRealmAndGUID(ctx, 1, 42)
+			require.NoError(t, err)
+			require.Nil(t, char, "offline character isn't added by update")
+
+			query := CharactersWhoQuery{LvlMin: 1, LvlMax: 80, RaceMask: ^uint32(0), ClassMask: ^uint32(0)}
+			chars, err := r.WhoRequest(ctx, 1, 999, query)
+			require.NoError(t, err)
+			require.Len(t, chars, 3)
+
+			query.Limit = 2
+			chars, err = r.WhoRequest(ctx, 1, 999, query)
+			require.NoError(t, err)
+			require.Len(t, chars, 2)
+
+			removed, err := r.RemoveAllWithGatewayID(ctx, 1, "gw1")
+			require.NoError(t, err)
+			require.ElementsMatch(t, []uint64{2, 3}, removed)
+
+			guids, err := r.AllGUIDsByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, []uint64{1}, guids)
+
+			require.NoError(t, r.Remove(ctx, 1, 1))
+			guids, err = r.AllGUIDsByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.Empty(t, guids)
+		})
+	}
+}
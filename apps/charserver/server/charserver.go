@@ -23,9 +23,10 @@ type CharServer struct {
 	onlineChars    repo.CharactersOnline
 	friendsService service.FriendsService
 	guildNames     service.GuildNameResolver
+	realmLinks     *service.RealmLinks
 }
 
-func NewCharServer(repo repo.Characters, onlineChars repo.CharactersOnline, whoHandler repo.WhoHandler, itemsTemplate repo.ItemsTemplate, friendsService service.FriendsService, guildNames service.GuildNameResolver) pb.CharactersServiceServer {
+func NewCharServer(repo repo.Characters, onlineChars repo.CharactersOnline, whoHandler repo.WhoHandler, itemsTemplate repo.ItemsTemplate, friendsService service.FriendsService, guildNames service.GuildNameResolver, realmLinks *service.RealmLinks) pb.CharactersServiceServer {
 	return &CharServer{
 		repo:           repo,
 		whoHandler:     whoHandler,
@@ -33,6 +34,7 @@ func NewCharServer(repo repo.Characters, onlineChars repo.CharactersOnline, whoH
 		onlineChars:    onlineChars,
 		friendsService: friendsService,
 		guildNames:     guildNames,
+		realmLinks:     realmLinks,
 	}
 }
 
@@ -205,6 +207,9 @@ func (c *CharServer) CharactersToLoginByGUID(ctx context.Context, request *pb.Ch
 	}, nil
 }
 
+// whoMaxResults is max amount of characters in the who list, client can't display more.
+const whoMaxResults = 50
+
 func (c *CharServer) WhoQuery(ctx context.Context, request *pb.WhoQueryRequest) (*pb.WhoQueryResponse, error) {
 	defer func(t time.Time) {
 		log.Debug().
@@ -214,50 +219,66 @@ func (c *CharServer) WhoQuery(ctx context.Context, request *pb.WhoQueryRequest)
 			Msg("Handled who query")
 	}(time.Now())
 
-	chars, err := c.whoHandler.WhoRequest(ctx, request.RealmID, request.CharacterGUID, repo.CharactersWhoQuery{
+	query := repo.CharactersWhoQuery{
 		LvlMin:    uint8(request.LvlMin),
 		LvlMax:    uint8(request.LvlMax),
 		ClassMask: request.ClassMask,
 		RaceMask:  request.RaceMask,
 		Zones:     request.Zones,
 		Strings:   request.Strings,
-	})
-	if err != nil {
-		return nil, err
 	}
+
+	// Characters of the linked realms are listed after characters of the requester realm.
+	var chars []repo.Character
+	for _, realmID := range c.realmLinks.LinkedRealms(request.RealmID) {
+		query.Limit = whoMaxResults - len(chars)
+		if query.Limit <= 0 {
+			break
+		}
+
+		realmChars, err := c.whoHandler.WhoRequest(ctx, realmID, request.CharacterGUID, query)
+		if err != nil {
+			return nil, err
+		}
+		chars = append(chars, realmChars...)
+	}
+
 	count := len(chars)
-	if count > 50 {
-		count = 50
+	if count > whoMaxResults {
+		count = whoMaxResults
 	}
 
-	// Guild ids are snapshotted at login, a guild joined mid-session shows up after relog.
-	guildIDs := make([]uint32, 0, count)
+	guildIDsByRealm := map[uint32][]uint32{}
 	for i := 0; i < count; i++ {
 		if chars[i].CharGuildID != 0 {
-			guildIDs = append(guildIDs, chars[i].CharGuildID)
+			guildIDsByRealm[chars[i].RealmID] = append(guildIDsByRealm[chars[i].RealmID], chars[i].CharGuildID)
 		}
 	}
 
-	guildNames := map[uint32]string{}
-	if len(guildIDs) > 0 {
-		guildNames, err = c.guildNames.GuildNamesByIDs(ctx, request.RealmID, guildIDs)
+	guildNamesByRealm := make(map[uint32]map[uint32]string, len(guildIDsByRealm))
+	for realmID, guildIDs := range guildIDsByRealm {
+		guildNames, err := c.guildNames.GuildNamesByIDs(ctx, realmID, guildIDs)
 		if err != nil {
-			log.Warn().Err(err).Msg("can't resolve guild names for who query")
-			guildNames = map[uint32]string{}
+			log.Warn().Err(err).Uint32("realmID", realmID).Msg("can't resolve guild names for who query")
+			continue
 		}
+		guildNamesByRealm[realmID] = guildNames
 	}
 
-	items := make([]*pb.WhoQueryResponse_WhoItem, 0, 50)
+	items := make([]*pb.WhoQueryResponse_WhoItem, 0, count)
 	for i := 0; i < count; i++ {
 		items = append(items, &pb.WhoQueryResponse_WhoItem{
-			Guid:   chars[i].CharGUID,
-			Name:   chars[i].CharName,
-			Guild:  guildNames[chars[i].CharGuildID],
-			Lvl:    uint32(chars[i].CharLevel),
-			Class:  uint32(chars[i].CharClass),
-			Race:   uint32(chars[i].CharRace),
-			Gender: uint32(chars[i].CharGender),
-			ZoneID: chars[i].CharZone,
+			Guid:    chars[i].CharGUID,
+			Name:    chars[i].CharName,
+			Guild:   guildNamesByRealm[chars[i].RealmID][chars[i].CharGuildID],
+			Lvl:     uint32(chars[i].CharLevel),
+			Class:   uint32(chars[i].CharClass),
+			Race:    uint32(chars[i].CharRace),
+			Gender:  uint32(chars[i].CharGender),
+			ZoneID:  chars[i].CharZone,
+			RealmID: chars[i].RealmID,
+
+			ChatFlags: uint32(chars[i].CharChatFlags),
 		})
 	}
 
@@ -277,7 +298,15 @@ func (c *CharServer) CharacterOnlineByName(ctx context.Context, request *pb.Char
 			Msg("Handled character online by name")
 	}(time.Now())
 
-	char, err := c.onlineChars.OneByRealmAndName(ctx, request.RealmID, request.CharacterName)
+	charRealmID, linked := c.characterRealm(request.RealmID, request.CharacterRealmID)
+	if !linked {
+		return &pb.CharacterOnlineByNameResponse{
+			Api:       ver,
+			Character: nil,
+		}, nil
+	}
+
+	char, err := c.onlineChars.OneByRealmAndName(ctx, charRealmID, request.CharacterName)
 	if err != nil {
 		return nil, err
 	}
@@ -317,7 +346,15 @@ func (c *CharServer) CharacterByName(ctx context.Context, request *pb.CharacterB
 			Msg("Handled character by name")
 	}(time.Now())
 
-	char, err := c.onlineChars.OneByRealmAndName(ctx, request.RealmID, request.CharacterName)
+	charRealmID, linked := c.characterRealm(request.RealmID, request.CharacterRealmID)
+	if !linked {
+		return &pb.CharacterByNameResponse{
+			Api:       ver,
+			Character: nil,
+		}, nil
+	}
+
+	char, err := c.onlineChars.OneByRealmAndName(ctx, charRealmID, request.CharacterName)
 	if err != nil {
 		return nil, err
 	}
@@ -343,7 +380,7 @@ func (c *CharServer) CharacterByName(ctx context.Context, request *pb.CharacterB
 		}, nil
 	}
 
-	char, err = c.repo.CharacterByName(ctx, request.RealmID, request.CharacterName)
+	char, err = c.repo.CharacterByName(ctx, charRealmID, request.CharacterName)
 	if err != nil {
 		return nil, err
 	}
@@ -442,6 +479,7 @@ func (c *CharServer) GetFriendsList(ctx context.Context, request *pb.GetFriendsL
 	friends := make([]*pb.GetFriendsListResponse_Friend, 0, len(friendsList.Friends))
 	for _, friend := range friendsList.Friends {
 		friends = append(friends, &pb.GetFriendsListResponse_Friend{
+			RealmID: friend.RealmID,
 			Guid:    friend.GUID,
 			Note:    friend.Note,
 			Status:  uint32(friend.Status),
@@ -475,7 +513,7 @@ func (c *CharServer) AddFriend(ctx context.Context, request *pb.AddFriendRequest
 			Msg("Handled add friend")
 	}(time.Now())
 
-	result, err := c.friendsService.AddFriend(ctx, request.RealmID, request.PlayerGUID, request.FriendGUID, request.FriendName, request.Note)
+	result, err := c.friendsService.AddFriend(ctx, request.RealmID, request.PlayerGUID, request.FriendRealmID, request.FriendGUID, request.FriendName, request.Note)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +538,7 @@ func (c *CharServer) RemoveFriend(ctx context.Context, request *pb.RemoveFriendR
 			Msg("Handled remove friend")
 	}(time.Now())
 
-	err := c.friendsService.RemoveFriend(ctx, request.RealmID, request.PlayerGUID, request.FriendGUID)
+	err := c.friendsService.RemoveFriend(ctx, request.RealmID, request.PlayerGUID, request.FriendRealmID, request.FriendGUID)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +558,7 @@ func (c *CharServer) SetFriendNote(ctx context.Context, request *pb.SetFriendNot
 			Msg("Handled set friend note")
 	}(time.Now())
 
-	err := c.friendsService.SetFriendNote(ctx, request.RealmID, request.PlayerGUID, request.FriendGUID, request.Note)
+	err := c.friendsService.SetFriendNote(ctx, request.RealmID, request.PlayerGUID, request.FriendRealmID, request.FriendGUID, request.Note)
 	if err != nil {
 		return nil, err
 	}
@@ -610,3 +648,12 @@ func (c *CharServer) GetOnlineCharacters(ctx context.Context, request *pb.GetOnl
 		TotalCount:      uint32(len(guids)),
 	}, nil
 }
+
+// characterRealm returns realm of the requested character, characterRealmID is 0 for the requester realm.
+// Returns false if requester can't see characters of the realm.
+func (c *CharServer) characterRealm(realmID, characterRealmID uint32) (uint32, bool) {
+	if characterRealmID == 0 {
+		return realmID, true
+	}
+	return characterRealmID, c.realmLinks.Linked(realmID, characterRealmID)
+}
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/walkline/ToCloud9/apps/charserver/repo"
 	"github.com/walkline/ToCloud9/shared/events"
 )
 
@@ -13,7 +14,6 @@ type OnlinePlayersCache interface {
 	PlayerLoggedIn(playerGUID uint64, level, class, area uint32)
 	PlayerLoggedOut(playerGUID uint64)
 	GetOnlineInfo(playerGUID uint64) (OnlinePlayerInfo, bool)
-	SetFriendsService(friendsService FriendsService)
 
 	HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error
 	HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error
@@ -26,9 +26,6 @@ type onlinePlayersCacheImpl struct {
 
 	// onlineInfoByGUID maps player GUID to their online info
 	onlineInfoByGUID map[uint64]*OnlinePlayerInfo
-
-	// friendsService is used to notify friends about status changes
-	friendsService FriendsService
 }
 
 func NewOnlinePlayersCache() OnlinePlayersCache {
@@ -37,10 +34,6 @@ func NewOnlinePlayersCache() OnlinePlayersCache {
 	}
 }
 
-func (o *onlinePlayersCacheImpl) SetFriendsService(friendsService FriendsService) {
-	o.friendsService = friendsService
-}
-
 func (o *onlinePlayersCacheImpl) PlayerLoggedIn(playerGUID uint64, level, class, area uint32) {
 	o.cacheMutex.Lock()
 	defer o.cacheMutex.Unlock()
@@ -72,45 +65,16 @@ func (o *onlinePlayersCacheImpl) GetOnlineInfo(playerGUID uint64) (OnlinePlayerI
 	return *info, true
 }
 
-// HandleCharacterLoggedIn handles character login event from gateway
+// HandleCharacterLoggedIn handles character login event from gateway.
+// Friends are notified by FriendsStatusNotifier, so the cache can be fed on every characters service.
 func (o *onlinePlayersCacheImpl) HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error {
 	o.PlayerLoggedIn(payload.CharGUID, uint32(payload.CharLevel), uint32(payload.CharClass), payload.CharZone)
-
-	// Notify friends service about login
-	if o.friendsService != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		return o.friendsService.NotifyStatusChange(
-			ctx,
-			payload.RealmID,
-			payload.CharGUID,
-			1, // online
-			payload.CharZone,
-			uint32(payload.CharLevel),
-			uint32(payload.CharClass),
-		)
-	}
-
 	return nil
 }
 
 // HandleCharacterLoggedOut handles character logout event from gateway
 func (o *onlinePlayersCacheImpl) HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error {
 	o.PlayerLoggedOut(payload.CharGUID)
-
-	// Notify friends service about logout
-	if o.friendsService != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-		defer cancel()
-		return o.friendsService.NotifyStatusChange(
-			ctx,
-			payload.RealmID,
-			payload.CharGUID,
-			0, // offline
-			0, 0, 0,
-		)
-	}
-
 	return nil
 }
 
@@ -136,7 +100,75 @@ func (o *onlinePlayersCacheImpl) HandleCharactersUpdates(payload events.GWEventC
 		} else if update.Area != nil {
 			info.Area = *update.Area
 		}
+
+		// Update AFK/DND status
+		if update.ChatFlags != nil {
+			info.Status = FriendStatusFromChatFlags(*update.ChatFlags)
+		}
 	}
 
 	return nil
 }
+
+// SeedOnlinePlayersCache fills the cache with characters that are already online, so the service
+// that started after them knows their status.
+func SeedOnlinePlayersCache(ctx context.Context, cache OnlinePlayersCache, onlineChars repo.CharactersOnline, realmIDs []uint32) error {
+	for _, realmID := range realmIDs {
+		guids, err := onlineChars.AllGUIDsByRealm(ctx, realmID)
+		if err != nil {
+			return err
+		}
+
+		if len(guids) == 0 {
+			continue
+		}
+
+		chars, err := onlineChars.CharactersByRealmAndGUIDs(ctx, realmID, guids)
+		if err != nil {
+			return err
+		}
+
+		for _, char := range chars {
+			cache.PlayerLoggedIn(char.CharGUID, uint32(char.CharLevel), uint32(char.CharClass), char.CharZone)
+		}
+	}
+	return nil
+}
+
+// FriendsStatusNotifier notifies friends when player logs in or out. It should be consumed with queue group,
+// so only one of characters services sends the notification.
+type FriendsStatusNotifier struct {
+	friendsService FriendsService
+}
+
+func NewFriendsStatusNotifier(friendsService FriendsService) *FriendsStatusNotifier {
+	return &FriendsStatusNotifier{friendsService: friendsService}
+}
+
+// HandleCharacterLoggedIn notifies friends about login.
+func (n *FriendsStatusNotifier) HandleCharacterLoggedIn(payload events.GWEventCharacterLoggedInPayload) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return n.friendsService.NotifyStatusChange(
+		ctx,
+		payload.RealmID,
+		payload.CharGUID,
+		1, // online
+		payload.CharZone,
+		uint32(payload.CharLevel),
+		uint32(payload.CharClass),
+	)
+}
+
+// HandleCharacterLoggedOut notifies friends about logout.
+func (n *FriendsStatusNotifier) HandleCharacterLoggedOut(payload events.GWEventCharacterLoggedOutPayload) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return n.friendsService.NotifyStatusChange(
+		ctx,
+		payload.RealmID,
+		payload.CharGUID,
+		0, // offline
+		0, 0, 0,
+	)
+}
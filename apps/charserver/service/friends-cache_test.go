@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/walkline/ToCloud9/apps/charserver/repo"
+	"github.com/walkline/ToCloud9/apps/charserver/service"
+	"github.com/walkline/ToCloud9/apps/charserver/service/mocks"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+func TestSeedOnlinePlayersCache(t *testing.T) {
+	ctx := context.Background()
+	onlineChars := repo.NewCharactersOnlineInMem()
+	assert.NoError(t, onlineChars.Add(ctx, &repo.Character{RealmID: 1, CharGUID: 10, CharLevel: 80, CharClass: 2, CharZone: 4395}))
+	assert.NoError(t, onlineChars.Add(ctx, &repo.Character{RealmID: 2, CharGUID: 20, CharLevel: 70, CharClass: 5, CharZone: 3703}))
+	assert.NoError(t, onlineChars.Add(ctx, &repo.Character{RealmID: 3, CharGUID: 30}))
+
+	cache := service.NewOnlinePlayersCache()
+	assert.NoError(t, service.SeedOnlinePlayersCache(ctx, cache, onlineChars, []uint32{1, 2}))
+
+	info, ok := cache.GetOnlineInfo(10)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(80), info.Level)
+	assert.Equal(t, uint32(2), info.Class)
+	assert.Equal(t, uint32(4395), info.Area)
+
+	_, ok = cache.GetOnlineInfo(20)
+	assert.True(t, ok)
+
+	// Realm that isn't served by this service is skipped.
+	_, ok = cache.GetOnlineInfo(30)
+	assert.False(t, ok)
+}
+
+func TestOnlinePlayersCache_DoesNotNotifyFriends(t *testing.T) {
+	cache := service.NewOnlinePlayersCache()
+
+	assert.NoError(t, cache.HandleCharacterLoggedIn(events.GWEventCharacterLoggedInPayload{RealmID: 1, CharGUID: 10, CharLevel: 80}))
+	_, ok := cache.GetOnlineInfo(10)
+	assert.True(t, ok)
+
+	assert.NoError(t, cache.HandleCharacterLoggedOut(events.GWEventCharacterLoggedOutPayload{RealmID: 1, CharGUID: 10}))
+	_, ok = cache.GetOnlineInfo(10)
+	assert.False(t, ok)
+}
+
+func TestFriendsStatusNotifier(t *testing.T) {
+	friends := mocks.NewFriendsService(t)
+	friends.On("NotifyStatusChange", mock.Anything, uint32(1), uint64(10), uint8(1), uint32(4395), uint32(80), uint32(2)).Return(nil).Once()
+	friends.On("NotifyStatusChange", mock.Anything, uint32(1), uint64(10), uint8(0), uint32(0), uint32(0), uint32(0)).Return(nil).Once()
+
+	notifier := service.NewFriendsStatusNotifier(friends)
+	assert.NoError(t, notifier.HandleCharacterLoggedIn(events.GWEventCharacterLoggedInPayload{
+		RealmID: 1, CharGUID: 10, CharLevel: 80, CharClass: 2, CharZone: 4395,
+	}))
+	assert.NoError(t, notifier.HandleCharacterLoggedOut(events.GWEventCharacterLoggedOutPayload{RealmID: 1, CharGUID: 10}))
+}
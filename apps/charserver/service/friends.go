@@ -35,6 +35,25 @@ const (
 	FriendResultIgnoreFull      = 0x10
 )
 
+// FriendStatus enum values matching AzerothCore protocol, AFK and DND replace online status.
+const (
+	FriendStatusOffline = 0x00
+	FriendStatusOnline  = 0x01
+	FriendStatusAFK     = 0x02
+	FriendStatusDND     = 0x04
+)
+
+// FriendStatusFromChatFlags returns friend status of the online player with the given chat flags.
+func FriendStatusFromChatFlags(chatFlags uint8) uint8 {
+	switch {
+	case chatFlags&events.CharacterChatFlagAFK != 0:
+		return FriendStatusAFK
+	case chatFlags&events.CharacterChatFlagDND != 0:
+		return FriendStatusDND
+	}
+	return FriendStatusOnline
+}
+
 var (
 	ErrFriendNotFound = errors.New("friend not found")
 	ErrFriendListFull = errors.New("friend list is full")
@@ -53,6 +72,7 @@ type OnlinePlayerInfo struct {
 }
 
 type FriendInfo struct {
+	RealmID uint32
 	GUID    uint64
 	Note    string
 	Status  uint8
@@ -77,9 +97,9 @@ type AddFriendResult struct {
 //go:generate mockery --name=FriendsService
 type FriendsService interface {
 	GetFriendsList(ctx context.Context, realmID uint32, playerGUID uint64) (*FriendsList, error)
-	AddFriend(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64, friendName, note string) (*AddFriendResult, error)
-	RemoveFriend(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64) error
-	SetFriendNote(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64, note string) error
+	AddFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, friendName, note string) (*AddFriendResult, error)
+	RemoveFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error
+	SetFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error
 	AddIgnore(ctx context.Context, realmID uint32, playerGUID, ignoredGUID uint64) (uint32, error)
 	RemoveIgnore(ctx context.Context, realmID uint32, playerGUID, ignoredGUID uint64) error
 
@@ -91,18 +111,24 @@ type friendsServiceImpl struct {
 	charRepo       repo.Characters
 	onlineCache    OnlinePlayersCache
 	eventsProducer events.FriendsServiceProducer
+
+	// onlineChars and realmLinks are used for friends from other realms.
+	onlineChars repo.CharactersOnline
+	realmLinks  *RealmLinks
 }
 
-func NewFriendsService(charRepo repo.Characters, onlineCache OnlinePlayersCache, eventsProducer events.FriendsServiceProducer) FriendsService {
+func NewFriendsService(charRepo repo.Characters, onlineCache OnlinePlayersCache, eventsProducer events.FriendsServiceProducer, onlineChars repo.CharactersOnline, realmLinks *RealmLinks) FriendsService {
 	return &friendsServiceImpl{
 		charRepo:       charRepo,
 		onlineCache:    onlineCache,
 		eventsProducer: eventsProducer,
+		onlineChars:    onlineChars,
+		realmLinks:     realmLinks,
 	}
 }
 
 func (f *friendsServiceImpl) GetFriendsList(ctx context.Context, realmID uint32, playerGUID uint64) (*FriendsList, error) {
-	entries, err := f.charRepo.GetFriendsForPlayer(ctx, realmID, playerGUID)
+	entries, err := f.friendEntries(ctx, realmID, playerGUID)
 	if err != nil {
 		return nil, err
 	}
@@ -115,13 +141,14 @@ func (f *friendsServiceImpl) GetFriendsList(ctx context.Context, realmID uint32,
 	for _, entry := range entries {
 		if entry.Flags == repo.SocialFlagFriend {
 			friend := &FriendInfo{
-				GUID: entry.FriendGUID,
-				Note: entry.Note,
+				RealmID: entry.FriendRealmID,
+				GUID:    entry.FriendGUID,
+				Note:    entry.Note,
 			}
 
 			// Check if friend is online
-			if onlineInfo, ok := f.onlineCache.GetOnlineInfo(entry.FriendGUID); ok {
-				friend.Status = 1 // online
+			if onlineInfo, ok := f.onlineInfo(ctx, realmID, entry.FriendRealmID, entry.FriendGUID); ok {
+				friend.Status = onlineInfo.Status
 				friend.Area = onlineInfo.Area
 				friend.Level = onlineInfo.Level
 				friend.ClassID = onlineInfo.Class
@@ -138,14 +165,23 @@ func (f *friendsServiceImpl) GetFriendsList(ctx context.Context, realmID uint32,
 	return result, nil
 }
 
-func (f *friendsServiceImpl) AddFriend(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64, friendName, note string) (*AddFriendResult, error) {
+func (f *friendsServiceImpl) AddFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, friendName, note string) (*AddFriendResult, error) {
+	if friendRealmID == 0 {
+		friendRealmID = realmID
+	}
+
+	crossRealm := isCrossRealmFriend(realmID, friendRealmID)
+	if crossRealm && !f.realmLinks.Linked(realmID, friendRealmID) {
+		return &AddFriendResult{Result: FriendResultNotFound}, nil
+	}
+
 	// Cannot add self
-	if playerGUID == friendGUID {
+	if !crossRealm && playerGUID == friendGUID {
 		return &AddFriendResult{Result: FriendResultSelf}, nil
 	}
 
 	// Check if already friends
-	entries, err := f.charRepo.GetFriendsForPlayer(ctx, realmID, playerGUID)
+	entries, err := f.friendEntries(ctx, realmID, playerGUID)
 	if err != nil {
 		return &AddFriendResult{Result: FriendResultDBError}, err
 	}
@@ -153,7 +189,7 @@ func (f *friendsServiceImpl) AddFriend(ctx context.Context, realmID uint32, play
 	friendCount := 0
 	for _, entry := range entries {
 		if entry.Flags == repo.SocialFlagFriend {
-			if entry.FriendGUID == friendGUID {
+			if entry.FriendGUID == friendGUID && entry.FriendRealmID == friendRealmID {
 				return &AddFriendResult{Result: FriendResultAlready}, nil
 			}
 			friendCount++
@@ -166,16 +202,20 @@ func (f *friendsServiceImpl) AddFriend(ctx context.Context, realmID uint32, play
 	}
 
 	// Add friend
-	err = f.charRepo.AddFriend(ctx, realmID, playerGUID, friendGUID, note)
+	if crossRealm {
+		err = f.charRepo.AddCrossRealmFriend(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+	} else {
+		err = f.charRepo.AddFriend(ctx, realmID, playerGUID, friendGUID, note)
+	}
 	if err != nil {
 		return &AddFriendResult{Result: FriendResultDBError}, err
 	}
 
 	// Get friend's online status
 	result := &AddFriendResult{}
-	if onlineInfo, ok := f.onlineCache.GetOnlineInfo(friendGUID); ok {
+	if onlineInfo, ok := f.onlineInfo(ctx, realmID, friendRealmID, friendGUID); ok {
 		result.Result = FriendResultAddedOnline
-		result.Status = 1 // online
+		result.Status = onlineInfo.Status
 		result.Area = onlineInfo.Area
 		result.Level = onlineInfo.Level
 		result.ClassID = onlineInfo.Class
@@ -197,8 +237,13 @@ func (f *friendsServiceImpl) AddFriend(ctx context.Context, realmID uint32, play
 	return result, nil
 }
 
-func (f *friendsServiceImpl) RemoveFriend(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64) error {
-	err := f.charRepo.RemoveFriend(ctx, realmID, playerGUID, friendGUID)
+func (f *friendsServiceImpl) RemoveFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error {
+	var err error
+	if isCrossRealmFriend(realmID, friendRealmID) {
+		err = f.charRepo.RemoveCrossRealmFriend(ctx, realmID, playerGUID, friendRealmID, friendGUID)
+	} else {
+		err = f.charRepo.RemoveFriend(ctx, realmID, playerGUID, friendGUID)
+	}
 	if err != nil {
 		return err
 	}
@@ -214,8 +259,13 @@ func (f *friendsServiceImpl) RemoveFriend(ctx context.Context, realmID uint32, p
 	return nil
 }
 
-func (f *friendsServiceImpl) SetFriendNote(ctx context.Context, realmID uint32, playerGUID, friendGUID uint64, note string) error {
-	err := f.charRepo.UpdateFriendNote(ctx, realmID, playerGUID, friendGUID, note)
+func (f *friendsServiceImpl) SetFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	var err error
+	if isCrossRealmFriend(realmID, friendRealmID) {
+		err = f.charRepo.UpdateCrossRealmFriendNote(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
+	} else {
+		err = f.charRepo.UpdateFriendNote(ctx, realmID, playerGUID, friendGUID, note)
+	}
 	if err != nil {
 		return err
 	}
@@ -273,25 +323,89 @@ func (f *friendsServiceImpl) RemoveIgnore(ctx context.Context, realmID uint32, p
 }
 
 func (f *friendsServiceImpl) NotifyStatusChange(ctx context.Context, realmID uint32, playerGUID uint64, status uint8, area, level, classID uint32) error {
-	// Get players who have this player as friend
-	notifyPlayers, err := f.charRepo.GetPlayersWhoHaveAsFriend(ctx, realmID, playerGUID)
+	for _, notifyRealmID := range f.realmLinks.LinkedRealms(realmID) {
+		// Get players who have this player as friend
+		var notifyPlayers []uint64
+		var err error
+		if notifyRealmID == realmID {
+			notifyPlayers, err = f.charRepo.GetPlayersWhoHaveAsFriend(ctx, realmID, playerGUID)
+		} else {
+			notifyPlayers, err = f.charRepo.GetPlayersWhoHaveAsCrossRealmFriend(ctx, notifyRealmID, realmID, playerGUID)
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(notifyPlayers) == 0 {
+			continue
+		}
+
+		// Publish status change event
+		err = f.eventsProducer.StatusChange(&events.FriendEventStatusChangePayload{
+			ServiceID:     charserver.ServiceID,
+			RealmID:       notifyRealmID,
+			PlayerGUID:    playerGUID,
+			PlayerRealmID: realmID,
+			Status:        status,
+			Area:          area,
+			Level:         level,
+			ClassID:       classID,
+			NotifyPlayers: notifyPlayers,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// friendEntries returns friends and ignored players of the player including friends from linked realms.
+// FriendRealmID is set for all entries.
+func (f *friendsServiceImpl) friendEntries(ctx context.Context, realmID uint32, playerGUID uint64) ([]*repo.FriendEntry, error) {
+	entries, err := f.charRepo.GetFriendsForPlayer(ctx, realmID, playerGUID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry.FriendRealmID = realmID
 	}
 
-	if len(notifyPlayers) == 0 {
-		return nil
+	// Cross-realm friends table is used only when realm is linked with others.
+	if len(f.realmLinks.LinkedRealms(realmID)) == 1 {
+		return entries, nil
 	}
 
-	// Publish status change event
-	return f.eventsProducer.StatusChange(&events.FriendEventStatusChangePayload{
-		ServiceID:     charserver.ServiceID,
-		RealmID:       realmID,
-		PlayerGUID:    playerGUID,
-		Status:        status,
-		Area:          area,
-		Level:         level,
-		ClassID:       classID,
-		NotifyPlayers: notifyPlayers,
-	})
+	crossRealmEntries, err := f.charRepo.GetCrossRealmFriendsForPlayer(ctx, realmID, playerGUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(entries, crossRealmEntries...), nil
+}
+
+// onlineInfo returns online info of the friend, friends from other realms are looked up in online characters.
+func (f *friendsServiceImpl) onlineInfo(ctx context.Context, realmID, friendRealmID uint32, friendGUID uint64) (OnlinePlayerInfo, bool) {
+	if !isCrossRealmFriend(realmID, friendRealmID) {
+		return f.onlineCache.GetOnlineInfo(friendGUID)
+	}
+
+	char, err := f.onlineChars.OneByRealmAndGUID(ctx, friendRealmID, friendGUID)
+	if err != nil || char == nil {
+		return OnlinePlayerInfo{}, false
+	}
+
+	return OnlinePlayerInfo{
+		GUID:   char.CharGUID,
+		Level:  uint32(char.CharLevel),
+		Class:  uint32(char.CharClass),
+		Area:   char.CharZone,
+		Status: FriendStatusFromChatFlags(char.CharChatFlags),
+	}, true
+}
+
+// isCrossRealmFriend returns true if friend is from the realm other than the player realm.
+func isCrossRealmFriend(realmID, friendRealmID uint32) bool {
+	return friendRealmID != 0 && friendRealmID != realmID
 }
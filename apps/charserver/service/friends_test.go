@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/walkline/ToCloud9/apps/charserver/repo"
+	repoMocks "github.com/walkline/ToCloud9/apps/charserver/repo/mocks"
+	"github.com/walkline/ToCloud9/apps/charserver/service"
+	"github.com/walkline/ToCloud9/apps/charserver/service/mocks"
+	"github.com/walkline/ToCloud9/shared/events"
+	eventsMocks "github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+// Realms 1 and 2 are linked, realm 3 is in another battle group.
+func testRealmLinks(t *testing.T) *service.RealmLinks {
+	links, err := service.NewRealmLinks(true, map[uint32]string{1: "1,2", 2: "3"})
+	assert.NoError(t, err)
+	return links
+}
+
+func TestFriendsService_AddFriend(t *testing.T) {
+	tests := []struct {
+		name          string
+		friendRealmID uint32
+		friendGUID    uint64
+		setup         func(chars *repoMocks.Characters, cache *mocks.OnlinePlayersCache, producer *eventsMocks.FriendsServiceProducer)
+		want          *service.AddFriendResult
+	}{
+		{
+			name:          "friend from the same realm",
+			friendRealmID: 1,
+			friendGUID:    11,
+			setup: func(chars *repoMocks.Characters, cache *mocks.OnlinePlayersCache, producer *eventsMocks.FriendsServiceProducer) {
+				chars.On("GetFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("GetCrossRealmFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("AddFriend", mock.Anything, uint32(1), uint64(10), uint64(11), "note").Return(nil)
+				cache.On("GetOnlineInfo", uint64(11)).Return(service.OnlinePlayerInfo{GUID: 11, Level: 80, Class: 1, Area: 4395, Status: service.FriendStatusOnline}, true)
+				producer.On("FriendAdded", mock.Anything).Return(nil)
+			},
+			want: &service.AddFriendResult{Result: service.FriendResultAddedOnline, Status: service.FriendStatusOnline, Area: 4395, Level: 80, ClassID: 1},
+		},
+		{
+			name:          "online friend from the linked realm",
+			friendRealmID: 2,
+			friendGUID:    20,
+			setup: func(chars *repoMocks.Characters, cache *mocks.OnlinePlayersCache, producer *eventsMocks.FriendsServiceProducer) {
+				chars.On("GetFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("GetCrossRealmFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("AddCrossRealmFriend", mock.Anything, uint32(1), uint64(10), uint32(2), uint64(20), "note").Return(nil)
+				producer.On("FriendAdded", mock.Anything).Return(nil)
+			},
+			want: &service.AddFriendResult{Result: service.FriendResultAddedOnline, Status: service.FriendStatusAFK, Area: 3703, Level: 70, ClassID: 5},
+		},
+		{
+			name:          "already friend from the linked realm",
+			friendRealmID: 2,
+			friendGUID:    20,
+			setup: func(chars *repoMocks.Characters, cache *mocks.OnlinePlayersCache, producer *eventsMocks.FriendsServiceProducer) {
+				chars.On("GetFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("GetCrossRealmFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return([]*repo.FriendEntry{
+					{PlayerGUID: 10, FriendGUID: 20, FriendRealmID: 2, Flags: repo.SocialFlagFriend},
+				}, nil)
+			},
+			want: &service.AddFriendResult{Result: service.FriendResultAlready},
+		},
+		{
+			name:          "friend from the unlinked realm",
+			friendRealmID: 3,
+			friendGUID:    30,
+			want:          &service.AddFriendResult{Result: service.FriendResultNotFound},
+		},
+		{
+			name:          "player with the same guid from the linked realm isn't the player",
+			friendRealmID: 2,
+			friendGUID:    10,
+			setup: func(chars *repoMocks.Characters, cache *mocks.OnlinePlayersCache, producer *eventsMocks.FriendsServiceProducer) {
+				chars.On("GetFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("GetCrossRealmFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("AddCrossRealmFriend", mock.Anything, uint32(1), uint64(10), uint32(2), uint64(10), "note").Return(nil)
+				producer.On("FriendAdded", mock.Anything).Return(nil)
+			},
+			want: &service.AddFriendResult{Result: service.FriendResultAddedOffline},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			chars := repoMocks.NewCharacters(t)
+			cache := mocks.NewOnlinePlayersCache(t)
+			producer := eventsMocks.NewFriendsServiceProducer(t)
+			if tt.setup != nil {
+				tt.setup(chars, cache, producer)
+			}
+
+			onlineChars := repo.NewCharactersOnlineInMem()
+			assert.NoError(t, onlineChars.Add(ctx, &repo.Character{
+				RealmID: 2, CharGUID: 20, CharLevel: 70, CharClass: 5, CharZone: 3703, CharChatFlags: events.CharacterChatFlagAFK,
+			}))
+
+			friends := service.NewFriendsService(chars, cache, producer, onlineChars, testRealmLinks(t))
+			got, err := friends.AddFriend(ctx, 1, 10, tt.friendRealmID, tt.friendGUID, "Friend", "note")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFriendsService_GetFriendsList(t *testing.T) {
+	ctx := context.Background()
+
+	chars := repoMocks.NewCharacters(t)
+	chars.On("GetFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return([]*repo.FriendEntry{
+		{PlayerGUID: 10, FriendGUID: 11, Flags: repo.SocialFlagFriend, Note: "same realm"},
+		{PlayerGUID: 10, FriendGUID: 12, Flags: repo.SocialFlagIgnore},
+	}, nil)
+	chars.On("GetCrossRealmFriendsForPlayer", mock.Anything, uint32(1), uint64(10)).Return([]*repo.FriendEntry{
+		{PlayerGUID: 10, FriendGUID: 20, FriendRealmID: 2, Flags: repo.SocialFlagFriend, Note: "online"},
+		{PlayerGUID: 10, FriendGUID: 21, FriendRealmID: 2, Flags: repo.SocialFlagFriend},
+	}, nil)
+
+	cache := mocks.NewOnlinePlayersCache(t)
+	cache.On("GetOnlineInfo", uint64(11)).Return(service.OnlinePlayerInfo{}, false)
+
+	onlineChars := repo.NewCharactersOnlineInMem()
+	assert.NoError(t, onlineChars.Add(ctx, &repo.Character{RealmID: 2, CharGUID: 20, CharLevel: 70, CharClass: 5, CharZone: 3703}))
+
+	friends := service.NewFriendsService(chars, cache, eventsMocks.NewFriendsServiceProducer(t), onlineChars, testRealmLinks(t))
+	list, err := friends.GetFriendsList(ctx, 1, 10)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*service.FriendInfo{
+		{RealmID: 1, GUID: 11, Note: "same realm", Status: service.FriendStatusOffline},
+		{RealmID: 2, GUID: 20, Note: "online", Status: service.FriendStatusOnline, Area: 3703, Level: 70, ClassID: 5},
+		{RealmID: 2, GUID: 21, Status: service.FriendStatusOffline},
+	}, list.Friends)
+	assert.Equal(t, []uint64{12}, list.Ignored)
+}
+
+func TestFriendsService_NotifyStatusChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		realmID uint32
+		setup   func(chars *repoMocks.Characters)
+		want    []events.FriendEventStatusChangePayload
+	}{
+		{
+			name:    "friends from the same and linked realms",
+			realmID: 1,
+			setup: func(chars *repoMocks.Characters) {
+				chars.On("GetPlayersWhoHaveAsFriend", mock.Anything, uint32(1), uint64(10)).Return([]uint64{11}, nil)
+				chars.On("GetPlayersWhoHaveAsCrossRealmFriend", mock.Anything, uint32(2), uint32(1), uint64(10)).Return([]uint64{20, 21}, nil)
+			},
+			want: []events.FriendEventStatusChangePayload{
+				{RealmID: 1, NotifyPlayers: []uint64{11}},
+				{RealmID: 2, NotifyPlayers: []uint64{20, 21}},
+			},
+		},
+		{
+			name:    "realm without friends of the player is skipped",
+			realmID: 1,
+			setup: func(chars *repoMocks.Characters) {
+				chars.On("GetPlayersWhoHaveAsFriend", mock.Anything, uint32(1), uint64(10)).Return(nil, nil)
+				chars.On("GetPlayersWhoHaveAsCrossRealmFriend", mock.Anything, uint32(2), uint32(1), uint64(10)).Return([]uint64{20}, nil)
+			},
+			want: []events.FriendEventStatusChangePayload{
+				{RealmID: 2, NotifyPlayers: []uint64{20}},
+			},
+		},
+		{
+			name:    "unlinked realm notifies only its own players",
+			realmID: 3,
+			setup: func(chars *repoMocks.Characters) {
+				chars.On("GetPlayersWhoHaveAsFriend", mock.Anything, uint32(3), uint64(10)).Return([]uint64{30}, nil)
+			},
+			want: []events.FriendEventStatusChangePayload{
+				{RealmID: 3, NotifyPlayers: []uint64{30}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chars := repoMocks.NewCharacters(t)
+			tt.setup(chars)
+
+			var got []events.FriendEventStatusChangePayload
+			producer := eventsMocks.NewFriendsServiceProducer(t)
+			producer.On("StatusChange", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				payload := args.Get(0).(*events.FriendEventStatusChangePayload)
+				got = append(got, events.FriendEventStatusChangePayload{RealmID: payload.RealmID, NotifyPlayers: payload.NotifyPlayers})
+
+				assert.Equal(t, tt.realmID, payload.PlayerRealmID)
+				assert.Equal(t, uint64(10), payload.PlayerGUID)
+				assert.Equal(t, uint8(service.FriendStatusOnline), payload.Status)
+			})
+
+			friends := service.NewFriendsService(chars, mocks.NewOnlinePlayersCache(t), producer, repo.NewCharactersOnlineInMem(), testRealmLinks(t))
+			assert.NoError(t, friends.NotifyStatusChange(context.Background(), tt.realmID, 10, service.FriendStatusOnline, 4395, 80, 1))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
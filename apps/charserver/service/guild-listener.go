@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/charserver/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// GuildMembershipListener keeps guild ids of online characters in sync with guild service events.
+type GuildMembershipListener struct {
+	onlineChars repo.CharactersOnline
+	nc          *nats.Conn
+	queueGroup  string
+	subs        []*nats.Subscription
+}
+
+// NewGuildMembershipListener creates listener of guild membership events. If queueGroup is not empty,
+// listeners with the same group share events, that's useful when online characters storage is shared.
+func NewGuildMembershipListener(onlineChars repo.CharactersOnline, nc *nats.Conn, queueGroup string) *GuildMembershipListener {
+	return &GuildMembershipListener{
+		onlineChars: onlineChars,
+		nc:          nc,
+		queueGroup:  queueGroup,
+	}
+}
+
+func (g *GuildMembershipListener) Listen() error {
+	err := g.subscribe(events.GuildEventMemberAdded, func(msg *nats.Msg) {
+		payload := events.GuildEventMemberAddedPayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read GuildEventMemberAdded (payload part) event")
+			return
+		}
+
+		if err := g.setGuildID(payload.RealmID, payload.MemberGUID, uint32(payload.GuildID)); err != nil {
+			log.Error().Err(err).Msg("can't update guild id in GuildEventMemberAdded event")
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = g.subscribe(events.GuildEventMemberLeft, func(msg *nats.Msg) {
+		payload := events.GuildEventMemberLeftPayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read GuildEventMemberLeft (payload part) event")
+			return
+		}
+
+		if err := g.resetGuildID(payload.RealmID, payload.MemberGUID, uint32(payload.GuildID)); err != nil {
+			log.Error().Err(err).Msg("can't update guild id in GuildEventMemberLeft event")
+		}
+	})
+	if err != nil {
+		g.unsubscribe()
+		return err
+	}
+
+	err = g.subscribe(events.GuildEventMemberKicked, func(msg *nats.Msg) {
+		payload := events.GuildEventMemberKickedPayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read GuildEventMemberKicked (payload part) event")
+			return
+		}
+
+		if err := g.resetGuildID(payload.RealmID, payload.MemberGUID, uint32(payload.GuildID)); err != nil {
+			log.Error().Err(err).Msg("can't update guild id in GuildEventMemberKicked event")
+		}
+	})
+	if err != nil {
+		g.unsubscribe()
+		return err
+	}
+
+	return nil
+}
+
+func (g *GuildMembershipListener) Stop() error {
+	return g.unsubscribe()
+}
+
+func (g *GuildMembershipListener) subscribe(e events.GuildServiceEvent, handler nats.MsgHandler) error {
+	var sb *nats.Subscription
+	var err error
+	if g.queueGroup != "" {
+		sb, err = g.nc.QueueSubscribe(e.SubjectName(), g.queueGroup, handler)
+	} else {
+		sb, err = g.nc.Subscribe(e.SubjectName(), handler)
+	}
+	if err != nil {
+		return err
+	}
+
+	g.subs = append(g.subs, sb)
+	return nil
+}
+
+// setGuildID updates guild id of the character if the character is online.
+func (g *GuildMembershipListener) setGuildID(realmID uint32, charGUID uint64, guildID uint32) error {
+	return g.onlineChars.HandleCharactersUpdates(events.GWEventCharactersUpdatesPayload{
+		RealmID: realmID,
+		Updates: []*events.CharacterUpdate{{ID: charGUID, GuildID: &guildID}},
+	})
+}
+
+// resetGuildID removes guild id of the character if the character is still in the guild.
+func (g *GuildMembershipListener) resetGuildID(realmID uint32, charGUID uint64, guildID uint32) error {
+	char, err := g.onlineChars.OneByRealmAndGUID(context.TODO(), realmID, charGUID)
+	if err != nil {
+		return err
+	}
+
+	// Character could join another guild already.
+	if char == nil || char.CharGuildID != guildID {
+		return nil
+	}
+
+	return g.setGuildID(realmID, charGUID, 0)
+}
+
+func (g *GuildMembershipListener) unsubscribe() error {
+	for _, sub := range g.subs {
+		if err := sub.Unsubscribe(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
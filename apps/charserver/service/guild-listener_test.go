@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/charserver/repo"
+)
+
+func TestGuildMembershipListener_UpdatesOnlineCharacters(t *testing.T) {
+	ctx := context.Background()
+	onlineChars := repo.NewCharactersOnlineInMem()
+	assert.NoError(t, onlineChars.Add(ctx, &repo.Character{RealmID: 1, CharGUID: 10, CharName: "Member"}))
+
+	l := NewGuildMembershipListener(onlineChars, nil, "")
+
+	assert.NoError(t, l.setGuildID(1, 10, 5))
+	char, err := onlineChars.OneByRealmAndGUID(ctx, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), char.CharGuildID)
+
+	// Left event of the previous guild comes after the character joined the new one.
+	assert.NoError(t, l.resetGuildID(1, 10, 4))
+	char, err = onlineChars.OneByRealmAndGUID(ctx, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), char.CharGuildID)
+
+	assert.NoError(t, l.resetGuildID(1, 10, 5))
+	char, err = onlineChars.OneByRealmAndGUID(ctx, 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0), char.CharGuildID)
+
+	// Offline characters are ignored.
+	assert.NoError(t, l.setGuildID(1, 11, 5))
+	char, err = onlineChars.OneByRealmAndGUID(ctx, 1, 11)
+	assert.NoError(t, err)
+	assert.Nil(t, char)
+}
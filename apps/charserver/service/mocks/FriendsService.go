@@ -14,25 +14,25 @@ type FriendsService struct {
 	mock.Mock
 }
 
-// AddFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID, friendName, note
-func (_m *FriendsService) AddFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64, friendName string, note string) (*service.AddFriendResult, error) {
-	ret := _m.Called(ctx, realmID, playerGUID, friendGUID, friendName, note)
+// AddFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID, friendName, note
+func (_m *FriendsService) AddFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, friendName string, note string) (*service.AddFriendResult, error) {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID, friendName, note)
 
 	var r0 *service.AddFriendResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, string, string) (*service.AddFriendResult, error)); ok {
-		return rf(ctx, realmID, playerGUID, friendGUID, friendName, note)
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64, string, string) (*service.AddFriendResult, error)); ok {
+		return rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, friendName, note)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, string, string) *service.AddFriendResult); ok {
-		r0 = rf(ctx, realmID, playerGUID, friendGUID, friendName, note)
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64, string, string) *service.AddFriendResult); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, friendName, note)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*service.AddFriendResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64, uint64, string, string) error); ok {
-		r1 = rf(ctx, realmID, playerGUID, friendGUID, friendName, note)
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64, uint32, uint64, string, string) error); ok {
+		r1 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, friendName, note)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,13 +104,13 @@ func (_m *FriendsService) NotifyStatusChange(ctx context.Context, realmID uint32
 	return r0
 }
 
-// RemoveFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID
-func (_m *FriendsService) RemoveFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64) error {
-	ret := _m.Called(ctx, realmID, playerGUID, friendGUID)
+// RemoveFriend provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID
+func (_m *FriendsService) RemoveFriend(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64) error); ok {
-		r0 = rf(ctx, realmID, playerGUID, friendGUID)
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -132,13 +132,13 @@ func (_m *FriendsService) RemoveIgnore(ctx context.Context, realmID uint32, play
 	return r0
 }
 
-// SetFriendNote provides a mock function with given fields: ctx, realmID, playerGUID, friendGUID, note
-func (_m *FriendsService) SetFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendGUID uint64, note string) error {
-	ret := _m.Called(ctx, realmID, playerGUID, friendGUID, note)
+// SetFriendNote provides a mock function with given fields: ctx, realmID, playerGUID, friendRealmID, friendGUID, note
+func (_m *FriendsService) SetFriendNote(ctx context.Context, realmID uint32, playerGUID uint64, friendRealmID uint32, friendGUID uint64, note string) error {
+	ret := _m.Called(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint64, string) error); ok {
-		r0 = rf(ctx, realmID, playerGUID, friendGUID, note)
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64, uint32, uint64, string) error); ok {
+		r0 = rf(ctx, realmID, playerGUID, friendRealmID, friendGUID, note)
 	} else {
 		r0 = ret.Error(0)
 	}
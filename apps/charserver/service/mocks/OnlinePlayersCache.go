@@ -90,11 +90,6 @@ func (_m *OnlinePlayersCache) PlayerLoggedOut(playerGUID uint64) {
 	_m.Called(playerGUID)
 }
 
-// SetFriendsService provides a mock function with given fields: friendsService
-func (_m *OnlinePlayersCache) SetFriendsService(friendsService service.FriendsService) {
-	_m.Called(friendsService)
-}
-
 type mockConstructorTestingTNewOnlinePlayersCache interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RealmLinks tells which realms share social features (who, whispers and friends) with each other.
+// Realms are linked when cross-realm social is enabled and they are in the same battle group.
+type RealmLinks struct {
+	battleGroupByRealm  map[uint32]uint32
+	realmsByBattleGroup map[uint32][]uint32
+}
+
+// NewRealmLinks creates RealmLinks from battle groups config value, that maps battle group id
+// to the comma separated realm ids. If crossRealm is false, every realm is linked only with itself.
+func NewRealmLinks(crossRealm bool, battleGroups map[uint32]string) (*RealmLinks, error) {
+	links := &RealmLinks{
+		battleGroupByRealm:  map[uint32]uint32{},
+		realmsByBattleGroup: map[uint32][]uint32{},
+	}
+	if !crossRealm {
+		return links, nil
+	}
+
+	for battleGroupID, realmsString := range battleGroups {
+		for _, realm := range strings.Split(realmsString, ",") {
+			realmID, err := strconv.ParseUint(strings.TrimSpace(realm), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse realmID for battlegroup, realmID: %s", realm)
+			}
+
+			links.battleGroupByRealm[uint32(realmID)] = battleGroupID
+			links.realmsByBattleGroup[battleGroupID] = append(links.realmsByBattleGroup[battleGroupID], uint32(realmID))
+		}
+	}
+
+	for _, realms := range links.realmsByBattleGroup {
+		sort.Slice(realms, func(i, j int) bool { return realms[i] < realms[j] })
+	}
+
+	return links, nil
+}
+
+// Linked returns true if characters of the realms can see each other.
+func (l *RealmLinks) Linked(realmID, otherRealmID uint32) bool {
+	if realmID == otherRealmID {
+		return true
+	}
+
+	battleGroupID, ok := l.battleGroupByRealm[realmID]
+	if !ok {
+		return false
+	}
+
+	otherBattleGroupID, ok := l.battleGroupByRealm[otherRealmID]
+	return ok && battleGroupID == otherBattleGroupID
+}
+
+// LinkedRealms returns the realm and all realms linked with it, the realm goes first.
+func (l *RealmLinks) LinkedRealms(realmID uint32) []uint32 {
+	result := []uint32{realmID}
+
+	battleGroupID, ok := l.battleGroupByRealm[realmID]
+	if !ok {
+		return result
+	}
+
+	for _, linkedRealmID := range l.realmsByBattleGroup[battleGroupID] {
+		if linkedRealmID != realmID {
+			result = append(result, linkedRealmID)
+		}
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRealmLinks_BattleGroups(t *testing.T) {
+	links, err := NewRealmLinks(true, map[uint32]string{1: "1,2", 2: "3"})
+	assert.NoError(t, err)
+
+	assert.True(t, links.Linked(1, 2))
+	assert.True(t, links.Linked(2, 1))
+	assert.True(t, links.Linked(3, 3))
+	assert.False(t, links.Linked(1, 3))
+	assert.False(t, links.Linked(1, 4))
+
+	assert.Equal(t, []uint32{2, 1}, links.LinkedRealms(2))
+	assert.Equal(t, []uint32{3}, links.LinkedRealms(3))
+	assert.Equal(t, []uint32{4}, links.LinkedRealms(4))
+}
+
+func TestRealmLinks_Disabled(t *testing.T) {
+	links, err := NewRealmLinks(false, map[uint32]string{1: "1,2"})
+	assert.NoError(t, err)
+
+	assert.True(t, links.Linked(1, 1))
+	assert.False(t, links.Linked(1, 2))
+	assert.Equal(t, []uint32{1}, links.LinkedRealms(1))
+}
+
+func TestRealmLinks_InvalidRealm(t *testing.T) {
+	_, err := NewRealmLinks(true, map[uint32]string{1: "1,abc"})
+	assert.Error(t, err)
+}
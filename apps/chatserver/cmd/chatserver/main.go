@@ -59,6 +59,7 @@ func main() {
 
 	// Setup MySQL connections for channels
 	charDB := shrepo.NewCharactersDB()
+	realmIDs := make([]uint32, 0, len(cfg.CharDBConnection))
 	for realmID, connStr := range cfg.CharDBConnection {
 		cdb, err := sql.Open("mysql", connStr)
 		if err != nil {
@@ -66,6 +67,7 @@ func main() {
 		}
 		configureDBConn(cdb)
 		charDB.SetDBForRealm(realmID, cdb)
+		realmIDs = append(realmIDs, realmID)
 	}
 	channelsRepo := repo.NewChannelsMYSQL(charDB)
 
@@ -135,9 +137,43 @@ func main() {
 		}
 	}()
 
+	// Chat log setup
+	chatLogRepo, err := chatLogRepo(cfg, charDB)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't setup chat log storage")
+	}
+	chatLogRetention, err := time.ParseDuration(cfg.ChatLogRetention)
+	if err != nil {
+		log.Fatal().Err(err).Str("retention", cfg.ChatLogRetention).Msg("invalid chat log retention")
+	}
+	chatLogger := service.NewChatLogger(chatLogRepo, chatLogRetention, cfg.ChatLogMaxSearchLimit)
+	go chatLogger.Run(ctx)
+
 	// Message producer setup (for broadcasting channel events)
 	msgProducer := sender.NewMsgProducerNatsJSON(nc, "ALL") // Broadcast to all gateways
 
+	// Chat filter setup
+	var chatFilterSources []repo.ChatFilterRulesRepo
+	if cfg.ChatFilterRulesFile != "" {
+		chatFilterSources = append(chatFilterSources, repo.NewChatFilterRulesFile(cfg.ChatFilterRulesFile))
+	}
+	if cfg.ChatFilterRulesDBRealm != 0 {
+		chatFilterSources = append(chatFilterSources, repo.NewChatFilterRulesMYSQL(charDB, cfg.ChatFilterRulesDBRealm))
+	}
+	chatFilterReloadInterval, err := time.ParseDuration(cfg.ChatFilterReloadInterval)
+	if err != nil {
+		log.Fatal().Err(err).Str("interval", cfg.ChatFilterReloadInterval).Msg("invalid chat filter reload interval")
+	}
+	mutePolicy := service.NewMutePolicy(serviceID, msgProducer, repo.NewChatMutesMYSQL(charDB, realmIDs))
+	if err = mutePolicy.Load(ctx, time.Now()); err != nil {
+		log.Fatal().Err(err).Msg("can't load chat mutes")
+	}
+	chatFilter := service.NewChatFilter(mutePolicy, chatFilterSources...)
+	if err = chatFilter.Reload(ctx); err != nil {
+		log.Fatal().Err(err).Msg("can't load chat filter rules")
+	}
+	go chatFilter.Run(ctx, chatFilterReloadInterval)
+
 	// listeners setup
 	charListener := service.NewCharactersListener(charRepo, channelMgr, nc)
 	err = charListener.Listen()
@@ -157,6 +193,20 @@ func main() {
 		log.Fatal().Err(err).Msg("can't start listen to channel sync events")
 	}
 
+	mutesListener := service.NewMutesListener(serviceID, mutePolicy, nc)
+	err = mutesListener.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't start listen to chat mutes events")
+	}
+
+	chatLogListener := service.NewChatLogListener(chatLogger, nc)
+	if cfg.ChatLogStorage != "" {
+		err = chatLogListener.Listen()
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't start listen to chat log events")
+		}
+	}
+
 	// grpc setup
 	lis, err := net.Listen("tcp4", ":"+cfg.Port)
 	if err != nil {
@@ -166,7 +216,7 @@ func main() {
 	grpcServer := grpc.NewServer()
 	pb.RegisterChatServiceServer(
 		grpcServer,
-		server.NewChatService(charRepo, channelMgr, msgProducer, serviceID),
+		server.NewChatService(charRepo, channelMgr, msgProducer, serviceID, charClient, chatLogger, chatFilter),
 	)
 
 	// graceful shutdown handling
@@ -195,6 +245,15 @@ func main() {
 		if err := channelsListener.Stop(); err != nil {
 			log.Error().Err(err).Msg("Failed to stop channels listener")
 		}
+		if err := mutesListener.Stop(); err != nil {
+			log.Error().Err(err).Msg("Failed to stop mutes listener")
+		}
+		if err := chatLogListener.Stop(); err != nil {
+			log.Error().Err(err).Msg("Failed to stop chat log listener")
+		}
+
+		// Flush remaining chat log messages
+		chatLogger.Wait()
 
 		// Close database connections
 		for realmID := range cfg.CharDBConnection {
@@ -226,6 +285,23 @@ func configureDBConn(db *sql.DB) {
 	db.SetConnMaxIdleTime(time.Minute * 8)
 }
 
+func chatLogRepo(cnf *config.Config, charDB shrepo.CharactersDB) (repo.ChatLogRepo, error) {
+	switch cnf.ChatLogStorage {
+	case "":
+		return repo.NewChatLogNoopRepo(), nil
+	case "mysql":
+		realmIDs := make([]uint32, 0, len(cnf.CharDBConnection))
+		for realmID := range cnf.CharDBConnection {
+			realmIDs = append(realmIDs, realmID)
+		}
+		return repo.NewChatLogMYSQL(charDB, realmIDs), nil
+	case "file":
+		return repo.NewChatLogFile(cnf.ChatLogFile)
+	}
+
+	return nil, fmt.Errorf("unknown chat log storage %q", cnf.ChatLogStorage)
+}
+
 func charService(cnf *config.Config) pbChar.CharactersServiceClient {
 	conn, err := grpc.Dial(cnf.CharServiceAddress, grpc.WithInsecure())
 	if err != nil {
@@ -26,6 +26,28 @@ type Config struct {
 
 	// ChannelInactiveThreshold is how long a channel must be inactive before cleanup (e.g., "720h" = 30 days)
 	ChannelInactiveThreshold string `yaml:"channelInactiveThreshold" env:"CHANNEL_INACTIVE_THRESHOLD" env-default:"720h"`
+
+	// ChatLogStorage is storage of the chat log for moderation: "mysql" (characters database), "file" or empty to disable logging
+	ChatLogStorage string `yaml:"chatLogStorage" env:"CHAT_LOG_STORAGE" env-default:""`
+
+	// ChatLogFile is path to the JSON lines file that stores chat log when ChatLogStorage is "file"
+	ChatLogFile string `yaml:"chatLogFile" env:"CHAT_LOG_FILE" env-default:"chat-log.jsonl"`
+
+	// ChatLogRetention is how long to keep chat log messages (e.g., "720h" = 30 days), "0" keeps messages forever
+	ChatLogRetention string `yaml:"chatLogRetention" env:"CHAT_LOG_RETENTION" env-default:"720h"`
+
+	// ChatLogMaxSearchLimit is the max amount of messages returned by one chat log search, 0 disables the limit
+	ChatLogMaxSearchLimit int `yaml:"chatLogMaxSearchLimit" env:"CHAT_LOG_MAX_SEARCH_LIMIT" env-default:"1000"`
+
+	// ChatFilterRulesFile is path to the chat filter rules file (yaml or json), empty value disables rules from file
+	ChatFilterRulesFile string `yaml:"chatFilterRulesFile" env:"CHAT_FILTER_RULES_FILE" env-default:""`
+
+	// ChatFilterRulesDBRealm is realm whose characters database has chat_filter_rules table with
+	// additional words and regex rules, 0 disables rules from database
+	ChatFilterRulesDBRealm uint32 `yaml:"chatFilterRulesDBRealm" env:"CHAT_FILTER_RULES_DB_REALM" env-default:"0"`
+
+	// ChatFilterReloadInterval is how often to reload chat filter rules (e.g., "1m")
+	ChatFilterReloadInterval string `yaml:"chatFilterReloadInterval" env:"CHAT_FILTER_RELOAD_INTERVAL" env-default:"1m"`
 }
 
 // LoadConfig loads config from env variables
@@ -0,0 +1,114 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Action is what filter does with the offending message.
+type Action uint8
+
+const (
+	// ActionAllow lets message through unchanged.
+	ActionAllow Action = iota
+	// ActionReplace masks offending part of the message.
+	ActionReplace
+	// ActionBlock drops the message.
+	ActionBlock
+)
+
+// ParseAction parses action from the rules, empty value defaults to the given action.
+func ParseAction(s string, def Action) (Action, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "":
+		return def, nil
+	case "allow":
+		return ActionAllow, nil
+	case "replace":
+		return ActionReplace, nil
+	case "block":
+		return ActionBlock, nil
+	}
+	return ActionAllow, fmt.Errorf("unknown filter action %q", s)
+}
+
+func (a Action) String() string {
+	switch a {
+	case ActionAllow:
+		return "allow"
+	case ActionReplace:
+		return "replace"
+	case ActionBlock:
+		return "block"
+	}
+	return fmt.Sprintf("Action(%d)", a)
+}
+
+// Message is chat message that goes through the filters.
+type Message struct {
+	RealmID    uint32
+	SenderGUID uint64
+
+	// ChatType is type of the message from the client (say, whisper, channel, etc.).
+	ChatType uint32
+	Text     string
+	At       time.Time
+}
+
+// Violation describes why filter changed or blocked the message.
+type Violation struct {
+	Filter string
+	Reason string
+	Action Action
+}
+
+// Filter checks the message. Filter may change message text, in this case it returns violation with ActionReplace.
+type Filter interface {
+	Apply(msg *Message) *Violation
+}
+
+// Result is result of the pipeline.
+type Result struct {
+	Text       string
+	Blocked    bool
+	Violations []Violation
+}
+
+// Pipeline applies filters one by one, each filter gets the text changed by previous filters.
+// Pipeline stops on the first filter that blocks the message.
+type Pipeline struct {
+	filters []Filter
+}
+
+func NewPipeline(filters ...Filter) *Pipeline {
+	return &Pipeline{filters: filters}
+}
+
+func (p *Pipeline) Apply(msg Message) Result {
+	if msg.At.IsZero() {
+		msg.At = time.Now()
+	}
+
+	res := Result{}
+	for _, f := range p.filters {
+		v := f.Apply(&msg)
+		if v == nil {
+			continue
+		}
+
+		res.Violations = append(res.Violations, *v)
+		if v.Action == ActionBlock {
+			res.Blocked = true
+			break
+		}
+	}
+
+	res.Text = msg.Text
+	return res
+}
+
+// mask replaces every character of the word with the asterisk.
+func mask(s string) string {
+	return strings.Repeat("*", len([]rune(s)))
+}
@@ -0,0 +1,163 @@
+package filter
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testItemLink = "|cff0070dd|Hitem:19019:0:0:0:0:0:0:0:80|h[Thunderfury]|h|r"
+
+func TestWordListFilter(t *testing.T) {
+	f := NewWordListFilter([]string{"gold4u"}, []string{"darn"})
+
+	msg := &Message{Text: "DARN it, darned thing"}
+	v := f.Apply(msg)
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionReplace, v.Action)
+	}
+	assert.Equal(t, "**** it, darned thing", msg.Text)
+
+	v = f.Apply(&Message{Text: "visit gold4u now"})
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionBlock, v.Action)
+	}
+
+	assert.Nil(t, f.Apply(&Message{Text: "hello"}))
+}
+
+func TestRegexFilter(t *testing.T) {
+	f := NewRegexFilter([]RegexRule{
+		{Re: regexPattern(t, `(?i)cheap\s+gold`), Action: ActionBlock},
+		{Re: regexPattern(t, `n00b`), Replacement: "newbie"},
+	})
+
+	msg := &Message{Text: "hi n00b"}
+	v := f.Apply(msg)
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionReplace, v.Action)
+	}
+	assert.Equal(t, "hi newbie", msg.Text)
+
+	v = f.Apply(&Message{Text: "CHEAP  GOLD here"})
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionBlock, v.Action)
+	}
+}
+
+func TestLinkFilter(t *testing.T) {
+	f := NewLinkFilter(ActionBlock, []string{"wowhead.com"})
+
+	assert.Nil(t, f.Apply(&Message{Text: "see https://www.wowhead.com/item=19019"}))
+	assert.Nil(t, f.Apply(&Message{Text: "nice " + testItemLink}))
+	assert.NotNil(t, f.Apply(&Message{Text: "buy at http://gold.example/shop"}))
+	assert.NotNil(t, f.Apply(&Message{Text: "buy at g0ld4u dot com"}))
+	assert.NotNil(t, f.Apply(&Message{Text: "buy at g0ld4u . net"}))
+
+	f = NewLinkFilter(ActionReplace, nil)
+	msg := &Message{Text: "go www.site.com now"}
+	v := f.Apply(msg)
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionReplace, v.Action)
+	}
+	assert.Equal(t, "go ************ now", msg.Text)
+}
+
+func TestRepeatFilter(t *testing.T) {
+	tracker := NewSpamTracker()
+	f := NewRepeatFilter(tracker, 2, time.Minute)
+	now := time.Now()
+
+	assert.Nil(t, f.Apply(&Message{SenderGUID: 1, Text: "LFG", At: now}))
+	assert.Nil(t, f.Apply(&Message{SenderGUID: 1, Text: "lfg ", At: now.Add(time.Second)}))
+	assert.Nil(t, f.Apply(&Message{SenderGUID: 2, Text: "lfg", At: now.Add(time.Second)}))
+	assert.NotNil(t, f.Apply(&Message{SenderGUID: 1, Text: "lfg", At: now.Add(2 * time.Second)}))
+
+	// Old messages are out of the window.
+	assert.Nil(t, f.Apply(&Message{SenderGUID: 1, Text: "lfg", At: now.Add(2 * time.Minute)}))
+
+	tracker.Cleanup(now.Add(time.Hour), time.Minute)
+	assert.Empty(t, tracker.history)
+}
+
+func TestCapsFilter(t *testing.T) {
+	f := NewCapsFilter(ActionReplace, 5, 0.7)
+
+	assert.Nil(t, f.Apply(&Message{Text: "OK"}))
+	assert.Nil(t, f.Apply(&Message{Text: "Hello There"}))
+
+	msg := &Message{Text: "SELLING " + testItemLink + " CHEAP"}
+	v := f.Apply(msg)
+	if assert.NotNil(t, v) {
+		assert.Equal(t, ActionReplace, v.Action)
+	}
+	assert.Equal(t, "selling "+testItemLink+" cheap", msg.Text)
+}
+
+func TestItemLinkFilter(t *testing.T) {
+	f := NewItemLinkFilter(2)
+
+	assert.Nil(t, f.Apply(&Message{Text: "wts " + testItemLink}))
+	assert.Nil(t, f.Apply(&Message{Text: "a || b"}))
+	assert.NotNil(t, f.Apply(&Message{Text: testItemLink + testItemLink + testItemLink}))
+	assert.NotNil(t, f.Apply(&Message{Text: "|cff0070dd|Hitem:abc|h[Fake]|h|r"}))
+	assert.NotNil(t, f.Apply(&Message{Text: "|cff0070dd|Hitem:0|h[Fake]|h|r"}))
+	assert.NotNil(t, f.Apply(&Message{Text: "|cff0070dd|Hbogus:1|h[Fake]|h|r"}))
+	assert.NotNil(t, f.Apply(&Message{Text: "|cff0070dd|Hitem:19019|h[Broken"}))
+}
+
+func TestPipeline(t *testing.T) {
+	rules := Rules{
+		ReplaceWords: []string{"darn"},
+		BlockWords:   []string{"gold4u"},
+		Spam:         SpamRules{MaxRepeats: 1, RepeatWindow: "1m"},
+		ItemLinks:    ItemLinksRules{Validate: true},
+		Links:        LinksRules{Enabled: true},
+	}
+	p, err := rules.Pipeline(NewSpamTracker())
+	assert.NoError(t, err)
+
+	res := p.Apply(Message{SenderGUID: 1, Text: "darn"})
+	assert.False(t, res.Blocked)
+	assert.Equal(t, "****", res.Text)
+	assert.Len(t, res.Violations, 1)
+
+	res = p.Apply(Message{SenderGUID: 1, Text: "darn"})
+	assert.True(t, res.Blocked)
+
+	res = p.Apply(Message{SenderGUID: 2, Text: "gold4u"})
+	assert.True(t, res.Blocked)
+
+	res = p.Apply(Message{SenderGUID: 3, Text: "hello"})
+	assert.False(t, res.Blocked)
+	assert.Equal(t, "hello", res.Text)
+	assert.Empty(t, res.Violations)
+}
+
+func TestRules_InvalidRegex(t *testing.T) {
+	rules := Rules{Regex: []RegexRuleConfig{{Pattern: "("}}}
+	_, err := rules.Pipeline(NewSpamTracker())
+	assert.Error(t, err)
+
+	rules = Rules{Regex: []RegexRuleConfig{{Pattern: "a", Action: "explode"}}}
+	_, err = rules.Pipeline(NewSpamTracker())
+	assert.Error(t, err)
+}
+
+func TestRules_Merge(t *testing.T) {
+	base := Rules{BlockWords: []string{"a"}, Spam: SpamRules{MaxRepeats: 3}}
+	merged := base.Merge(&Rules{BlockWords: []string{"b"}, Spam: SpamRules{MaxRepeats: 10}})
+
+	assert.Equal(t, []string{"a", "b"}, merged.BlockWords)
+	assert.Equal(t, 3, merged.Spam.MaxRepeats)
+	assert.Equal(t, []string{"a"}, base.BlockWords)
+}
+
+func regexPattern(t *testing.T, pattern string) *regexp.Regexp {
+	t.Helper()
+	re, err := regexp.Compile(pattern)
+	assert.NoError(t, err)
+	return re
+}
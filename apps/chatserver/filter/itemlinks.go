@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// linkRe matches client hyperlink: |cAARRGGBB|Htype:data|h[text]|h|r
+var linkRe = regexp.MustCompile(`\|c[0-9a-fA-F]{8}\|H([a-z]+):([^|]*)\|h\[([^\]|]*)\]\|h\|r`)
+
+var knownLinkTypes = map[string]bool{
+	"item":        true,
+	"spell":       true,
+	"enchant":     true,
+	"quest":       true,
+	"achievement": true,
+	"talent":      true,
+	"trade":       true,
+	"glyph":       true,
+}
+
+// maxItemLinkFields is count of numeric fields of 3.3.5 item link:
+// itemID:enchant:gem1:gem2:gem3:gem4:suffixID:uniqueID:linkLevel.
+const maxItemLinkFields = 9
+
+// ItemLinkFilter blocks messages with malformed or unknown hyperlinks, that can be used to crash or confuse clients,
+// and messages with too many links.
+type ItemLinkFilter struct {
+	maxLinks int
+}
+
+// NewItemLinkFilter creates ItemLinkFilter, zero maxLinks means no limit.
+func NewItemLinkFilter(maxLinks int) *ItemLinkFilter {
+	return &ItemLinkFilter{maxLinks: maxLinks}
+}
+
+func (f *ItemLinkFilter) Apply(msg *Message) *Violation {
+	links := linkRe.FindAllStringSubmatch(msg.Text, -1)
+	if f.maxLinks > 0 && len(links) > f.maxLinks {
+		return &Violation{Filter: "itemlinks", Reason: fmt.Sprintf("too many links (%d)", len(links)), Action: ActionBlock}
+	}
+
+	for _, link := range links {
+		if err := validateLink(link[1], link[2]); err != nil {
+			return &Violation{Filter: "itemlinks", Reason: err.Error(), Action: ActionBlock}
+		}
+	}
+
+	// Client escapes pipe typed by player as "||", any other pipe is a broken link.
+	if strings.Contains(strings.ReplaceAll(stripLinks(msg.Text), "||", ""), "|") {
+		return &Violation{Filter: "itemlinks", Reason: "malformed link", Action: ActionBlock}
+	}
+
+	return nil
+}
+
+func validateLink(linkType, data string) error {
+	if !knownLinkTypes[linkType] {
+		return fmt.Errorf("unknown link type %q", linkType)
+	}
+
+	if linkType != "item" {
+		return nil
+	}
+
+	fields := strings.Split(data, ":")
+	if len(fields) > maxItemLinkFields {
+		return fmt.Errorf("invalid item link %q", data)
+	}
+
+	for i, field := range fields {
+		v, err := strconv.ParseInt(field, 10, 32)
+		if err != nil || (i == 0 && v <= 0) {
+			return fmt.Errorf("invalid item link %q", data)
+		}
+	}
+
+	return nil
+}
+
+// stripLinks removes hyperlinks from the text.
+func stripLinks(text string) string {
+	return linkRe.ReplaceAllString(text, "")
+}
+
+// lowerOutsideLinks lowercases the text and keeps hyperlinks untouched, since links are case-sensitive.
+func lowerOutsideLinks(text string) string {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range linkRe.FindAllStringIndex(text, -1) {
+		sb.WriteString(strings.ToLower(text[last:loc[0]]))
+		sb.WriteString(text[loc[0]:loc[1]])
+		last = loc[1]
+	}
+	sb.WriteString(strings.ToLower(text[last:]))
+	return sb.String()
+}
@@ -0,0 +1,67 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// urlRe matches web links including the common obfuscations like "site dot com".
+var urlRe = regexp.MustCompile(`(?i)(?:https?://|www\.)[^\s|]+|\b[a-z0-9-]+(?:\s*\.\s*|\s*\(?dot\)?\s*)(?:com|net|org|info|biz|ru|cn|io|gg|us|eu|de|uk|co|me|tk|cc)\b(?:/[^\s|]*)?`)
+
+var urlDotRe = regexp.MustCompile(`(?i)\s*\.\s*|\s*\(?dot\)?\s*`)
+
+// LinkFilter blocks or masks web links, links to the allowed domains (and their subdomains) pass through.
+type LinkFilter struct {
+	action         Action
+	allowedDomains []string
+}
+
+func NewLinkFilter(action Action, allowedDomains []string) *LinkFilter {
+	domains := make([]string, 0, len(allowedDomains))
+	for _, d := range allowedDomains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			domains = append(domains, d)
+		}
+	}
+	return &LinkFilter{action: action, allowedDomains: domains}
+}
+
+func (f *LinkFilter) Apply(msg *Message) *Violation {
+	found := false
+	text := urlRe.ReplaceAllStringFunc(msg.Text, func(link string) string {
+		if f.allowed(link) {
+			return link
+		}
+		found = true
+		return mask(link)
+	})
+
+	if !found {
+		return nil
+	}
+
+	if f.action == ActionBlock {
+		return &Violation{Filter: "links", Reason: "web link", Action: ActionBlock}
+	}
+
+	msg.Text = text
+	return &Violation{Filter: "links", Reason: "web link", Action: ActionReplace}
+}
+
+func (f *LinkFilter) allowed(link string) bool {
+	host := strings.ToLower(link)
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexAny(host, "/?#"); i >= 0 {
+		host = host[:i]
+	}
+	host = urlDotRe.ReplaceAllString(host, ".")
+
+	for _, d := range f.allowedDomains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Rules is chat filter configuration. Rules can be loaded from the file or database and reloaded at runtime.
+type Rules struct {
+	// BlockWords block the message that contains any of them.
+	BlockWords []string `yaml:"blockWords" json:"blockWords"`
+
+	// ReplaceWords are masked with asterisks.
+	ReplaceWords []string `yaml:"replaceWords" json:"replaceWords"`
+
+	Regex []RegexRuleConfig `yaml:"regex" json:"regex"`
+
+	Links     LinksRules     `yaml:"links" json:"links"`
+	Spam      SpamRules      `yaml:"spam" json:"spam"`
+	ItemLinks ItemLinksRules `yaml:"itemLinks" json:"itemLinks"`
+	Mute      MuteRules      `yaml:"mute" json:"mute"`
+}
+
+// RegexRuleConfig is regex rule, useful for gold sellers advertisement and obfuscated words.
+type RegexRuleConfig struct {
+	Pattern string `yaml:"pattern" json:"pattern"`
+
+	// Action is "replace" (default) or "block".
+	Action string `yaml:"action" json:"action"`
+
+	// Replacement replaces matched text, empty replacement masks it with asterisks.
+	// Supports regexp expansion like $1.
+	Replacement string `yaml:"replacement" json:"replacement"`
+
+	Reason string `yaml:"reason" json:"reason"`
+}
+
+// LinksRules configures web links filter.
+type LinksRules struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+
+	// Action is "block" (default) or "replace".
+	Action         string   `yaml:"action" json:"action"`
+	AllowedDomains []string `yaml:"allowedDomains" json:"allowedDomains"`
+}
+
+// SpamRules configures repeated messages and caps spam detection.
+type SpamRules struct {
+	// MaxRepeats is how many times player can send the same message within RepeatWindow, 0 disables the check.
+	MaxRepeats   int    `yaml:"maxRepeats" json:"maxRepeats"`
+	RepeatWindow string `yaml:"repeatWindow" json:"repeatWindow"`
+
+	// CapsMinLength is minimal count of letters to check message for caps, 0 disables the check.
+	CapsMinLength int     `yaml:"capsMinLength" json:"capsMinLength"`
+	CapsMaxRatio  float64 `yaml:"capsMaxRatio" json:"capsMaxRatio"`
+
+	// CapsAction is "replace" (default, lowercases the message) or "block".
+	CapsAction string `yaml:"capsAction" json:"capsAction"`
+}
+
+// ItemLinksRules configures hyperlinks validation.
+type ItemLinksRules struct {
+	Validate bool `yaml:"validate" json:"validate"`
+
+	// MaxLinks is max count of links in the message, 0 means no limit.
+	MaxLinks int `yaml:"maxLinks" json:"maxLinks"`
+}
+
+// MuteRules configures auto-mute of the players who keep violating the rules.
+type MuteRules struct {
+	// Violations is count of blocked messages within Window that mutes the player, 0 disables auto-mute.
+	Violations int    `yaml:"violations" json:"violations"`
+	Window     string `yaml:"window" json:"window"`
+	Duration   string `yaml:"duration" json:"duration"`
+}
+
+// Merge appends word lists, regex rules and allowed domains of other rules. Other settings are kept.
+func (r Rules) Merge(other *Rules) Rules {
+	if other == nil {
+		return r
+	}
+
+	r.BlockWords = append(append([]string{}, r.BlockWords...), other.BlockWords...)
+	r.ReplaceWords = append(append([]string{}, r.ReplaceWords...), other.ReplaceWords...)
+	r.Regex = append(append([]RegexRuleConfig{}, r.Regex...), other.Regex...)
+	r.Links.AllowedDomains = append(append([]string{}, r.Links.AllowedDomains...), other.Links.AllowedDomains...)
+	return r
+}
+
+// Pipeline validates rules and builds filters pipeline. Spam tracker keeps messages history between pipelines.
+func (r *Rules) Pipeline(tracker *SpamTracker) (*Pipeline, error) {
+	var filters []Filter
+
+	if r.ItemLinks.Validate {
+		filters = append(filters, NewItemLinkFilter(r.ItemLinks.MaxLinks))
+	}
+
+	if r.Spam.MaxRepeats > 0 {
+		window, err := parseDuration(r.Spam.RepeatWindow, time.Minute)
+		if err != nil {
+			return nil, fmt.Errorf("invalid spam repeat window: %w", err)
+		}
+		filters = append(filters, NewRepeatFilter(tracker, r.Spam.MaxRepeats, window))
+	}
+
+	if r.Spam.CapsMinLength > 0 {
+		action, err := ParseAction(r.Spam.CapsAction, ActionReplace)
+		if err != nil {
+			return nil, err
+		}
+		ratio := r.Spam.CapsMaxRatio
+		if ratio == 0 {
+			ratio = 0.7
+		}
+		filters = append(filters, NewCapsFilter(action, r.Spam.CapsMinLength, ratio))
+	}
+
+	if len(r.BlockWords) > 0 || len(r.ReplaceWords) > 0 {
+		filters = append(filters, NewWordListFilter(r.BlockWords, r.ReplaceWords))
+	}
+
+	if len(r.Regex) > 0 {
+		rules := make([]RegexRule, 0, len(r.Regex))
+		for _, cfg := range r.Regex {
+			re, err := regexp.Compile(cfg.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex rule %q: %w", cfg.Pattern, err)
+			}
+
+			action, err := ParseAction(cfg.Action, ActionReplace)
+			if err != nil {
+				return nil, err
+			}
+
+			rules = append(rules, RegexRule{Re: re, Action: action, Replacement: cfg.Replacement, Reason: cfg.Reason})
+		}
+		filters = append(filters, NewRegexFilter(rules))
+	}
+
+	if r.Links.Enabled {
+		action, err := ParseAction(r.Links.Action, ActionBlock)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, NewLinkFilter(action, r.Links.AllowedDomains))
+	}
+
+	return NewPipeline(filters...), nil
+}
+
+// MuteSettings returns parsed mute rules.
+func (r *Rules) MuteSettings() (violations int, window, duration time.Duration, err error) {
+	window, err = parseDuration(r.Mute.Window, time.Minute*5)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid mute window: %w", err)
+	}
+
+	duration, err = parseDuration(r.Mute.Duration, time.Minute*10)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("invalid mute duration: %w", err)
+	}
+
+	return r.Mute.Violations, window, duration, nil
+}
+
+func parseDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,119 @@
+package filter
+
+import (
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+type playerKey struct {
+	realmID uint32
+	guid    uint64
+}
+
+type sentMessage struct {
+	text string
+	at   time.Time
+}
+
+// SpamTracker remembers recent messages of the players. It's shared between pipelines,
+// so history survives rules reload.
+type SpamTracker struct {
+	mu      sync.Mutex
+	history map[playerKey][]sentMessage
+}
+
+func NewSpamTracker() *SpamTracker {
+	return &SpamTracker{history: map[playerKey][]sentMessage{}}
+}
+
+// track adds message to the history and returns how many times the same text was sent within the window, including this one.
+func (t *SpamTracker) track(key playerKey, text string, at time.Time, window time.Duration) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	history := t.history[key]
+	fresh := history[:0]
+	repeats := 1
+	for _, m := range history {
+		if at.Sub(m.at) > window {
+			continue
+		}
+		fresh = append(fresh, m)
+		if m.text == text {
+			repeats++
+		}
+	}
+	t.history[key] = append(fresh, sentMessage{text: text, at: at})
+
+	return repeats
+}
+
+// Cleanup removes history older than maxAge.
+func (t *SpamTracker) Cleanup(now time.Time, maxAge time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, history := range t.history {
+		if len(history) == 0 || now.Sub(history[len(history)-1].at) > maxAge {
+			delete(t.history, key)
+		}
+	}
+}
+
+// RepeatFilter blocks the message if player sent the same text more than maxRepeats times within the window.
+type RepeatFilter struct {
+	tracker    *SpamTracker
+	maxRepeats int
+	window     time.Duration
+}
+
+func NewRepeatFilter(tracker *SpamTracker, maxRepeats int, window time.Duration) *RepeatFilter {
+	return &RepeatFilter{tracker: tracker, maxRepeats: maxRepeats, window: window}
+}
+
+func (f *RepeatFilter) Apply(msg *Message) *Violation {
+	text := strings.ToLower(strings.Join(strings.Fields(msg.Text), " "))
+	repeats := f.tracker.track(playerKey{msg.RealmID, msg.SenderGUID}, text, msg.At, f.window)
+	if repeats > f.maxRepeats {
+		return &Violation{Filter: "spam", Reason: "repeated message", Action: ActionBlock}
+	}
+	return nil
+}
+
+// CapsFilter handles messages written mostly in capital letters, such messages are lowercased or blocked.
+type CapsFilter struct {
+	action    Action
+	minLength int
+	maxRatio  float64
+}
+
+// NewCapsFilter creates CapsFilter, messages with at least minLength letters and upper case letters ratio above maxRatio are caps spam.
+func NewCapsFilter(action Action, minLength int, maxRatio float64) *CapsFilter {
+	return &CapsFilter{action: action, minLength: minLength, maxRatio: maxRatio}
+}
+
+func (f *CapsFilter) Apply(msg *Message) *Violation {
+	letters, upper := 0, 0
+	for _, r := range stripLinks(msg.Text) {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+
+	if letters < f.minLength || float64(upper)/float64(letters) <= f.maxRatio {
+		return nil
+	}
+
+	if f.action == ActionBlock {
+		return &Violation{Filter: "caps", Reason: "caps spam", Action: ActionBlock}
+	}
+
+	msg.Text = lowerOutsideLinks(msg.Text)
+	return &Violation{Filter: "caps", Reason: "caps spam", Action: ActionReplace}
+}
@@ -0,0 +1,96 @@
+package filter
+
+import (
+	"regexp"
+	"strings"
+)
+
+// WordListFilter finds listed words (case-insensitive, whole words only) and masks them or blocks the message.
+type WordListFilter struct {
+	block   *regexp.Regexp
+	replace *regexp.Regexp
+}
+
+// NewWordListFilter creates WordListFilter, blockWords block message and replaceWords are masked with asterisks.
+func NewWordListFilter(blockWords, replaceWords []string) *WordListFilter {
+	return &WordListFilter{
+		block:   wordsRegexp(blockWords),
+		replace: wordsRegexp(replaceWords),
+	}
+}
+
+func (f *WordListFilter) Apply(msg *Message) *Violation {
+	if f.block != nil {
+		if word := f.block.FindString(msg.Text); word != "" {
+			return &Violation{Filter: "words", Reason: "blocked word: " + word, Action: ActionBlock}
+		}
+	}
+
+	if f.replace != nil && f.replace.MatchString(msg.Text) {
+		msg.Text = f.replace.ReplaceAllStringFunc(msg.Text, mask)
+		return &Violation{Filter: "words", Reason: "profanity", Action: ActionReplace}
+	}
+
+	return nil
+}
+
+func wordsRegexp(words []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(words))
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w != "" {
+			quoted = append(quoted, regexp.QuoteMeta(w))
+		}
+	}
+
+	if len(quoted) == 0 {
+		return nil
+	}
+
+	return regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+}
+
+// RegexRule is a single rule of RegexFilter.
+type RegexRule struct {
+	Re          *regexp.Regexp
+	Action      Action
+	Replacement string
+	Reason      string
+}
+
+// RegexFilter applies regex rules in order, matched text is replaced with replacement
+// (masked if replacement is empty) or the message is blocked.
+type RegexFilter struct {
+	rules []RegexRule
+}
+
+func NewRegexFilter(rules []RegexRule) *RegexFilter {
+	return &RegexFilter{rules: rules}
+}
+
+func (f *RegexFilter) Apply(msg *Message) *Violation {
+	var violation *Violation
+	for _, rule := range f.rules {
+		if !rule.Re.MatchString(msg.Text) {
+			continue
+		}
+
+		reason := rule.Reason
+		if reason == "" {
+			reason = "matched " + rule.Re.String()
+		}
+
+		if rule.Action == ActionBlock {
+			return &Violation{Filter: "regex", Reason: reason, Action: ActionBlock}
+		}
+
+		if rule.Replacement == "" {
+			msg.Text = rule.Re.ReplaceAllStringFunc(msg.Text, mask)
+		} else {
+			msg.Text = rule.Re.ReplaceAllString(msg.Text, rule.Replacement)
+		}
+		violation = &Violation{Filter: "regex", Reason: reason, Action: ActionReplace}
+	}
+
+	return violation
+}
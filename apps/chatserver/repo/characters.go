@@ -13,6 +13,10 @@ type Character struct {
 	Name      string
 	Race      uint8
 
+	// ChatFlags is AFK/DND status of the character, see events.CharacterChatFlagAFK.
+	ChatFlags   uint8
+	AwayMessage string
+
 	MsgSender sender.MsgSender
 }
 
@@ -20,6 +24,9 @@ type CharactersRepo interface {
 	AddCharacter(ctx context.Context, character *Character) error
 	RemoveCharacter(ctx context.Context, realmID uint32, characterGUID uint64) error
 
+	// UpdateCharacterChatStatus updates AFK/DND status of the character if the character is online.
+	UpdateCharacterChatStatus(ctx context.Context, realmID uint32, characterGUID uint64, chatFlags uint8, awayMessage string) error
+
 	RemoveCharactersWithRealm(ctx context.Context, realmID uint32) error
 
 	CharacterByRealmAndGUID(ctx context.Context, realmID uint32, characterGUID uint64) (*Character, error)
@@ -50,6 +50,33 @@ func (c *charactersInMemRepo) RemoveCharacter(ctx context.Context, realmID uint3
 	return nil
 }
 
+func (c *charactersInMemRepo) UpdateCharacterChatStatus(ctx context.Context, realmID uint32, characterGUID uint64, chatFlags uint8, awayMessage string) error {
+	guidKey := c.mapKeyForRealmAndGuid(realmID, characterGUID)
+
+	// Characters are replaced with updated copies, since returned characters are read without locks.
+	c.guidMu.Lock()
+	char := c.charsByGUID[guidKey]
+	if char == nil {
+		c.guidMu.Unlock()
+		return nil
+	}
+
+	updated := *char
+	updated.ChatFlags = chatFlags
+	updated.AwayMessage = awayMessage
+	c.charsByGUID[guidKey] = &updated
+	c.guidMu.Unlock()
+
+	nameKey := c.mapKeyForRealmAndName(realmID, char.Name)
+	c.nameMu.Lock()
+	if c.charsByName[nameKey] == char {
+		c.charsByName[nameKey] = &updated
+	}
+	c.nameMu.Unlock()
+
+	return nil
+}
+
 func (c *charactersInMemRepo) CharacterByRealmAndGUID(ctx context.Context, realmID uint32, characterGUID uint64) (*Character, error) {
 	c.guidMu.RLock()
 	defer c.guidMu.RUnlock()
@@ -0,0 +1,13 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+)
+
+// ChatFilterRulesRepo is source of the chat filter rules
+type ChatFilterRulesRepo interface {
+	// LoadRules loads current rules, called periodically to pick up changes
+	LoadRules(ctx context.Context) (*filter.Rules, error)
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+)
+
+// ChatFilterRulesFile loads chat filter rules from yaml, json or toml file
+type ChatFilterRulesFile struct {
+	path string
+}
+
+func NewChatFilterRulesFile(path string) ChatFilterRulesRepo {
+	return &ChatFilterRulesFile{path: path}
+}
+
+func (r *ChatFilterRulesFile) LoadRules(ctx context.Context) (*filter.Rules, error) {
+	rules := &filter.Rules{}
+	if err := cleanenv.ReadConfig(r.path, rules); err != nil {
+		return nil, fmt.Errorf("can't read chat filter rules file %s: %w", r.path, err)
+	}
+	return rules, nil
+}
@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+// ChatFilterRulesMYSQL loads word lists, regex rules and allowed domains from the chat_filter_rules table
+// of the realm characters database. Other settings should be configured in the rules file.
+type ChatFilterRulesMYSQL struct {
+	db      shrepo.CharactersDB
+	realmID uint32
+}
+
+func NewChatFilterRulesMYSQL(db shrepo.CharactersDB, realmID uint32) ChatFilterRulesRepo {
+	return &ChatFilterRulesMYSQL{db: db, realmID: realmID}
+}
+
+func (r *ChatFilterRulesMYSQL) LoadRules(ctx context.Context) (*filter.Rules, error) {
+	db := r.db.DBByRealm(r.realmID)
+	if db == nil {
+		return nil, fmt.Errorf("unknown realm %d", r.realmID)
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT ruleType, pattern, action, replacement, reason FROM chat_filter_rules ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := &filter.Rules{}
+	for rows.Next() {
+		var ruleType, pattern, action, replacement, reason string
+		if err = rows.Scan(&ruleType, &pattern, &action, &replacement, &reason); err != nil {
+			return nil, err
+		}
+
+		switch ruleType {
+		case "block_word":
+			rules.BlockWords = append(rules.BlockWords, pattern)
+		case "replace_word":
+			rules.ReplaceWords = append(rules.ReplaceWords, pattern)
+		case "regex":
+			rules.Regex = append(rules.Regex, filter.RegexRuleConfig{
+				Pattern:     pattern,
+				Action:      action,
+				Replacement: replacement,
+				Reason:      reason,
+			})
+		case "allowed_domain":
+			rules.Links.AllowedDomains = append(rules.Links.AllowedDomains, pattern)
+		}
+	}
+
+	return rules, rows.Err()
+}
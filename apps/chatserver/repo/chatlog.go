@@ -0,0 +1,94 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// ChatLogMessageType is type of the logged chat message
+type ChatLogMessageType uint8
+
+const (
+	ChatLogMessageWhisper ChatLogMessageType = iota + 1
+	ChatLogMessageChannel
+	ChatLogMessageGuild
+	ChatLogMessageOfficer
+	ChatLogMessageGroup
+)
+
+// ChatLogMessage represents a chat message stored for moderation
+type ChatLogMessage struct {
+	ID      uint64             `json:"id"`
+	RealmID uint32             `json:"realmId"`
+	Type    ChatLogMessageType `json:"type"`
+
+	SenderGUID uint64 `json:"senderGuid"`
+	SenderName string `json:"senderName"`
+
+	// Receiver is set for whispers only
+	ReceiverGUID    uint64 `json:"receiverGuid,omitempty"`
+	ReceiverName    string `json:"receiverName,omitempty"`
+	ReceiverRealmID uint32 `json:"receiverRealmId,omitempty"`
+
+	ChannelName string `json:"channelName,omitempty"`
+	GuildID     uint64 `json:"guildId,omitempty"`
+	GroupID     uint64 `json:"groupId,omitempty"`
+
+	Language  uint32    `json:"language"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ChatLogQuery represents chat log search filters, zero values match everything
+type ChatLogQuery struct {
+	RealmID uint32
+
+	// PlayerGUID matches sender or receiver of the message
+	PlayerGUID  uint64
+	ChannelName string
+
+	// From is inclusive and To is exclusive
+	From time.Time
+	To   time.Time
+
+	Limit int
+}
+
+// Matches returns true if message passes the query filters
+func (q *ChatLogQuery) Matches(msg *ChatLogMessage) bool {
+	if q.PlayerGUID != 0 {
+		isSender := msg.RealmID == q.RealmID && msg.SenderGUID == q.PlayerGUID
+		isReceiver := msg.ReceiverRealmID == q.RealmID && msg.ReceiverGUID == q.PlayerGUID
+		if !isSender && !isReceiver {
+			return false
+		}
+	} else if msg.RealmID != q.RealmID && msg.ReceiverRealmID != q.RealmID {
+		return false
+	}
+
+	if q.ChannelName != "" && msg.ChannelName != q.ChannelName {
+		return false
+	}
+
+	if !q.From.IsZero() && msg.CreatedAt.Before(q.From) {
+		return false
+	}
+
+	if !q.To.IsZero() && !msg.CreatedAt.Before(q.To) {
+		return false
+	}
+
+	return true
+}
+
+// ChatLogRepo stores chat messages for moderation
+type ChatLogRepo interface {
+	// SaveMessages stores batch of messages
+	SaveMessages(ctx context.Context, messages []ChatLogMessage) error
+
+	// SearchMessages returns messages that match the query, newest messages go first
+	SearchMessages(ctx context.Context, query ChatLogQuery) ([]ChatLogMessage, error)
+
+	// DeleteMessagesOlderThan removes messages created before the given time
+	DeleteMessagesOlderThan(ctx context.Context, olderThan time.Time) error
+}
@@ -0,0 +1,148 @@
+package repo
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ChatLogFile stores chat log in the JSON lines file, one message per line.
+// Suitable for small deployments and local development, search scans the whole file.
+type ChatLogFile struct {
+	mu     sync.Mutex
+	path   string
+	nextID uint64
+}
+
+func NewChatLogFile(path string) (ChatLogRepo, error) {
+	r := &ChatLogFile{path: path, nextID: 1}
+
+	// Restore ids sequence from the existing file.
+	err := r.scan(func(msg *ChatLogMessage) {
+		if msg.ID >= r.nextID {
+			r.nextID = msg.ID + 1
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *ChatLogFile) SaveMessages(ctx context.Context, messages []ChatLogMessage) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("can't open chat log file: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for i := range messages {
+		msg := messages[i]
+		msg.ID = r.nextID
+		r.nextID++
+		if err = enc.Encode(&msg); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+func (r *ChatLogFile) SearchMessages(ctx context.Context, query ChatLogQuery) ([]ChatLogMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []ChatLogMessage
+	err := r.scan(func(msg *ChatLogMessage) {
+		if query.Matches(msg) {
+			result = append(result, *msg)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].ID > result[j].ID
+		}
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	if query.Limit > 0 && len(result) > query.Limit {
+		result = result[:query.Limit]
+	}
+
+	return result, nil
+}
+
+func (r *ChatLogFile) DeleteMessagesOlderThan(ctx context.Context, olderThan time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tmpPath := r.path + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return fmt.Errorf("can't create temporary chat log file: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	err = r.scan(func(msg *ChatLogMessage) {
+		if err != nil || msg.CreatedAt.Before(olderThan) {
+			return
+		}
+		err = enc.Encode(msg)
+	})
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+
+	return os.Rename(tmpPath, r.path)
+}
+
+// scan reads all messages from the file, missing file is treated as empty.
+func (r *ChatLogFile) scan(fn func(msg *ChatLogMessage)) error {
+	f, err := os.Open(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("can't open chat log file: %w", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		msg := ChatLogMessage{}
+		if err = json.Unmarshal(scanner.Bytes(), &msg); err != nil {
+			return fmt.Errorf("can't parse chat log line: %w", err)
+		}
+		fn(&msg)
+	}
+
+	return scanner.Err()
+}
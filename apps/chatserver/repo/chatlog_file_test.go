@@ -0,0 +1,61 @@
+package repo
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatLogFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "chat-log.jsonl")
+	now := time.Unix(1700000000, 0)
+
+	r, err := NewChatLogFile(path)
+	assert.NoError(t, err)
+
+	err = r.SaveMessages(context.Background(), []ChatLogMessage{
+		{RealmID: 1, Type: ChatLogMessageChannel, SenderGUID: 10, ChannelName: "world", Message: "old", CreatedAt: now.Add(-time.Hour)},
+		{RealmID: 1, Type: ChatLogMessageWhisper, SenderGUID: 10, ReceiverGUID: 20, ReceiverRealmID: 2, Message: "hi", CreatedAt: now},
+		{RealmID: 1, Type: ChatLogMessageGuild, SenderGUID: 30, GuildID: 5, Message: "guild", CreatedAt: now},
+	})
+	assert.NoError(t, err)
+
+	msgs, err := r.SearchMessages(context.Background(), ChatLogQuery{RealmID: 1, PlayerGUID: 10, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, msgs, 2) {
+		assert.Equal(t, "hi", msgs[0].Message)
+		assert.Equal(t, "old", msgs[1].Message)
+	}
+
+	// Cross-realm whisper is visible for the receiver realm.
+	msgs, err = r.SearchMessages(context.Background(), ChatLogQuery{RealmID: 2, PlayerGUID: 20, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+
+	msgs, err = r.SearchMessages(context.Background(), ChatLogQuery{RealmID: 1, ChannelName: "world", To: now, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+
+	msgs, err = r.SearchMessages(context.Background(), ChatLogQuery{RealmID: 1, Limit: 1})
+	assert.NoError(t, err)
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, "guild", msgs[0].Message)
+	}
+
+	assert.NoError(t, r.DeleteMessagesOlderThan(context.Background(), now.Add(-time.Minute)))
+
+	// Reopened repo continues ids sequence.
+	r, err = NewChatLogFile(path)
+	assert.NoError(t, err)
+	assert.NoError(t, r.SaveMessages(context.Background(), []ChatLogMessage{{RealmID: 1, Message: "new", CreatedAt: now}}))
+
+	msgs, err = r.SearchMessages(context.Background(), ChatLogQuery{RealmID: 1, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, msgs, 3) {
+		assert.Equal(t, "new", msgs[0].Message)
+		assert.Equal(t, uint64(4), msgs[0].ID)
+	}
+}
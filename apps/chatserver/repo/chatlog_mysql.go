@@ -0,0 +1,152 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+type ChatLogPreparedStatements uint32
+
+func (s ChatLogPreparedStatements) Stmt() string {
+	switch s {
+	case StmtDeleteChatLogOlderThan:
+		return "DELETE FROM chat_log WHERE createdAt < ?"
+	}
+
+	panic(fmt.Errorf("unk stmt %d", s))
+}
+
+func (s ChatLogPreparedStatements) ID() uint32 {
+	return uint32(s) + 1000 // offset to avoid collision with channels stmts
+}
+
+const (
+	StmtDeleteChatLogOlderThan ChatLogPreparedStatements = iota
+)
+
+const chatLogColumns = "id, realmId, type, senderGuid, senderName, receiverGuid, receiverName, receiverRealmId, channelName, guildId, groupId, language, message, createdAt"
+
+// ChatLogMYSQL stores chat log in the characters database of the realm.
+// Cross-realm whispers are stored in databases of both realms.
+type ChatLogMYSQL struct {
+	db       shrepo.CharactersDB
+	realmIDs []uint32
+}
+
+func NewChatLogMYSQL(db shrepo.CharactersDB, realmIDs []uint32) ChatLogRepo {
+	db.SetPreparedStatement(StmtDeleteChatLogOlderThan)
+
+	return &ChatLogMYSQL{db: db, realmIDs: realmIDs}
+}
+
+func (r *ChatLogMYSQL) SaveMessages(ctx context.Context, messages []ChatLogMessage) error {
+	messagesByRealm := map[uint32][]*ChatLogMessage{}
+	for i := range messages {
+		msg := &messages[i]
+		messagesByRealm[msg.RealmID] = append(messagesByRealm[msg.RealmID], msg)
+		if msg.ReceiverRealmID != 0 && msg.ReceiverRealmID != msg.RealmID {
+			messagesByRealm[msg.ReceiverRealmID] = append(messagesByRealm[msg.ReceiverRealmID], msg)
+		}
+	}
+
+	for realmID, realmMessages := range messagesByRealm {
+		db := r.db.DBByRealm(realmID)
+		if db == nil {
+			continue
+		}
+
+		placeholders := make([]string, 0, len(realmMessages))
+		args := make([]interface{}, 0, len(realmMessages)*13)
+		for _, msg := range realmMessages {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			args = append(args,
+				msg.RealmID, msg.Type, msg.SenderGUID, msg.SenderName,
+				msg.ReceiverGUID, msg.ReceiverName, msg.ReceiverRealmID,
+				msg.ChannelName, msg.GuildID, msg.GroupID,
+				msg.Language, msg.Message, msg.CreatedAt.Unix(),
+			)
+		}
+
+		query := "INSERT INTO chat_log (realmId, type, senderGuid, senderName, receiverGuid, receiverName, receiverRealmId, channelName, guildId, groupId, language, message, createdAt) VALUES " +
+			strings.Join(placeholders, ", ")
+		if _, err := db.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("can't save chat log for realm %d: %w", realmID, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *ChatLogMYSQL) SearchMessages(ctx context.Context, query ChatLogQuery) ([]ChatLogMessage, error) {
+	db := r.db.DBByRealm(query.RealmID)
+	if db == nil {
+		return nil, fmt.Errorf("unknown realm %d", query.RealmID)
+	}
+
+	conditions := []string{"(realmId = ? OR receiverRealmId = ?)"}
+	args := []interface{}{query.RealmID, query.RealmID}
+
+	if query.PlayerGUID != 0 {
+		conditions = append(conditions, "((realmId = ? AND senderGuid = ?) OR (receiverRealmId = ? AND receiverGuid = ?))")
+		args = append(args, query.RealmID, query.PlayerGUID, query.RealmID, query.PlayerGUID)
+	}
+
+	if query.ChannelName != "" {
+		conditions = append(conditions, "channelName = ?")
+		args = append(args, query.ChannelName)
+	}
+
+	if !query.From.IsZero() {
+		conditions = append(conditions, "createdAt >= ?")
+		args = append(args, query.From.Unix())
+	}
+
+	if !query.To.IsZero() {
+		conditions = append(conditions, "createdAt < ?")
+		args = append(args, query.To.Unix())
+	}
+
+	args = append(args, query.Limit)
+
+	rows, err := db.QueryContext(ctx,
+		"SELECT "+chatLogColumns+" FROM chat_log WHERE "+strings.Join(conditions, " AND ")+" ORDER BY createdAt DESC, id DESC LIMIT ?",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []ChatLogMessage
+	for rows.Next() {
+		msg := ChatLogMessage{}
+		var createdAt int64
+		err = rows.Scan(
+			&msg.ID, &msg.RealmID, &msg.Type, &msg.SenderGUID, &msg.SenderName,
+			&msg.ReceiverGUID, &msg.ReceiverName, &msg.ReceiverRealmID,
+			&msg.ChannelName, &msg.GuildID, &msg.GroupID,
+			&msg.Language, &msg.Message, &createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		msg.CreatedAt = time.Unix(createdAt, 0)
+		result = append(result, msg)
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ChatLogMYSQL) DeleteMessagesOlderThan(ctx context.Context, olderThan time.Time) error {
+	for _, realmID := range r.realmIDs {
+		_, err := r.db.PreparedStatement(realmID, StmtDeleteChatLogOlderThan).ExecContext(ctx, olderThan.Unix())
+		if err != nil {
+			return fmt.Errorf("can't clean chat log for realm %d: %w", realmID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// chatLogNoopRepo is a no-op implementation of ChatLogRepo
+// Used when chat logging is disabled
+type chatLogNoopRepo struct{}
+
+func NewChatLogNoopRepo() ChatLogRepo {
+	return chatLogNoopRepo{}
+}
+
+func (chatLogNoopRepo) SaveMessages(ctx context.Context, messages []ChatLogMessage) error {
+	return nil
+}
+
+func (chatLogNoopRepo) SearchMessages(ctx context.Context, query ChatLogQuery) ([]ChatLogMessage, error) {
+	return nil, nil
+}
+
+func (chatLogNoopRepo) DeleteMessagesOlderThan(ctx context.Context, olderThan time.Time) error {
+	return nil
+}
@@ -0,0 +1,26 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// ChatMute represents a player muted by the chat filter
+type ChatMute struct {
+	RealmID    uint32
+	PlayerGUID uint64
+	MutedUntil time.Time
+	Reason     string
+}
+
+// ChatMutesRepo stores mutes issued by the chat filter
+type ChatMutesRepo interface {
+	// SaveMute stores the mute, the longer mute is kept if player is already muted.
+	SaveMute(ctx context.Context, mute ChatMute) error
+
+	// ActiveMutes returns mutes of all realms that expire after now.
+	ActiveMutes(ctx context.Context, now time.Time) ([]ChatMute, error)
+
+	// DeleteExpiredMutes removes mutes of all realms that expired before now.
+	DeleteExpiredMutes(ctx context.Context, now time.Time) error
+}
@@ -0,0 +1,97 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+type ChatMutesPreparedStatements uint32
+
+func (s ChatMutesPreparedStatements) Stmt() string {
+	switch s {
+	case StmtSaveChatMute:
+		return "INSERT INTO chat_mutes (playerGuid, mutedUntil, reason) VALUES (?, ?, ?) " +
+			"ON DUPLICATE KEY UPDATE reason = IF(VALUES(mutedUntil) > mutedUntil, VALUES(reason), reason), mutedUntil = GREATEST(mutedUntil, VALUES(mutedUntil))"
+	case StmtSelectActiveChatMutes:
+		return "SELECT playerGuid, mutedUntil, reason FROM chat_mutes WHERE mutedUntil > ?"
+	case StmtDeleteExpiredChatMutes:
+		return "DELETE FROM chat_mutes WHERE mutedUntil <= ?"
+	}
+
+	panic(fmt.Errorf("unk stmt %d", s))
+}
+
+func (s ChatMutesPreparedStatements) ID() uint32 {
+	return uint32(s) + 2000 // offset to avoid collision with channels and chat log stmts
+}
+
+const (
+	StmtSaveChatMute ChatMutesPreparedStatements = iota
+	StmtSelectActiveChatMutes
+	StmtDeleteExpiredChatMutes
+)
+
+// ChatMutesMYSQL stores mutes in the characters database of the player realm.
+type ChatMutesMYSQL struct {
+	db       shrepo.CharactersDB
+	realmIDs []uint32
+}
+
+func NewChatMutesMYSQL(db shrepo.CharactersDB, realmIDs []uint32) ChatMutesRepo {
+	db.SetPreparedStatement(StmtSaveChatMute)
+	db.SetPreparedStatement(StmtSelectActiveChatMutes)
+	db.SetPreparedStatement(StmtDeleteExpiredChatMutes)
+
+	return &ChatMutesMYSQL{db: db, realmIDs: realmIDs}
+}
+
+func (r *ChatMutesMYSQL) SaveMute(ctx context.Context, mute ChatMute) error {
+	stmt := r.db.PreparedStatement(mute.RealmID, StmtSaveChatMute)
+	if stmt == nil {
+		return fmt.Errorf("unknown realm %d", mute.RealmID)
+	}
+
+	_, err := stmt.ExecContext(ctx, mute.PlayerGUID, mute.MutedUntil.Unix(), mute.Reason)
+	return err
+}
+
+func (r *ChatMutesMYSQL) ActiveMutes(ctx context.Context, now time.Time) ([]ChatMute, error) {
+	var result []ChatMute
+	for _, realmID := range r.realmIDs {
+		rows, err := r.db.PreparedStatement(realmID, StmtSelectActiveChatMutes).QueryContext(ctx, now.Unix())
+		if err != nil {
+			return nil, fmt.Errorf("can't load chat mutes of realm %d: %w", realmID, err)
+		}
+
+		for rows.Next() {
+			mute := ChatMute{RealmID: realmID}
+			var mutedUntil int64
+			if err = rows.Scan(&mute.PlayerGUID, &mutedUntil, &mute.Reason); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			mute.MutedUntil = time.Unix(mutedUntil, 0)
+			result = append(result, mute)
+		}
+		rows.Close()
+
+		if err = rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *ChatMutesMYSQL) DeleteExpiredMutes(ctx context.Context, now time.Time) error {
+	for _, realmID := range r.realmIDs {
+		if _, err := r.db.PreparedStatement(realmID, StmtDeleteExpiredChatMutes).ExecContext(ctx, now.Unix()); err != nil {
+			return fmt.Errorf("can't delete expired chat mutes of realm %d: %w", realmID, err)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,9 @@ type Character struct {
 	GUID    uint64
 	Race    uint8
 	Name    string
+
+	// ChatFlags is AFK/DND status of the character, see events.CharacterChatFlagAFK.
+	ChatFlags uint8
 }
 
 type MsgSender interface {
@@ -23,6 +26,7 @@ type MsgProducer interface {
 	ProduceChannelJoined(payload *events.ChatEventChannelJoinedPayload) error
 	ProduceChannelLeft(payload *events.ChatEventChannelLeftPayload) error
 	ProduceChannelNotification(payload *events.ChatEventChannelNotificationPayload) error
+	ProducePlayerMuted(payload *events.ChatEventPlayerMutedPayload) error
 }
 
 type msgSenderNatsJSON struct {
@@ -44,6 +48,9 @@ func (m msgSenderNatsJSON) SendWhisper(sender *Character, receiver *Character, l
 		ReceiverName: receiver.Name,
 		Language:     language,
 		Msg:          msg,
+
+		SenderRealmID:   sender.RealmID,
+		SenderChatFlags: sender.ChatFlags,
 	})
 }
 
@@ -72,3 +79,7 @@ func (m *msgProducerNatsJSON) ProduceChannelLeft(payload *events.ChatEventChanne
 func (m *msgProducerNatsJSON) ProduceChannelNotification(payload *events.ChatEventChannelNotificationPayload) error {
 	return m.producer.ChannelNotification(payload)
 }
+
+func (m *msgProducerNatsJSON) ProducePlayerMuted(payload *events.ChatEventPlayerMutedPayload) error {
+	return m.producer.PlayerMuted(payload)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/walkline/ToCloud9/apps/chatserver"
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
 	"github.com/walkline/ToCloud9/apps/chatserver/service"
 	"github.com/walkline/ToCloud9/gen/chat/pb"
 	"github.com/walkline/ToCloud9/shared/events"
@@ -117,12 +118,38 @@ func (s *ChatService) SendChannelMessage(ctx context.Context, req *pb.SendChanne
 		return nil, err
 	}
 
+	filtered := s.filterMessage(req.RealmID, req.SenderGUID, req.SenderName, chatTypeChannel, req.Message)
+	switch filtered.Status {
+	case service.ChatFilterStatusBlocked:
+		return &pb.SendChannelMessageResponse{
+			Api:    chatserver.Ver,
+			Status: pb.SendChannelMessageResponse_Blocked,
+		}, nil
+	case service.ChatFilterStatusMuted:
+		return &pb.SendChannelMessageResponse{
+			Api:        chatserver.Ver,
+			Status:     pb.SendChannelMessageResponse_ChatMuted,
+			MutedUntil: filtered.MutedUntil.Unix(),
+		}, nil
+	}
+	message := filtered.Text
+
 	// Broadcast the message
-	if err := s.broadcastChannelMessage(req.RealmID, channel, req.SenderGUID, req.SenderName, req.Language, req.Message); err != nil {
+	if err := s.broadcastChannelMessage(req.RealmID, channel, req.SenderGUID, req.SenderName, req.Language, message); err != nil {
 		log.Error().Err(err).Msg("Failed to broadcast channel message")
 		return nil, err
 	}
 
+	s.chatLogger.Log(repo.ChatLogMessage{
+		RealmID:     req.RealmID,
+		Type:        repo.ChatLogMessageChannel,
+		SenderGUID:  req.SenderGUID,
+		SenderName:  req.SenderName,
+		ChannelName: req.ChannelName,
+		Language:    req.Language,
+		Message:     message,
+	})
+
 	// Update last used timestamp (fire and forget)
 	go func() {
 		if err := s.channelMgr.UpdateLastUsed(context.Background(), req.RealmID, req.ChannelName, req.TeamID); err != nil {
@@ -131,8 +158,9 @@ func (s *ChatService) SendChannelMessage(ctx context.Context, req *pb.SendChanne
 	}()
 
 	return &pb.SendChannelMessageResponse{
-		Api:    chatserver.Ver,
-		Status: pb.SendChannelMessageResponse_Ok,
+		Api:     chatserver.Ver,
+		Status:  pb.SendChannelMessageResponse_Ok,
+		Message: message,
 	}, nil
 }
 
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"context"
+
+	"github.com/walkline/ToCloud9/apps/chatserver"
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+	"github.com/walkline/ToCloud9/apps/chatserver/service"
+	"github.com/walkline/ToCloud9/gen/chat/pb"
+)
+
+// Client chat types of the messages that chat service handles itself.
+const (
+	chatTypeWhisper = 0x07
+	chatTypeChannel = 0x11
+)
+
+func (s *ChatService) FilterChatMessage(ctx context.Context, req *pb.FilterChatMessageRequest) (*pb.FilterChatMessageResponse, error) {
+	res := s.filterMessage(req.RealmID, req.SenderGUID, req.SenderName, req.ChatType, req.Message)
+
+	resp := &pb.FilterChatMessageResponse{
+		Api:     chatserver.Ver,
+		Message: res.Text,
+	}
+
+	switch res.Status {
+	case service.ChatFilterStatusBlocked:
+		resp.Status = pb.FilterChatMessageResponse_Blocked
+	case service.ChatFilterStatusMuted:
+		resp.Status = pb.FilterChatMessageResponse_Muted
+		resp.MutedUntil = res.MutedUntil.Unix()
+	}
+
+	return resp, nil
+}
+
+func (s *ChatService) filterMessage(realmID uint32, senderGUID uint64, senderName string, chatType uint32, text string) service.ChatFilterResult {
+	return s.chatFilter.Check(filter.Message{
+		RealmID:    realmID,
+		SenderGUID: senderGUID,
+		ChatType:   chatType,
+		Text:       text,
+	}, senderName)
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/walkline/ToCloud9/apps/chatserver"
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+	"github.com/walkline/ToCloud9/gen/chat/pb"
+)
+
+const defaultChatLogSearchLimit = 100
+
+func (s *ChatService) SearchChatLog(ctx context.Context, req *pb.SearchChatLogRequest) (*pb.SearchChatLogResponse, error) {
+	query := repo.ChatLogQuery{
+		RealmID:     req.RealmID,
+		PlayerGUID:  req.PlayerGUID,
+		ChannelName: req.ChannelName,
+		Limit:       int(req.Limit),
+	}
+	if req.From != 0 {
+		query.From = time.Unix(req.From, 0)
+	}
+	if req.To != 0 {
+		query.To = time.Unix(req.To, 0)
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultChatLogSearchLimit
+	}
+
+	messages, err := s.chatLogger.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.ChatLogMessage, len(messages))
+	for i, msg := range messages {
+		result[i] = &pb.ChatLogMessage{
+			Id:              msg.ID,
+			RealmID:         msg.RealmID,
+			Type:            pb.ChatLogMessage_Type(msg.Type),
+			SenderGUID:      msg.SenderGUID,
+			SenderName:      msg.SenderName,
+			ReceiverGUID:    msg.ReceiverGUID,
+			ReceiverName:    msg.ReceiverName,
+			ReceiverRealmID: msg.ReceiverRealmID,
+			ChannelName:     msg.ChannelName,
+			GuildID:         msg.GuildID,
+			GroupID:         msg.GroupID,
+			Language:        msg.Language,
+			Message:         msg.Message,
+			CreatedAt:       msg.CreatedAt.Unix(),
+		}
+	}
+
+	return &pb.SearchChatLogResponse{
+		Api:      chatserver.Ver,
+		Messages: result,
+	}, nil
+}
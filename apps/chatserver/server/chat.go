@@ -9,6 +9,7 @@ import (
 	"github.com/walkline/ToCloud9/apps/chatserver/repo"
 	"github.com/walkline/ToCloud9/apps/chatserver/sender"
 	"github.com/walkline/ToCloud9/apps/chatserver/service"
+	pbChar "github.com/walkline/ToCloud9/gen/characters/pb"
 	"github.com/walkline/ToCloud9/gen/chat/pb"
 )
 
@@ -18,19 +19,64 @@ type ChatService struct {
 	channelMgr  *service.ChannelManager
 	msgProducer sender.MsgProducer
 	serviceID   string
+	charClient  pbChar.CharactersServiceClient
+	chatLogger  *service.ChatLogger
+	chatFilter  *service.ChatFilter
 }
 
-func NewChatService(charRepo repo.CharactersRepo, channelMgr *service.ChannelManager, msgProducer sender.MsgProducer, serviceID string) *ChatService {
+func NewChatService(charRepo repo.CharactersRepo, channelMgr *service.ChannelManager, msgProducer sender.MsgProducer, serviceID string, charClient pbChar.CharactersServiceClient, chatLogger *service.ChatLogger, chatFilter *service.ChatFilter) *ChatService {
 	return &ChatService{
 		charRepo:    charRepo,
 		channelMgr:  channelMgr,
 		msgProducer: msgProducer,
 		serviceID:   serviceID,
+		charClient:  charClient,
+		chatLogger:  chatLogger,
+		chatFilter:  chatFilter,
 	}
 }
 
 func (s *ChatService) SendWhisperMessage(ctx context.Context, request *pb.SendWhisperMessageRequest) (*pb.SendWhisperMessageResponse, error) {
-	char, err := s.charRepo.CharacterByRealmAndName(ctx, request.RealmID, request.ReceiverName)
+	filtered := s.filterMessage(request.RealmID, request.SenderGUID, request.SenderName, chatTypeWhisper, request.Msg)
+	switch filtered.Status {
+	case service.ChatFilterStatusBlocked:
+		return &pb.SendWhisperMessageResponse{
+			Api:    chatserver.Ver,
+			Status: pb.SendWhisperMessageResponse_Blocked,
+		}, nil
+	case service.ChatFilterStatusMuted:
+		return &pb.SendWhisperMessageResponse{
+			Api:        chatserver.Ver,
+			Status:     pb.SendWhisperMessageResponse_Muted,
+			MutedUntil: filtered.MutedUntil.Unix(),
+		}, nil
+	}
+	msg := filtered.Text
+
+	receiverRealmID := request.RealmID
+	if request.ReceiverRealmID != 0 && request.ReceiverRealmID != request.RealmID {
+		// Characters service knows which realms are linked for cross-realm social.
+		resp, err := s.charClient.CharacterOnlineByName(ctx, &pbChar.CharacterOnlineByNameRequest{
+			Api:              chatserver.Ver,
+			RealmID:          request.RealmID,
+			CharacterName:    request.ReceiverName,
+			CharacterRealmID: request.ReceiverRealmID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.Character == nil {
+			return &pb.SendWhisperMessageResponse{
+				Api:    chatserver.Ver,
+				Status: pb.SendWhisperMessageResponse_CharacterNotFound,
+			}, nil
+		}
+
+		receiverRealmID = request.ReceiverRealmID
+	}
+
+	char, err := s.charRepo.CharacterByRealmAndName(ctx, receiverRealmID, request.ReceiverName)
 	if err != nil {
 		return nil, err
 	}
@@ -44,29 +90,55 @@ func (s *ChatService) SendWhisperMessage(ctx context.Context, request *pb.SendWh
 
 	log.Debug().Msgf("New whisper from '%s' to '%s'", request.SenderName, char.Name)
 
+	var senderChatFlags uint8
+	senderChar, err := s.charRepo.CharacterByRealmAndGUID(ctx, request.RealmID, request.SenderGUID)
+	if err != nil {
+		return nil, err
+	}
+	if senderChar != nil {
+		senderChatFlags = senderChar.ChatFlags
+	}
+
 	err = char.MsgSender.SendWhisper(
 		&sender.Character{
-			RealmID: request.RealmID,
-			GUID:    request.SenderGUID,
-			Name:    request.SenderName,
-			Race:    uint8(request.SenderRace),
+			RealmID:   request.RealmID,
+			GUID:      request.SenderGUID,
+			Name:      request.SenderName,
+			Race:      uint8(request.SenderRace),
+			ChatFlags: senderChatFlags,
 		},
 		&sender.Character{
-			RealmID: request.RealmID,
+			RealmID: receiverRealmID,
 			GUID:    char.GUID,
 			Name:    char.Name,
 			Race:    char.Race,
 		},
 		request.Language,
-		request.Msg,
+		msg,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	s.chatLogger.Log(repo.ChatLogMessage{
+		RealmID:         request.RealmID,
+		Type:            repo.ChatLogMessageWhisper,
+		SenderGUID:      request.SenderGUID,
+		SenderName:      request.SenderName,
+		ReceiverGUID:    char.GUID,
+		ReceiverName:    char.Name,
+		ReceiverRealmID: receiverRealmID,
+		Language:        request.Language,
+		Message:         msg,
+	})
+
 	return &pb.SendWhisperMessageResponse{
 		Api:          chatserver.Ver,
 		Status:       pb.SendWhisperMessageResponse_Ok,
 		ReceiverGUID: char.GUID,
+		Msg:          msg,
+
+		ReceiverChatFlags:   uint32(char.ChatFlags),
+		ReceiverAwayMessage: char.AwayMessage,
 	}, nil
 }
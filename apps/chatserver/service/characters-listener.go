@@ -43,6 +43,10 @@ func (c *CharactersListener) Listen() error {
 			GUID:      loggedInP.CharGUID,
 			Name:      loggedInP.CharName,
 			Race:      loggedInP.CharRace,
+
+			ChatFlags:   loggedInP.CharChatFlags,
+			AwayMessage: loggedInP.CharAwayMessage,
+
 			MsgSender: sender.NewMsgSenderNatsJSON(c.nc, loggedInP.GatewayID),
 		})
 
@@ -108,6 +112,38 @@ func (c *CharactersListener) Listen() error {
 
 	c.subs = append(c.subs, sb)
 
+	sb, err = c.nc.Subscribe(events.GWEventCharactersUpdates.SubjectName(), func(msg *nats.Msg) {
+		updatesP := events.GWEventCharactersUpdatesPayload{}
+		_, err := events.Unmarshal(msg.Data, &updatesP)
+		if err != nil {
+			log.Error().Err(err).Msg("can't read GWEventCharactersUpdates (payload part) event")
+			return
+		}
+
+		for _, upd := range updatesP.Updates {
+			if upd.ChatFlags == nil {
+				continue
+			}
+
+			awayMsg := ""
+			if upd.AwayMsg != nil {
+				awayMsg = *upd.AwayMsg
+			}
+
+			err = c.charRepo.UpdateCharacterChatStatus(context.TODO(), updatesP.RealmID, upd.ID, *upd.ChatFlags, awayMsg)
+			if err != nil {
+				log.Error().Err(err).Msg("can't update character chat status in GWEventCharactersUpdates event")
+				return
+			}
+		}
+	})
+	if err != nil {
+		c.unsubscribe()
+		return err
+	}
+
+	c.subs = append(c.subs, sb)
+
 	return nil
 }
 
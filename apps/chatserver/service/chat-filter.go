@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+)
+
+// spamHistoryMaxAge is how long spam tracker keeps messages of the player.
+const spamHistoryMaxAge = time.Hour
+
+// ChatFilterStatus is result status of the chat filter check.
+type ChatFilterStatus uint8
+
+const (
+	ChatFilterStatusOk ChatFilterStatus = iota
+	ChatFilterStatusBlocked
+	ChatFilterStatusMuted
+)
+
+// ChatFilterResult is result of the chat filter check.
+type ChatFilterResult struct {
+	Status ChatFilterStatus
+
+	// Text is message text after filtering, might be changed even if status is Ok.
+	Text string
+
+	// MutedUntil is set with ChatFilterStatusMuted status.
+	MutedUntil time.Time
+}
+
+// ChatFilter applies filters pipeline to the chat messages and reports offenders to the mute policy.
+// Rules are loaded from the rules sources and can be reloaded at runtime.
+type ChatFilter struct {
+	sources []repo.ChatFilterRulesRepo
+	mutes   *MutePolicy
+	tracker *filter.SpamTracker
+
+	mu       sync.RWMutex
+	rules    *filter.Rules
+	pipeline *filter.Pipeline
+}
+
+// NewChatFilter creates ChatFilter. The first source is the base rules, word lists, regex rules and
+// allowed domains of other sources are appended to it. Without sources every message passes.
+func NewChatFilter(mutes *MutePolicy, sources ...repo.ChatFilterRulesRepo) *ChatFilter {
+	return &ChatFilter{
+		sources:  sources,
+		mutes:    mutes,
+		tracker:  filter.NewSpamTracker(),
+		pipeline: filter.NewPipeline(),
+	}
+}
+
+// Reload loads rules from the sources and rebuilds the pipeline. If rules are invalid, previous rules are kept.
+func (f *ChatFilter) Reload(ctx context.Context) error {
+	if len(f.sources) == 0 {
+		return nil
+	}
+
+	var rules filter.Rules
+	for i, source := range f.sources {
+		r, err := source.LoadRules(ctx)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			rules = *r
+		} else {
+			rules = rules.Merge(r)
+		}
+	}
+
+	f.mu.RLock()
+	unchanged := f.rules != nil && reflect.DeepEqual(*f.rules, rules)
+	f.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	pipeline, err := rules.Pipeline(f.tracker)
+	if err != nil {
+		return err
+	}
+
+	violations, window, duration, err := rules.MuteSettings()
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	f.rules = &rules
+	f.pipeline = pipeline
+	f.mu.Unlock()
+
+	f.mutes.SetRules(violations, window, duration)
+
+	log.Info().
+		Int("blockWords", len(rules.BlockWords)).
+		Int("replaceWords", len(rules.ReplaceWords)).
+		Int("regexRules", len(rules.Regex)).
+		Msg("Chat filter rules loaded")
+
+	return nil
+}
+
+// Run reloads rules with the given interval until ctx is done.
+func (f *ChatFilter) Run(ctx context.Context, reloadInterval time.Duration) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := f.Reload(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to reload chat filter rules, keeping previous rules")
+			}
+
+			now := time.Now()
+			f.tracker.Cleanup(now, spamHistoryMaxAge)
+			f.mutes.Cleanup(ctx, now)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Check passes the message through the filters.
+func (f *ChatFilter) Check(msg filter.Message, senderName string) ChatFilterResult {
+	if msg.At.IsZero() {
+		msg.At = time.Now()
+	}
+
+	if until, muted := f.mutes.MutedUntil(msg.RealmID, msg.SenderGUID, msg.At); muted {
+		return ChatFilterResult{Status: ChatFilterStatusMuted, MutedUntil: until}
+	}
+
+	f.mu.RLock()
+	pipeline := f.pipeline
+	f.mu.RUnlock()
+
+	res := pipeline.Apply(msg)
+	if len(res.Violations) == 0 {
+		return ChatFilterResult{Status: ChatFilterStatusOk, Text: res.Text}
+	}
+
+	reasons := make([]string, len(res.Violations))
+	for i, v := range res.Violations {
+		reasons[i] = v.Filter + ": " + v.Reason
+	}
+	reason := strings.Join(reasons, "; ")
+
+	log.Info().
+		Str("audit", "chat_filter").
+		Uint32("realmID", msg.RealmID).
+		Uint64("playerGUID", msg.SenderGUID).
+		Str("playerName", senderName).
+		Uint32("chatType", msg.ChatType).
+		Bool("blocked", res.Blocked).
+		Str("reason", reason).
+		Str("message", msg.Text).
+		Msg("Chat message filtered")
+
+	if !res.Blocked {
+		return ChatFilterResult{Status: ChatFilterStatusOk, Text: res.Text}
+	}
+
+	if until, muted := f.mutes.ReportBlocked(msg.RealmID, msg.SenderGUID, senderName, reason, msg.At); muted {
+		return ChatFilterResult{Status: ChatFilterStatusMuted, MutedUntil: until}
+	}
+
+	return ChatFilterResult{Status: ChatFilterStatusBlocked}
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/filter"
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+type staticRulesRepo struct {
+	rules *filter.Rules
+}
+
+func (r *staticRulesRepo) LoadRules(ctx context.Context) (*filter.Rules, error) {
+	return r.rules, nil
+}
+
+type mutedEventsRecorder struct {
+	mutes []*events.ChatEventPlayerMutedPayload
+}
+
+func (m *mutedEventsRecorder) ProduceChannelMessage(*events.ChatEventChannelMessagePayload) error {
+	return nil
+}
+
+func (m *mutedEventsRecorder) ProduceChannelJoined(*events.ChatEventChannelJoinedPayload) error {
+	return nil
+}
+
+func (m *mutedEventsRecorder) ProduceChannelLeft(*events.ChatEventChannelLeftPayload) error {
+	return nil
+}
+
+func (m *mutedEventsRecorder) ProduceChannelNotification(*events.ChatEventChannelNotificationPayload) error {
+	return nil
+}
+
+func (m *mutedEventsRecorder) ProducePlayerMuted(payload *events.ChatEventPlayerMutedPayload) error {
+	m.mutes = append(m.mutes, payload)
+	return nil
+}
+
+type chatMutesRepoStub struct {
+	mutes []repo.ChatMute
+}
+
+func (r *chatMutesRepoStub) SaveMute(ctx context.Context, mute repo.ChatMute) error {
+	r.mutes = append(r.mutes, mute)
+	return nil
+}
+
+func (r *chatMutesRepoStub) ActiveMutes(ctx context.Context, now time.Time) ([]repo.ChatMute, error) {
+	var result []repo.ChatMute
+	for _, mute := range r.mutes {
+		if mute.MutedUntil.After(now) {
+			result = append(result, mute)
+		}
+	}
+	return result, nil
+}
+
+func (r *chatMutesRepoStub) DeleteExpiredMutes(ctx context.Context, now time.Time) error {
+	r.mutes, _ = r.ActiveMutes(ctx, now)
+	return nil
+}
+
+func TestChatFilter_AutoMute(t *testing.T) {
+	producer := &mutedEventsRecorder{}
+	mutesRepo := &chatMutesRepoStub{}
+	source := &staticRulesRepo{rules: &filter.Rules{
+		BlockWords:   []string{"gold4u"},
+		ReplaceWords: []string{"darn"},
+		Mute:         filter.MuteRules{Violations: 2, Window: "1m", Duration: "10m"},
+	}}
+	f := NewChatFilter(NewMutePolicy("svc", producer, mutesRepo), source)
+	assert.NoError(t, f.Reload(context.Background()))
+
+	now := time.Now()
+	msg := func(text string, at time.Time) filter.Message {
+		return filter.Message{RealmID: 1, SenderGUID: 5, Text: text, At: at}
+	}
+
+	res := f.Check(msg("darn", now), "Spammer")
+	assert.Equal(t, ChatFilterStatusOk, res.Status)
+	assert.Equal(t, "****", res.Text)
+
+	res = f.Check(msg("gold4u", now), "Spammer")
+	assert.Equal(t, ChatFilterStatusBlocked, res.Status)
+
+	res = f.Check(msg("gold4u", now.Add(time.Second)), "Spammer")
+	assert.Equal(t, ChatFilterStatusMuted, res.Status)
+	assert.Equal(t, now.Add(time.Second+10*time.Minute), res.MutedUntil)
+	if assert.Len(t, producer.mutes, 1) {
+		assert.Equal(t, uint64(5), producer.mutes[0].PlayerGUID)
+		assert.Equal(t, "svc", producer.mutes[0].ServiceID)
+	}
+	if assert.Len(t, mutesRepo.mutes, 1) {
+		assert.Equal(t, uint32(1), mutesRepo.mutes[0].RealmID)
+		assert.Equal(t, uint64(5), mutesRepo.mutes[0].PlayerGUID)
+		assert.Equal(t, res.MutedUntil, mutesRepo.mutes[0].MutedUntil)
+	}
+
+	res = f.Check(msg("hello", now.Add(time.Minute)), "Spammer")
+	assert.Equal(t, ChatFilterStatusMuted, res.Status)
+
+	res = f.Check(msg("hello", now.Add(11*time.Minute)), "Spammer")
+	assert.Equal(t, ChatFilterStatusOk, res.Status)
+}
+
+func TestChatFilter_ReloadKeepsPreviousRulesOnError(t *testing.T) {
+	source := &staticRulesRepo{rules: &filter.Rules{BlockWords: []string{"bad"}}}
+	f := NewChatFilter(NewMutePolicy("svc", &mutedEventsRecorder{}, &chatMutesRepoStub{}), source)
+	assert.NoError(t, f.Reload(context.Background()))
+
+	source.rules = &filter.Rules{Regex: []filter.RegexRuleConfig{{Pattern: "("}}}
+	assert.Error(t, f.Reload(context.Background()))
+
+	res := f.Check(filter.Message{RealmID: 1, SenderGUID: 1, Text: "bad"}, "Player")
+	assert.Equal(t, ChatFilterStatusBlocked, res.Status)
+}
+
+func TestChatFilter_MergesSources(t *testing.T) {
+	base := &staticRulesRepo{rules: &filter.Rules{BlockWords: []string{"first"}}}
+	db := &staticRulesRepo{rules: &filter.Rules{BlockWords: []string{"second"}}}
+	f := NewChatFilter(NewMutePolicy("svc", &mutedEventsRecorder{}, &chatMutesRepoStub{}), base, db)
+	assert.NoError(t, f.Reload(context.Background()))
+
+	assert.Equal(t, ChatFilterStatusBlocked, f.Check(filter.Message{SenderGUID: 1, Text: "first"}, "").Status)
+	assert.Equal(t, ChatFilterStatusBlocked, f.Check(filter.Message{SenderGUID: 2, Text: "second"}, "").Status)
+}
+
+func TestMutePolicy_ApplyMute(t *testing.T) {
+	p := NewMutePolicy("svc", &mutedEventsRecorder{}, &chatMutesRepoStub{})
+	now := time.Now()
+
+	p.ApplyMute(1, 2, now.Add(time.Minute))
+	until, ok := p.MutedUntil(1, 2, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Minute), until)
+
+	_, ok = p.MutedUntil(2, 2, now)
+	assert.False(t, ok)
+
+	p.Cleanup(context.Background(), now.Add(2*time.Minute))
+	_, ok = p.MutedUntil(1, 2, now)
+	assert.False(t, ok)
+}
+
+func TestMutePolicy_LoadKeepsMutesAfterRestart(t *testing.T) {
+	now := time.Now()
+	mutesRepo := &chatMutesRepoStub{mutes: []repo.ChatMute{
+		{RealmID: 1, PlayerGUID: 2, MutedUntil: now.Add(time.Minute)},
+		{RealmID: 1, PlayerGUID: 3, MutedUntil: now.Add(-time.Minute)},
+	}}
+
+	p := NewMutePolicy("svc", &mutedEventsRecorder{}, mutesRepo)
+	assert.NoError(t, p.Load(context.Background(), now))
+
+	until, ok := p.MutedUntil(1, 2, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(time.Minute), until)
+
+	_, ok = p.MutedUntil(1, 3, now)
+	assert.False(t, ok)
+
+	p.Cleanup(context.Background(), now.Add(2*time.Minute))
+	assert.Empty(t, mutesRepo.mutes)
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+)
+
+const (
+	chatLogQueueSize     = 4096
+	chatLogBatchSize     = 100
+	chatLogFlushInterval = time.Second
+)
+
+// ChatLogger collects chat messages and stores them in batches, so logging never blocks chat.
+type ChatLogger struct {
+	repo      repo.ChatLogRepo
+	retention time.Duration
+	// maxSearchLimit is the max amount of messages returned by search.
+	maxSearchLimit int
+	queue          chan repo.ChatLogMessage
+	done           chan struct{}
+}
+
+// NewChatLogger creates ChatLogger. Messages older than retention are removed periodically,
+// zero retention keeps messages forever. Search limit is clamped to maxSearchLimit, zero disables clamping.
+func NewChatLogger(r repo.ChatLogRepo, retention time.Duration, maxSearchLimit int) *ChatLogger {
+	return &ChatLogger{
+		repo:           r,
+		retention:      retention,
+		maxSearchLimit: maxSearchLimit,
+		queue:          make(chan repo.ChatLogMessage, chatLogQueueSize),
+		done:           make(chan struct{}),
+	}
+}
+
+// Log queues message for storing, message is dropped if queue is full.
+func (l *ChatLogger) Log(msg repo.ChatLogMessage) {
+	if msg.CreatedAt.IsZero() {
+		msg.CreatedAt = time.Now()
+	}
+
+	select {
+	case l.queue <- msg:
+	default:
+		log.Warn().Uint64("sender", msg.SenderGUID).Msg("chat log queue is full, message dropped")
+	}
+}
+
+// Search returns logged messages that match the query.
+func (l *ChatLogger) Search(ctx context.Context, query repo.ChatLogQuery) ([]repo.ChatLogMessage, error) {
+	if l.maxSearchLimit > 0 && query.Limit > l.maxSearchLimit {
+		query.Limit = l.maxSearchLimit
+	}
+	return l.repo.SearchMessages(ctx, query)
+}
+
+// Run stores queued messages until ctx is done, remaining messages are flushed before return.
+func (l *ChatLogger) Run(ctx context.Context) {
+	defer close(l.done)
+
+	flushTicker := time.NewTicker(chatLogFlushInterval)
+	defer flushTicker.Stop()
+
+	var retentionC <-chan time.Time
+	if l.retention > 0 {
+		l.deleteExpired()
+
+		retentionTicker := time.NewTicker(l.retentionCheckInterval())
+		defer retentionTicker.Stop()
+		retentionC = retentionTicker.C
+	}
+
+	batch := make([]repo.ChatLogMessage, 0, chatLogBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := l.repo.SaveMessages(context.Background(), batch); err != nil {
+			log.Error().Err(err).Int("count", len(batch)).Msg("failed to save chat log")
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case msg := <-l.queue:
+			batch = append(batch, msg)
+			if len(batch) >= chatLogBatchSize {
+				flush()
+			}
+		case <-flushTicker.C:
+			flush()
+		case <-retentionC:
+			l.deleteExpired()
+		case <-ctx.Done():
+			for {
+				select {
+				case msg := <-l.queue:
+					batch = append(batch, msg)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// Wait blocks until Run flushes remaining messages and returns.
+func (l *ChatLogger) Wait() {
+	<-l.done
+}
+
+func (l *ChatLogger) deleteExpired() {
+	if err := l.repo.DeleteMessagesOlderThan(context.Background(), time.Now().Add(-l.retention)); err != nil {
+		log.Error().Err(err).Msg("failed to delete expired chat log")
+	}
+}
+
+func (l *ChatLogger) retentionCheckInterval() time.Duration {
+	if l.retention < time.Hour {
+		return l.retention
+	}
+	return time.Hour
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+)
+
+func TestChatLogger_FlushesOnStop(t *testing.T) {
+	r, err := repo.NewChatLogFile(filepath.Join(t.TempDir(), "chat-log.jsonl"))
+	assert.NoError(t, err)
+
+	logger := NewChatLogger(r, 0, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	go logger.Run(ctx)
+
+	logger.Log(repo.ChatLogMessage{RealmID: 1, Type: repo.ChatLogMessageChannel, SenderGUID: 1, Message: "first"})
+	logger.Log(repo.ChatLogMessage{RealmID: 1, Type: repo.ChatLogMessageChannel, SenderGUID: 1, Message: "second"})
+
+	cancel()
+	logger.Wait()
+
+	msgs, err := logger.Search(context.Background(), repo.ChatLogQuery{RealmID: 1, PlayerGUID: 1, Limit: 10})
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 2)
+}
+
+func TestChatLogger_Retention(t *testing.T) {
+	r, err := repo.NewChatLogFile(filepath.Join(t.TempDir(), "chat-log.jsonl"))
+	assert.NoError(t, err)
+
+	err = r.SaveMessages(context.Background(), []repo.ChatLogMessage{
+		{RealmID: 1, SenderGUID: 1, Message: "expired", CreatedAt: time.Now().Add(-2 * time.Hour)},
+		{RealmID: 1, SenderGUID: 1, Message: "fresh", CreatedAt: time.Now()},
+	})
+	assert.NoError(t, err)
+
+	logger := NewChatLogger(r, time.Hour, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	go logger.Run(ctx)
+	cancel()
+	logger.Wait()
+
+	msgs, err := logger.Search(context.Background(), repo.ChatLogQuery{RealmID: 1, Limit: 10})
+	assert.NoError(t, err)
+	if assert.Len(t, msgs, 1) {
+		assert.Equal(t, "fresh", msgs[0].Message)
+	}
+}
+
+func TestChatLogger_SearchLimitIsClamped(t *testing.T) {
+	r, err := repo.NewChatLogFile(filepath.Join(t.TempDir(), "chat-log.jsonl"))
+	assert.NoError(t, err)
+
+	msgs := make([]repo.ChatLogMessage, 5)
+	for i := range msgs {
+		msgs[i] = repo.ChatLogMessage{RealmID: 1, SenderGUID: 1, Message: "spam", CreatedAt: time.Now()}
+	}
+	assert.NoError(t, r.SaveMessages(context.Background(), msgs))
+
+	logger := NewChatLogger(r, 0, 3)
+
+	found, err := logger.Search(context.Background(), repo.ChatLogQuery{RealmID: 1, Limit: 1000000})
+	assert.NoError(t, err)
+	assert.Len(t, found, 3)
+
+	found, err = logger.Search(context.Background(), repo.ChatLogQuery{RealmID: 1, Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// chatLogQueueGroup makes sure that only one chat service instance logs guild and group message.
+const chatLogQueueGroup = "chat_log_group"
+
+// ChatLogListener logs guild and group messages, that are delivered without chat service.
+type ChatLogListener struct {
+	logger *ChatLogger
+	nc     *nats.Conn
+	subs   []*nats.Subscription
+}
+
+func NewChatLogListener(logger *ChatLogger, nc *nats.Conn) *ChatLogListener {
+	return &ChatLogListener{
+		logger: logger,
+		nc:     nc,
+	}
+}
+
+func (c *ChatLogListener) Listen() error {
+	sb, err := c.nc.QueueSubscribe(events.GuildEventNewMessage.SubjectName(), chatLogQueueGroup, func(msg *nats.Msg) {
+		payload := events.GuildEventNewMessagePayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read GuildEventNewMessage event")
+			return
+		}
+
+		msgType := repo.ChatLogMessageGuild
+		if payload.ForOfficers {
+			msgType = repo.ChatLogMessageOfficer
+		}
+
+		c.logger.Log(repo.ChatLogMessage{
+			RealmID:    payload.RealmID,
+			Type:       msgType,
+			SenderGUID: payload.SenderGUID,
+			SenderName: payload.SenderName,
+			GuildID:    payload.GuildID,
+			Language:   payload.Language,
+			Message:    payload.Msg,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	c.subs = append(c.subs, sb)
+
+	sb, err = c.nc.QueueSubscribe(events.GroupEventNewChatMessage.SubjectName(), chatLogQueueGroup, func(msg *nats.Msg) {
+		payload := events.GroupEventNewMessagePayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read GroupEventNewChatMessage event")
+			return
+		}
+
+		c.logger.Log(repo.ChatLogMessage{
+			RealmID:    payload.RealmID,
+			Type:       repo.ChatLogMessageGroup,
+			SenderGUID: payload.SenderGUID,
+			SenderName: payload.SenderName,
+			GroupID:    uint64(payload.GroupID),
+			Language:   payload.Language,
+			Message:    payload.Msg,
+		})
+	})
+	if err != nil {
+		c.unsubscribe()
+		return err
+	}
+	c.subs = append(c.subs, sb)
+
+	return nil
+}
+
+func (c *ChatLogListener) Stop() error {
+	return c.unsubscribe()
+}
+
+func (c *ChatLogListener) unsubscribe() error {
+	for _, sub := range c.subs {
+		if err := sub.Unsubscribe(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
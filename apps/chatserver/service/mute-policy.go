@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/chatserver/repo"
+	"github.com/walkline/ToCloud9/apps/chatserver/sender"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+type mutedPlayerKey struct {
+	realmID uint32
+	guid    uint64
+}
+
+// MutePolicy mutes players that keep sending messages blocked by the chat filter.
+// Every mute is written to the audit log and published to the moderation event, that also syncs mutes between chat service instances.
+// Mutes are stored in the repo and loaded on startup.
+type MutePolicy struct {
+	serviceID string
+	producer  sender.MsgProducer
+	repo      repo.ChatMutesRepo
+
+	mu         sync.Mutex
+	violations int
+	window     time.Duration
+	duration   time.Duration
+
+	blocked map[mutedPlayerKey][]time.Time
+	mutes   map[mutedPlayerKey]time.Time
+}
+
+func NewMutePolicy(serviceID string, producer sender.MsgProducer, mutesRepo repo.ChatMutesRepo) *MutePolicy {
+	return &MutePolicy{
+		serviceID: serviceID,
+		producer:  producer,
+		repo:      mutesRepo,
+		blocked:   map[mutedPlayerKey][]time.Time{},
+		mutes:     map[mutedPlayerKey]time.Time{},
+	}
+}
+
+// Load loads mutes that are still active from the repo.
+func (p *MutePolicy) Load(ctx context.Context, now time.Time) error {
+	mutes, err := p.repo.ActiveMutes(ctx, now)
+	if err != nil {
+		return fmt.Errorf("can't load chat mutes: %w", err)
+	}
+
+	for _, mute := range mutes {
+		p.ApplyMute(mute.RealmID, mute.PlayerGUID, mute.MutedUntil)
+	}
+
+	return nil
+}
+
+// SetRules updates mute thresholds, zero violations disables auto-mute.
+func (p *MutePolicy) SetRules(violations int, window, duration time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.violations = violations
+	p.window = window
+	p.duration = duration
+}
+
+// MutedUntil returns time when player mute expires, ok is false if player is not muted.
+func (p *MutePolicy) MutedUntil(realmID uint32, guid uint64, now time.Time) (until time.Time, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	until, ok = p.mutes[mutedPlayerKey{realmID, guid}]
+	if !ok || !now.Before(until) {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// ReportBlocked records blocked message of the player and mutes the player if threshold is reached.
+func (p *MutePolicy) ReportBlocked(realmID uint32, guid uint64, name, reason string, now time.Time) (mutedUntil time.Time, muted bool) {
+	p.mu.Lock()
+	if p.violations <= 0 {
+		p.mu.Unlock()
+		return time.Time{}, false
+	}
+
+	key := mutedPlayerKey{realmID, guid}
+	recent := p.blocked[key][:0]
+	for _, at := range p.blocked[key] {
+		if now.Sub(at) <= p.window {
+			recent = append(recent, at)
+		}
+	}
+	recent = append(recent, now)
+
+	if len(recent) < p.violations {
+		p.blocked[key] = recent
+		p.mu.Unlock()
+		return time.Time{}, false
+	}
+
+	delete(p.blocked, key)
+	mutedUntil = now.Add(p.duration)
+	p.mutes[key] = mutedUntil
+	p.mu.Unlock()
+
+	log.Warn().
+		Str("audit", "chat_mute").
+		Uint32("realmID", realmID).
+		Uint64("playerGUID", guid).
+		Str("playerName", name).
+		Time("mutedUntil", mutedUntil).
+		Str("reason", reason).
+		Msg("Player auto-muted by chat filter")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	err := p.repo.SaveMute(ctx, repo.ChatMute{
+		RealmID:    realmID,
+		PlayerGUID: guid,
+		MutedUntil: mutedUntil,
+		Reason:     reason,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("can't save player mute")
+	}
+
+	err = p.producer.ProducePlayerMuted(&events.ChatEventPlayerMutedPayload{
+		ServiceID:  p.serviceID,
+		RealmID:    realmID,
+		PlayerGUID: guid,
+		PlayerName: name,
+		MutedUntil: mutedUntil.Unix(),
+		Reason:     reason,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("can't publish player muted event")
+	}
+
+	return mutedUntil, true
+}
+
+// ApplyMute applies mute issued by another chat service instance.
+func (p *MutePolicy) ApplyMute(realmID uint32, guid uint64, until time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := mutedPlayerKey{realmID, guid}
+	if until.After(p.mutes[key]) {
+		p.mutes[key] = until
+	}
+}
+
+// Cleanup removes expired mutes and outdated violations.
+func (p *MutePolicy) Cleanup(ctx context.Context, now time.Time) {
+	if err := p.repo.DeleteExpiredMutes(ctx, now); err != nil {
+		log.Error().Err(err).Msg("can't delete expired chat mutes")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, until := range p.mutes {
+		if !now.Before(until) {
+			delete(p.mutes, key)
+		}
+	}
+
+	for key, blocked := range p.blocked {
+		if len(blocked) == 0 || now.Sub(blocked[len(blocked)-1]) > p.window {
+			delete(p.blocked, key)
+		}
+	}
+}
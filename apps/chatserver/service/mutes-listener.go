@@ -0,0 +1,55 @@
+package service
+
+import (
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// MutesListener applies auto-mutes issued by other chat service instances.
+type MutesListener struct {
+	serviceID string
+	mutes     *MutePolicy
+	nc        *nats.Conn
+	sub       *nats.Subscription
+}
+
+func NewMutesListener(serviceID string, mutes *MutePolicy, nc *nats.Conn) *MutesListener {
+	return &MutesListener{
+		serviceID: serviceID,
+		mutes:     mutes,
+		nc:        nc,
+	}
+}
+
+func (c *MutesListener) Listen() error {
+	sb, err := c.nc.Subscribe(events.ChatEventPlayerMuted.SubjectName("ALL"), func(msg *nats.Msg) {
+		payload := events.ChatEventPlayerMutedPayload{}
+		if _, err := events.Unmarshal(msg.Data, &payload); err != nil {
+			log.Error().Err(err).Msg("can't read ChatEventPlayerMuted event")
+			return
+		}
+
+		if payload.ServiceID == c.serviceID {
+			return
+		}
+
+		c.mutes.ApplyMute(payload.RealmID, payload.PlayerGUID, time.Unix(payload.MutedUntil, 0))
+	})
+	if err != nil {
+		return err
+	}
+	c.sub = sb
+
+	return nil
+}
+
+func (c *MutesListener) Stop() error {
+	if c.sub == nil {
+		return nil
+	}
+	return c.sub.Unsubscribe()
+}
@@ -3,7 +3,12 @@ package main
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -79,6 +84,9 @@ func main() {
 	auctionHouseClient := auctionHouseService(conf)
 
 	healthandmetrics.EnableActiveConnectionsMetrics()
+
+	mapPopulation := service.NewMapPopulation()
+	healthandmetrics.EnableMapPlayersMetrics(mapPopulation)
 	healthCheckServer := healthandmetrics.NewServer(conf.HealthCheckPort, promhttp.Handler())
 
 	go func() {
@@ -135,13 +143,27 @@ func main() {
 		log.Fatal().Err(err).Msg("can't listen to matchmaking events-broadcaster")
 	}
 
-	friendsListener := service.NewFriendsNatsListener(nc, broadcaster)
+	friendsListener := service.NewFriendsNatsListener(nc, root.RealmID, broadcaster)
 	err = friendsListener.Listen()
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't listen to friends events-broadcaster")
 	}
 
-	producer := events.NewGatewayProducerNatsJSON(nc, root.Ver, root.RealmID, root.RetrievedGatewayID)
+	mapRegions := service.NewMapRegions(servRegistryClient, time.Duration(conf.MapRegionsCacheTTLSecs)*time.Second)
+	serversRegistryListener := service.NewServersRegistryNatsListener(nc, root.RealmID, mapPopulation, mapRegions, broadcaster)
+	err = serversRegistryListener.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to servers registry events")
+	}
+
+	producer := service.NewOnlineCharactersProducer(events.NewGatewayProducerNatsJSON(nc, root.Ver, root.RealmID, root.RetrievedGatewayID))
+
+	charsListener := service.NewCharactersNatsListener(nc, root.RealmID, producer)
+	err = charsListener.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to characters events")
+	}
+
 	charsUpdsBarrier := service.NewCharactersUpdatesBarrier(&log.Logger, producer, time.Second)
 	go charsUpdsBarrier.Run(context.TODO())
 
@@ -150,6 +172,42 @@ func main() {
 		log.Fatal().Err(err).Msg("can't create realm names service")
 	}
 
+	drainer := session.NewDrainer()
+	healthandmetrics.EnableSessionsMetrics(drainer)
+	drainRequests := make(chan struct{}, 1)
+	requestDrain := func() {
+		select {
+		case drainRequests <- struct{}{}:
+		default:
+		}
+	}
+
+	drainingListener := service.NewGatewayDrainingNatsListener(nc, root.RetrievedGatewayID, requestDrain)
+	err = drainingListener.Listen()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't listen to gateway draining events")
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-sigCh
+		fmt.Println("")
+		log.Info().Msgf("🧨 Got signal %v, attempting graceful shutdown...", sig)
+		requestDrain()
+
+		sig = <-sigCh
+		log.Warn().Msgf("Got signal %v again, stopping without drain", sig)
+		os.Exit(1)
+	}()
+
+	drained := make(chan struct{})
+	go func() {
+		defer close(drained)
+		<-drainRequests
+		drainGateway(l, drainer, servRegistryClient, conf)
+	}()
+
 	log.Info().
 		Str("address", l.Addr().String()).
 		Msg("🚀 Gateway started!")
@@ -157,6 +215,9 @@ func main() {
 	for {
 		conn, err := l.Accept()
 		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				break
+			}
 			log.Fatal().Err(err).Msg("can't accept connection")
 		}
 
@@ -175,10 +236,16 @@ func main() {
 			EventsBroadcaster:                broadcaster,
 			ChatChannelsEventBroadcaster:     chatChannelsBroadcasterService,
 			CharsUpdsBarrier:                 charsUpdsBarrier,
+			MapPopulation:                    mapPopulation,
+			MapRegions:                       mapRegions,
+			MapRegionBorderBand:              conf.MapRegionBorderBandYards,
 			RealmNamesService:                realmNamesServive,
 			GameServerGRPCConnMgr:            gameserverconn.DefaultGameServerGRPCConnMgr,
 			PacketProcessTimeout:             time.Second * time.Duration(conf.PacketProcessTimeoutSecs),
 			ShowGameserverConnChangeToClient: conf.ShowGameserverConnChangeToClient,
+			ChatFilterEnabled:                conf.ChatFilterEnabled,
+			ChatFilterFailOpen:               conf.ChatFilterFailOpen,
+			Drainer:                          drainer,
 		})
 		go func() {
 			healthandmetrics.ActiveConnectionsMetrics.Inc()
@@ -188,6 +255,39 @@ func main() {
 			s.ListenAndProcess(context.Background())
 		}()
 	}
+
+	<-drained
+
+	log.Info().Msg("👍 Gateway successfully stopped.")
+}
+
+// drainGateway stops accepting connections and marks the gateway as draining, so servers registry sends new players
+// to other gateways, then hands off players of the gateway until there are no sessions left or drain times out.
+func drainGateway(l net.Listener, drainer *session.Drainer, servRegistryClient pbServ.ServersRegistryServiceClient, conf *config.Config) {
+	log.Info().Int("sessions", drainer.Sessions()).Msg("Draining gateway...")
+
+	_ = l.Close()
+
+	_, err := servRegistryClient.SetGatewayDraining(context.Background(), &pbServ.SetGatewayDrainingRequest{
+		Api:      root.SupportedServerRegistryVer,
+		Id:       root.RetrievedGatewayID,
+		Draining: true,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("can't mark gateway as draining")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(conf.DrainTimeoutSecs)*time.Second)
+	defer cancel()
+
+	err = drainer.Drain(ctx, conf.DrainBatchSize, time.Duration(conf.DrainBatchIntervalSecs)*time.Second)
+	if err != nil {
+		log.Warn().Int("remainingSessions", drainer.Sessions()).Msg("Drain timed out, disconnecting remaining players")
+
+		disconnectCtx, disconnectCancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer disconnectCancel()
+		drainer.Disconnect(disconnectCtx)
+	}
 }
 
 func charService(cnf *config.Config) pbChar.CharactersServiceClient {
@@ -251,15 +351,24 @@ func mailService(cnf *config.Config) pbMail.MailServiceClient {
 }
 
 func auctionHouseService(cnf *config.Config) pbAH.AuctionHouseServiceClient {
-	conn, err := grpc.Dial(cnf.AuctionHouseServiceAddress, grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
-		dialer := net.Dialer{Timeout: time.Second * 5}
-		return dialer.DialContext(ctx, "tcp", s)
-	}))
+	dial := func(address string) (pbAH.AuctionHouseServiceClient, error) {
+		conn, err := grpc.Dial(address, grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) {
+			dialer := net.Dialer{Timeout: time.Second * 5}
+			return dialer.DialContext(ctx, "tcp", s)
+		}))
+		if err != nil {
+			return nil, err
+		}
+		return pbAH.NewAuctionHouseServiceClient(conn), nil
+	}
+
+	discovery, err := dial(cnf.AuctionHouseServiceAddress)
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't connect to auction house service")
 	}
 
-	return pbAH.NewAuctionHouseServiceClient(conn)
+	// Auction house instances can partition realms, so requests are sent to the owner of the realm.
+	return service.NewAuctionHouseRouter(discovery, dial)
 }
 
 func groupService(cnf *config.Config) pbGroup.GroupServiceClient {
@@ -281,6 +390,9 @@ func registerGateway(servRegistryClient pbServ.ServersRegistryServiceClient, con
 		HealthPort:        uint32(conf.HealthCheckPortInt()),
 		RealmID:           root.RealmID,
 		PreferredHostName: conf.PreferredHostname,
+		Capacity:          conf.Capacity,
+		Region:            conf.Region,
+		Zone:              conf.Zone,
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't register gateway")
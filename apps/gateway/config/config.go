@@ -29,6 +29,13 @@ type Config struct {
 	// PreferredHostname is referred host name that will be used to connect from game client
 	PreferredHostname string `yaml:"preferredHostname" env:"PREFERRED_HOSTNAME" env-default:"localhost"`
 
+	// Capacity is max players count of the gateway for the servers registry, 0 uses default capacity of the servers registry.
+	Capacity uint32 `yaml:"capacity" env:"CAPACITY" env-default:"0"`
+
+	// Region and Zone are locality labels of the gateway, players are sent to the gateways of their locality.
+	Region string `yaml:"region" env:"REGION"`
+	Zone   string `yaml:"zone" env:"ZONE"`
+
 	// CharServiceAddress is address of characters service
 	CharServiceAddress string `yaml:"charactersServiceAddress" env:"CHAR_SERVICE_ADDRESS" env-default:"localhost:8991"`
 
@@ -61,6 +68,29 @@ type Config struct {
 
 	// ShowGameserverConnChangeToClient when enabled sends chat system message to the player with information about connection change.
 	ShowGameserverConnChangeToClient bool `yaml:"showGameserverConnChangeToClient" env:"SHOW_GAMESERVER_CONN_CHANGE_TO_CLIENT" env-default:"true"`
+
+	// ChatFilterEnabled passes say, yell, emote, guild and group messages through chat service filter.
+	// Whispers and channel messages are always filtered by chat service.
+	ChatFilterEnabled bool `yaml:"chatFilterEnabled" env:"CHAT_FILTER_ENABLED" env-default:"true"`
+
+	// ChatFilterFailOpen sends messages unfiltered when chat service is unavailable, otherwise they are blocked.
+	ChatFilterFailOpen bool `yaml:"chatFilterFailOpen" env:"CHAT_FILTER_FAIL_OPEN" env-default:"true"`
+
+	// MapRegionBorderBandYards is distance in yards that character can go beyond the regions of its game server
+	// on the partitioned map before it's redirected to the game server of the new region.
+	MapRegionBorderBandYards float32 `yaml:"mapRegionBorderBandYards" env:"MAP_REGION_BORDER_BAND_YARDS" env-default:"50"`
+
+	// MapRegionsCacheTTLSecs is time to keep regions of the partitioned maps without reloading from servers registry.
+	MapRegionsCacheTTLSecs uint32 `yaml:"mapRegionsCacheTTLSecs" env:"MAP_REGIONS_CACHE_TTL_SECS" env-default:"30"`
+
+	// DrainBatchSize is count of the players that draining gateway starts to hand off every DrainBatchIntervalSecs.
+	DrainBatchSize int `yaml:"drainBatchSize" env:"DRAIN_BATCH_SIZE" env-default:"100"`
+
+	// DrainBatchIntervalSecs is interval between handoff batches of the draining gateway.
+	DrainBatchIntervalSecs uint32 `yaml:"drainBatchIntervalSecs" env:"DRAIN_BATCH_INTERVAL_SECS" env-default:"5"`
+
+	// DrainTimeoutSecs is max time of the drain, remaining players are disconnected after it.
+	DrainTimeoutSecs uint32 `yaml:"drainTimeoutSecs" env:"DRAIN_TIMEOUT_SECS" env-default:"900"`
 }
 
 func (c Config) PortInt() (p int) {
@@ -49,6 +49,7 @@ const (
 	EventTypeChannelJoined
 	EventTypeChannelLeft
 	EventTypeChannelNotification
+	EventTypeMapPlayersRedirect
 )
 
 type IncomingWhisperPayload struct {
@@ -59,6 +60,12 @@ type IncomingWhisperPayload struct {
 	ReceiverName string
 	Language     uint32
 	Msg          string
+
+	// SenderRealmID is realm of the sender, differs from the gateway realm for cross-realm whispers.
+	SenderRealmID uint32
+
+	// SenderChatFlags is AFK/DND status of the sender.
+	SenderChatFlags uint8
 }
 
 type ChannelMessagePayload struct {
@@ -102,6 +109,19 @@ type ChannelNotificationPayload struct {
 	AffectsPlayer uint64
 }
 
+// MapPlayersRedirectPayload asks characters to move to another game server that handles their map.
+type MapPlayersRedirectPayload struct {
+	CharGUIDs []uint64
+
+	MapID            uint32
+	FromGameServerID string
+
+	ToGameServerID          string
+	ToGameServerAddress     string
+	ToGameServerGRPCAddress string
+	ToGameServerAlias       string
+}
+
 type GuildInviteCreatedPayload struct {
 	RealmID uint32
 
@@ -165,6 +185,8 @@ type Broadcaster interface {
 	NewChannelJoinedEvent(payload *ChannelJoinedPayload)
 	NewChannelLeftEvent(payload *ChannelLeftPayload)
 	NewChannelNotificationEvent(payload *ChannelNotificationPayload)
+
+	NewMapPlayersRedirectEvent(payload *MapPlayersRedirectPayload)
 }
 
 type broadcasterImpl struct {
@@ -598,6 +620,15 @@ func (b *broadcasterImpl) NewChannelNotificationEvent(payload *ChannelNotificati
 	})
 }
 
+func (b *broadcasterImpl) NewMapPlayersRedirectEvent(payload *MapPlayersRedirectPayload) {
+	for _, ch := range b.channelsForGUIDs(payload.CharGUIDs) {
+		ch <- Event{
+			Type:    EventTypeMapPlayersRedirect,
+			Payload: payload,
+		}
+	}
+}
+
 func (b *broadcasterImpl) channelsForGUIDs(guids []uint64) []chan Event {
 	channels := make([]chan Event, 0, len(guids))
 	b.channelsMu.RLock()
@@ -183,6 +183,11 @@ func (_m *Broadcaster) NewIncomingWhisperEvent(payload *events_broadcaster.Incom
 	_m.Called(payload)
 }
 
+// NewMapPlayersRedirectEvent provides a mock function with given fields: payload
+func (_m *Broadcaster) NewMapPlayersRedirectEvent(payload *events_broadcaster.MapPlayersRedirectPayload) {
+	_m.Called(payload)
+}
+
 // NewMatchmakingInviteToBGOrArenaExpiredEvent provides a mock function with given fields: payload
 func (_m *Broadcaster) NewMatchmakingInviteToBGOrArenaExpiredEvent(payload *events.MatchmakingEventPlayersInviteExpiredPayload) {
 	_m.Called(payload)
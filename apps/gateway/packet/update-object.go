@@ -19,6 +19,10 @@ const (
 	unitFieldMaxHealth = objectEnd + 0x1A
 	unitFieldMaxPower1 = objectEnd + 0x1B
 	unitFieldLevel     = objectEnd + 0x30
+	unitEnd            = objectEnd + 0x8E
+
+	playerFieldFlags   = unitEnd + 0x2
+	playerFieldGuildID = unitEnd + 0x3
 
 	powersCount = 7
 )
@@ -74,11 +78,15 @@ type UnitStatsUpdate struct {
 	PowerType *uint8
 	Powers    [powersCount]*uint32
 	MaxPowers [powersCount]*uint32
+	GuildID   *uint32
+
+	// PlayerFlags is PLAYER_FLAGS field, it's set only for players.
+	PlayerFlags *uint32
 }
 
 // IsEmpty returns true if no tracked field was present in the packet.
 func (u *UnitStatsUpdate) IsEmpty() bool {
-	if u.Level != nil || u.CurHP != nil || u.MaxHP != nil || u.PowerType != nil {
+	if u.Level != nil || u.CurHP != nil || u.MaxHP != nil || u.PowerType != nil || u.GuildID != nil || u.PlayerFlags != nil {
 		return false
 	}
 	for i := 0; i < powersCount; i++ {
@@ -156,7 +164,7 @@ func DecompressUpdateObject(data []byte) ([]byte, error) {
 }
 
 // maxStatsFieldsBlock is the last mask block that can contain tracked fields.
-const maxStatsFieldsBlock = unitFieldLevel / 32
+const maxStatsFieldsBlock = playerFieldGuildID / 32
 
 // parseValuesBlock reads a values part of a block, keeping fields of interest when isTarget is set.
 func parseValuesBlock(r *Reader, isTarget bool, upd *UnitStatsUpdate) {
@@ -204,6 +212,12 @@ func parseValuesBlock(r *Reader, isTarget bool, upd *UnitStatsUpdate) {
 			case idx == unitFieldLevel:
 				v := r.Uint32()
 				upd.Level = &v
+			case idx == playerFieldFlags:
+				v := r.Uint32()
+				upd.PlayerFlags = &v
+			case idx == playerFieldGuildID:
+				v := r.Uint32()
+				upd.GuildID = &v
 			case idx >= unitFieldPower1 && idx < unitFieldPower1+powersCount:
 				v := r.Uint32()
 				upd.Powers[idx-unitFieldPower1] = &v
@@ -100,6 +100,29 @@ func TestParseUpdateObjectStatsReadsCorrectMaxPowerSlot(t *testing.T) {
 	assert.Equal(t, uint32(200), *upd.MaxPowers[0])
 }
 
+// TestParseUpdateObjectStatsReadsGuildID pins the guild id and player flags field indexes
+// to PLAYER_GUILDID (UNIT_END+0x3) and PLAYER_FLAGS (UNIT_END+0x2).
+func TestParseUpdateObjectStatsReadsGuildID(t *testing.T) {
+	const (
+		fieldPlayerFlags = 0x94 + 0x2
+		fieldGuildID     = 0x94 + 0x3
+		fieldGuildRank   = 0x94 + 0x4
+	)
+	data := selfValuesBlockPacket(map[int]uint32{
+		fieldPlayerFlags: 0x8,
+		fieldGuildID:     15,
+		fieldGuildRank:   2,
+	})
+
+	upd, err := ParseUpdateObjectStatsForGUID(data, testCharGUID)
+	require.NoError(t, err)
+	require.NotNil(t, upd.GuildID)
+	assert.Equal(t, uint32(15), *upd.GuildID)
+	require.NotNil(t, upd.PlayerFlags)
+	assert.Equal(t, uint32(0x8), *upd.PlayerFlags)
+	assert.False(t, upd.IsEmpty())
+}
+
 func TestParseUpdateObjectStatsIgnoresOtherGUIDs(t *testing.T) {
 	w := NewWriter(SMsgUpdateObject)
 	w.Uint32(1)
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbAH "github.com/walkline/ToCloud9/gen/auctionhouse/pb"
+)
+
+// AuctionHouseRouter is pbAH.AuctionHouseServiceClient that sends requests to the auction house
+// instance that owns the realm of the request. Owner is resolved with RealmOwner request to the
+// discovery client and re-resolved once ownership is handed over to another instance.
+type AuctionHouseRouter struct {
+	discovery pbAH.AuctionHouseServiceClient
+	dial      func(address string) (pbAH.AuctionHouseServiceClient, error)
+
+	mu      sync.Mutex
+	owners  map[uint32]pbAH.AuctionHouseServiceClient
+	clients map[string]pbAH.AuctionHouseServiceClient
+}
+
+// NewAuctionHouseRouter creates router, discovery is client of any auction house instance
+// and dial creates client of the instance with the given address.
+func NewAuctionHouseRouter(discovery pbAH.AuctionHouseServiceClient, dial func(address string) (pbAH.AuctionHouseServiceClient, error)) *AuctionHouseRouter {
+	return &AuctionHouseRouter{
+		discovery: discovery,
+		dial:      dial,
+		owners:    map[uint32]pbAH.AuctionHouseServiceClient{},
+		clients:   map[string]pbAH.AuctionHouseServiceClient{},
+	}
+}
+
+func (r *AuctionHouseRouter) Hello(ctx context.Context, in *pbAH.AuctionHelloRequest, opts ...grpc.CallOption) (*pbAH.AuctionHelloResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.Hello, opts)
+}
+
+func (r *AuctionHouseRouter) SellItem(ctx context.Context, in *pbAH.AuctionSellItemRequest, opts ...grpc.CallOption) (*pbAH.AuctionSellItemResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.SellItem, opts)
+}
+
+func (r *AuctionHouseRouter) PlaceBid(ctx context.Context, in *pbAH.AuctionPlaceBidRequest, opts ...grpc.CallOption) (*pbAH.AuctionPlaceBidResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.PlaceBid, opts)
+}
+
+func (r *AuctionHouseRouter) CancelAuction(ctx context.Context, in *pbAH.AuctionCancelRequest, opts ...grpc.CallOption) (*pbAH.AuctionCancelResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.CancelAuction, opts)
+}
+
+func (r *AuctionHouseRouter) ListItems(ctx context.Context, in *pbAH.AuctionListItemsRequest, opts ...grpc.CallOption) (*pbAH.AuctionListItemsResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.ListItems, opts)
+}
+
+func (r *AuctionHouseRouter) ListOwnerItems(ctx context.Context, in *pbAH.AuctionListOwnerItemsRequest, opts ...grpc.CallOption) (*pbAH.AuctionListOwnerItemsResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.ListOwnerItems, opts)
+}
+
+func (r *AuctionHouseRouter) ListBidderItems(ctx context.Context, in *pbAH.AuctionListBidderItemsRequest, opts ...grpc.CallOption) (*pbAH.AuctionListBidderItemsResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.ListBidderItems, opts)
+}
+
+func (r *AuctionHouseRouter) ListPendingSales(ctx context.Context, in *pbAH.AuctionListPendingSalesRequest, opts ...grpc.CallOption) (*pbAH.AuctionListPendingSalesResponse, error) {
+	return routeAuctionHouse(ctx, r, in, pbAH.AuctionHouseServiceClient.ListPendingSales, opts)
+}
+
+// MarketStats is served by any instance.
+func (r *AuctionHouseRouter) MarketStats(ctx context.Context, in *pbAH.AuctionMarketStatsRequest, opts ...grpc.CallOption) (*pbAH.AuctionMarketStatsResponse, error) {
+	return r.discovery.MarketStats(ctx, in, opts...)
+}
+
+func (r *AuctionHouseRouter) RealmOwner(ctx context.Context, in *pbAH.AuctionRealmOwnerRequest, opts ...grpc.CallOption) (*pbAH.AuctionRealmOwnerResponse, error) {
+	return r.discovery.RealmOwner(ctx, in, opts...)
+}
+
+// owner returns client of the instance that owns the realm.
+func (r *AuctionHouseRouter) owner(ctx context.Context, realmID uint32) (pbAH.AuctionHouseServiceClient, error) {
+	r.mu.Lock()
+	client := r.owners[realmID]
+	r.mu.Unlock()
+	if client != nil {
+		return client, nil
+	}
+
+	resp, err := r.discovery.RealmOwner(ctx, &pbAH.AuctionRealmOwnerRequest{RealmID: realmID})
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Instances that don't partition realms serve all of them.
+	if err != nil || resp.Address == "" {
+		r.owners[realmID] = r.discovery
+		return r.discovery, nil
+	}
+
+	client = r.clients[resp.Address]
+	if client == nil {
+		client, err = r.dial(resp.Address)
+		if err != nil {
+			return nil, err
+		}
+		r.clients[resp.Address] = client
+	}
+
+	r.owners[realmID] = client
+	return client, nil
+}
+
+// resetOwner forgets owner of the realm if it's still the given client.
+func (r *AuctionHouseRouter) resetOwner(realmID uint32, client pbAH.AuctionHouseServiceClient) {
+	r.mu.Lock()
+	if r.owners[realmID] == client {
+		delete(r.owners, realmID)
+	}
+	r.mu.Unlock()
+}
+
+// routeAuctionHouse sends request to the owner of the realm, retries once with the new owner
+// if the instance doesn't own the realm anymore or is unavailable.
+func routeAuctionHouse[Req interface{ GetRealmID() uint32 }, Resp any](
+	ctx context.Context, r *AuctionHouseRouter, req Req,
+	call func(pbAH.AuctionHouseServiceClient, context.Context, Req, ...grpc.CallOption) (Resp, error),
+	opts []grpc.CallOption,
+) (Resp, error) {
+	var resp Resp
+
+	client, err := r.owner(ctx, req.GetRealmID())
+	if err != nil {
+		return resp, err
+	}
+
+	resp, err = call(client, ctx, req, opts...)
+	if code := status.Code(err); code != codes.FailedPrecondition && code != codes.Unavailable {
+		return resp, err
+	}
+
+	r.resetOwner(req.GetRealmID(), client)
+
+	client, ownerErr := r.owner(ctx, req.GetRealmID())
+	if ownerErr != nil {
+		return resp, err
+	}
+
+	return call(client, ctx, req, opts...)
+}
@@ -39,6 +39,10 @@ func (b *CharactersUpdatesBarrier) UpdateMap(charGUID uint64, mapID uint32) {
 	b.updsChan <- events.CharacterUpdate{ID: charGUID, Map: &mapID}
 }
 
+func (b *CharactersUpdatesBarrier) UpdateChatStatus(charGUID uint64, chatFlags uint8, awayMsg string) {
+	b.updsChan <- events.CharacterUpdate{ID: charGUID, ChatFlags: &chatFlags, AwayMsg: &awayMsg}
+}
+
 // Update queues one update that can carry several changed fields at once.
 func (b *CharactersUpdatesBarrier) Update(upd events.CharacterUpdate) {
 	b.updsChan <- upd
@@ -141,5 +145,17 @@ func mergeCharUpdates(oldCharUpd, newCharUpd events.CharacterUpdate) events.Char
 		oldCharUpd.MaxPower = newCharUpd.MaxPower
 	}
 
+	if newCharUpd.GuildID != nil {
+		oldCharUpd.GuildID = newCharUpd.GuildID
+	}
+
+	if newCharUpd.ChatFlags != nil {
+		oldCharUpd.ChatFlags = newCharUpd.ChatFlags
+	}
+
+	if newCharUpd.AwayMsg != nil {
+		oldCharUpd.AwayMsg = newCharUpd.AwayMsg
+	}
+
 	return oldCharUpd
 }
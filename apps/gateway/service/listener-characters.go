@@ -0,0 +1,63 @@
+package service
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+type charactersNatsListener struct {
+	nc       *nats.Conn
+	subs     []*nats.Subscription
+	realmID  uint32
+	producer *OnlineCharactersProducer
+}
+
+// NewCharactersNatsListener creates listener that sends characters online on the gateway
+// when characters service asks for resync.
+func NewCharactersNatsListener(nc *nats.Conn, realmID uint32, producer *OnlineCharactersProducer) Listener {
+	return &charactersNatsListener{
+		nc:       nc,
+		realmID:  realmID,
+		producer: producer,
+	}
+}
+
+func (c *charactersNatsListener) Listen() error {
+	sb, err := c.nc.Subscribe(events.CharEventOnlineCharactersResyncRequested.SubjectName(), func(msg *nats.Msg) {
+		payload := events.CharEventOnlineCharactersResyncRequestedPayload{}
+		_, err := events.Unmarshal(msg.Data, &payload)
+		if err != nil {
+			log.Error().Err(err).Msg("can't read CharEventOnlineCharactersResyncRequested (payload part) event")
+			return
+		}
+
+		for _, realmID := range payload.RealmIDs {
+			if realmID != c.realmID {
+				continue
+			}
+
+			if err = c.producer.SendOnlineCharacters(); err != nil {
+				log.Error().Err(err).Msg("can't send online characters")
+			}
+			return
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	c.subs = append(c.subs, sb)
+
+	return nil
+}
+
+func (c *charactersNatsListener) Stop() error {
+	for _, sub := range c.subs {
+		if err := sub.Unsubscribe(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
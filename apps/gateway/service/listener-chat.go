@@ -50,6 +50,9 @@ func (c *chatNatsListener) Listen() error {
 			ReceiverName: chatMsg.ReceiverName,
 			Language:     chatMsg.Language,
 			Msg:          chatMsg.Msg,
+
+			SenderRealmID:   chatMsg.SenderRealmID,
+			SenderChatFlags: chatMsg.SenderChatFlags,
 		})
 	})
 	if err != nil {
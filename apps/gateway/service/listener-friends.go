@@ -13,12 +13,14 @@ import (
 type friendsNatsListener struct {
 	nc          *nats.Conn
 	subs        []*nats.Subscription
+	realmID     uint32
 	broadcaster eBroadcaster.Broadcaster
 }
 
-func NewFriendsNatsListener(nc *nats.Conn, broadcaster eBroadcaster.Broadcaster) Listener {
+func NewFriendsNatsListener(nc *nats.Conn, realmID uint32, broadcaster eBroadcaster.Broadcaster) Listener {
 	return &friendsNatsListener{
 		nc:          nc,
+		realmID:     realmID,
 		broadcaster: broadcaster,
 	}
 }
@@ -27,6 +29,10 @@ func (f *friendsNatsListener) Listen() error {
 	err := f.newSubscribe(events.FriendEventStatusChange, func() (interface{}, func()) {
 		d := &events.FriendEventStatusChangePayload{}
 		return d, func() {
+			// Status changes are sent to every realm with players to notify, cross-realm friends included.
+			if d.RealmID != f.realmID {
+				return
+			}
 			f.broadcaster.NewFriendStatusChangeEvent(d)
 		}
 	})
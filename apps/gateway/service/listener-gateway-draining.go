@@ -0,0 +1,52 @@
+package service
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+type gatewayDrainingNatsListener struct {
+	nc        *nats.Conn
+	sub       *nats.Subscription
+	gatewayID string
+	onDrain   func()
+}
+
+// NewGatewayDrainingNatsListener creates listener that calls onDrain when servers registry marks the gateway as draining.
+func NewGatewayDrainingNatsListener(nc *nats.Conn, gatewayID string, onDrain func()) Listener {
+	return &gatewayDrainingNatsListener{
+		nc:        nc,
+		gatewayID: gatewayID,
+		onDrain:   onDrain,
+	}
+}
+
+func (l *gatewayDrainingNatsListener) Listen() error {
+	var err error
+	l.sub, err = l.nc.Subscribe(events.ServerRegistryEventGWDrainingChanged.SubjectName(), func(msg *nats.Msg) {
+		payload := events.ServerRegistryEventGWDrainingChangedPayload{}
+		_, err := events.Unmarshal(msg.Data, &payload)
+		if err != nil {
+			log.Error().Err(err).Msg("can't read ServerRegistryEventGWDrainingChanged (payload part) event")
+			return
+		}
+
+		l.DrainingChangedEvent(&payload)
+	})
+	return err
+}
+
+func (l *gatewayDrainingNatsListener) Stop() error {
+	return l.sub.Unsubscribe()
+}
+
+// DrainingChangedEvent starts drain of the gateway, drain can't be cancelled, since the gateway doesn't accept new connections anymore.
+func (l *gatewayDrainingNatsListener) DrainingChangedEvent(payload *events.ServerRegistryEventGWDrainingChangedPayload) {
+	if payload.ID != l.gatewayID || !payload.Draining {
+		return
+	}
+
+	l.onDrain()
+}
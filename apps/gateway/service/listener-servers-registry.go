@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/nats-io/nats.go"
+	"github.com/rs/zerolog/log"
+
+	eBroadcaster "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+type serversRegistryNatsListener struct {
+	nc          *nats.Conn
+	subs        []*nats.Subscription
+	realmID     uint32
+	population  *MapPopulation
+	regions     *MapRegions
+	broadcaster eBroadcaster.Broadcaster
+}
+
+// NewServersRegistryNatsListener creates listener that redirects characters of the migrating maps
+// to their new game servers in batches that servers registry asks for,
+// and drops cached regions of the partitioned maps when they are reassigned.
+func NewServersRegistryNatsListener(nc *nats.Conn, realmID uint32, population *MapPopulation, regions *MapRegions, broadcaster eBroadcaster.Broadcaster) Listener {
+	return &serversRegistryNatsListener{
+		nc:          nc,
+		realmID:     realmID,
+		population:  population,
+		regions:     regions,
+		broadcaster: broadcaster,
+	}
+}
+
+func (l *serversRegistryNatsListener) Listen() error {
+	sb, err := l.nc.Subscribe(events.ServerRegistryEventMapPlayersRedirect.SubjectName(), func(msg *nats.Msg) {
+		payload := events.ServerRegistryEventMapPlayersRedirectPayload{}
+		_, err := events.Unmarshal(msg.Data, &payload)
+		if err != nil {
+			log.Error().Err(err).Msg("can't read ServerRegistryEventMapPlayersRedirect (payload part) event")
+			return
+		}
+
+		l.MapPlayersRedirectEvent(&payload)
+	})
+	if err != nil {
+		return err
+	}
+
+	l.subs = append(l.subs, sb)
+
+	sb, err = l.nc.Subscribe(events.ServerRegistryEventGSMapsReassigned.SubjectName(), func(msg *nats.Msg) {
+		payload := events.ServerRegistryEventGSMapsReassignedPayload{}
+		_, err := events.Unmarshal(msg.Data, &payload)
+		if err != nil {
+			log.Error().Err(err).Msg("can't read ServerRegistryEventGSMapsReassigned (payload part) event")
+			return
+		}
+
+		l.MapsReassignedEvent(&payload)
+	})
+	if err != nil {
+		return err
+	}
+
+	l.subs = append(l.subs, sb)
+
+	return nil
+}
+
+func (l *serversRegistryNatsListener) Stop() error {
+	for _, sub := range l.subs {
+		if err := sub.Unsubscribe(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *serversRegistryNatsListener) MapPlayersRedirectEvent(payload *events.ServerRegistryEventMapPlayersRedirectPayload) {
+	if !payload.IsCrossRealm && payload.RealmID != l.realmID {
+		return
+	}
+
+	chars := l.population.Characters(payload.FromGameServerID, payload.MapID, int(payload.BatchSize))
+	if len(chars) == 0 {
+		return
+	}
+
+	l.broadcaster.NewMapPlayersRedirectEvent(&eBroadcaster.MapPlayersRedirectPayload{
+		CharGUIDs:               chars,
+		MapID:                   payload.MapID,
+		FromGameServerID:        payload.FromGameServerID,
+		ToGameServerID:          payload.ToGameServerID,
+		ToGameServerAddress:     payload.ToGameServerAddress,
+		ToGameServerGRPCAddress: payload.ToGameServerGRPCAddress,
+		ToGameServerAlias:       payload.ToGameServerAlias,
+	})
+}
+
+func (l *serversRegistryNatsListener) MapsReassignedEvent(payload *events.ServerRegistryEventGSMapsReassignedPayload) {
+	for _, server := range payload.Servers {
+		if !server.IsCrossRealm && server.RealmID != l.realmID {
+			continue
+		}
+
+		if len(server.MapsWithChangedRegions()) > 0 {
+			l.regions.Invalidate()
+			return
+		}
+	}
+}
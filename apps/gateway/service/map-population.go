@@ -0,0 +1,72 @@
+package service
+
+import (
+	"sort"
+	"sync"
+)
+
+// MapPopulation keeps track of game server and map of every character online on the gateway,
+// so servers registry can balance layers by real per map populations.
+type MapPopulation struct {
+	mu    sync.Mutex
+	chars map[uint64]characterLocation
+}
+
+type characterLocation struct {
+	gameServerID string
+	mapID        uint32
+}
+
+func NewMapPopulation() *MapPopulation {
+	return &MapPopulation{
+		chars: map[uint64]characterLocation{},
+	}
+}
+
+// SetCharacterLocation sets game server and map where character is.
+func (p *MapPopulation) SetCharacterLocation(charGUID uint64, gameServerID string, mapID uint32) {
+	p.mu.Lock()
+	p.chars[charGUID] = characterLocation{gameServerID: gameServerID, mapID: mapID}
+	p.mu.Unlock()
+}
+
+// RemoveCharacter removes character that left the world.
+func (p *MapPopulation) RemoveCharacter(charGUID uint64) {
+	p.mu.Lock()
+	delete(p.chars, charGUID)
+	p.mu.Unlock()
+}
+
+// MapPlayers returns players count per game server ID and map ID.
+func (p *MapPopulation) MapPlayers() map[string]map[uint32]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	res := map[string]map[uint32]int{}
+	for _, location := range p.chars {
+		if res[location.gameServerID] == nil {
+			res[location.gameServerID] = map[uint32]int{}
+		}
+		res[location.gameServerID][location.mapID]++
+	}
+	return res
+}
+
+// Characters returns up to limit characters that are on the map of the game server.
+// Characters are sorted by GUID, so batches of the same population are stable.
+func (p *MapPopulation) Characters(gameServerID string, mapID uint32, limit int) []uint64 {
+	p.mu.Lock()
+	res := []uint64{}
+	for charGUID, location := range p.chars {
+		if location.gameServerID == gameServerID && location.mapID == mapID {
+			res = append(res, charGUID)
+		}
+	}
+	p.mu.Unlock()
+
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	if len(res) > limit {
+		res = res[:limit]
+	}
+	return res
+}
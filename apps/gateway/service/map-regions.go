@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	root "github.com/walkline/ToCloud9/apps/gateway"
+	pbServ "github.com/walkline/ToCloud9/gen/servers-registry/pb"
+	"github.com/walkline/ToCloud9/shared/wow/grid"
+)
+
+type cachedMapRegions struct {
+	regions  []*pbServ.MapRegion
+	loadedAt time.Time
+}
+
+// MapRegions caches regions of the partitioned maps of the realm.
+type MapRegions struct {
+	client pbServ.ServersRegistryServiceClient
+	ttl    time.Duration
+
+	mu    sync.RWMutex
+	cache map[uint32]cachedMapRegions
+}
+
+// NewMapRegions creates MapRegions that reloads regions of the map after ttl.
+func NewMapRegions(client pbServ.ServersRegistryServiceClient, ttl time.Duration) *MapRegions {
+	return &MapRegions{
+		client: client,
+		ttl:    ttl,
+		cache:  map[uint32]cachedMapRegions{},
+	}
+}
+
+// Regions returns regions of the map ordered by grids, empty if the map is not partitioned.
+func (m *MapRegions) Regions(ctx context.Context, mapID uint32) ([]*pbServ.MapRegion, error) {
+	m.mu.RLock()
+	cached, found := m.cache[mapID]
+	m.mu.RUnlock()
+	if found && time.Since(cached.loadedAt) < m.ttl {
+		return cached.regions, nil
+	}
+
+	resp, err := m.client.GetMapRegions(ctx, &pbServ.GetMapRegionsRequest{
+		Api:     root.SupportedServerRegistryVer,
+		RealmID: root.RealmID,
+		MapID:   mapID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.cache[mapID] = cachedMapRegions{regions: resp.Regions, loadedAt: time.Now()}
+	m.mu.Unlock()
+
+	return resp.Regions, nil
+}
+
+// Invalidate drops cached regions, so they are reloaded with the next request.
+func (m *MapRegions) Invalidate() {
+	m.mu.Lock()
+	m.cache = map[uint32]cachedMapRegions{}
+	m.mu.Unlock()
+}
+
+// RegionRedirectTarget returns game server of the region with the X world coordinate if character should be redirected to it.
+// Character stays on the current game server while it's within band yards from the regions of the current game server,
+// so moving along the border doesn't redirect character back and forth.
+func RegionRedirectTarget(regions []*pbServ.MapRegion, currentServerID string, x, band float32) *pbServ.Server {
+	gridX := grid.XByPosition(x)
+
+	var target *pbServ.Server
+	for _, region := range regions {
+		if (grid.Range{Min: region.GridXMin, Max: region.GridXMax}).Contains(gridX) {
+			target = region.GameServer
+			break
+		}
+	}
+
+	if target == nil || target.ID == currentServerID {
+		return nil
+	}
+
+	for _, region := range regions {
+		if region.GameServer.GetID() != currentServerID {
+			continue
+		}
+		if (grid.Range{Min: region.GridXMin, Max: region.GridXMax}).Distance(x) <= band {
+			return nil
+		}
+	}
+
+	return target
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	pbServ "github.com/walkline/ToCloud9/gen/servers-registry/pb"
+	"github.com/walkline/ToCloud9/gen/servers-registry/pb/mocks"
+)
+
+func testRegions() []*pbServ.MapRegion {
+	// Grids 0-31 are positive X coordinates, grids 32-63 are negative.
+	return []*pbServ.MapRegion{
+		{MapID: 0, GridXMin: 0, GridXMax: 31, GameServer: &pbServ.Server{ID: "north"}},
+		{MapID: 0, GridXMin: 32, GridXMax: 63, GameServer: &pbServ.Server{ID: "south"}},
+	}
+}
+
+func TestRegionRedirectTarget(t *testing.T) {
+	regions := testRegions()
+
+	require.Nil(t, RegionRedirectTarget(regions, "north", 100, 50), "within own region")
+	require.Nil(t, RegionRedirectTarget(regions, "north", -40, 50), "within border band")
+	require.Equal(t, "south", RegionRedirectTarget(regions, "north", -60, 50).ID)
+
+	require.Nil(t, RegionRedirectTarget(regions, "south", 40, 50), "band works in both directions")
+	require.Equal(t, "north", RegionRedirectTarget(regions, "south", 60, 50).ID)
+
+	require.Equal(t, "south", RegionRedirectTarget(regions, "layer", -10, 50).ID, "server without regions of the map")
+	require.Nil(t, RegionRedirectTarget(nil, "north", -1000, 50), "not partitioned map")
+}
+
+func TestMapRegionsCachesUntilInvalidated(t *testing.T) {
+	client := mocks.NewServersRegistryServiceClient(t)
+	client.On("GetMapRegions", mock.Anything, mock.MatchedBy(func(req *pbServ.GetMapRegionsRequest) bool {
+		return req.MapID == 0
+	})).Return(&pbServ.GetMapRegionsResponse{Regions: testRegions()}, nil).Twice()
+
+	regions := NewMapRegions(client, time.Minute)
+
+	for i := 0; i < 2; i++ {
+		res, err := regions.Regions(context.Background(), 0)
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+	}
+
+	regions.Invalidate()
+
+	_, err := regions.Regions(context.Background(), 0)
+	require.NoError(t, err)
+	client.AssertNumberOfCalls(t, "GetMapRegions", 2)
+}
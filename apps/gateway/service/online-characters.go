@@ -0,0 +1,86 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// OnlineCharactersProducer is events.GatewayProducer that keeps track of characters online on the gateway,
+// so the gateway can send snapshot of them when characters service asks for resync.
+type OnlineCharactersProducer struct {
+	events.GatewayProducer
+
+	mu    sync.Mutex
+	chars map[uint64]events.GWEventCharacterLoggedInPayload
+}
+
+func NewOnlineCharactersProducer(producer events.GatewayProducer) *OnlineCharactersProducer {
+	return &OnlineCharactersProducer{
+		GatewayProducer: producer,
+		chars:           map[uint64]events.GWEventCharacterLoggedInPayload{},
+	}
+}
+
+func (p *OnlineCharactersProducer) CharacterLoggedIn(payload *events.GWEventCharacterLoggedInPayload) error {
+	p.mu.Lock()
+	p.chars[payload.CharGUID] = *payload
+	p.mu.Unlock()
+
+	return p.GatewayProducer.CharacterLoggedIn(payload)
+}
+
+func (p *OnlineCharactersProducer) CharacterLoggedOut(payload *events.GWEventCharacterLoggedOutPayload) error {
+	p.mu.Lock()
+	delete(p.chars, payload.CharGUID)
+	p.mu.Unlock()
+
+	return p.GatewayProducer.CharacterLoggedOut(payload)
+}
+
+func (p *OnlineCharactersProducer) CharactersUpdates(payload *events.GWEventCharactersUpdatesPayload) error {
+	p.mu.Lock()
+	for _, upd := range payload.Updates {
+		char, ok := p.chars[upd.ID]
+		if !ok {
+			continue
+		}
+
+		if upd.Lvl != nil {
+			char.CharLevel = *upd.Lvl
+		}
+		if upd.Zone != nil {
+			char.CharZone = *upd.Zone
+		}
+		if upd.Map != nil {
+			char.CharMap = *upd.Map
+		}
+		if upd.GuildID != nil {
+			char.CharGuildID = *upd.GuildID
+		}
+		if upd.ChatFlags != nil {
+			char.CharChatFlags = *upd.ChatFlags
+		}
+		if upd.AwayMsg != nil {
+			char.CharAwayMessage = *upd.AwayMsg
+		}
+		p.chars[upd.ID] = char
+	}
+	p.mu.Unlock()
+
+	return p.GatewayProducer.CharactersUpdates(payload)
+}
+
+// SendOnlineCharacters sends snapshot of characters online on the gateway.
+func (p *OnlineCharactersProducer) SendOnlineCharacters() error {
+	p.mu.Lock()
+	chars := make([]events.GWEventCharacterLoggedInPayload, 0, len(p.chars))
+	for _, char := range p.chars {
+		chars = append(chars, char)
+	}
+	p.mu.Unlock()
+
+	return p.GatewayProducer.OnlineCharacters(&events.GWEventOnlineCharactersPayload{
+		Characters: chars,
+	})
+}
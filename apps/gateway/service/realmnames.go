@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/walkline/ToCloud9/apps/gateway/repo"
 )
@@ -33,3 +34,19 @@ func (r *RealmNamesService) NameByID(ctx context.Context, realmID uint32) (strin
 	}
 	return realm.Name, nil
 }
+
+// IDByName returns id of the realm with the given name. Case and spaces are ignored,
+// since realm names are written without spaces in Name-Realm character names.
+func (r *RealmNamesService) IDByName(ctx context.Context, name string) (uint32, error) {
+	name = normalizeRealmName(name)
+	for realmID, realm := range r.cache {
+		if normalizeRealmName(realm.Name) == name {
+			return realmID, nil
+		}
+	}
+	return 0, errors.New("realm not found")
+}
+
+func normalizeRealmName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
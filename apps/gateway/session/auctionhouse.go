@@ -3,8 +3,10 @@ package session
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
+	root "github.com/walkline/ToCloud9/apps/gateway"
 	"github.com/walkline/ToCloud9/apps/gateway/packet"
 	pbAH "github.com/walkline/ToCloud9/gen/auctionhouse/pb"
 	"github.com/walkline/ToCloud9/gen/worldserver/pb"
@@ -497,3 +499,64 @@ func NewAuctionHouseServiceUnavailableErr(err error) error {
 		RealError: err,
 	}
 }
+
+// auctionPriceAddonPrefix is the prefix of the market stats line that can be parsed by addons.
+const auctionPriceAddonPrefix = "TC9AH"
+
+// handleCommandMsgAuctionPrice handles ".tc9 ah price <itemEntry> [days]" command.
+// Sends market stats of the item for the player's auction house as the addon-friendly line
+// "TC9AH:PRICE:<itemEntry>:<days>:<salesCount>:<volume>:<minPrice>:<medianPrice>:<meanPrice>",
+// and per day stats as "TC9AH:DAY:<itemEntry>:<dayStartTime>:<salesCount>:<volume>:<minPrice>:<medianPrice>:<meanPrice>".
+func (s *GameSession) handleCommandMsgAuctionPrice(ctx context.Context, args []string) error {
+	if s.character == nil {
+		return nil
+	}
+
+	if len(args) < 1 || len(args) > 2 {
+		s.SendSysMessage("Usage: .tc9 ah price <item-entry> [days]")
+		return nil
+	}
+
+	itemEntry, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		s.SendSysMessage("Item entry should be a number.")
+		return nil
+	}
+
+	days := uint64(7)
+	if len(args) == 2 {
+		days, err = strconv.ParseUint(args[1], 10, 16)
+		if err != nil || days == 0 {
+			s.SendSysMessage("Days should be a positive number.")
+			return nil
+		}
+	}
+
+	const secsInDay = 24 * 60 * 60
+	resp, err := s.auctionHouseServiceClient.MarketStats(ctx, &pbAH.AuctionMarketStatsRequest{
+		RealmID:    root.RealmID,
+		HouseID:    auctionHouseIDForRace(s.character.Race),
+		ItemEntry:  uint32(itemEntry),
+		FromTime:   time.Now().Unix() - int64(days)*secsInDay,
+		BucketSecs: secsInDay,
+	})
+	if err != nil {
+		return err
+	}
+
+	total := resp.Total
+	s.SendSysMessage(fmt.Sprintf("%s:PRICE:%d:%d:%d:%d:%d:%d:%d",
+		auctionPriceAddonPrefix, resp.ItemEntry, days,
+		total.GetSalesCount(), total.GetVolume(), total.GetMinPrice(), total.GetMedianPrice(), total.GetMeanPrice(),
+	))
+
+	for _, point := range resp.Series {
+		stats := point.Stats
+		s.SendSysMessage(fmt.Sprintf("%s:DAY:%d:%d:%d:%d:%d:%d:%d",
+			auctionPriceAddonPrefix, resp.ItemEntry, point.Time,
+			stats.GetSalesCount(), stats.GetVolume(), stats.GetMinPrice(), stats.GetMedianPrice(), stats.GetMeanPrice(),
+		))
+	}
+
+	return nil
+}
@@ -458,13 +458,17 @@ func (s *GameSession) SendChannelMessageToChat(ctx context.Context, channelName
 	switch resp.Status {
 	case pbChat.SendChannelMessageResponse_Ok:
 		// Echo the message back to the sender (like guild/party messages)
-		s.SendChannelMessage(channelName, s.character.GUID, s.character.Name, language, message)
+		s.SendChannelMessage(channelName, s.character.GUID, s.character.Name, language, resp.Message)
 	case pbChat.SendChannelMessageResponse_NotMember:
 		s.ChannelNotify(&ChannelInfo{Name: channelName}).Simple(ChatNotMemberNotice)
 	case pbChat.SendChannelMessageResponse_Muted:
 		s.ChannelNotify(&ChannelInfo{Name: channelName}).Simple(ChatMutedNotice)
 	case pbChat.SendChannelMessageResponse_Throttled:
 		s.ChannelNotify(&ChannelInfo{Name: channelName}).Simple(ChatThrottledNotice)
+	case pbChat.SendChannelMessageResponse_Blocked:
+		s.sendChatFilterBlocked()
+	case pbChat.SendChannelMessageResponse_ChatMuted:
+		s.sendChatFilterMuted(resp.MutedUntil)
 	}
 
 	return nil
@@ -22,6 +22,12 @@ func (s *GameSession) CharactersList(ctx context.Context, p *packet.Packet) erro
 		s.onLoggedOut()
 	}
 
+	// Player is back on the characters screen after logout requested by the draining gateway.
+	if s.handoff != nil {
+		s.continueHandoff()
+		return nil
+	}
+
 	r, err := s.charServiceClient.CharactersToLoginForAccount(ctx, &pbChar.CharactersToLoginForAccountRequest{
 		Api:       root.SupportedCharServiceVer,
 		AccountID: s.accountID,
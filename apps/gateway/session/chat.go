@@ -3,7 +3,9 @@ package session
 import (
 	"context"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	root "github.com/walkline/ToCloud9/apps/gateway"
 	eBroadcaster "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster"
@@ -12,6 +14,7 @@ import (
 	pbGroup "github.com/walkline/ToCloud9/gen/group/pb"
 	pbGuild "github.com/walkline/ToCloud9/gen/guilds/pb"
 	pbServ "github.com/walkline/ToCloud9/gen/servers-registry/pb"
+	"github.com/walkline/ToCloud9/shared/events"
 )
 
 type ChatType uint8
@@ -27,7 +30,10 @@ const (
 	ChatTypeWhisper
 	ChatTypeWhisperForeign
 	ChatTypeWhisperInform
+	ChatTypeEmote
 	ChatTypeChannel     = 0x11
+	ChatTypeAFK         = 0x17
+	ChatTypeDND         = 0x18
 	ChatTypeRaidLeader  = 0x27
 	ChatTypePartyLeader = 0x33
 )
@@ -61,33 +67,57 @@ func (s *GameSession) HandleChatMessage(ctx context.Context, p *packet.Packet) e
 	case ChatTypeWhisper:
 		to = r.String()
 		msg = r.String()
-		res, err := s.chatServiceClient.SendWhisperMessage(ctx, &pbChat.SendWhisperMessageRequest{
-			Api:          root.Ver,
-			RealmID:      root.RealmID,
-			SenderGUID:   s.character.GUID,
-			SenderName:   s.character.Name,
-			SenderRace:   uint32(s.character.Race),
-			Language:     lang,
-			ReceiverName: to,
-			Msg:          msg,
-		})
 
-		// TODO: handle response
+		receiverName, receiverRealmID, ok := s.splitCrossRealmName(ctx, to)
+		if !ok {
+			s.sendChatPlayerNotFound(to)
+			return nil
+		}
 
+		res, err := s.chatServiceClient.SendWhisperMessage(ctx, &pbChat.SendWhisperMessageRequest{
+			Api:             root.Ver,
+			RealmID:         root.RealmID,
+			SenderGUID:      s.character.GUID,
+			SenderName:      s.character.Name,
+			SenderRace:      uint32(s.character.Race),
+			Language:        lang,
+			ReceiverName:    receiverName,
+			Msg:             msg,
+			ReceiverRealmID: receiverRealmID,
+		})
 		if err != nil {
 			return err
 		}
 
+		switch res.Status {
+		case pbChat.SendWhisperMessageResponse_CharacterNotFound:
+			s.sendChatPlayerNotFound(to)
+			return nil
+		case pbChat.SendWhisperMessageResponse_Blocked:
+			s.sendChatFilterBlocked()
+			return nil
+		case pbChat.SendWhisperMessageResponse_Muted:
+			s.sendChatFilterMuted(res.MutedUntil)
+			return nil
+		}
+		msg = res.Msg
+
+		receiverGUID := clientPlayerGUID(receiverRealmID, res.ReceiverGUID)
+
 		resp := packet.NewWriterWithSize(packet.SMsgMessageChat, 0)
 		resp.Uint8(uint8(ChatTypeWhisperInform))
 		resp.Uint32(lang)
-		resp.Uint64(res.ReceiverGUID)
+		resp.Uint64(receiverGUID)
 		resp.Uint32(0) // some flags
-		resp.Uint64(res.ReceiverGUID)
+		resp.Uint64(receiverGUID)
 		resp.Uint32(uint32(len(msg) + 1))
 		resp.String(msg)
 		resp.Uint8(0) // chat tag
 		s.gameSocket.Send(resp)
+
+		if res.ReceiverChatFlags != 0 {
+			s.sendChatAutoReply(receiverGUID, res.ReceiverChatFlags, res.ReceiverAwayMessage)
+		}
 	case ChatTypeGuild:
 		msg = r.String()
 
@@ -100,6 +130,11 @@ func (s *GameSession) HandleChatMessage(ctx context.Context, p *packet.Packet) e
 			return nil
 		}
 
+		msg, ok := s.filterChatMessage(ctx, ChatType(msgType), msg)
+		if !ok {
+			return nil
+		}
+
 		_, err = s.guildServiceClient.SendGuildMessage(ctx, &pbGuild.SendGuildMessageParams{
 			Api:              root.Ver,
 			RealmID:          root.RealmID,
@@ -135,6 +170,11 @@ func (s *GameSession) HandleChatMessage(ctx context.Context, p *packet.Packet) e
 			return nil
 		}
 
+		msg, ok := s.filterChatMessage(ctx, ChatType(msgType), msg)
+		if !ok {
+			return nil
+		}
+
 		_, err = s.groupServiceClient.SendMessage(ctx, &pbGroup.SendGroupMessageParams{
 			Api:         root.Ver,
 			RealmID:     root.RealmID,
@@ -187,6 +227,15 @@ func (s *GameSession) HandleChatMessage(ctx context.Context, p *packet.Packet) e
 			return nil
 		}
 
+		return s.forwardFilteredChatMessage(ctx, p, msgType, lang, msg)
+	case ChatTypeYell, ChatTypeEmote:
+		msg = r.String()
+		return s.forwardFilteredChatMessage(ctx, p, msgType, lang, msg)
+	case ChatTypeAFK, ChatTypeDND:
+		msg = r.String()
+		s.handleChatStatusMessage(ChatType(msgType), msg)
+
+		// Game server still needs the message to set player flags.
 		if s.worldSocket != nil {
 			s.worldSocket.WriteChannel() <- p
 		}
@@ -205,21 +254,122 @@ func (s *GameSession) HandleChatMessage(ctx context.Context, p *packet.Packet) e
 
 func (s *GameSession) HandleEventIncomingWhisperMessage(ctx context.Context, e *eBroadcaster.Event) error {
 	eventData := e.Payload.(*eBroadcaster.IncomingWhisperPayload)
+	senderGUID := clientPlayerGUID(eventData.SenderRealmID, eventData.SenderGUID)
 
 	resp := packet.NewWriterWithSize(packet.SMsgMessageChat, 0)
 	resp.Uint8(uint8(ChatTypeWhisper))
 	resp.Uint32(eventData.Language)
-	resp.Uint64(eventData.SenderGUID)
+	resp.Uint64(senderGUID)
 	resp.Uint32(0) // some flags
-	resp.Uint64(eventData.SenderGUID)
+	resp.Uint64(senderGUID)
 	resp.Uint32(uint32(len(eventData.Msg) + 1))
 	resp.String(eventData.Msg)
-	resp.Uint8(0) // chat tag
+	resp.Uint8(eventData.SenderChatFlags) // chat tag
 	s.gameSocket.Send(resp)
 
 	return nil
 }
 
+// sendChatAutoReply sends AFK or DND auto-reply of the whisper receiver.
+func (s *GameSession) sendChatAutoReply(receiverGUID uint64, receiverChatFlags uint32, msg string) {
+	chatType := ChatTypeDND
+	if uint8(receiverChatFlags)&events.CharacterChatFlagAFK != 0 {
+		chatType = ChatTypeAFK
+	}
+
+	resp := packet.NewWriterWithSize(packet.SMsgMessageChat, 0)
+	resp.Uint8(uint8(chatType))
+	resp.Uint32(0) // language universal
+	resp.Uint64(receiverGUID)
+	resp.Uint32(0) // some flags
+	resp.Uint64(s.character.GUID)
+	resp.Uint32(uint32(len(msg) + 1))
+	resp.String(msg)
+	resp.Uint8(uint8(receiverChatFlags)) // chat tag
+	s.gameSocket.Send(resp)
+}
+
+// filterChatMessage passes the message that doesn't go through chat service to the chat service filter.
+// Returns false if message shouldn't be sent, in this case the player is already notified.
+// If the chat service is unavailable, the original message is sent when chatFilterFailOpen is enabled, otherwise it's blocked.
+func (s *GameSession) filterChatMessage(ctx context.Context, chatType ChatType, msg string) (string, bool) {
+	if !s.chatFilterEnabled {
+		return msg, true
+	}
+
+	res, err := s.chatServiceClient.FilterChatMessage(ctx, &pbChat.FilterChatMessageRequest{
+		Api:        root.Ver,
+		RealmID:    root.RealmID,
+		SenderGUID: s.character.GUID,
+		SenderName: s.character.Name,
+		ChatType:   uint32(chatType),
+		Message:    msg,
+	})
+	if err != nil {
+		if s.chatFilterFailOpen {
+			s.logger.Warn().Err(err).Msg("can't filter chat message, sending it unfiltered")
+			return msg, true
+		}
+
+		s.logger.Warn().Err(err).Msg("can't filter chat message, blocking it")
+		s.sendChatFilterBlocked()
+		return "", false
+	}
+
+	switch res.Status {
+	case pbChat.FilterChatMessageResponse_Blocked:
+		s.sendChatFilterBlocked()
+		return "", false
+	case pbChat.FilterChatMessageResponse_Muted:
+		s.sendChatFilterMuted(res.MutedUntil)
+		return "", false
+	}
+
+	return res.Message, true
+}
+
+// forwardFilteredChatMessage filters the message and forwards it to the world server,
+// the packet is rebuilt if the filter changed the text.
+func (s *GameSession) forwardFilteredChatMessage(ctx context.Context, p *packet.Packet, msgType, lang uint32, msg string) error {
+	filtered, ok := s.filterChatMessage(ctx, ChatType(msgType), msg)
+	if !ok {
+		return nil
+	}
+
+	if s.worldSocket == nil {
+		return nil
+	}
+
+	if filtered != msg {
+		p = packet.NewWriter(packet.CMsgMessageChat).
+			Uint32(msgType).
+			Uint32(lang).
+			String(filtered).
+			ToPacket()
+	}
+
+	s.worldSocket.WriteChannel() <- p
+	return nil
+}
+
+func (s *GameSession) sendChatFilterBlocked() {
+	s.SendSysMessage("Your message was blocked by the chat filter.")
+}
+
+func (s *GameSession) sendChatFilterMuted(mutedUntil int64) {
+	minutes := int(math.Ceil(time.Until(time.Unix(mutedUntil, 0)).Minutes()))
+	if minutes < 1 {
+		minutes = 1
+	}
+	s.SendSysMessage(fmt.Sprintf("You are muted by the chat filter for %d more minute(s).", minutes))
+}
+
+func (s *GameSession) sendChatPlayerNotFound(name string) {
+	resp := packet.NewWriterWithSize(packet.SMsgChatPlayerNotFound, 0)
+	resp.String(name)
+	s.gameSocket.Send(resp)
+}
+
 // TODO: rewrite commands handler with some better and more manageable constructions.
 func (s *GameSession) handleCommandMsgIfNeeded(ctx context.Context, msg string) ( /* isHandled */ bool, error) {
 	const TC9CommandPrefix = ".tc9 "
@@ -247,6 +397,18 @@ func (s *GameSession) handleCommandMsgIfNeeded(ctx context.Context, msg string)
 		default:
 			s.SendSysMessage("unk subcommand")
 		}
+	case "auctionhouse", "ah":
+		if len(args) < 2 {
+			s.SendSysMessage("not enough args")
+			return true, nil
+		}
+
+		switch strings.ToLower(args[1]) {
+		case "price", "stats":
+			return true, s.handleCommandMsgAuctionPrice(ctx, args[2:])
+		default:
+			s.SendSysMessage("unk subcommand")
+		}
 	case "gateways", "gw":
 		if len(args) < 2 {
 			s.SendSysMessage("not enough args")
@@ -331,7 +493,7 @@ func (s *GameSession) sendLayerConfiguration(ctx context.Context) error {
 			if alias == "" {
 				alias = layer.Address
 			}
-			s.SendSysMessage(fmt.Sprintf("  %s%s", alias, layerMarker))
+			s.SendSysMessage(fmt.Sprintf("  %s: %d players%s", alias, layer.Players, layerMarker))
 		}
 		for unavailable := len(stats.Layers); unavailable < int(configuredMap.LayerCount); unavailable++ {
 			s.SendSysMessage("  (unavailable)")
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// Flags of PLAYER_FLAGS field that reflect chat status of the player.
+const (
+	playerFlagAFK uint32 = 0x02
+	playerFlagDND uint32 = 0x04
+)
+
+// Default auto-reply messages of the game server (LANG_PLAYER_AFK_DEFAULT and LANG_PLAYER_DND_DEFAULT).
+const (
+	defaultAFKMessage = "Away from Keyboard"
+	defaultDNDMessage = "Do not Disturb"
+)
+
+// handleChatStatusMessage tracks AFK/DND status set with CHAT_MSG_AFK or CHAT_MSG_DND message
+// the same way game server does. Game server can ignore the message (e.g. in combat),
+// in this case status is fixed by syncChatStatusWithPlayerFlags on the next player flags update.
+func (s *GameSession) handleChatStatusMessage(chatType ChatType, msg string) {
+	char := s.character
+	if char == nil {
+		return
+	}
+
+	flag, otherFlag, defaultMsg := events.CharacterChatFlagAFK, events.CharacterChatFlagDND, defaultAFKMessage
+	if chatType == ChatTypeDND {
+		flag, otherFlag, defaultMsg = events.CharacterChatFlagDND, events.CharacterChatFlagAFK, defaultDNDMessage
+	}
+
+	flags, awayMsg := char.ChatFlags, msg
+	switch {
+	case flags&flag != 0 && msg == "":
+		// Empty message toggles status off.
+		flags &^= flag
+	case flags&flag == 0:
+		flags = flags&^otherFlag | flag
+		if awayMsg == "" {
+			awayMsg = defaultMsg
+		}
+	}
+
+	s.setChatStatus(flags, awayMsg)
+}
+
+// syncChatStatusWithPlayerFlags updates chat status with AFK/DND flags of PLAYER_FLAGS field,
+// since game server can toggle them without chat messages from the player.
+func (s *GameSession) syncChatStatusWithPlayerFlags(playerFlags uint32) {
+	char := s.character
+	if char == nil {
+		return
+	}
+
+	flags := uint8(0)
+	if playerFlags&playerFlagAFK != 0 {
+		flags |= events.CharacterChatFlagAFK
+	}
+	if playerFlags&playerFlagDND != 0 {
+		flags |= events.CharacterChatFlagDND
+	}
+
+	if flags == char.ChatFlags {
+		return
+	}
+
+	awayMsg := char.AwayMessage
+	switch {
+	case flags == 0:
+		awayMsg = ""
+	case awayMsg == "" && flags&events.CharacterChatFlagAFK != 0:
+		awayMsg = defaultAFKMessage
+	case awayMsg == "":
+		awayMsg = defaultDNDMessage
+	}
+
+	s.setChatStatus(flags, awayMsg)
+}
+
+// setChatStatus stores chat status of the character and publishes it to the online characters index.
+func (s *GameSession) setChatStatus(flags uint8, awayMsg string) {
+	if flags == 0 {
+		awayMsg = ""
+	}
+
+	char := s.character
+	if flags == char.ChatFlags && awayMsg == char.AwayMessage {
+		return
+	}
+
+	char.ChatFlags = flags
+	char.AwayMessage = awayMsg
+
+	s.charsUpdsBarrier.UpdateChatStatus(char.GUID, flags, awayMsg)
+}
@@ -0,0 +1,239 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/walkline/ToCloud9/apps/gateway/packet"
+	"github.com/walkline/ToCloud9/apps/gateway/service"
+	mocks "github.com/walkline/ToCloud9/apps/gateway/sockets/socketmock"
+	pbChat "github.com/walkline/ToCloud9/gen/chat/pb"
+	chatMocks "github.com/walkline/ToCloud9/gen/chat/pb/mocks"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+func chatMessagePacket(chatType ChatType, msg string) *packet.Packet {
+	return packet.NewWriter(packet.CMsgMessageChat).
+		Uint32(uint32(chatType)).
+		Uint32(7). // language
+		String(msg).
+		ToPacket()
+}
+
+func TestHandleChatMessageSayFiltered(t *testing.T) {
+	chatClient := &chatMocks.ChatServiceClient{}
+	chatClient.On("FilterChatMessage", mock.Anything, mock.MatchedBy(func(req *pbChat.FilterChatMessageRequest) bool {
+		return req.ChatType == uint32(ChatTypeSay) && req.Message == "darn it"
+	})).Return(&pbChat.FilterChatMessageResponse{
+		Status:  pbChat.FilterChatMessageResponse_Ok,
+		Message: "**** it",
+	}, nil)
+
+	worldWrite := make(chan *packet.Packet, 1)
+	worldSocket := &mocks.Socket{}
+	worldSocket.On("WriteChannel").Return((chan<- *packet.Packet)(worldWrite))
+
+	session := &GameSession{
+		logger:            &log.Logger,
+		worldSocket:       worldSocket,
+		chatServiceClient: chatClient,
+		chatFilterEnabled: true,
+		character:         &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	err := session.HandleChatMessage(context.Background(), chatMessagePacket(ChatTypeSay, "darn it"))
+	assert.NoError(t, err)
+
+	forwarded := <-worldWrite
+	r := forwarded.Reader()
+	assert.Equal(t, uint32(ChatTypeSay), r.Uint32())
+	assert.Equal(t, uint32(7), r.Uint32())
+	assert.Equal(t, "**** it", r.String())
+}
+
+func TestHandleChatMessageYellBlocked(t *testing.T) {
+	chatClient := &chatMocks.ChatServiceClient{}
+	chatClient.On("FilterChatMessage", mock.Anything, mock.Anything).Return(&pbChat.FilterChatMessageResponse{
+		Status: pbChat.FilterChatMessageResponse_Blocked,
+	}, nil)
+
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("Send", mock.MatchedBy(func(w *packet.Writer) bool {
+		return w.Opcode == packet.SMsgMessageChat
+	})).Return()
+
+	worldSocket := &mocks.Socket{}
+
+	session := &GameSession{
+		logger:            &log.Logger,
+		gameSocket:        gameSocket,
+		worldSocket:       worldSocket,
+		chatServiceClient: chatClient,
+		chatFilterEnabled: true,
+		character:         &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	err := session.HandleChatMessage(context.Background(), chatMessagePacket(ChatTypeYell, "gold4u"))
+	assert.NoError(t, err)
+
+	gameSocket.AssertNumberOfCalls(t, "Send", 1)
+	worldSocket.AssertNotCalled(t, "WriteChannel")
+}
+
+func TestHandleChatMessageFilterUnavailable(t *testing.T) {
+	chatClient := &chatMocks.ChatServiceClient{}
+	chatClient.On("FilterChatMessage", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+
+	p := chatMessagePacket(ChatTypeSay, "hello")
+
+	worldWrite := make(chan *packet.Packet, 1)
+	worldSocket := &mocks.Socket{}
+	worldSocket.On("WriteChannel").Return((chan<- *packet.Packet)(worldWrite))
+
+	session := &GameSession{
+		logger:             &log.Logger,
+		worldSocket:        worldSocket,
+		chatServiceClient:  chatClient,
+		chatFilterEnabled:  true,
+		chatFilterFailOpen: true,
+		character:          &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	err := session.HandleChatMessage(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Same(t, p, <-worldWrite, "original message is sent")
+}
+
+func TestHandleChatMessageFilterUnavailableFailClosed(t *testing.T) {
+	chatClient := &chatMocks.ChatServiceClient{}
+	chatClient.On("FilterChatMessage", mock.Anything, mock.Anything).Return(nil, errors.New("unavailable"))
+
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("Send", mock.MatchedBy(func(w *packet.Writer) bool {
+		return w.Opcode == packet.SMsgMessageChat
+	})).Return()
+
+	worldSocket := &mocks.Socket{}
+
+	session := &GameSession{
+		logger:            &log.Logger,
+		gameSocket:        gameSocket,
+		worldSocket:       worldSocket,
+		chatServiceClient: chatClient,
+		chatFilterEnabled: true,
+		character:         &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	err := session.HandleChatMessage(context.Background(), chatMessagePacket(ChatTypeSay, "hello"))
+	assert.NoError(t, err)
+
+	gameSocket.AssertNumberOfCalls(t, "Send", 1)
+	worldSocket.AssertNotCalled(t, "WriteChannel")
+}
+
+func TestHandleChatMessageFilterDisabled(t *testing.T) {
+	p := chatMessagePacket(ChatTypeSay, "hello")
+
+	worldWrite := make(chan *packet.Packet, 1)
+	worldSocket := &mocks.Socket{}
+	worldSocket.On("WriteChannel").Return((chan<- *packet.Packet)(worldWrite))
+
+	session := &GameSession{
+		logger:            &log.Logger,
+		worldSocket:       worldSocket,
+		chatServiceClient: &chatMocks.ChatServiceClient{},
+		character:         &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	err := session.HandleChatMessage(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Same(t, p, <-worldWrite)
+}
+
+func TestHandleChatMessageAFKStatus(t *testing.T) {
+	worldWrite := make(chan *packet.Packet, 4)
+	worldSocket := &mocks.Socket{}
+	worldSocket.On("WriteChannel").Return((chan<- *packet.Packet)(worldWrite))
+
+	session := &GameSession{
+		logger:           &log.Logger,
+		worldSocket:      worldSocket,
+		charsUpdsBarrier: service.NewCharactersUpdatesBarrier(&log.Logger, nil, time.Second),
+		character:        &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	send := func(chatType ChatType, msg string) {
+		assert.NoError(t, session.HandleChatMessage(context.Background(), chatMessagePacket(chatType, msg)))
+		<-worldWrite
+	}
+
+	send(ChatTypeAFK, "")
+	assert.Equal(t, events.CharacterChatFlagAFK, session.character.ChatFlags)
+	assert.Equal(t, defaultAFKMessage, session.character.AwayMessage)
+
+	send(ChatTypeAFK, "lunch")
+	assert.Equal(t, events.CharacterChatFlagAFK, session.character.ChatFlags)
+	assert.Equal(t, "lunch", session.character.AwayMessage)
+
+	send(ChatTypeDND, "busy")
+	assert.Equal(t, events.CharacterChatFlagDND, session.character.ChatFlags)
+	assert.Equal(t, "busy", session.character.AwayMessage)
+
+	send(ChatTypeDND, "")
+	assert.Equal(t, uint8(0), session.character.ChatFlags)
+	assert.Equal(t, "", session.character.AwayMessage)
+
+	// Game server can set status on its own, e.g. on idle.
+	session.syncChatStatusWithPlayerFlags(playerFlagAFK)
+	assert.Equal(t, events.CharacterChatFlagAFK, session.character.ChatFlags)
+	assert.Equal(t, defaultAFKMessage, session.character.AwayMessage)
+}
+
+func TestHandleChatMessageWhisperAutoReply(t *testing.T) {
+	chatClient := &chatMocks.ChatServiceClient{}
+	chatClient.On("SendWhisperMessage", mock.Anything, mock.Anything).Return(&pbChat.SendWhisperMessageResponse{
+		Status:              pbChat.SendWhisperMessageResponse_Ok,
+		ReceiverGUID:        2,
+		Msg:                 "hi",
+		ReceiverChatFlags:   uint32(events.CharacterChatFlagDND),
+		ReceiverAwayMessage: "raiding",
+	}, nil)
+
+	var sent []*packet.Writer
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent = append(sent, args.Get(0).(*packet.Writer))
+	}).Return()
+
+	session := &GameSession{
+		logger:            &log.Logger,
+		gameSocket:        gameSocket,
+		chatServiceClient: chatClient,
+		character:         &LoggedInCharacter{GUID: 1, Name: "Player"},
+	}
+
+	p := packet.NewWriter(packet.CMsgMessageChat).
+		Uint32(uint32(ChatTypeWhisper)).
+		Uint32(7).
+		String("Receiver").
+		String("hi").
+		ToPacket()
+	assert.NoError(t, session.HandleChatMessage(context.Background(), p))
+
+	if assert.Len(t, sent, 2) {
+		r := sent[1].ToPacket().Reader()
+		assert.Equal(t, uint8(ChatTypeDND), r.Uint8())
+		assert.Equal(t, uint32(0), r.Uint32())
+		assert.Equal(t, uint64(2), r.Uint64())
+		r.Uint32()
+		assert.Equal(t, uint64(1), r.Uint64())
+		r.Uint32()
+		assert.Equal(t, "raiding", r.String())
+	}
+}
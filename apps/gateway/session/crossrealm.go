@@ -0,0 +1,48 @@
+package session
+
+import (
+	"context"
+	"strings"
+
+	root "github.com/walkline/ToCloud9/apps/gateway"
+	"github.com/walkline/ToCloud9/shared/wow/guid"
+)
+
+// splitCrossRealmName splits Name-Realm character name into the name and realm id.
+// Realm id is 0 for names without realm and for the gateway realm.
+// Returns false if the realm is unknown.
+func (s *GameSession) splitCrossRealmName(ctx context.Context, fullName string) (string, uint32, bool) {
+	i := strings.IndexByte(fullName, '-')
+	if i < 0 {
+		return fullName, 0, true
+	}
+
+	realmID, err := s.realmNamesService.IDByName(ctx, fullName[i+1:])
+	if err != nil {
+		return fullName[:i], 0, false
+	}
+
+	if realmID == root.RealmID {
+		realmID = 0
+	}
+
+	return fullName[:i], realmID, true
+}
+
+// clientPlayerGUID returns guid of the player that client uses, players from other realms have cross-realm guids.
+func clientPlayerGUID(realmID uint32, charGUID uint64) uint64 {
+	if realmID == 0 || realmID == root.RealmID {
+		return charGUID
+	}
+	return guid.NewCrossrealmPlayerGUID(uint16(realmID), guid.LowType(charGUID)).GetRawValue()
+}
+
+// playerRealmAndGUID is reverse of clientPlayerGUID, realm id is 0 for players from the gateway realm.
+func playerRealmAndGUID(clientGUID uint64) (uint32, uint64) {
+	g := guid.New(clientGUID)
+	realmID := uint32(g.GetRealmID())
+	if realmID == root.RealmID {
+		realmID = 0
+	}
+	return realmID, uint64(g.GetCounter())
+}
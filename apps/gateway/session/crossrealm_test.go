@@ -0,0 +1,71 @@
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	root "github.com/walkline/ToCloud9/apps/gateway"
+	"github.com/walkline/ToCloud9/apps/gateway/repo"
+	"github.com/walkline/ToCloud9/apps/gateway/service"
+)
+
+type realmNamesRepoMock struct {
+	names []*repo.RealmName
+}
+
+func (r *realmNamesRepoMock) LoadRealmNames(context.Context) ([]*repo.RealmName, error) {
+	return r.names, nil
+}
+
+func TestSplitCrossRealmName(t *testing.T) {
+	defer func(realmID uint32) { root.RealmID = realmID }(root.RealmID)
+	root.RealmID = 1
+
+	realmNames, err := service.NewRealmNamesService(context.Background(), &realmNamesRepoMock{names: []*repo.RealmName{
+		{RealmID: 1, Name: "Home"},
+		{RealmID: 2, Name: "Other Realm"},
+	}})
+	assert.NoError(t, err)
+
+	s := &GameSession{realmNamesService: realmNames}
+
+	tests := []struct {
+		fullName string
+		name     string
+		realmID  uint32
+		ok       bool
+	}{
+		{fullName: "Arthas", name: "Arthas", realmID: 0, ok: true},
+		{fullName: "Arthas-Home", name: "Arthas", realmID: 0, ok: true},
+		{fullName: "Arthas-OtherRealm", name: "Arthas", realmID: 2, ok: true},
+		{fullName: "Arthas-otherrealm", name: "Arthas", realmID: 2, ok: true},
+		{fullName: "Arthas-Unknown", name: "Arthas", realmID: 0, ok: false},
+	}
+	for _, tt := range tests {
+		name, realmID, ok := s.splitCrossRealmName(context.Background(), tt.fullName)
+		assert.Equal(t, tt.name, name, tt.fullName)
+		assert.Equal(t, tt.realmID, realmID, tt.fullName)
+		assert.Equal(t, tt.ok, ok, tt.fullName)
+	}
+}
+
+func TestClientPlayerGUID(t *testing.T) {
+	defer func(realmID uint32) { root.RealmID = realmID }(root.RealmID)
+	root.RealmID = 1
+
+	assert.Equal(t, uint64(42), clientPlayerGUID(0, 42))
+	assert.Equal(t, uint64(42), clientPlayerGUID(1, 42))
+
+	crossRealmGUID := clientPlayerGUID(2, 42)
+	assert.NotEqual(t, uint64(42), crossRealmGUID)
+
+	realmID, lowGUID := playerRealmAndGUID(crossRealmGUID)
+	assert.Equal(t, uint32(2), realmID)
+	assert.Equal(t, uint64(42), lowGUID)
+
+	realmID, lowGUID = playerRealmAndGUID(42)
+	assert.Equal(t, uint32(0), realmID)
+	assert.Equal(t, uint64(42), lowGUID)
+}
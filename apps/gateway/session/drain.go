@@ -0,0 +1,173 @@
+package session
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/gateway/packet"
+)
+
+// handoffLogoutRetryInterval is time to wait for the logout requested by the gateway
+// before it's requested again, e.g. when player cancelled it.
+const handoffLogoutRetryInterval = time.Second * 30
+
+// Drainer keeps track of the sessions of the gateway and hands off their players
+// to other gateways when the gateway is draining.
+type Drainer struct {
+	mu sync.Mutex
+
+	// sessions of the gateway, value is true if handoff is requested for the session.
+	sessions map[*GameSession]bool
+}
+
+func NewDrainer() *Drainer {
+	return &Drainer{
+		sessions: map[*GameSession]bool{},
+	}
+}
+
+// Sessions returns count of the sessions on the gateway.
+func (d *Drainer) Sessions() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.sessions)
+}
+
+// Drain hands off players of batchSize sessions every interval and continues handoff of the previous batches,
+// until there are no sessions left or ctx is done. Remaining sessions are reported with every batch.
+// Sessions that are created during the drain are handed off too.
+func (d *Drainer) Drain(ctx context.Context, batchSize int, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		remaining := d.handoffBatch(batchSize)
+		if remaining == 0 {
+			return nil
+		}
+
+		log.Info().Int("remainingSessions", remaining).Msg("Draining gateway...")
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Disconnect closes remaining sessions and waits until they are logged out or ctx is done.
+func (d *Drainer) Disconnect(ctx context.Context) {
+	d.mu.Lock()
+	for s := range d.sessions {
+		s.gameSocket.Close()
+	}
+	d.mu.Unlock()
+
+	ticker := time.NewTicker(time.Millisecond * 100)
+	defer ticker.Stop()
+	for d.Sessions() > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Drainer) handoffBatch(batchSize int) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	requested := 0
+	for s, handoff := range d.sessions {
+		if !handoff {
+			if requested >= batchSize {
+				continue
+			}
+			requested++
+		}
+
+		// Session can be busy, then it's requested again with the next batch.
+		if s.requestHandoff() {
+			d.sessions[s] = true
+		}
+	}
+	return len(d.sessions)
+}
+
+func (d *Drainer) add(s *GameSession) {
+	d.mu.Lock()
+	d.sessions[s] = false
+	d.mu.Unlock()
+}
+
+func (d *Drainer) remove(s *GameSession) {
+	d.mu.Lock()
+	delete(d.sessions, s)
+	d.mu.Unlock()
+}
+
+// handoff is state of the player handoff to another gateway.
+type handoff struct {
+	logoutRequestedAt      time.Time
+	awaitingLogoutResponse bool
+}
+
+// requestHandoff asks session to continue handoff of the player, returns false if session is busy.
+func (s *GameSession) requestHandoff() bool {
+	select {
+	case s.sessionSafeFuChan <- (*GameSession).continueHandoff:
+		return true
+	default:
+		return false
+	}
+}
+
+// continueHandoff moves player off the gateway at the safe moment.
+// Player on the characters screen is disconnected, so the client logs in again through the realm list,
+// that selects gateway which is not draining. Player in the world is logged out by the game server first,
+// game server refuses the logout in unsafe moments like combat, so logout is requested until it succeeds.
+func (s *GameSession) continueHandoff() {
+	if s.handoff == nil {
+		s.handoff = &handoff{}
+		if s.character != nil {
+			s.SendSysMessage("This gateway is going down for maintenance. You will be returned to the character selection screen, please log in again to continue playing.")
+		}
+	}
+
+	if s.character == nil {
+		s.logger.Info().Msg("Disconnecting session of the draining gateway")
+		s.gameSocket.Close()
+		return
+	}
+
+	if s.worldSocket == nil || s.worldEntryPending || s.teleportingToNewMap != nil || s.handoff.awaitingLogoutResponse {
+		return
+	}
+
+	if time.Since(s.handoff.logoutRequestedAt) < handoffLogoutRetryInterval {
+		return
+	}
+
+	s.handoff.logoutRequestedAt = time.Now()
+	s.handoff.awaitingLogoutResponse = true
+	s.worldSocket.Send(packet.NewWriterWithSize(packet.CMsgLogoutRequest, 0))
+}
+
+// InterceptLogoutResponse hides the refused logout that gateway requested to hand off the player, so it's retried silently.
+func (s *GameSession) InterceptLogoutResponse(ctx context.Context, p *packet.Packet) error {
+	if s.handoff != nil && s.handoff.awaitingLogoutResponse {
+		s.handoff.awaitingLogoutResponse = false
+		if reason := p.Reader().Uint32(); reason != 0 {
+			s.handoff.logoutRequestedAt = time.Time{}
+			return nil
+		}
+	}
+
+	s.gameSocket.SendPacket(p)
+	return nil
+}
@@ -0,0 +1,96 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/walkline/ToCloud9/apps/gateway/packet"
+	mocks "github.com/walkline/ToCloud9/apps/gateway/sockets/socketmock"
+)
+
+func TestDrainerHandsOffInBatches(t *testing.T) {
+	drainer := NewDrainer()
+	sessions := make([]*GameSession, 5)
+	for i := range sessions {
+		sessions[i] = &GameSession{sessionSafeFuChan: make(chan func(*GameSession), 10)}
+		drainer.add(sessions[i])
+	}
+
+	requested := func() (res int) {
+		for _, s := range sessions {
+			res += len(s.sessionSafeFuChan)
+		}
+		return res
+	}
+
+	assert.Equal(t, 5, drainer.handoffBatch(2))
+	assert.Equal(t, 2, requested())
+
+	// Handoff of the previous batch continues with the next one.
+	assert.Equal(t, 5, drainer.handoffBatch(2))
+	assert.Equal(t, 6, requested())
+
+	drainer.remove(sessions[0])
+	assert.Equal(t, 4, drainer.Sessions())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	assert.ErrorIs(t, drainer.Drain(ctx, 2, time.Millisecond), context.DeadlineExceeded)
+
+	for _, s := range sessions {
+		drainer.remove(s)
+	}
+	assert.NoError(t, drainer.Drain(context.Background(), 2, time.Millisecond))
+}
+
+func TestContinueHandoffDisconnectsCharactersScreen(t *testing.T) {
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("Close").Return()
+
+	session := &GameSession{logger: &log.Logger, gameSocket: gameSocket}
+	session.continueHandoff()
+
+	gameSocket.AssertCalled(t, "Close")
+}
+
+func TestContinueHandoffRetriesRefusedLogout(t *testing.T) {
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("Send", mock.Anything).Return()
+	gameSocket.On("SendPacket", mock.Anything).Return()
+
+	logoutRequests := 0
+	worldSocket := &mocks.Socket{}
+	worldSocket.On("Send", mock.MatchedBy(func(p *packet.Writer) bool {
+		return p.Opcode == packet.CMsgLogoutRequest
+	})).Run(func(mock.Arguments) { logoutRequests++ }).Return()
+
+	session := &GameSession{
+		logger:      &log.Logger,
+		gameSocket:  gameSocket,
+		worldSocket: worldSocket,
+		character:   &LoggedInCharacter{GUID: 40554},
+	}
+
+	session.continueHandoff()
+	session.continueHandoff()
+	assert.Equal(t, 1, logoutRequests, "logout is requested once until the response")
+
+	refused := packet.NewWriter(packet.SMsgLogoutResponse).Uint32(1).Uint8(0).ToPacket()
+	assert.NoError(t, session.InterceptLogoutResponse(context.Background(), refused))
+	gameSocket.AssertNotCalled(t, "SendPacket", refused)
+
+	session.continueHandoff()
+	assert.Equal(t, 2, logoutRequests, "refused logout is requested again")
+
+	accepted := packet.NewWriter(packet.SMsgLogoutResponse).Uint32(0).Uint8(0).ToPacket()
+	assert.NoError(t, session.InterceptLogoutResponse(context.Background(), accepted))
+	gameSocket.AssertCalled(t, "SendPacket", accepted)
+
+	session.continueHandoff()
+	assert.Equal(t, 2, logoutRequests, "accepted logout is not requested again while it's in progress")
+}
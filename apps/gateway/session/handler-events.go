@@ -56,6 +56,8 @@ var EventsHandleMap = map[eBroadcaster.EventType]EventsHandlersQueue{
 	eBroadcaster.EventTypeChannelJoined:       NewEventHandler("ChannelJoined", (*GameSession).HandleEventChannelJoined),
 	eBroadcaster.EventTypeChannelLeft:         NewEventHandler("ChannelLeft", (*GameSession).HandleEventChannelLeft),
 	eBroadcaster.EventTypeChannelNotification: NewEventHandler("ChannelNotification", (*GameSession).HandleEventChannelNotification),
+
+	eBroadcaster.EventTypeMapPlayersRedirect: NewEventHandler("MapPlayersRedirect", (*GameSession).HandleEventMapPlayersRedirect),
 }
 
 type EventHandler func(*GameSession, context.Context, *eBroadcaster.Event) error
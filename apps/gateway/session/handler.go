@@ -77,6 +77,7 @@ var HandleMap = map[packet.Opcode]HandlersQueue{
 	packet.CMsgMailTakeMoney:          NewHandler("CMsgMailTakeMoney", (*GameSession).HandleMailTakeMoney),
 	packet.CMsgMailTakeItem:           NewHandler("CMsgMailTakeItem", (*GameSession).HandleMailTakeItem),
 	packet.CMsgMailDelete:             NewHandler("CMsgMailDelete", (*GameSession).HandleDeleteMail),
+	packet.CMsgMailReturnToSender:     NewHandler("CMsgMailReturnToSender", (*GameSession).HandleMailReturnToSender),
 	packet.MsgQueryNextMailTime:       NewHandler("MsgQueryNextMailTime", (*GameSession).HandleQueryNextMailTime),
 	packet.SMsgInitWorldStates:        NewHandler("SMsgInitWorldStates", (*GameSession).InterceptInitWorldStates),
 	packet.SMsgLevelUpInfo:            NewHandler("SMsgLevelUpInfo", (*GameSession).InterceptLevelUpInfo),
@@ -91,6 +92,8 @@ var HandleMap = map[packet.Opcode]HandlersQueue{
 	packet.SMsgMOTD:                   NewHandler("SMsgMOTD", (*GameSession).InterceptMessageOfTheDay),
 	packet.SMsgAccountDataTimes:       NewHandler("SMsgAccountDataTimes", (*GameSession).InterceptAccountDataTimes),
 
+	packet.SMsgLogoutResponse: NewHandler("SMsgLogoutResponse", (*GameSession).InterceptLogoutResponse),
+
 	packet.TC9SMsgReadyForRedirect: NewHandler("TC9SMsgReadyForRedirect", (*GameSession).HandleReadyForRedirectRequest),
 
 	packet.SMsgNameQueryResponse: NewHandler("SMsgNameQueryResponse", (*GameSession).InterceptSMsgNameQueryResponse),
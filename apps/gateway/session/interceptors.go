@@ -27,6 +27,11 @@ func (s *GameSession) InterceptInitWorldStates(ctx context.Context, p *packet.Pa
 		s.character.Map = uint32(mapID)
 	}
 
+	// Init world states are sent on every world entry, including login, map change and layer switch.
+	if s.mapPopulation != nil && s.currentGameServerID != "" {
+		s.mapPopulation.SetCharacterLocation(s.character.GUID, s.currentGameServerID, s.character.Map)
+	}
+
 	if s.character.Zone != uint32(zoneID) {
 		s.charsUpdsBarrier.UpdateZone(s.character.GUID, uint32(areaID), uint32(zoneID))
 		s.character.Zone = uint32(zoneID)
@@ -158,15 +163,31 @@ func (s *GameSession) trackCharacterStats(data []byte) {
 		changed = true
 	}
 
+	// Guild can be created or disbanded by the game server, so membership is tracked by the player field.
+	if upd.GuildID != nil && *upd.GuildID != char.GuildID {
+		char.GuildID = *upd.GuildID
+		guildID := char.GuildID
+		barrierUpd.GuildID = &guildID
+		changed = true
+	}
+
 	if changed {
 		s.charsUpdsBarrier.Update(barrierUpd)
 	}
+
+	if upd.PlayerFlags != nil {
+		char.PlayerFlags = *upd.PlayerFlags
+		s.syncChatStatusWithPlayerFlags(char.PlayerFlags)
+	}
 }
 
 func (s *GameSession) InterceptNewWorld(ctx context.Context, p *packet.Packet) error {
-	mapID := p.Reader().Uint32()
+	r := p.Reader()
+	mapID := r.Uint32()
 	if s.character.ignoreNextInterceptToNewMap == nil || mapID != *s.character.ignoreNextInterceptToNewMap {
 		s.teleportingToNewMap = &mapID
+		// Destination is needed to select game server of the partitioned map region.
+		s.character.PositionX, s.character.PositionY, s.character.PositionZ, s.character.PositionO = r.Float32(), r.Float32(), r.Float32(), r.Float32()
 	}
 	s.gameSocket.SendPacket(p)
 	return nil
@@ -192,7 +213,7 @@ func (s *GameSession) InterceptMoveWorldPortAck(ctx context.Context, p *packet.P
 	s.teleportingToNewMap = nil
 	s.character.ignoreNextInterceptToNewMap = nil
 
-	desiredServer, err := s.selectGameServerForMap(ctx, mapID)
+	desiredServer, err := s.selectGameServerForMap(ctx, mapID, s.character.GUID, s.character.GuildID, s.character.PositionX)
 	if err != nil {
 		return err
 	}
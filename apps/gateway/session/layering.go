@@ -6,6 +6,7 @@ import (
 	"time"
 
 	root "github.com/walkline/ToCloud9/apps/gateway"
+	eBroadcaster "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster"
 	"github.com/walkline/ToCloud9/apps/gateway/packet"
 	"github.com/walkline/ToCloud9/apps/gateway/sockets"
 	pbServ "github.com/walkline/ToCloud9/gen/servers-registry/pb"
@@ -18,6 +19,7 @@ func (s *GameSession) selectLayerGameServer(ctx context.Context, groupID uint32,
 	response, err := s.serversRegistryClient.AvailableGameServersForMapAndRealm(ctx, &pbServ.AvailableGameServersForMapAndRealmRequest{
 		Api: root.SupportedServerRegistryVer, RealmID: root.RealmID, MapID: s.character.Map,
 		GroupID: groupID, PreferredGameServerAlias: preferredAlias,
+		CharacterGUID: s.character.GUID, GuildID: uint64(s.character.GuildID), PositionX: &s.character.PositionX,
 	})
 	if err != nil || len(response.GameServers) == 0 {
 		return nil, err
@@ -59,6 +61,33 @@ func (s *GameSession) redirectToSelectedLayer(ctx context.Context, server *pbSer
 	return nil
 }
 
+// HandleEventMapPlayersRedirect moves character of the migrating map to the game server that handles the map now.
+func (s *GameSession) HandleEventMapPlayersRedirect(ctx context.Context, e *eBroadcaster.Event) error {
+	eventData := e.Payload.(*eBroadcaster.MapPlayersRedirectPayload)
+
+	// Character could change map or game server after the batch was chosen.
+	if s.character == nil || s.worldSocket == nil || s.character.Map != eventData.MapID || s.currentGameServerID != eventData.FromGameServerID {
+		return nil
+	}
+
+	err := s.redirectToSelectedLayer(ctx, &pbServ.Server{
+		ID:          eventData.ToGameServerID,
+		Address:     eventData.ToGameServerAddress,
+		GrpcAddress: eventData.ToGameServerGRPCAddress,
+		Alias:       eventData.ToGameServerAlias,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Updated right away, so the next redirect batch doesn't pick this character again.
+	if s.mapPopulation != nil {
+		s.mapPopulation.SetCharacterLocation(s.character.GUID, s.currentGameServerID, s.character.Map)
+	}
+
+	return nil
+}
+
 func (s *GameSession) layerPlayerRedirect(ctx context.Context, characterGUID uint64, address, layerAlias string) error {
 	if s.worldSocket == nil || s.character == nil {
 		return nil
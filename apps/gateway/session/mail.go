@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	root "github.com/walkline/ToCloud9/apps/gateway"
 	eBroadcaster "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster"
 	"github.com/walkline/ToCloud9/apps/gateway/packet"
@@ -654,6 +657,48 @@ func (s *GameSession) HandleDeleteMail(ctx context.Context, p *packet.Packet) er
 	return nil
 }
 
+func (s *GameSession) HandleMailReturnToSender(ctx context.Context, p *packet.Packet) error {
+	reader := p.Reader()
+	mailBox := reader.Uint64()
+	mailID := reader.Int32()
+	/*senderGUID :=*/ reader.Uint64()
+
+	canInteract, err := s.CanInteractWithMailingObject(ctx, mailBox)
+	if err != nil {
+		return err
+	}
+	if !canInteract {
+		return fmt.Errorf("player '%d' tried to interact with '%d' object, that not in reach", s.character.GUID, mailBox)
+	}
+
+	// Mail service checks that the mail belongs to the player and can be returned.
+	_, err = s.mailServiceClient.ReturnMailToSender(ctx, &pbMail.ReturnMailToSenderRequest{
+		Api:        root.SupportedMailServiceVer,
+		RealmID:    root.RealmID,
+		PlayerGuid: &s.character.GUID,
+		MailID:     mailID,
+	})
+	if err != nil {
+		if code := status.Code(err); code == codes.FailedPrecondition || code == codes.PermissionDenied {
+			s.gameSocket.SendPacket(mailResult{
+				MailID: uint(mailID),
+				Type:   MailResponseTypeReturnedToSender,
+				Status: MailResponseStatusInternalError,
+			}.BuildPacket())
+			return nil
+		}
+		return NewMailServiceUnavailableErr(err)
+	}
+
+	s.gameSocket.SendPacket(mailResult{
+		MailID: uint(mailID),
+		Type:   MailResponseTypeReturnedToSender,
+		Status: MailResponseStatusOk,
+	}.BuildPacket())
+
+	return nil
+}
+
 func (s *GameSession) CanInteractWithMailingObject(ctx context.Context, object uint64) (bool, error) {
 	switch guid.New(object).GetHigh() {
 	case guid.GameObject:
@@ -0,0 +1,46 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/walkline/ToCloud9/apps/gateway/service"
+)
+
+// mapRegionRedirectRetryDelay is delay before the next redirect attempt to another region after failed one.
+const mapRegionRedirectRetryDelay = 10 * time.Second
+
+// checkMapRegionBorder redirects character to the game server of the partitioned map region
+// when character goes beyond the border band of the regions of its current game server.
+func (s *GameSession) checkMapRegionBorder(ctx context.Context) error {
+	if s.mapRegions == nil || s.character == nil || s.worldSocket == nil || s.worldEntryPending || s.teleportingToNewMap != nil {
+		return nil
+	}
+
+	if time.Now().Before(s.mapRegionRedirectRetryAt) {
+		return nil
+	}
+
+	regions, err := s.mapRegions.Regions(ctx, s.character.Map)
+	if err != nil {
+		s.mapRegionRedirectRetryAt = time.Now().Add(mapRegionRedirectRetryDelay)
+		return fmt.Errorf("can't load regions of map %d: %w", s.character.Map, err)
+	}
+
+	server := service.RegionRedirectTarget(regions, s.currentGameServerID, s.character.PositionX, s.mapRegionBorderBand)
+	if server == nil {
+		return nil
+	}
+
+	if err = s.redirectToSelectedLayer(ctx, server); err != nil {
+		s.mapRegionRedirectRetryAt = time.Now().Add(mapRegionRedirectRetryDelay)
+		return fmt.Errorf("can't redirect to region of map %d: %w", s.character.Map, err)
+	}
+
+	if s.mapPopulation != nil {
+		s.mapPopulation.SetCharacterLocation(s.character.GUID, s.currentGameServerID, s.character.Map)
+	}
+
+	return nil
+}
@@ -34,5 +34,5 @@ func (s *GameSession) HandleMovement(ctx context.Context, p *packet.Packet) erro
 
 	s.character.PositionX, s.character.PositionY, s.character.PositionZ, s.character.PositionO = r.Float32(), r.Float32(), r.Float32(), r.Float32()
 
-	return nil
+	return s.checkMapRegionBorder(ctx)
 }
@@ -57,6 +57,8 @@ type GameSession struct {
 	eventsBroadcaster             eBroadcaster.Broadcaster
 	chatChannelsEventsBroadcaster *eBroadcaster.ChatChannelsService
 	charsUpdsBarrier              *service.CharactersUpdatesBarrier
+	mapPopulation                 *service.MapPopulation
+	mapRegions                    *service.MapRegions
 	realmNamesService             *service.RealmNamesService
 	gameServerGRPCConnMgr         conn.GameServerGRPCConnMgr
 
@@ -96,12 +98,29 @@ type GameSession struct {
 	worldserverChannelBufferMu sync.Mutex
 	worldserverChannelTimer    *time.Timer
 
+	// chatFilterEnabled when enabled passes say, yell, emote, guild and group messages through chat service filter.
+	chatFilterEnabled bool
+
+	// chatFilterFailOpen when enabled sends messages unfiltered if chat service filter is unavailable.
+	chatFilterFailOpen bool
+
 	// showGameserverConnChangeToClient when enabled sends chat system message
 	// to the player with information about connection change.
 	showGameserverConnChangeToClient bool
 	currentGameServerID              string
 	currentGameServerAlias           string
 	currentGroupID                   uint32
+
+	// mapRegionBorderBand is distance in yards beyond the regions of the current game server
+	// that character can go on the partitioned map before redirect.
+	mapRegionBorderBand float32
+
+	// mapRegionRedirectRetryAt is time when failed redirect to another region can be retried.
+	mapRegionRedirectRetryAt time.Time
+
+	// drainer hands off the player to another gateway when gateway is draining.
+	drainer *Drainer
+	handoff *handoff
 }
 
 type GameSessionParams struct {
@@ -115,12 +134,18 @@ type GameSessionParams struct {
 	GroupServiceClient               pbGroup.GroupServiceClient
 	EventsProducer                   events.GatewayProducer
 	CharsUpdsBarrier                 *service.CharactersUpdatesBarrier
+	MapPopulation                    *service.MapPopulation
+	MapRegions                       *service.MapRegions
 	RealmNamesService                *service.RealmNamesService
 	EventsBroadcaster                eBroadcaster.Broadcaster
 	ChatChannelsEventBroadcaster     *eBroadcaster.ChatChannelsService
 	GameServerGRPCConnMgr            conn.GameServerGRPCConnMgr
 	PacketProcessTimeout             time.Duration
 	ShowGameserverConnChangeToClient bool
+	ChatFilterEnabled                bool
+	ChatFilterFailOpen               bool
+	MapRegionBorderBand              float32
+	Drainer                          *Drainer
 }
 
 func NewGameSession(
@@ -153,9 +178,15 @@ func NewGameSession(
 		eventsBroadcaster:                params.EventsBroadcaster,
 		chatChannelsEventsBroadcaster:    params.ChatChannelsEventBroadcaster,
 		charsUpdsBarrier:                 params.CharsUpdsBarrier,
+		mapPopulation:                    params.MapPopulation,
+		mapRegions:                       params.MapRegions,
 		realmNamesService:                params.RealmNamesService,
 		gameServerGRPCConnMgr:            params.GameServerGRPCConnMgr,
 		showGameserverConnChangeToClient: params.ShowGameserverConnChangeToClient,
+		chatFilterEnabled:                params.ChatFilterEnabled,
+		chatFilterFailOpen:               params.ChatFilterFailOpen,
+		mapRegionBorderBand:              params.MapRegionBorderBand,
+		drainer:                          params.Drainer,
 
 		sessionSafeFuChan:        make(chan func(*GameSession), 100),
 		packetProcessTimeout:     packetProcessTimeout,
@@ -172,6 +203,11 @@ func (s *GameSession) HandlePackets(ctx context.Context) {
 	defer cancel()
 	defer s.logger.Debug().Msg("Stopped to handle packets")
 
+	if s.drainer != nil {
+		s.drainer.add(s)
+		defer s.drainer.remove(s)
+	}
+
 	defer func() {
 		if s.character != nil {
 			s.onLoggedOut()
@@ -462,7 +498,7 @@ func (s *GameSession) connectToGameServer(ctx context.Context, characterGUID uin
 	}
 	s.currentGroupID = groupID
 
-	selected, err := s.selectGameServerForMap(ctx, mapIDToLogin)
+	selected, err := s.selectGameServerForMap(ctx, mapIDToLogin, characterGUID, r.Character.GuildID, r.Character.PositionX)
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("can't select game server for map: %w", err)
@@ -487,9 +523,10 @@ func (s *GameSession) connectToGameServer(ctx context.Context, characterGUID uin
 	return r.Character, socket, err
 }
 
-func (s *GameSession) selectGameServerForMap(ctx context.Context, mapID uint32) (*pbServ.Server, error) {
+func (s *GameSession) selectGameServerForMap(ctx context.Context, mapID uint32, characterGUID uint64, guildID uint32, positionX float32) (*pbServ.Server, error) {
 	response, err := s.serversRegistryClient.AvailableGameServersForMapAndRealm(ctx, &pbServ.AvailableGameServersForMapAndRealmRequest{
 		Api: root.SupportedServerRegistryVer, RealmID: root.RealmID, MapID: mapID, GroupID: s.currentGroupID,
+		CharacterGUID: characterGUID, GuildID: uint64(guildID), PositionX: &positionX,
 	})
 	if err != nil || len(response.GameServers) == 0 {
 		return nil, err
@@ -651,6 +688,9 @@ func (s *GameSession) onLoggedOut() {
 
 	s.eventsBroadcaster.UnregisterCharacter(s.character.GUID)
 	s.chatChannelsEventsBroadcaster.DisconnectPlayer(s.character.GUID)
+	if s.mapPopulation != nil {
+		s.mapPopulation.RemoveCharacter(s.character.GUID)
+	}
 	s.channelMembership.events = nil
 
 	// The session survives going back to the character selection screen, but it is
@@ -707,6 +747,11 @@ type LoggedInCharacter struct {
 	// and the group is managed by game server but not group server.
 	GroupMangedByGameServer bool
 
+	// AFK/DND status and its auto-reply message, published to the online characters index
+	// so that chat service can send auto-replies for the whispers.
+	ChatFlags   uint8
+	AwayMessage string
+
 	// Last known stats parsed from update object packets, used to publish group member updates.
 	CurHP     uint32
 	MaxHP     uint32
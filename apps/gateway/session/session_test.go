@@ -15,6 +15,7 @@ import (
 	eBroadcaster "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster"
 	ebroadMock "github.com/walkline/ToCloud9/apps/gateway/events-broadcaster/mocks"
 	"github.com/walkline/ToCloud9/apps/gateway/packet"
+	"github.com/walkline/ToCloud9/apps/gateway/service"
 	"github.com/walkline/ToCloud9/apps/gateway/sockets"
 	mocks "github.com/walkline/ToCloud9/apps/gateway/sockets/socketmock"
 	pbChar "github.com/walkline/ToCloud9/gen/characters/pb"
@@ -372,7 +373,7 @@ func TestGameSessionLogin(t *testing.T) {
 
 	servRegistryMock := &regMock.ServersRegistryServiceClient{}
 	servRegistryMock.On("AvailableGameServersForMapAndRealm", mock.Anything, mock.MatchedBy(func(req *pbServ.AvailableGameServersForMapAndRealmRequest) bool {
-		return req.MapID == 1 && req.GroupID == groupID
+		return req.MapID == 1 && req.GroupID == groupID && req.CharacterGUID == charID
 	})).Return(&pbServ.AvailableGameServersForMapAndRealmResponse{
 		GameServers: []*pbServ.Server{{
 			ID:      "world-1",
@@ -505,3 +506,47 @@ func TestOnLoggedOutClearsGroupMemberStats(t *testing.T) {
 
 	assert.Nil(t, session.groupMemberStats, "stale group member stats would be answered as online after relogin")
 }
+
+func TestMapPopulationFollowsCharacter(t *testing.T) {
+	gameSocket := &mocks.Socket{}
+	gameSocket.On("SendPacket", mock.Anything).Return()
+
+	gwEventProducerMock := &gwProducerMock.GatewayProducer{}
+	gwEventProducerMock.On("CharacterLoggedOut", mock.Anything).Return(nil)
+
+	broadcasterMock := &ebroadMock.Broadcaster{}
+	broadcasterMock.On("UnregisterCharacter", mock.Anything).Return(nil)
+
+	chatChannels := eBroadcaster.NewChatChannelsService()
+	population := service.NewMapPopulation()
+
+	session := &GameSession{
+		gameSocket:                    gameSocket,
+		eventsProducer:                gwEventProducerMock,
+		eventsBroadcaster:             broadcasterMock,
+		chatChannelsEventsBroadcaster: chatChannels,
+		charsUpdsBarrier:              service.NewCharactersUpdatesBarrier(&log.Logger, gwEventProducerMock, time.Second),
+		mapPopulation:                 population,
+		channelMembership:             NewChannelMembership(40554, chatChannels),
+		character:                     &LoggedInCharacter{GUID: 40554, Map: 0},
+		currentGameServerID:           "layer-1",
+	}
+
+	initWorldStates := func(mapID int32) {
+		p := packet.NewWriter(packet.SMsgInitWorldStates).Int32(mapID).Int32(1519).Int32(1519).ToPacket()
+		assert.NoError(t, session.InterceptInitWorldStates(context.Background(), p))
+	}
+
+	initWorldStates(0)
+	assert.Equal(t, map[string]map[uint32]int{"layer-1": {0: 1}}, population.MapPlayers())
+
+	initWorldStates(1)
+	assert.Equal(t, map[string]map[uint32]int{"layer-1": {1: 1}}, population.MapPlayers())
+
+	session.currentGameServerID = "layer-2"
+	initWorldStates(1)
+	assert.Equal(t, map[string]map[uint32]int{"layer-2": {1: 1}}, population.MapPlayers())
+
+	session.onLoggedOut()
+	assert.Empty(t, population.MapPlayers())
+}
@@ -56,7 +56,7 @@ func (s *GameSession) HandleContactList(ctx context.Context, p *packet.Packet) e
 
 	// Friends
 	for _, friend := range resp.Friends {
-		w.Uint64(friend.Guid)
+		w.Uint64(clientPlayerGUID(friend.RealmID, friend.Guid))
 		w.Uint32(0x01)        // SOCIAL_FLAG_FRIEND
 		w.String(friend.Note) // note comes BEFORE status!
 		w.Uint8(uint8(friend.Status)) // 0=offline, 1=online
@@ -88,11 +88,18 @@ func (s *GameSession) HandleAddFriend(ctx context.Context, p *packet.Packet) err
 
 	s.logger.Debug().Str("friendName", friendName).Msg("Handling add friend")
 
+	name, friendRealmID, ok := s.splitCrossRealmName(ctx, friendName)
+	if !ok {
+		s.SendFriendStatus(FriendResultNotFound, 0, "", 0, 0, 0, 0)
+		return nil
+	}
+
 	// Resolve friend name to GUID
 	charResp, err := s.charServiceClient.CharacterByName(ctx, &pbChar.CharacterByNameRequest{
-		Api:           root.Ver,
-		RealmID:       root.RealmID,
-		CharacterName: friendName,
+		Api:              root.Ver,
+		RealmID:          root.RealmID,
+		CharacterName:    name,
+		CharacterRealmID: friendRealmID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to lookup character: %w", err)
@@ -104,12 +111,13 @@ func (s *GameSession) HandleAddFriend(ctx context.Context, p *packet.Packet) err
 	}
 
 	friendResp, err := s.charServiceClient.AddFriend(ctx, &pbChar.AddFriendRequest{
-		Api:        root.Ver,
-		RealmID:    root.RealmID,
-		PlayerGUID: s.character.GUID,
-		FriendGUID: charResp.Character.CharGUID,
-		FriendName: friendName,
-		Note:       note,
+		Api:           root.Ver,
+		RealmID:       root.RealmID,
+		PlayerGUID:    s.character.GUID,
+		FriendGUID:    charResp.Character.CharGUID,
+		FriendName:    name,
+		Note:          note,
+		FriendRealmID: friendRealmID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to add friend: %w", err)
@@ -118,7 +126,7 @@ func (s *GameSession) HandleAddFriend(ctx context.Context, p *packet.Packet) err
 	// Send friend status with note and online info
 	s.SendFriendStatus(
 		friendResp.Result,
-		charResp.Character.CharGUID,
+		clientPlayerGUID(friendRealmID, charResp.Character.CharGUID),
 		note,
 		uint8(friendResp.Status),
 		friendResp.Area,
@@ -134,11 +142,13 @@ func (s *GameSession) HandleDelFriend(ctx context.Context, p *packet.Packet) err
 
 	s.logger.Debug().Uint64("friendGUID", friendGUID).Msg("Handling delete friend")
 
+	friendRealmID, friendLowGUID := playerRealmAndGUID(friendGUID)
 	_, err := s.charServiceClient.RemoveFriend(ctx, &pbChar.RemoveFriendRequest{
-		Api:        root.Ver,
-		RealmID:    root.RealmID,
-		PlayerGUID: s.character.GUID,
-		FriendGUID: friendGUID,
+		Api:           root.Ver,
+		RealmID:       root.RealmID,
+		PlayerGUID:    s.character.GUID,
+		FriendGUID:    friendLowGUID,
+		FriendRealmID: friendRealmID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to remove friend: %w", err)
@@ -156,12 +166,14 @@ func (s *GameSession) HandleSetContactNotes(ctx context.Context, p *packet.Packe
 
 	s.logger.Debug().Uint64("friendGUID", friendGUID).Msg("Handling set contact notes")
 
+	friendRealmID, friendLowGUID := playerRealmAndGUID(friendGUID)
 	_, err := s.charServiceClient.SetFriendNote(ctx, &pbChar.SetFriendNoteRequest{
-		Api:        root.Ver,
-		RealmID:    root.RealmID,
-		PlayerGUID: s.character.GUID,
-		FriendGUID: friendGUID,
-		Note:       note,
+		Api:           root.Ver,
+		RealmID:       root.RealmID,
+		PlayerGUID:    s.character.GUID,
+		FriendGUID:    friendLowGUID,
+		Note:          note,
+		FriendRealmID: friendRealmID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to set friend note: %w", err)
@@ -176,6 +188,12 @@ func (s *GameSession) HandleAddIgnore(ctx context.Context, p *packet.Packet) err
 
 	s.logger.Debug().Str("ignoreName", ignoreName).Msg("Handling add ignore")
 
+	// Ignore list supports only characters of the same realm.
+	if _, realmID, ok := s.splitCrossRealmName(ctx, ignoreName); !ok || realmID != 0 {
+		s.SendFriendStatus(FriendResultIgnoreNotFound, 0, "", 0, 0, 0, 0)
+		return nil
+	}
+
 	charResp, err := s.charServiceClient.CharacterByName(ctx, &pbChar.CharacterByNameRequest{
 		Api:           root.Ver,
 		RealmID:       root.RealmID,
@@ -258,7 +276,7 @@ func (s *GameSession) HandleEventFriendStatusChange(ctx context.Context, e *eBro
 	} else {
 		w.Uint8(FriendResultOffline)
 	}
-	w.Uint64(eventData.PlayerGUID)
+	w.Uint64(clientPlayerGUID(eventData.PlayerRealmID, eventData.PlayerGUID))
 
 	// For FRIEND_ONLINE, send status (uint8) + area/level/class
 	if eventData.Status == 1 {
@@ -343,7 +361,14 @@ func (s *GameSession) HandleWho(ctx context.Context, p *packet.Packet) error {
 	w.Uint32(uint32(len(resp.ItemsToDisplay)))
 	w.Uint32(resp.TotalFound)
 	for _, item := range resp.ItemsToDisplay {
-		w.String(item.Name)
+		name := item.Name
+		if item.RealmID != 0 && item.RealmID != root.RealmID {
+			if realmName, err := s.realmNamesService.NameByID(ctx, item.RealmID); err == nil {
+				name += "-" + realmName
+			}
+		}
+
+		w.String(name)
 		w.String(item.Guild)
 		w.Uint32(item.Lvl)
 		w.Uint32(item.Class)
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"sync"
@@ -12,7 +13,7 @@ import (
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
-	redis "github.com/redis/go-redis/v9"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
@@ -22,6 +23,8 @@ import (
 	"github.com/walkline/ToCloud9/apps/guidserver/server"
 	"github.com/walkline/ToCloud9/apps/guidserver/service"
 	"github.com/walkline/ToCloud9/gen/guid/pb"
+	"github.com/walkline/ToCloud9/shared/healthandmetrics"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 	shrepo "github.com/walkline/ToCloud9/shared/repo"
 )
 
@@ -46,6 +49,14 @@ func main() {
 	}
 	pb.RegisterGuidServiceServer(grpcServer, guidServer)
 
+	healthCheckServer := healthandmetrics.NewServer(cfg.HealthCheckPort, promhttp.Handler())
+	go func() {
+		err := healthCheckServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatal().Err(err).Msg("failed to ListenAndServe health check server")
+		}
+	}()
+
 	// graceful shutdown handling
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
@@ -56,6 +67,9 @@ func main() {
 		fmt.Println("")
 		log.Info().Msgf("🧨 Got signal %v, attempting graceful shutdown...", sig)
 		grpcServer.GracefulStop()
+		if err := healthCheckServer.Shutdown(context.Background()); err != nil {
+			log.Err(err).Msg("failed to shutdown health check server")
+		}
 		wg.Done()
 	}()
 
@@ -88,14 +102,20 @@ func createGuidService(cfg *config.Config) service.GuidService {
 		log.Fatal().Err(err).Msg("can't create char repo")
 	}
 
-	opt, err := redis.ParseURL(cfg.RedisConnection)
+	limits, err := guidTypesLimits(cfg)
 	if err != nil {
-		log.Fatal().Err(err).Msg("can't connect to the redis")
+		log.Fatal().Err(err).Msg("invalid guid types config")
 	}
 
-	rdb := redis.NewClient(opt)
-
-	service, err := service.NewGuidService(context.Background(), charRepo, repo.NewRedisMaxGuidStorage(rdb, 10), realms, 4)
+	service, err := service.NewGuidService(
+		context.Background(),
+		charRepo,
+		createMaxGuidStorage(cfg, charDB),
+		realms,
+		4,
+		limits,
+		cfg.GuidSpaceAlertPct,
+	)
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't create guid service")
 	}
@@ -103,9 +123,61 @@ func createGuidService(cfg *config.Config) service.GuidService {
 	return service
 }
 
+func createMaxGuidStorage(cfg *config.Config, charDB shrepo.CharactersDB) repo.MaxGuidStorage {
+	switch cfg.Storage {
+	case config.StorageMySQL:
+		storage, err := repo.NewMysqlMaxGuidStorage(charDB)
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't create mysql max guid storage")
+		}
+		return storage
+	case config.StorageRedis:
+		rdb, err := redisclient.NewClient(cfg.RedisConnection, cfg.Redis)
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't connect to the redis")
+		}
+
+		return repo.NewRedisMaxGuidStorage(rdb, 10)
+	}
+
+	log.Fatal().Str("storage", cfg.Storage).Msg("unknown max guid storage")
+	return nil
+}
+
 func configureDBConn(db *sql.DB) {
 	db.SetMaxIdleConns(5)
 	db.SetMaxOpenConns(10)
 	db.SetConnMaxLifetime(time.Minute * 4)
 	db.SetConnMaxIdleTime(time.Minute * 8)
 }
+
+// guidTypesLimits applies limits overrides from config to the defaults of guid types.
+func guidTypesLimits(cfg *config.Config) ([repo.GuidTypeMax]repo.GuidTypeLimits, error) {
+	limits := repo.DefaultGuidTypesLimits()
+
+	if cfg.PrefetchLowWatermark > 0 {
+		for i := range limits {
+			limits[i].PrefetchLowWatermark = cfg.PrefetchLowWatermark
+		}
+	}
+
+	for name, typeCfg := range cfg.GuidTypes {
+		guidType, found := repo.GuidTypeByName(name)
+		if !found {
+			return limits, fmt.Errorf("unknown guid type %q", name)
+		}
+
+		if typeCfg.PrefetchLowWatermark > 0 {
+			limits[guidType].PrefetchLowWatermark = typeCfg.PrefetchLowWatermark
+		}
+
+		if typeCfg.MaxValue > 0 {
+			if typeCfg.MaxValue > guidType.DefaultLimits().MaxValue {
+				return limits, fmt.Errorf("max value of guid type %s can't be bigger than %d", guidType, guidType.DefaultLimits().MaxValue)
+			}
+			limits[guidType].MaxValue = typeCfg.MaxValue
+		}
+	}
+
+	return limits, nil
+}
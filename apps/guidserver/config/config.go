@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/walkline/ToCloud9/shared/config"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 // Config is config of application
@@ -11,13 +12,50 @@ type Config struct {
 	// Port is port that would be used for grpc server
 	Port string `yaml:"port" env:"PORT" env-default:"8996"`
 
+	// HealthCheckPort is port that would be used to listen for health checks and prometheus metrics requests.
+	HealthCheckPort string `yaml:"healthCheckPort" env:"HEALTH_CHECK_PORT" env-default:"8903"`
+
+	// Storage is the storage of max given guids, "redis" or "mysql".
+	// With "mysql" max guids are stored in the guid_sequences table of the characters database
+	// and Redis is not needed. Several guid servers can share any of storages.
+	Storage string `yaml:"storage" env:"STORAGE" env-default:"redis"`
+
 	// RedisConnection is connection string for the redis connection
 	RedisConnection string `yaml:"redisUrl" env:"REDIS_URL" env-default:"redis://:@redis:6379/0"`
 
+	// Redis is config of the redis sentinel or cluster connection and retries on failover.
+	Redis redisclient.Config `yaml:"redis"`
+
+	// PrefetchLowWatermark is the minimal amount of available guids per realm and guid type.
+	// When available guids amount drops below it, new guids are prefetched from the storage.
+	// Overrides defaults of every guid type, 0 keeps defaults that are small for low-volume types (characters, groups, etc.).
+	PrefetchLowWatermark uint64 `yaml:"prefetchLowWatermark" env:"PREFETCH_LOW_WATERMARK" env-default:"0"`
+
+	// GuidTypes overrides limits of the guid types, key is the guid type name, e.g. "Item" or "ArenaTeam".
+	GuidTypes map[string]GuidType `yaml:"guidTypes"`
+
+	// GuidSpaceAlertPct is the percent of used guid space of the type that triggers alert.
+	// 0 disables alert.
+	GuidSpaceAlertPct float32 `yaml:"guidSpaceAlertPct" env:"GUID_SPACE_ALERT_PCT" env-default:"90"`
+
 	// CharDBConnection is connection string to the characters database
 	CharDBConnection map[uint32]string `yaml:"charactersDB" env:"CHAR_DB_CONNECTION" env-separator:";" env-default:"1:trinity:trinity@tcp(127.0.0.1:3306)/characters"`
 }
 
+// GuidType is config of the guid type, zero values keep defaults.
+type GuidType struct {
+	// PrefetchLowWatermark is the minimal amount of available guids of the type per realm.
+	PrefetchLowWatermark uint64 `yaml:"prefetchLowWatermark"`
+
+	// MaxValue is the biggest guid of the type that can be given away, can't be bigger than 4294967295.
+	MaxValue uint64 `yaml:"maxValue"`
+}
+
+const (
+	StorageRedis = "redis"
+	StorageMySQL = "mysql"
+)
+
 // LoadConfig loads config from env variables
 func LoadConfig() (*Config, error) {
 	var c struct {
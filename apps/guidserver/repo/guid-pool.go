@@ -0,0 +1,95 @@
+package repo
+
+import (
+	"context"
+	"sort"
+)
+
+// GuidRange is inclusive range of guids.
+type GuidRange struct {
+	Start uint64
+	End   uint64
+}
+
+// Count returns amount of guids in the range.
+func (r GuidRange) Count() uint64 {
+	return r.End - r.Start + 1
+}
+
+// GuidPool is the state of guids of the type that is shared between all guid servers.
+type GuidPool struct {
+	// Issued are sorted not overlapping ranges of guids that were given to game servers.
+	Issued []GuidRange `json:"issued,omitempty"`
+
+	// Returned are ranges of guids that were returned by game servers and can be given again.
+	Returned []GuidRange `json:"returned,omitempty"`
+}
+
+// GuidPoolStorage keeps guid pools shared between guid servers.
+type GuidPoolStorage interface {
+	// UpdateGuidPool atomically reads guid pool of the given type, applies f to it and stores the result.
+	// Pool is not stored if f returns error, the error is returned as is.
+	UpdateGuidPool(ctx context.Context, realmID uint32, guidType GuidType, f func(pool *GuidPool) error) error
+}
+
+// AddIssued marks range as given to game server.
+func (p *GuidPool) AddIssued(r GuidRange) {
+	i := sort.Search(len(p.Issued), func(i int) bool { return p.Issued[i].Start > r.Start })
+	p.Issued = append(p.Issued, GuidRange{})
+	copy(p.Issued[i+1:], p.Issued[i:])
+	p.Issued[i] = r
+
+	// Merges overlapping and adjacent ranges, so the list stays small.
+	merged := p.Issued[:1]
+	for _, next := range p.Issued[1:] {
+		last := &merged[len(merged)-1]
+		if next.Start <= last.End+1 {
+			if next.End > last.End {
+				last.End = next.End
+			}
+			continue
+		}
+		merged = append(merged, next)
+	}
+	p.Issued = merged
+}
+
+// ReturnRange moves range from issued to returned guids.
+// Returns false if range (or some part of it) wasn't issued.
+func (p *GuidPool) ReturnRange(r GuidRange) bool {
+	i := sort.Search(len(p.Issued), func(i int) bool { return p.Issued[i].End >= r.Start })
+	if i == len(p.Issued) || p.Issued[i].Start > r.Start || p.Issued[i].End < r.End {
+		return false
+	}
+
+	issued := p.Issued[i]
+	remaining := make([]GuidRange, 0, 2)
+	if issued.Start < r.Start {
+		remaining = append(remaining, GuidRange{Start: issued.Start, End: r.Start - 1})
+	}
+	if issued.End > r.End {
+		remaining = append(remaining, GuidRange{Start: r.End + 1, End: issued.End})
+	}
+
+	p.Issued = append(p.Issued[:i], append(remaining, p.Issued[i+1:]...)...)
+	p.Returned = append(p.Returned, r)
+	return true
+}
+
+// TakeReturned removes up to amount of returned guids from the pool and returns them.
+func (p *GuidPool) TakeReturned(amount uint64) []GuidRange {
+	var taken []GuidRange
+	for amount > 0 && len(p.Returned) > 0 {
+		r := p.Returned[0]
+		if r.Count() > amount {
+			taken = append(taken, GuidRange{Start: r.Start, End: r.Start + amount - 1})
+			p.Returned[0].Start += amount
+			return taken
+		}
+
+		taken = append(taken, r)
+		amount -= r.Count()
+		p.Returned = p.Returned[1:]
+	}
+	return taken
+}
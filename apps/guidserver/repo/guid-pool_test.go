@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuidPool_AddIssued(t *testing.T) {
+	pool := &GuidPool{}
+	pool.AddIssued(GuidRange{Start: 20, End: 30})
+	pool.AddIssued(GuidRange{Start: 1, End: 5})
+	pool.AddIssued(GuidRange{Start: 6, End: 10})
+	pool.AddIssued(GuidRange{Start: 50, End: 60})
+
+	assert.Equal(t, []GuidRange{{1, 10}, {20, 30}, {50, 60}}, pool.Issued)
+
+	pool.AddIssued(GuidRange{Start: 11, End: 19})
+	assert.Equal(t, []GuidRange{{1, 30}, {50, 60}}, pool.Issued)
+}
+
+func TestGuidPool_ReturnRange(t *testing.T) {
+	pool := &GuidPool{}
+	pool.AddIssued(GuidRange{Start: 1, End: 10})
+	pool.AddIssued(GuidRange{Start: 20, End: 30})
+
+	assert.True(t, pool.ReturnRange(GuidRange{Start: 4, End: 6}))
+	assert.Equal(t, []GuidRange{{1, 3}, {7, 10}, {20, 30}}, pool.Issued)
+
+	assert.True(t, pool.ReturnRange(GuidRange{Start: 20, End: 30}))
+	assert.Equal(t, []GuidRange{{1, 3}, {7, 10}}, pool.Issued)
+
+	assert.False(t, pool.ReturnRange(GuidRange{Start: 5, End: 5}), "already returned")
+	assert.False(t, pool.ReturnRange(GuidRange{Start: 9, End: 12}), "partially issued")
+	assert.False(t, pool.ReturnRange(GuidRange{Start: 40, End: 45}), "never issued")
+
+	assert.Equal(t, []GuidRange{{4, 6}, {20, 30}}, pool.Returned)
+}
+
+func TestGuidPool_TakeReturned(t *testing.T) {
+	pool := &GuidPool{Returned: []GuidRange{{4, 6}, {20, 30}}}
+
+	assert.Equal(t, []GuidRange{{4, 6}, {20, 21}}, pool.TakeReturned(5))
+	assert.Equal(t, []GuidRange{{22, 30}}, pool.Returned)
+
+	assert.Equal(t, []GuidRange{{22, 30}}, pool.TakeReturned(100))
+	assert.Empty(t, pool.Returned)
+	assert.Empty(t, pool.TakeReturned(1))
+}
@@ -0,0 +1,95 @@
+package repo
+
+import (
+	"math"
+	"strings"
+)
+
+// GuidType is type of the entity that guids are used for.
+// Values are the same as in guid.proto GuidType.
+type GuidType uint8
+
+const (
+	GuidTypeCharacter GuidType = iota
+	GuidTypeItem
+	GuidTypeInstance
+	GuidTypePet
+	GuidTypeMail
+	GuidTypeCorpse
+	GuidTypeEquipmentSet
+	GuidTypeGroup
+	GuidTypeArenaTeam
+	GuidTypeMax
+)
+
+// String returns text representation of guid type.
+func (t GuidType) String() string {
+	switch t {
+	case GuidTypeCharacter:
+		return "Character"
+	case GuidTypeItem:
+		return "Item"
+	case GuidTypeInstance:
+		return "Instance"
+	case GuidTypePet:
+		return "Pet"
+	case GuidTypeMail:
+		return "Mail"
+	case GuidTypeCorpse:
+		return "Corpse"
+	case GuidTypeEquipmentSet:
+		return "EquipmentSet"
+	case GuidTypeGroup:
+		return "Group"
+	case GuidTypeArenaTeam:
+		return "ArenaTeam"
+	}
+	return "Unknown"
+}
+
+// GuidTypeByName returns guid type by its case-insensitive text representation.
+func GuidTypeByName(name string) (GuidType, bool) {
+	for t := GuidType(0); t < GuidTypeMax; t++ {
+		if strings.EqualFold(t.String(), name) {
+			return t, true
+		}
+	}
+	return GuidTypeMax, false
+}
+
+// GuidTypeLimits are settings of guids allocation of the guid type.
+type GuidTypeLimits struct {
+	// PrefetchLowWatermark is the minimal amount of available guids of the type in the local cache,
+	// when available guids amount drops below it, new guids are prefetched from the storage.
+	PrefetchLowWatermark uint64
+
+	// MaxValue is the biggest guid value that can be given away.
+	MaxValue uint64
+}
+
+// DefaultLimits returns limits of the guid type that are used if config doesn't override them.
+func (t GuidType) DefaultLimits() GuidTypeLimits {
+	// Every supported type uses 32 bits counter on the game server side.
+	limits := GuidTypeLimits{MaxValue: math.MaxUint32}
+
+	switch t {
+	case GuidTypeItem, GuidTypeMail:
+		limits.PrefetchLowWatermark = 1000
+	case GuidTypeCharacter, GuidTypeGroup, GuidTypeArenaTeam:
+		// Low-volume types, big prefetch would waste guids space on every restart.
+		limits.PrefetchLowWatermark = 10
+	default:
+		limits.PrefetchLowWatermark = 100
+	}
+
+	return limits
+}
+
+// DefaultGuidTypesLimits returns default limits of every guid type.
+func DefaultGuidTypesLimits() [GuidTypeMax]GuidTypeLimits {
+	limits := [GuidTypeMax]GuidTypeLimits{}
+	for t := range limits {
+		limits[t] = GuidType(t).DefaultLimits()
+	}
+	return limits
+}
@@ -8,14 +8,21 @@ import (
 )
 
 type MaxGuidProvider interface {
-	// MaxGuidForCharacters returns max guid for characters.
-	MaxGuidForCharacters(ctx context.Context, realmID uint32) (uint64, error)
-
-	// MaxGuidForItems returns max guid for items.
-	MaxGuidForItems(ctx context.Context, realmID uint32) (uint64, error)
+	// MaxGuid returns max used guid of the given type.
+	MaxGuid(ctx context.Context, realmID uint32, guidType GuidType) (uint64, error)
+}
 
-	// MaxGuidForInstances returns max guid for dungeon/raid instance.
-	MaxGuidForInstances(ctx context.Context, realmID uint32) (uint64, error)
+// maxGuidStmtByType maps guid type to the statement that returns max guid of this type.
+// Types without statement (like corpses) are not persisted and generated from scratch.
+var maxGuidStmtByType = map[GuidType]CharsPreparedStatements{
+	GuidTypeCharacter:    StmtGetMaxCharacterGUID,
+	GuidTypeItem:         StmtGetMaxItemGUID,
+	GuidTypeInstance:     StmtGetMaxInstanceGUID,
+	GuidTypePet:          StmtGetMaxPetGUID,
+	GuidTypeMail:         StmtGetMaxMailGUID,
+	GuidTypeEquipmentSet: StmtGetMaxEquipmentSetGUID,
+	GuidTypeGroup:        StmtGetMaxGroupGUID,
+	GuidTypeArenaTeam:    StmtGetMaxArenaTeamGUID,
 }
 
 type mysqlMaxGuidRepo struct {
@@ -23,45 +30,22 @@ type mysqlMaxGuidRepo struct {
 }
 
 func NewMysqlMaxGuidRepo(db shrepo.CharactersDB) (MaxGuidProvider, error) {
-	db.SetPreparedStatement(StmtGetMaxCharacterGUID)
-	db.SetPreparedStatement(StmtGetMaxItemGUID)
-	db.SetPreparedStatement(StmtGetMaxInstanceGUID)
+	for _, stmt := range maxGuidStmtByType {
+		db.SetPreparedStatement(stmt)
+	}
 
 	return &mysqlMaxGuidRepo{
 		charDB: db,
 	}, nil
 }
 
-func (m *mysqlMaxGuidRepo) MaxGuidForCharacters(ctx context.Context, realmID uint32) (uint64, error) {
-	row := m.charDB.PreparedStatement(realmID, StmtGetMaxCharacterGUID).QueryRowContext(ctx)
-	if row.Err() != nil {
-		return 0, row.Err()
-	}
-
-	var guid uint64
-	err := row.Scan(&guid)
-	if err != nil {
-		return 0, err
-	}
-	return guid, nil
-}
-
-func (m *mysqlMaxGuidRepo) MaxGuidForItems(ctx context.Context, realmID uint32) (uint64, error) {
-	row := m.charDB.PreparedStatement(realmID, StmtGetMaxItemGUID).QueryRowContext(ctx)
-	if row.Err() != nil {
-		return 0, row.Err()
-	}
-
-	var guid uint64
-	err := row.Scan(&guid)
-	if err != nil {
-		return 0, err
+func (m *mysqlMaxGuidRepo) MaxGuid(ctx context.Context, realmID uint32, guidType GuidType) (uint64, error) {
+	stmt, found := maxGuidStmtByType[guidType]
+	if !found {
+		return 0, nil
 	}
-	return guid, nil
-}
 
-func (m *mysqlMaxGuidRepo) MaxGuidForInstances(ctx context.Context, realmID uint32) (uint64, error) {
-	row := m.charDB.PreparedStatement(realmID, StmtGetMaxInstanceGUID).QueryRowContext(ctx)
+	row := m.charDB.PreparedStatement(realmID, stmt).QueryRowContext(ctx)
 	if row.Err() != nil {
 		return 0, row.Err()
 	}
@@ -87,6 +71,39 @@ const (
 
 	// StmtGetMaxInstanceGUID returns max GUID for instance table.
 	StmtGetMaxInstanceGUID
+
+	// StmtGetMaxPetGUID returns max pet number for character_pet table.
+	StmtGetMaxPetGUID
+
+	// StmtGetMaxMailGUID returns max ID for mail table.
+	StmtGetMaxMailGUID
+
+	// StmtGetMaxEquipmentSetGUID returns max GUID for character_equipmentsets table.
+	StmtGetMaxEquipmentSetGUID
+
+	// StmtGetMaxGroupGUID returns max GUID for groups table.
+	StmtGetMaxGroupGUID
+
+	// StmtGetMaxArenaTeamGUID returns max ID for arena_team table.
+	StmtGetMaxArenaTeamGUID
+
+	// StmtGetGuidSequence returns max given guid from guid_sequences table.
+	StmtGetGuidSequence
+
+	// StmtGetGuidSequenceForUpdate returns max given guid from guid_sequences table and locks the row.
+	StmtGetGuidSequenceForUpdate
+
+	// StmtUpdateGuidSequence updates max given guid in guid_sequences table.
+	StmtUpdateGuidSequence
+
+	// StmtUpsertGuidSequence creates guid sequence or raises its max given guid if the new value is bigger.
+	StmtUpsertGuidSequence
+
+	// StmtGetGuidPoolForUpdate returns guid pool from guid_sequences table and locks the row.
+	StmtGetGuidPoolForUpdate
+
+	// StmtUpdateGuidPool updates guid pool in guid_sequences table.
+	StmtUpdateGuidPool
 )
 
 // ID returns identifier of prepared statement.
@@ -103,6 +120,28 @@ func (s CharsPreparedStatements) Stmt() string {
 		return "SELECT COALESCE(MAX(guid), 0) FROM item_instance"
 	case StmtGetMaxInstanceGUID:
 		return "SELECT COALESCE(MAX(id), 0) FROM instance"
+	case StmtGetMaxPetGUID:
+		return "SELECT COALESCE(MAX(id), 0) FROM character_pet"
+	case StmtGetMaxMailGUID:
+		return "SELECT COALESCE(MAX(id), 0) FROM mail"
+	case StmtGetMaxEquipmentSetGUID:
+		return "SELECT COALESCE(MAX(setguid), 0) FROM character_equipmentsets"
+	case StmtGetMaxGroupGUID:
+		return "SELECT COALESCE(MAX(guid), 0) FROM `groups`"
+	case StmtGetMaxArenaTeamGUID:
+		return "SELECT COALESCE(MAX(arenaTeamId), 0) FROM arena_team"
+	case StmtGetGuidSequence:
+		return "SELECT maxGuid FROM guid_sequences WHERE guidType = ?"
+	case StmtGetGuidSequenceForUpdate:
+		return "SELECT maxGuid FROM guid_sequences WHERE guidType = ? FOR UPDATE"
+	case StmtUpdateGuidSequence:
+		return "UPDATE guid_sequences SET maxGuid = ? WHERE guidType = ?"
+	case StmtUpsertGuidSequence:
+		return "INSERT INTO guid_sequences (guidType, maxGuid) VALUES (?, ?) ON DUPLICATE KEY UPDATE maxGuid = GREATEST(maxGuid, VALUES(maxGuid))"
+	case StmtGetGuidPoolForUpdate:
+		return "SELECT pool FROM guid_sequences WHERE guidType = ? FOR UPDATE"
+	case StmtUpdateGuidPool:
+		return "UPDATE guid_sequences SET pool = ? WHERE guidType = ?"
 	}
 	panic(fmt.Errorf("unk stmt %d", s))
 }
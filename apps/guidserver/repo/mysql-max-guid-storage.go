@@ -0,0 +1,129 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+// ErrGuidSequenceNotFound is returned when guid sequence is not initialized with SetMaxGuid.
+var ErrGuidSequenceNotFound = errors.New("guid sequence not found")
+
+// NewMysqlMaxGuidStorage returns max guids storage that keeps max given guids in the guid_sequences
+// table of the characters database. Increases are serialized with row locks, so several
+// guid servers can safely share the same storage.
+func NewMysqlMaxGuidStorage(db shrepo.CharactersDB) (MaxGuidStorage, error) {
+	db.SetPreparedStatement(StmtGetGuidSequence)
+	db.SetPreparedStatement(StmtGetGuidSequenceForUpdate)
+	db.SetPreparedStatement(StmtUpdateGuidSequence)
+	db.SetPreparedStatement(StmtUpsertGuidSequence)
+	db.SetPreparedStatement(StmtGetGuidPoolForUpdate)
+	db.SetPreparedStatement(StmtUpdateGuidPool)
+
+	return &mysqlMaxGuidStorage{
+		charDB: db,
+	}, nil
+}
+
+type mysqlMaxGuidStorage struct {
+	charDB shrepo.CharactersDB
+}
+
+// MaxGuid returns max given guid of the given type. Returns 0 if the sequence is not initialized.
+func (m *mysqlMaxGuidStorage) MaxGuid(ctx context.Context, realmID uint32, guidType GuidType) (uint64, error) {
+	var maxGuid uint64
+	err := m.charDB.PreparedStatement(realmID, StmtGetGuidSequence).QueryRowContext(ctx, guidType).Scan(&maxGuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return maxGuid, nil
+}
+
+// SetMaxGuid creates the sequence or raises its max guid. Never decreases max guid, so it's safe
+// to call it concurrently from several guid servers on start up.
+func (m *mysqlMaxGuidStorage) SetMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, value uint64) error {
+	_, err := m.charDB.PreparedStatement(realmID, StmtUpsertGuidSequence).ExecContext(ctx, guidType, value)
+	return err
+}
+
+func (m *mysqlMaxGuidStorage) IncreaseMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, increaseAmount uint64) (uint64, error) {
+	tx, err := m.charDB.DBByRealm(realmID).BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var maxGuid uint64
+	err = tx.StmtContext(ctx, m.charDB.PreparedStatement(realmID, StmtGetGuidSequenceForUpdate)).
+		QueryRowContext(ctx, guidType).
+		Scan(&maxGuid)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, fmt.Errorf("%w: realm %d, type %s", ErrGuidSequenceNotFound, realmID, guidType)
+		}
+		return 0, err
+	}
+
+	newMaxGuid := maxGuid + increaseAmount
+	_, err = tx.StmtContext(ctx, m.charDB.PreparedStatement(realmID, StmtUpdateGuidSequence)).
+		ExecContext(ctx, newMaxGuid, guidType)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return newMaxGuid, nil
+}
+
+func (m *mysqlMaxGuidStorage) UpdateGuidPool(ctx context.Context, realmID uint32, guidType GuidType, f func(pool *GuidPool) error) error {
+	tx, err := m.charDB.DBByRealm(realmID).BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var data sql.NullString
+	err = tx.StmtContext(ctx, m.charDB.PreparedStatement(realmID, StmtGetGuidPoolForUpdate)).
+		QueryRowContext(ctx, guidType).
+		Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: realm %d, type %s", ErrGuidSequenceNotFound, realmID, guidType)
+		}
+		return err
+	}
+
+	pool := &GuidPool{}
+	if data.Valid && data.String != "" {
+		if err = json.Unmarshal([]byte(data.String), pool); err != nil {
+			return fmt.Errorf("can't unmarshal guid pool of realm %d, type %s: %w", realmID, guidType, err)
+		}
+	}
+
+	if err = f(pool); err != nil {
+		return err
+	}
+
+	newData, err := json.Marshal(pool)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.StmtContext(ctx, m.charDB.PreparedStatement(realmID, StmtUpdateGuidPool)).
+		ExecContext(ctx, string(newData), guidType)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
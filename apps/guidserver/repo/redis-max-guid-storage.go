@@ -2,93 +2,67 @@ package repo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	redis "github.com/redis/go-redis/v9"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 type MaxGuidStorage interface {
 	MaxGuidProvider
+	GuidPoolStorage
 
-	// SetMaxGuidForCharacters sets max guid for characters. Unsafe for concurrent usage. Use IncreaseMaxGuidForCharacters instead.
-	SetMaxGuidForCharacters(ctx context.Context, realmID uint32, value uint64) error
-
-	// SetMaxGuidForItems sets max guid for items. Unsafe for concurrent usage. Use IncreaseMaxGuidForItems instead.
-	SetMaxGuidForItems(ctx context.Context, realmID uint32, value uint64) error
-
-	// SetMaxGuidForInstances sets max guid for dungeon/raid instance. Unsafe for concurrent usage. Use IncreaseMaxGuidForItems instead.
-	SetMaxGuidForInstances(ctx context.Context, realmID uint32, value uint64) error
-
-	// IncreaseMaxGuidForCharacters increases max character guid to increaseAmount value and returns new max guid.
-	IncreaseMaxGuidForCharacters(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error)
+	// SetMaxGuid sets max guid of the given type if it's bigger than the current one.
+	// Used to initialize storage, use IncreaseMaxGuid to get new guids.
+	SetMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, value uint64) error
 
-	// IncreaseMaxGuidForItems increases max item guid to increaseAmount value and returns new max guid.
-	IncreaseMaxGuidForItems(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error)
-
-	// IncreaseMaxGuidForInstances increases max dungeon/raid instance guid to increaseAmount value and returns new max guid.
-	IncreaseMaxGuidForInstances(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error)
+	// IncreaseMaxGuid increases max guid of the given type to increaseAmount value and returns new max guid.
+	IncreaseMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, increaseAmount uint64) (uint64, error)
 }
 
 // NewRedisMaxGuidStorage returns new redis max guids storage.
-func NewRedisMaxGuidStorage(rdb *redis.Client, optimisticLockRetriesCount int) MaxGuidStorage {
+func NewRedisMaxGuidStorage(rdb redis.UniversalClient, optimisticLockRetriesCount int) MaxGuidStorage {
 	return &redisMaxGuidStorage{
 		rdb:          rdb,
 		retriesCount: optimisticLockRetriesCount,
 	}
 }
 
+// failoverRetriesCount is count of the transaction retries while redis master is switching.
+const failoverRetriesCount = 5
+
 type redisMaxGuidStorage struct {
-	rdb          *redis.Client
+	rdb          redis.UniversalClient
 	retriesCount int
 }
 
-func (r *redisMaxGuidStorage) SetMaxGuidForCharacters(ctx context.Context, realmID uint32, value uint64) error {
-	return r.rdb.Set(ctx, r.characterKey(realmID), value, 0).Err()
-}
-
-func (r *redisMaxGuidStorage) SetMaxGuidForItems(ctx context.Context, realmID uint32, value uint64) error {
-	return r.rdb.Set(ctx, r.itemKey(realmID), value, 0).Err()
-}
-
-func (r *redisMaxGuidStorage) SetMaxGuidForInstances(ctx context.Context, realmID uint32, value uint64) error {
-	return r.rdb.Set(ctx, r.instanceKey(realmID), value, 0).Err()
-}
-
-func (r *redisMaxGuidStorage) MaxGuidForCharacters(ctx context.Context, realmID uint32) (uint64, error) {
-	v, err := r.rdb.Get(ctx, r.characterKey(realmID)).Uint64()
-	if err != nil && err != redis.Nil {
-		return 0, err
-	}
-	return v, nil
-}
+// setIfBiggerScript sets the key only if the new value is bigger than the current one.
+// Protects from decreasing max guid when several guid servers are starting at the same time.
+var setIfBiggerScript = redis.NewScript(`
+local current = tonumber(redis.call("GET", KEYS[1]) or "0")
+if tonumber(ARGV[1]) > current then
+	redis.call("SET", KEYS[1], ARGV[1])
+end
+return 0
+`)
 
-func (r *redisMaxGuidStorage) MaxGuidForItems(ctx context.Context, realmID uint32) (uint64, error) {
-	v, err := r.rdb.Get(ctx, r.itemKey(realmID)).Uint64()
-	if err != nil && err != redis.Nil {
-		return 0, err
-	}
-	return v, nil
+func (r *redisMaxGuidStorage) SetMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, value uint64) error {
+	return setIfBiggerScript.Run(ctx, r.rdb, []string{r.key(realmID, guidType)}, value).Err()
 }
 
-func (r *redisMaxGuidStorage) MaxGuidForInstances(ctx context.Context, realmID uint32) (uint64, error) {
-	v, err := r.rdb.Get(ctx, r.instanceKey(realmID)).Uint64()
+func (r *redisMaxGuidStorage) MaxGuid(ctx context.Context, realmID uint32, guidType GuidType) (uint64, error) {
+	v, err := r.rdb.Get(ctx, r.key(realmID, guidType)).Uint64()
 	if err != nil && err != redis.Nil {
 		return 0, err
 	}
 	return v, nil
 }
 
-func (r *redisMaxGuidStorage) IncreaseMaxGuidForCharacters(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	return r.increaseKey(ctx, r.characterKey(realmID), increaseAmount)
-}
-
-func (r *redisMaxGuidStorage) IncreaseMaxGuidForItems(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	return r.increaseKey(ctx, r.itemKey(realmID), increaseAmount)
-}
-
-func (r *redisMaxGuidStorage) IncreaseMaxGuidForInstances(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	return r.increaseKey(ctx, r.instanceKey(realmID), increaseAmount)
+func (r *redisMaxGuidStorage) IncreaseMaxGuid(ctx context.Context, realmID uint32, guidType GuidType, increaseAmount uint64) (uint64, error) {
+	return r.increaseKey(ctx, r.key(realmID, guidType), increaseAmount)
 }
 
 func (r *redisMaxGuidStorage) increaseKey(ctx context.Context, key string, increaseAmount uint64) (uint64, error) {
@@ -111,32 +85,83 @@ func (r *redisMaxGuidStorage) increaseKey(ctx context.Context, key string, incre
 		return err
 	}
 
-	// Retry if the key has been changed.
-	for i := 0; i < r.retriesCount; i++ {
-		err := r.rdb.Watch(ctx, txf, key)
-		if err == nil {
-			// Success.
-			return newMaxAmount, nil
+	if err := r.watch(ctx, txf, key); err != nil {
+		return 0, err
+	}
+
+	return newMaxAmount, nil
+}
+
+func (r *redisMaxGuidStorage) UpdateGuidPool(ctx context.Context, realmID uint32, guidType GuidType, f func(pool *GuidPool) error) error {
+	key := r.poolKey(realmID, guidType)
+	txf := func(tx *redis.Tx) error {
+		pool := &GuidPool{}
+		data, err := tx.Get(ctx, key).Bytes()
+		if err != nil && err != redis.Nil {
+			return err
 		}
-		if err == redis.TxFailedErr {
-			// Optimistic lock lost. Retry.
-			continue
+
+		if len(data) > 0 {
+			if err = json.Unmarshal(data, pool); err != nil {
+				return fmt.Errorf("can't unmarshal guid pool %s: %w", key, err)
+			}
 		}
-		// Return any other error.
-		return 0, err
+
+		if err = f(pool); err != nil {
+			return err
+		}
+
+		data, err = json.Marshal(pool)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, data, 0)
+			return nil
+		})
+		return err
 	}
 
-	return 0, errors.New("reached maximum number of retries")
+	return r.watch(ctx, txf, key)
+}
+
+// watch runs transaction txf with the watched key, txf is retried if the key has been changed.
+// Transaction is not retried by the client, so the whole optimistic lock loop is retried if redis master is switching.
+func (r *redisMaxGuidStorage) watch(ctx context.Context, txf func(tx *redis.Tx) error, key string) error {
+	return redisclient.RetryOnFailover(ctx, failoverRetriesCount, func() error {
+		for i := 0; i < r.retriesCount; i++ {
+			err := r.rdb.Watch(ctx, txf, key)
+			if err == redis.TxFailedErr {
+				// Optimistic lock lost. Retry.
+				continue
+			}
+			return err
+		}
+
+		return errors.New("reached maximum number of retries")
+	})
 }
 
-func (r *redisMaxGuidStorage) itemKey(realmID uint32) string {
-	return fmt.Sprintf("realm:%d:maxItem", realmID)
+// redisKeyNameByGuidType maps guid type to the last part of redis key.
+var redisKeyNameByGuidType = map[GuidType]string{
+	GuidTypeCharacter:    "maxChar",
+	GuidTypeItem:         "maxItem",
+	GuidTypeInstance:     "maxInstance",
+	GuidTypePet:          "maxPet",
+	GuidTypeMail:         "maxMail",
+	GuidTypeCorpse:       "maxCorpse",
+	GuidTypeEquipmentSet: "maxEquipmentSet",
+	GuidTypeGroup:        "maxGroup",
+	GuidTypeArenaTeam:    "maxArenaTeam",
 }
 
-func (r *redisMaxGuidStorage) characterKey(realmID uint32) string {
-	return fmt.Sprintf("realm:%d:maxChar", realmID)
+// key returns redis key for the given realm and guid type, for example "realm:1:maxChar".
+func (r *redisMaxGuidStorage) key(realmID uint32, guidType GuidType) string {
+	return fmt.Sprintf("realm:%d:%s", realmID, redisKeyNameByGuidType[guidType])
 }
 
-func (r *redisMaxGuidStorage) instanceKey(realmID uint32) string {
-	return fmt.Sprintf("realm:%d:maxInstance", realmID)
+// poolKey returns redis key of the guid pool for the given realm and guid type, for example "realm:1:maxChar:pool".
+func (r *redisMaxGuidStorage) poolKey(realmID uint32, guidType GuidType) string {
+	return r.key(realmID, guidType) + ":pool"
 }
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
+)
+
+func TestRedisMaxGuidStorage(t *testing.T) {
+	for _, mode := range []string{redisclient.ModeStandalone, redisclient.ModeCluster} {
+		t.Run(mode, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{Mode: mode, MaxRetries: 1})
+			require.NoError(t, err)
+			defer rdb.Close()
+
+			ctx := context.Background()
+			storage := NewRedisMaxGuidStorage(rdb, 10)
+
+			require.NoError(t, storage.SetMaxGuid(ctx, 1, GuidTypeItem, 100))
+			require.NoError(t, storage.SetMaxGuid(ctx, 1, GuidTypeItem, 50), "smaller value is ignored")
+
+			maxGuid, err := storage.MaxGuid(ctx, 1, GuidTypeItem)
+			require.NoError(t, err)
+			require.Equal(t, uint64(100), maxGuid)
+
+			maxGuid, err = storage.IncreaseMaxGuid(ctx, 1, GuidTypeItem, 20)
+			require.NoError(t, err)
+			require.Equal(t, uint64(120), maxGuid)
+
+			maxGuid, err = storage.MaxGuid(ctx, 2, GuidTypeItem)
+			require.NoError(t, err)
+			require.Zero(t, maxGuid, "guids of other realm are separate")
+		})
+	}
+}
+
+func TestRedisMaxGuidStorageUpdateGuidPool(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{Mode: redisclient.ModeStandalone})
+	require.NoError(t, err)
+	defer rdb.Close()
+
+	ctx := context.Background()
+	storage := NewRedisMaxGuidStorage(rdb, 10)
+
+	require.NoError(t, storage.UpdateGuidPool(ctx, 1, GuidTypeItem, func(pool *GuidPool) error {
+		pool.AddIssued(GuidRange{Start: 1, End: 10})
+		return nil
+	}))
+
+	errNotIssued := errors.New("not issued")
+	err = storage.UpdateGuidPool(ctx, 1, GuidTypeItem, func(pool *GuidPool) error {
+		pool.AddIssued(GuidRange{Start: 11, End: 20})
+		if !pool.ReturnRange(GuidRange{Start: 30, End: 40}) {
+			return errNotIssued
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errNotIssued)
+
+	require.NoError(t, storage.UpdateGuidPool(ctx, 1, GuidTypeItem, func(pool *GuidPool) error {
+		require.Equal(t, []GuidRange{{1, 10}}, pool.Issued, "failed update is not stored")
+		return nil
+	}))
+
+	require.NoError(t, storage.UpdateGuidPool(ctx, 2, GuidTypeItem, func(pool *GuidPool) error {
+		require.Empty(t, pool.Issued, "pools of other realm are separate")
+		return nil
+	}))
+}
+
+func TestRedisMaxGuidStorageSurvivesRedisRestart(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{Mode: redisclient.ModeStandalone})
+	require.NoError(t, err)
+	defer rdb.Close()
+
+	ctx := context.Background()
+	storage := NewRedisMaxGuidStorage(rdb, 10)
+	_, err = storage.IncreaseMaxGuid(ctx, 1, GuidTypeCharacter, 10)
+	require.NoError(t, err)
+
+	// Simulates failover, new master is available on the same address a bit later.
+	addr := mr.Addr()
+	mr.Close()
+	go func() {
+		time.Sleep(150 * time.Millisecond)
+		_ = mr.StartAddr(addr)
+	}()
+
+	maxGuid, err := storage.IncreaseMaxGuid(ctx, 1, GuidTypeCharacter, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(20), maxGuid)
+}
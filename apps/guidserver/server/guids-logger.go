@@ -37,5 +37,25 @@ func (g *guidServerLoggerMiddleware) GetGUIDPool(ctx context.Context, request *p
 
 	res, err = g.realService.GetGUIDPool(ctx, request)
 	return
+}
+
+// ReturnGUIDPool puts unused GUIDs back to the pool.
+func (g *guidServerLoggerMiddleware) ReturnGUIDPool(ctx context.Context, request *pb.ReturnGUIDPoolRequest) (res *pb.ReturnGUIDPoolResponse, err error) {
+	defer func(t time.Time) {
+		returned := uint64(0)
+		if res != nil {
+			returned = res.GuidsReturned
+		}
+
+		g.logger.Debug().
+			Uint32("type", uint32(request.GuidType)).
+			Uint32("realmID", request.RealmID).
+			Int("diapasons", len(request.Diapasons)).
+			Uint64("returned", returned).
+			Err(err).
+			Msgf("Handled ReturnGUIDPool for %v.", time.Since(t))
+	}(time.Now())
 
+	res, err = g.realService.ReturnGUIDPool(ctx, request)
+	return
 }
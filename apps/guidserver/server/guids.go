@@ -39,3 +39,24 @@ func (g *GuidServer) GetGUIDPool(ctx context.Context, request *pb.GetGUIDPoolReq
 		ReceiverGUID: guidsResp,
 	}, nil
 }
+
+// ReturnGUIDPool puts unused GUIDs back to the pool.
+func (g *GuidServer) ReturnGUIDPool(ctx context.Context, request *pb.ReturnGUIDPoolRequest) (*pb.ReturnGUIDPoolResponse, error) {
+	diapasons := make([]service.GuidDiapason, len(request.Diapasons))
+	for i := range request.Diapasons {
+		diapasons[i] = service.GuidDiapason{
+			Start: request.Diapasons[i].Start,
+			End:   request.Diapasons[i].End,
+		}
+	}
+
+	returned, err := g.guildsService.ReturnGuids(ctx, request.RealmID, uint8(request.GuidType), diapasons)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReturnGUIDPoolResponse{
+		Api:           guidserver.Ver,
+		GuidsReturned: returned,
+	}, nil
+}
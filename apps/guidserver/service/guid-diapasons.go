@@ -44,6 +44,18 @@ func (d *AvailableDiapasons) PctUsage() float32 {
 	return 100 - float32(float64(totalLeft)/(float64(totalCount)/100))
 }
 
+// AvailableCount returns the amount of guids that are not used yet.
+func (d *AvailableDiapasons) AvailableCount() uint64 {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	totalLeft := uint64(0)
+	for _, diapason := range d.Diapasons {
+		totalLeft += diapason.GuidsLeft()
+	}
+	return totalLeft
+}
+
 func (d *AvailableDiapasons) UseGuids(guidsAmount uint64) []GuidDiapason {
 	diapasonsToReturn := []GuidDiapason{}
 	remainingGuidsToRequest := guidsAmount
@@ -73,7 +85,7 @@ func (d *AvailableDiapasons) UseGuids(guidsAmount uint64) []GuidDiapason {
 			End:   diapason.End,
 		})
 
-		diapason.CurrentGuid = diapason.End
+		d.Diapasons[i].CurrentGuid = diapason.End + 1
 	}
 
 	return diapasonsToReturn
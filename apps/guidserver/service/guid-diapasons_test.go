@@ -205,3 +205,12 @@ func TestAvailableDiapasons_CleanupEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestAvailableDiapasons_UseGuids_DrainedNotReused(t *testing.T) {
+	d := &AvailableDiapasons{}
+	d.AddDiapason(GuidDiapason{Start: 1, End: 10})
+	d.AddDiapason(GuidDiapason{Start: 20, End: 30})
+
+	assert.Equal(t, []GuidDiapason{{Start: 1, End: 10}, {Start: 20, End: 22}}, d.UseGuids(13))
+	assert.Equal(t, []GuidDiapason{{Start: 23, End: 24}}, d.UseGuids(2))
+}
@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/rs/zerolog/log"
@@ -12,88 +14,78 @@ import (
 
 var RequestGuidsBufferMultiplayer = 5
 
-type GuidType uint8
+var (
+	// ErrUnknownGuidType is returned when guid type is not supported.
+	ErrUnknownGuidType = errors.New("unknown guid type")
 
-const (
-	GuidTypeCharacter GuidType = iota
-	GuidTypeItem
-	GuidTypeInstance
-	GuidTypeMax
+	// ErrInvalidDiapason is returned when returned diapason can't be put back to the pool.
+	ErrInvalidDiapason = errors.New("invalid diapason")
+
+	// ErrGuidsUnavailable is returned when storage failed to give new guids or guids space is exhausted.
+	ErrGuidsUnavailable = errors.New("guids unavailable")
 )
 
 type GuidService interface {
 	GetGuids(ctx context.Context, realmID uint32, guidType uint8, desiredSize uint64) ([]GuidDiapason, error)
+
+	// ReturnGuids puts unused guids back to the pool shared between guid servers, so they can be given to other game servers.
+	// Only guids that were given away and not returned yet can be put back.
+	// Returns the amount of guids that were put back.
+	ReturnGuids(ctx context.Context, realmID uint32, guidType uint8, diapasons []GuidDiapason) (uint64, error)
 }
 
 type guidServiceImpl struct {
 	maxGuidsStorage repo.MaxGuidStorage
 
+	// limits are prefetch low watermark and max guid value of every guid type.
+	limits [repo.GuidTypeMax]repo.GuidTypeLimits
+
+	// spaceAlertPct is the percent of used guid space of the type that triggers alert.
+	spaceAlertPct float32
+
 	requestChan chan *guidsRequest
-	localCache  map[uint32][GuidTypeMax]*AvailableDiapasons
+	localCache  map[uint32][repo.GuidTypeMax]*AvailableDiapasons
 }
 
-func NewGuidService(ctx context.Context, mysql repo.MaxGuidProvider, redisStorage repo.MaxGuidStorage, realmIDs []uint32, workersCount int) (GuidService, error) {
+// NewGuidService creates guid service. Several instances of the service can share the same storage,
+// since storage increases max guids atomically and every instance has its own diapasons.
+// Given away and returned guids are tracked in the storage too, so any instance can validate returned guids and reuse them.
+//
+// limits are the minimal amount of available guids per realm and type, when it's reached new guids are prefetched,
+// and the biggest guid value of every type.
+// spaceAlertPct is the percent of used guid space of the type, when it's reached warning is logged.
+func NewGuidService(ctx context.Context, mysql repo.MaxGuidProvider, storage repo.MaxGuidStorage, realmIDs []uint32, workersCount int, limits [repo.GuidTypeMax]repo.GuidTypeLimits, spaceAlertPct float32) (GuidService, error) {
 	service := &guidServiceImpl{
-		maxGuidsStorage: redisStorage,
-		localCache:      map[uint32][GuidTypeMax]*AvailableDiapasons{},
+		maxGuidsStorage: storage,
+		limits:          limits,
+		spaceAlertPct:   spaceAlertPct,
+		localCache:      map[uint32][repo.GuidTypeMax]*AvailableDiapasons{},
 	}
 
 	for _, realmID := range realmIDs {
-		// Inits characters max guids in redis if needed.
-		max, err := redisStorage.MaxGuidForCharacters(ctx, realmID)
-		if err != nil {
-			return nil, err
-		}
-
-		if max == 0 {
-			max, err = mysql.MaxGuidForCharacters(ctx, realmID)
-			if err != nil {
-				return nil, err
-			}
-
-			err = redisStorage.SetMaxGuidForCharacters(ctx, realmID, max)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		// Inits items max guids in redis if needed.
-		max, err = redisStorage.MaxGuidForItems(ctx, realmID)
-		if err != nil {
-			return nil, err
-		}
-
-		if max == 0 {
-			max, err = mysql.MaxGuidForItems(ctx, realmID)
-			if err != nil {
-				return nil, err
-			}
-
-			err = redisStorage.SetMaxGuidForItems(ctx, realmID, max)
+		for guidType := repo.GuidType(0); guidType < repo.GuidTypeMax; guidType++ {
+			// Inits max guids in the storage if needed.
+			max, err := storage.MaxGuid(ctx, realmID, guidType)
 			if err != nil {
 				return nil, err
 			}
-		}
 
-		// Inits instances max guids in redis if needed.
-		max, err = redisStorage.MaxGuidForInstances(ctx, realmID)
-		if err != nil {
-			return nil, err
-		}
+			if max == 0 {
+				max, err = mysql.MaxGuid(ctx, realmID, guidType)
+				if err != nil {
+					return nil, err
+				}
 
-		if max == 0 {
-			max, err = mysql.MaxGuidForInstances(ctx, realmID)
-			if err != nil {
-				return nil, err
+				err = storage.SetMaxGuid(ctx, realmID, guidType, max)
+				if err != nil {
+					return nil, err
+				}
 			}
 
-			err = redisStorage.SetMaxGuidForInstances(ctx, realmID, max)
-			if err != nil {
-				return nil, err
-			}
+			service.checkGuidSpace(realmID, guidType, max)
 		}
 
-		caches := [GuidTypeMax]*AvailableDiapasons{}
+		caches := [repo.GuidTypeMax]*AvailableDiapasons{}
 		for i := range service.localCache[realmID] {
 			caches[i] = &AvailableDiapasons{}
 		}
@@ -111,25 +103,39 @@ func (g *guidServiceImpl) GetGuids(ctx context.Context, realmID uint32, guidType
 		return nil, fmt.Errorf("realmID %d not found", realmID)
 	}
 
+	if repo.GuidType(guidType) >= repo.GuidTypeMax {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownGuidType, guidType)
+	}
+
 	availableGuids := availableGuidsWithTypes[guidType]
 
+	// Requests at least low watermark amount of guids, to not request storage too often.
+	lowWatermark := g.limits[guidType].PrefetchLowWatermark
+	desiredAmount := desiredSize * uint64(RequestGuidsBufferMultiplayer)
+	if desiredAmount < lowWatermark {
+		desiredAmount = lowWatermark
+	}
+
 	pctUsed := availableGuids.PctUsage()
 	switch {
 	case pctUsed >= 99:
 		waitCh := make(chan struct{})
 		g.requestChan <- &guidsRequest{
 			realmID:       realmID,
-			guidType:      GuidType(guidType),
-			desiredAmount: desiredSize * uint64(RequestGuidsBufferMultiplayer),
+			guidType:      repo.GuidType(guidType),
+			desiredAmount: desiredAmount,
 			callback:      waitCh,
 		}
 		<-waitCh
+		if availableGuids.AvailableCount() == 0 {
+			return nil, ErrGuidsUnavailable
+		}
 		return g.GetGuids(ctx, realmID, guidType, desiredSize)
-	case pctUsed >= 70:
+	case pctUsed >= 70 || availableGuids.AvailableCount() < lowWatermark:
 		g.requestChan <- &guidsRequest{
 			realmID:       realmID,
-			guidType:      GuidType(guidType),
-			desiredAmount: desiredSize * uint64(RequestGuidsBufferMultiplayer),
+			guidType:      repo.GuidType(guidType),
+			desiredAmount: desiredAmount,
 		}
 	}
 
@@ -139,12 +145,60 @@ func (g *guidServiceImpl) GetGuids(ctx context.Context, realmID uint32, guidType
 		return g.GetGuids(ctx, realmID, guidType, desiredSize)
 	}
 
+	// Remembers given guids, so only they can be returned later.
+	err := g.maxGuidsStorage.UpdateGuidPool(ctx, realmID, repo.GuidType(guidType), func(pool *repo.GuidPool) error {
+		for _, diapason := range diapasons {
+			pool.AddIssued(repo.GuidRange(diapason))
+		}
+		return nil
+	})
+	if err != nil {
+		// Guids weren't given away, so they are put back to not lose them.
+		for _, diapason := range diapasons {
+			availableGuids.AddDiapason(diapason)
+		}
+		return nil, fmt.Errorf("can't store given guids: %w", err)
+	}
+
 	return diapasons, nil
 }
 
+func (g *guidServiceImpl) ReturnGuids(ctx context.Context, realmID uint32, guidType uint8, diapasons []GuidDiapason) (uint64, error) {
+	if _, found := g.localCache[realmID]; !found {
+		return 0, fmt.Errorf("realmID %d not found", realmID)
+	}
+
+	if repo.GuidType(guidType) >= repo.GuidTypeMax {
+		return 0, fmt.Errorf("%w: %d", ErrUnknownGuidType, guidType)
+	}
+
+	for _, diapason := range diapasons {
+		if diapason.Start == 0 || diapason.Start > diapason.End {
+			return 0, fmt.Errorf("%w: %d-%d", ErrInvalidDiapason, diapason.Start, diapason.End)
+		}
+	}
+
+	returned := uint64(0)
+	err := g.maxGuidsStorage.UpdateGuidPool(ctx, realmID, repo.GuidType(guidType), func(pool *repo.GuidPool) error {
+		returned = 0
+		for _, diapason := range diapasons {
+			if !pool.ReturnRange(repo.GuidRange(diapason)) {
+				return fmt.Errorf("%w: %d-%d wasn't given away or is returned already", ErrInvalidDiapason, diapason.Start, diapason.End)
+			}
+			returned += diapason.End - diapason.Start + 1
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return returned, nil
+}
+
 type guidsRequest struct {
 	realmID       uint32
-	guidType      GuidType
+	guidType      repo.GuidType
 	desiredAmount uint64
 	callback      chan<- struct{}
 }
@@ -156,7 +210,7 @@ func (r *guidsRequest) key() string {
 type guidsResponse struct {
 	request guidsRequest
 
-	newGuids GuidDiapason
+	newGuids []GuidDiapason
 }
 
 func (g *guidServiceImpl) startProcessingGoroutines(ctx context.Context, processorsCount int) {
@@ -190,7 +244,10 @@ func (g *guidServiceImpl) startProcessingGoroutines(ctx context.Context, process
 			case r := <-responseChan:
 				key = r.request.key()
 
-				g.localCache[r.request.realmID][r.request.guidType].AddDiapason(r.newGuids)
+				// No diapasons means that storage failed to give new guids.
+				for _, diapason := range r.newGuids {
+					g.localCache[r.request.realmID][r.request.guidType].AddDiapason(diapason)
+				}
 
 				if r.request.callback != nil {
 					r.request.callback <- struct{}{}
@@ -215,61 +272,88 @@ func (g *guidServiceImpl) startProcessingGoroutines(ctx context.Context, process
 
 func (g *guidServiceImpl) requestProcessor(requests <-chan guidsRequest, response chan<- guidsResponse) {
 	for r := range requests {
-		var newMax uint64
-		var err error
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		newGuids := g.takeReturnedGuids(ctx, r)
 
-		switch r.guidType {
-		case GuidTypeCharacter:
-			newMax, err = g.maxGuidsStorage.IncreaseMaxGuidForCharacters(ctx, r.realmID, r.desiredAmount)
-			cancel()
-			if err != nil {
-				log.Err(err).Msg("can't increase characters guid")
-				response <- guidsResponse{
-					request:  r,
-					newGuids: GuidDiapason{},
-				}
-				continue
-			}
+		amountLeft := r.desiredAmount
+		for _, diapason := range newGuids {
+			amountLeft -= diapason.End - diapason.Start + 1
+		}
 
-		case GuidTypeItem:
-			newMax, err = g.maxGuidsStorage.IncreaseMaxGuidForItems(ctx, r.realmID, r.desiredAmount)
-			cancel()
-			if err != nil {
-				log.Err(err).Msg("can't increase items guid")
-				response <- guidsResponse{
-					request:  r,
-					newGuids: GuidDiapason{},
-				}
-				continue
+		if amountLeft > 0 {
+			if diapason, ok := g.increaseMaxGuid(ctx, r.realmID, r.guidType, amountLeft); ok {
+				newGuids = append(newGuids, diapason)
 			}
+		}
+		cancel()
 
-		case GuidTypeInstance:
-			newMax, err = g.maxGuidsStorage.IncreaseMaxGuidForInstances(ctx, r.realmID, r.desiredAmount)
-			cancel()
-			if err != nil {
-				log.Err(err).Msg("can't increase instances guid")
-				response <- guidsResponse{
-					request:  r,
-					newGuids: GuidDiapason{},
-				}
-				continue
-			}
+		response <- guidsResponse{
+			request:  r,
+			newGuids: newGuids,
+		}
+	}
+}
 
-		default:
-			log.Err(fmt.Errorf("unk guid type: %d", r.guidType))
-			cancel()
-			continue
+// takeReturnedGuids takes up to desired amount of guids that were returned by game servers from the shared pool.
+func (g *guidServiceImpl) takeReturnedGuids(ctx context.Context, r guidsRequest) []GuidDiapason {
+	var taken []GuidDiapason
+	err := g.maxGuidsStorage.UpdateGuidPool(ctx, r.realmID, r.guidType, func(pool *repo.GuidPool) error {
+		taken = taken[:0]
+		for _, guidRange := range pool.TakeReturned(r.desiredAmount) {
+			taken = append(taken, GuidDiapason(guidRange))
 		}
+		return nil
+	})
+	if err != nil {
+		log.Err(err).Str("type", r.guidType.String()).Msg("can't take returned guids")
+		return nil
+	}
+	return taken
+}
 
-		response <- guidsResponse{
-			request: r,
-			newGuids: GuidDiapason{
-				Start: newMax - r.desiredAmount + 1,
-				End:   newMax,
-			},
+// increaseMaxGuid gets new guids from the storage. Returns false if storage failed to give new guids.
+func (g *guidServiceImpl) increaseMaxGuid(ctx context.Context, realmID uint32, guidType repo.GuidType, amount uint64) (GuidDiapason, bool) {
+	newMax, err := g.maxGuidsStorage.IncreaseMaxGuid(ctx, realmID, guidType, amount)
+	if err != nil {
+		log.Err(err).Str("type", guidType.String()).Msg("can't increase max guid")
+		return GuidDiapason{}, false
+	}
+
+	g.checkGuidSpace(realmID, guidType, newMax)
+
+	newGuids := GuidDiapason{
+		Start: newMax - amount + 1,
+		End:   newMax,
+	}
+
+	// Guids bigger than type max value can't be used by game server.
+	if maxValue := g.limits[guidType].MaxValue; newGuids.End > maxValue {
+		newGuids.End = maxValue
+		if newGuids.Start > newGuids.End {
+			log.Error().Uint32("realmID", realmID).Str("type", guidType.String()).Msg("guids space exhausted")
+			return GuidDiapason{}, false
 		}
 	}
+
+	return newGuids, true
+}
+
+// checkGuidSpace updates guid space metrics and logs warning if guid space of the type is close to the end.
+func (g *guidServiceImpl) checkGuidSpace(realmID uint32, guidType repo.GuidType, maxGuid uint64) {
+	realm := strconv.FormatUint(uint64(realmID), 10)
+	pctUsed := float32(float64(maxGuid) / float64(g.limits[guidType].MaxValue) * 100)
+
+	maxGivenGuidMetrics.WithLabelValues(realm, guidType.String()).Set(float64(maxGuid))
+	guidSpaceUsageMetrics.WithLabelValues(realm, guidType.String()).Set(float64(pctUsed))
+
+	if g.spaceAlertPct > 0 && pctUsed >= g.spaceAlertPct {
+		log.Warn().
+			Uint32("realmID", realmID).
+			Str("type", guidType.String()).
+			Uint64("maxGuid", maxGuid).
+			Float32("pctUsed", pctUsed).
+			Msg("guids space is close to the end")
+	}
 }
 
 func (g *guidServiceImpl) requestNewDiapason() {
@@ -2,91 +2,66 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/guidserver/repo"
 )
 
 type MaxGuidStorageMock struct {
-	charCounter      []uint64
-	itemCounter      []uint64
-	instancesCounter []uint64
-
-	charLock      sync.RWMutex
-	itemLock      sync.RWMutex
-	instancesLock sync.RWMutex
+	counters [repo.GuidTypeMax][]uint64
+	locks    [repo.GuidTypeMax]sync.RWMutex
 
 	increaseDelay time.Duration
 
 	counterLock     sync.Mutex
 	requestsCounter int
-}
-
-func (m *MaxGuidStorageMock) MaxGuidForCharacters(ctx context.Context, realmID uint32) (uint64, error) {
-	m.charLock.RLock()
-	defer m.charLock.RUnlock()
-	return m.charCounter[realmID], nil
-}
-
-func (m *MaxGuidStorageMock) MaxGuidForItems(ctx context.Context, realmID uint32) (uint64, error) {
-	m.itemLock.RLock()
-	defer m.itemLock.RUnlock()
-
-	return m.itemCounter[realmID], nil
-}
 
-func (m *MaxGuidStorageMock) MaxGuidForInstances(ctx context.Context, realmID uint32) (uint64, error) {
-	m.instancesLock.RLock()
-	defer m.instancesLock.RUnlock()
+	poolsLock sync.Mutex
+	pools     map[string]*repo.GuidPool
 
-	return m.instancesCounter[realmID], nil
+	// poolErr is returned by UpdateGuidPool if set.
+	poolErr error
 }
 
-func (m *MaxGuidStorageMock) SetMaxGuidForCharacters(ctx context.Context, realmID uint32, value uint64) error {
-	m.charLock.Lock()
-	defer m.charLock.Unlock()
-
-	m.charCounter[realmID] = value
-	return nil
+// newMaxGuidStorageMock creates storage mock with max guid 1 for every guid type in every realm.
+func newMaxGuidStorageMock(realmsCount int) *MaxGuidStorageMock {
+	m := &MaxGuidStorageMock{pools: map[string]*repo.GuidPool{}}
+	for i := range m.counters {
+		m.counters[i] = make([]uint64, realmsCount)
+		for realm := range m.counters[i] {
+			m.counters[i][realm] = 1
+		}
+	}
+	return m
 }
 
-func (m *MaxGuidStorageMock) SetMaxGuidForItems(ctx context.Context, realmID uint32, value uint64) error {
-	m.itemLock.Lock()
-	defer m.itemLock.Unlock()
+func (m *MaxGuidStorageMock) MaxGuid(ctx context.Context, realmID uint32, guidType repo.GuidType) (uint64, error) {
+	m.locks[guidType].RLock()
+	defer m.locks[guidType].RUnlock()
 
-	m.itemCounter[realmID] = value
-	return nil
+	return m.counters[guidType][realmID], nil
 }
 
-func (m *MaxGuidStorageMock) SetMaxGuidForInstances(ctx context.Context, realmID uint32, value uint64) error {
-	m.instancesLock.Lock()
-	defer m.instancesLock.Unlock()
-
-	m.instancesCounter[realmID] = value
-	return nil
-}
+func (m *MaxGuidStorageMock) SetMaxGuid(ctx context.Context, realmID uint32, guidType repo.GuidType, value uint64) error {
+	m.locks[guidType].Lock()
+	defer m.locks[guidType].Unlock()
 
-func (m *MaxGuidStorageMock) IncreaseMaxGuidForCharacters(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	m.charLock.Lock()
-	defer m.charLock.Unlock()
-
-	m.counterLock.Lock()
-	m.requestsCounter++
-	m.counterLock.Unlock()
-
-	if m.increaseDelay > 0 {
-		time.Sleep(m.increaseDelay)
+	if value > m.counters[guidType][realmID] {
+		m.counters[guidType][realmID] = value
 	}
-
-	m.charCounter[realmID] += increaseAmount
-	return m.charCounter[realmID], nil
+	return nil
 }
 
-func (m *MaxGuidStorageMock) IncreaseMaxGuidForItems(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	m.itemLock.Lock()
-	defer m.itemLock.Unlock()
+func (m *MaxGuidStorageMock) IncreaseMaxGuid(ctx context.Context, realmID uint32, guidType repo.GuidType, increaseAmount uint64) (uint64, error) {
+	m.locks[guidType].Lock()
+	defer m.locks[guidType].Unlock()
 
 	if m.increaseDelay > 0 {
 		time.Sleep(m.increaseDelay)
@@ -96,24 +71,37 @@ func (m *MaxGuidStorageMock) IncreaseMaxGuidForItems(ctx context.Context, realmI
 	m.requestsCounter++
 	m.counterLock.Unlock()
 
-	m.itemCounter[realmID] += increaseAmount
-	return m.itemCounter[realmID], nil
+	m.counters[guidType][realmID] += increaseAmount
+	return m.counters[guidType][realmID], nil
 }
 
-func (m *MaxGuidStorageMock) IncreaseMaxGuidForInstances(ctx context.Context, realmID uint32, increaseAmount uint64) (uint64, error) {
-	m.instancesLock.Lock()
-	defer m.instancesLock.Unlock()
+func (m *MaxGuidStorageMock) UpdateGuidPool(ctx context.Context, realmID uint32, guidType repo.GuidType, f func(pool *repo.GuidPool) error) error {
+	m.poolsLock.Lock()
+	defer m.poolsLock.Unlock()
 
-	if m.increaseDelay > 0 {
-		time.Sleep(m.increaseDelay)
+	if m.poolErr != nil {
+		return m.poolErr
 	}
 
-	m.counterLock.Lock()
-	m.requestsCounter++
-	m.counterLock.Unlock()
+	key := fmt.Sprintf("%d:%d", realmID, guidType)
+	pool := &repo.GuidPool{}
+	if current := m.pools[key]; current != nil {
+		pool.Issued = append(pool.Issued, current.Issued...)
+		pool.Returned = append(pool.Returned, current.Returned...)
+	}
 
-	m.instancesCounter[realmID] += increaseAmount
-	return m.instancesCounter[realmID], nil
+	if err := f(pool); err != nil {
+		return err
+	}
+
+	m.pools[key] = pool
+	return nil
+}
+
+func (m *MaxGuidStorageMock) setPoolErr(err error) {
+	m.poolsLock.Lock()
+	defer m.poolsLock.Unlock()
+	m.poolErr = err
 }
 
 func (m *MaxGuidStorageMock) GetRequestsCounter() int {
@@ -122,18 +110,23 @@ func (m *MaxGuidStorageMock) GetRequestsCounter() int {
 	return m.requestsCounter
 }
 
+// testLimits returns limits with the given low watermark for every guid type.
+func testLimits(lowWatermark uint64) [repo.GuidTypeMax]repo.GuidTypeLimits {
+	limits := repo.DefaultGuidTypesLimits()
+	for i := range limits {
+		limits[i].PrefetchLowWatermark = lowWatermark
+	}
+	return limits
+}
+
 func Test_guidServiceImpl_GetGuids(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	mock := &MaxGuidStorageMock{
-		charCounter:      []uint64{1000, 1000, 1000},
-		itemCounter:      []uint64{1, 1, 1},
-		instancesCounter: []uint64{1, 1, 1},
-		//increaseDelay: time.Microsecond * 1,
-	}
+	mock := newMaxGuidStorageMock(3)
+	mock.counters[repo.GuidTypeCharacter] = []uint64{1000, 1000, 1000}
 
-	s, err := NewGuidService(ctx, nil, mock, []uint32{1, 2}, 4)
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1, 2}, 4, testLimits(0), 0)
 	assert.NoError(t, err)
 	wg := sync.WaitGroup{}
 	for i := 0; i < 10; i++ {
@@ -154,12 +147,10 @@ func Test_guidServiceImpl_GetGuids_ThreeTypes(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	mock := &MaxGuidStorageMock{
-		charCounter:      []uint64{1000, 1000, 1000},
-		itemCounter:      []uint64{1, 1, 1},
-		instancesCounter: []uint64{50, 50, 50},
-		increaseDelay:    time.Millisecond * 1,
-	}
+	mock := newMaxGuidStorageMock(3)
+	mock.counters[repo.GuidTypeCharacter] = []uint64{1000, 1000, 1000}
+	mock.counters[repo.GuidTypeInstance] = []uint64{50, 50, 50}
+	mock.increaseDelay = time.Millisecond * 1
 
 	expCharDiapasons := []GuidDiapason{{1001, 1001}, {1002, 1002}, {1003, 1003}, {1004, 1004}, {1005, 1005}, {1006, 1006}}
 	expItemDiapasons := []GuidDiapason{{2, 2}, {3, 3}, {4, 4}, {5, 5}, {6, 6}, {7, 7}}
@@ -169,13 +160,13 @@ func Test_guidServiceImpl_GetGuids_ThreeTypes(t *testing.T) {
 	itemDiapasons := []GuidDiapason{}
 	instancesDiapasons := []GuidDiapason{}
 
-	s, err := NewGuidService(ctx, nil, mock, []uint32{1, 2}, 4)
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1, 2}, 4, testLimits(0), 0)
 	assert.NoError(t, err)
 	wg := sync.WaitGroup{}
 	wg.Add(3)
 	go func() {
 		for i := 0; i < 6; i++ {
-			diapason, err := s.GetGuids(ctx, 1, uint8(GuidTypeCharacter), 1)
+			diapason, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeCharacter), 1)
 			assert.NoError(t, err)
 			charDiapasons = append(charDiapasons, diapason...)
 		}
@@ -183,7 +174,7 @@ func Test_guidServiceImpl_GetGuids_ThreeTypes(t *testing.T) {
 	}()
 	go func() {
 		for i := 0; i < 6; i++ {
-			diapason, err := s.GetGuids(ctx, 1, uint8(GuidTypeItem), 1)
+			diapason, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeItem), 1)
 			assert.NoError(t, err)
 			itemDiapasons = append(itemDiapasons, diapason...)
 		}
@@ -191,7 +182,7 @@ func Test_guidServiceImpl_GetGuids_ThreeTypes(t *testing.T) {
 	}()
 	go func() {
 		for i := 0; i < 6; i++ {
-			diapason, err := s.GetGuids(ctx, 1, uint8(GuidTypeInstance), 1)
+			diapason, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeInstance), 1)
 			assert.NoError(t, err)
 			instancesDiapasons = append(instancesDiapasons, diapason...)
 		}
@@ -203,3 +194,173 @@ func Test_guidServiceImpl_GetGuids_ThreeTypes(t *testing.T) {
 	assert.Equal(t, expItemDiapasons, itemDiapasons)
 	assert.Equal(t, expInstancesDiapasons, instancesDiapasons)
 }
+
+func Test_guidServiceImpl_ReturnGuids(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := newMaxGuidStorageMock(2)
+	mock.counters[repo.GuidTypeMail] = []uint64{100, 100}
+
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, testLimits(0), 0)
+	assert.NoError(t, err)
+
+	diapasons, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{101, 110}}, diapasons)
+
+	returned, err := s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{105, 110}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(6), returned)
+
+	_, err = s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{1000, 2000}})
+	assert.ErrorIs(t, err, ErrInvalidDiapason)
+
+	_, err = s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{108, 110}})
+	assert.ErrorIs(t, err, ErrInvalidDiapason, "already returned")
+
+	_, err = s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{111, 115}})
+	assert.ErrorIs(t, err, ErrInvalidDiapason, "prefetched, but not given away")
+
+	_, err = s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMax), []GuidDiapason{{1, 2}})
+	assert.ErrorIs(t, err, ErrUnknownGuidType)
+
+	// Drains rest of the prefetched guids, returned guids are given after them.
+	diapasons, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 40)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{111, 150}}, diapasons)
+
+	diapasons, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{105, 110}}, diapasons)
+
+	// Returned guids are shared between guid servers using the same storage.
+	_, err = s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{111, 120}})
+	assert.NoError(t, err)
+
+	other, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, testLimits(0), 0)
+	assert.NoError(t, err)
+
+	diapasons, err = other.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{111, 120}}, diapasons)
+}
+
+func Test_guidServiceImpl_GetGuids_StorageFailureKeepsGuids(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := newMaxGuidStorageMock(2)
+	mock.counters[repo.GuidTypeMail] = []uint64{100, 100}
+
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, testLimits(0), 0)
+	assert.NoError(t, err)
+
+	given, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{101, 110}}, given)
+
+	mock.setPoolErr(errors.New("storage is down"))
+	_, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 10)
+	assert.Error(t, err)
+	mock.setPoolErr(nil)
+
+	// Guids of the failed request are given later, so every prefetched guid is given exactly once.
+	diapasons, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeMail), 40)
+	assert.NoError(t, err)
+	given = append(given, diapasons...)
+
+	givenGuids := map[uint64]int{}
+	for _, diapason := range given {
+		for guid := diapason.Start; guid <= diapason.End; guid++ {
+			givenGuids[guid]++
+		}
+	}
+	for guid := uint64(101); guid <= 150; guid++ {
+		assert.Equal(t, 1, givenGuids[guid], "guid %d", guid)
+	}
+
+	// Put back guids are stored as given when they are given again.
+	returned, err := s.ReturnGuids(ctx, 1, uint8(repo.GuidTypeMail), []GuidDiapason{{111, 120}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), returned)
+}
+
+func Test_guidServiceImpl_GetGuids_LowWatermarkPrefetch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := newMaxGuidStorageMock(2)
+	mock.counters[repo.GuidTypeItem] = []uint64{100, 100}
+
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, testLimits(50), 0)
+	assert.NoError(t, err)
+
+	// Low watermark is bigger than desired size multiplied by buffer multiplier.
+	diapasons, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeItem), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{101, 105}}, diapasons)
+
+	// 45 guids left, that is below low watermark.
+	_, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeItem), 1)
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		max, _ := mock.MaxGuid(ctx, 1, repo.GuidTypeItem)
+		return max == 200
+	}, time.Second, time.Millisecond*10)
+}
+
+func Test_guidServiceImpl_GetGuids_GuidSpaceLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := newMaxGuidStorageMock(2)
+	mock.counters[repo.GuidTypeCharacter] = []uint64{math.MaxUint32 - 5, math.MaxUint32 - 5}
+
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, testLimits(0), 90)
+	assert.NoError(t, err)
+
+	diapasons, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeCharacter), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{math.MaxUint32 - 4, math.MaxUint32 - 3}}, diapasons)
+
+	diapasons, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeCharacter), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{math.MaxUint32 - 2, math.MaxUint32}}, diapasons)
+
+	_, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeCharacter), 1)
+	assert.ErrorIs(t, err, ErrGuidsUnavailable)
+}
+
+func Test_guidServiceImpl_GetGuids_TypeLimits(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := newMaxGuidStorageMock(2)
+	mock.counters[repo.GuidTypeArenaTeam] = []uint64{100, 100}
+
+	limits := testLimits(0)
+	limits[repo.GuidTypeArenaTeam] = repo.GuidTypeLimits{PrefetchLowWatermark: 10, MaxValue: 112}
+
+	s, err := NewGuidService(ctx, nil, mock, []uint32{1}, 1, limits, 0)
+	assert.NoError(t, err)
+
+	// Low watermark of the type is used instead of the big buffer.
+	diapasons, err := s.GetGuids(ctx, 1, uint8(repo.GuidTypeArenaTeam), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{101, 101}}, diapasons)
+
+	max, err := mock.MaxGuid(ctx, 1, repo.GuidTypeArenaTeam)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(110), max)
+
+	// Guids bigger than max value of the type are not given away.
+	diapasons, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeArenaTeam), 9)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{102, 110}}, diapasons)
+
+	diapasons, err = s.GetGuids(ctx, 1, uint8(repo.GuidTypeArenaTeam), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []GuidDiapason{{111, 112}}, diapasons)
+}
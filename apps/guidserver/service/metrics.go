@@ -0,0 +1,16 @@
+package service
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var maxGivenGuidMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "guidserver_max_given_guid",
+	Help: "The max guid given by the storage",
+}, []string{"realm", "type"})
+
+var guidSpaceUsageMetrics = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "guidserver_guid_space_usage_pct",
+	Help: "The percent of used guid space of the type",
+}, []string{"realm", "type"})
@@ -22,6 +22,7 @@ import (
 	"github.com/walkline/ToCloud9/apps/mailserver/repo"
 	"github.com/walkline/ToCloud9/apps/mailserver/server"
 	"github.com/walkline/ToCloud9/apps/mailserver/service"
+	pbGuid "github.com/walkline/ToCloud9/gen/guid/pb"
 	"github.com/walkline/ToCloud9/gen/mail/pb"
 	"github.com/walkline/ToCloud9/shared/events"
 	shrepo "github.com/walkline/ToCloud9/shared/repo"
@@ -50,6 +51,29 @@ func main() {
 		log.Fatal().Err(err).Msg("can't create guilds repo")
 	}
 
+	systemMailRepo, err := repo.NewSystemMailMySQLRepo(charDB)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't create system mail repo")
+	}
+
+	worldDB, err := sql.Open("mysql", cfg.WorldDBConnection)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to world db")
+	}
+	defer worldDB.Close()
+	configureDBConn(worldDB)
+
+	itemTemplates, err := repo.NewItemTemplateCache(worldDB)
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't load item templates")
+	}
+
+	guidConn, err := grpc.Dial(cfg.GuidProviderServiceAddress, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to guid service")
+	}
+	defer guidConn.Close()
+
 	nc, err := nats.Connect(
 		cfg.NatsURL,
 		nats.PingInterval(20*time.Second),
@@ -71,6 +95,24 @@ func main() {
 	ticker := service.NewMailsCleanupTicker([]uint32{1}, time.Second*time.Duration(cfg.ExpiredMailsCleanupSecsDelay), mailService)
 	go ticker.Start(context.TODO())
 
+	systemMailService := service.NewSystemMailService(
+		systemMailRepo,
+		mailService,
+		itemTemplates,
+		service.NewGuidServiceItemGuidProvider(pbGuid.NewGuidServiceClient(guidConn)),
+		cfg.SystemMailsPerSecond,
+	)
+
+	realms := make([]uint32, 0, len(cfg.CharDBConnection))
+	for realmID := range cfg.CharDBConnection {
+		realms = append(realms, realmID)
+	}
+
+	systemMailCtx, stopSystemMails := context.WithCancel(context.Background())
+	if err = systemMailService.Start(systemMailCtx, realms); err != nil {
+		log.Fatal().Err(err).Msg("can't resume system mail jobs")
+	}
+
 	// grpc setup
 	lis, err := net.Listen("tcp4", ":"+cfg.Port)
 	if err != nil {
@@ -78,7 +120,7 @@ func main() {
 	}
 
 	grpcServer := grpc.NewServer()
-	mailServer := server.NewMailServer(mailService)
+	mailServer := server.NewMailServer(mailService, systemMailService)
 	if cfg.LogLevel == zerolog.DebugLevel {
 		mailServer = server.NewMailDebugLoggerMiddleware(mailServer, log.Logger)
 	}
@@ -94,6 +136,8 @@ func main() {
 		fmt.Println("")
 		log.Info().Msgf("🧨 Got signal %v, attempting graceful shutdown...", sig)
 		grpcServer.GracefulStop()
+		stopSystemMails()
+		systemMailService.Wait()
 		wg.Done()
 	}()
 
@@ -14,6 +14,12 @@ type Config struct {
 	// CharDBConnection is connection string to the characters database
 	CharDBConnection map[uint32]string `yaml:"charactersDB" env:"CHAR_DB_CONNECTION" env-separator:";" env-default:"1:trinity:trinity@tcp(127.0.0.1:3306)/characters"`
 
+	// WorldDBConnection is connection string to the world database (for item templates of system mails)
+	WorldDBConnection string `yaml:"worldDB" env:"WORLD_DB_CONNECTION" env-default:"trinity:trinity@tcp(127.0.0.1:3306)/world"`
+
+	// GuidProviderServiceAddress is address of service that provides guids for the items of system mails
+	GuidProviderServiceAddress string `yaml:"guidProviderServiceAddress" env:"GUID_PROVIDER_SERVICE_ADDRESS" env-default:"localhost:8996"`
+
 	// NatsURL is nats connection url
 	NatsURL string `yaml:"natsUrl" env:"NATS_URL" env-default:"nats://nats:4222"`
 
@@ -23,6 +29,9 @@ type Config struct {
 	// DefaultMailExpirationTimeSecs is default mail expiration time if client doesnt provide one.
 	// By default - 2592000 - 30 days.
 	DefaultMailExpirationTimeSecs int64 `yaml:"defaultMailExpirationTimeSecs" env:"DEFAULT_MAIL_EXPIRATION_TIME_SECS" env-default:"2592000"`
+
+	// SystemMailsPerSecond limits amount of system mails sent per second by all bulk jobs, 0 disables the limit.
+	SystemMailsPerSecond int `yaml:"systemMailsPerSecond" env:"SYSTEM_MAILS_PER_SECOND" env-default:"50"`
 }
 
 // LoadConfig loads config from env variables
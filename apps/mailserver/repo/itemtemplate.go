@@ -0,0 +1,77 @@
+package repo
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ItemTemplate contains item template data needed to create items for system mails
+type ItemTemplate struct {
+	Entry         uint32
+	Stackable     uint32
+	MaxDurability uint32
+}
+
+// ItemTemplateCache caches item templates, it's read only after creation
+type ItemTemplateCache struct {
+	templates map[uint32]*ItemTemplate
+}
+
+// NewItemTemplateCache loads item templates from world database
+func NewItemTemplateCache(worldDB *sql.DB) (*ItemTemplateCache, error) {
+	rows, err := worldDB.Query("SELECT entry, stackable, MaxDurability FROM item_template")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load item templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := make(map[uint32]*ItemTemplate)
+	for rows.Next() {
+		var (
+			tmpl ItemTemplate
+			// stackable column is signed, some DBs have negative values in it.
+			stackable int32
+		)
+		if err = rows.Scan(&tmpl.Entry, &stackable, &tmpl.MaxDurability); err != nil {
+			return nil, fmt.Errorf("failed to scan item template: %w", err)
+		}
+		tmpl.Stackable = stackSize(stackable)
+		templates[tmpl.Entry] = &tmpl
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating item templates: %w", err)
+	}
+
+	log.Info().Int("count", len(templates)).Msg("Loaded item templates into cache")
+
+	return &ItemTemplateCache{
+		templates: templates,
+	}, nil
+}
+
+// stackSize converts stackable column value to the max stack size, not positive values mean not stackable item.
+func stackSize(stackable int32) uint32 {
+	if stackable < 1 {
+		return 1
+	}
+	return uint32(stackable)
+}
+
+// NewItemTemplateCacheWithTemplates creates cache with the given templates
+func NewItemTemplateCacheWithTemplates(templates []ItemTemplate) *ItemTemplateCache {
+	c := &ItemTemplateCache{
+		templates: make(map[uint32]*ItemTemplate, len(templates)),
+	}
+	for i := range templates {
+		c.templates[templates[i].Entry] = &templates[i]
+	}
+	return c
+}
+
+// Get returns an item template by entry ID
+func (c *ItemTemplateCache) Get(entry uint32) *ItemTemplate {
+	return c.templates[entry]
+}
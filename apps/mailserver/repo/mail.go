@@ -91,7 +91,7 @@ type MailRepo interface {
 	// UpdateMailWithoutAttachments updates mail object, ignores mail attachments.
 	UpdateMailWithoutAttachments(ctx context.Context, realmID uint32, mail *Mail) error
 
-	// UpdateMailItemsOwner updates owner for mail items by mailID.
+	// UpdateMailItemsOwner updates owner for mail items and their item instances by mailID.
 	UpdateMailItemsOwner(ctx context.Context, realmID uint32, mailID uint, newReceiverID uint64) error
 
 	// RemoveMailItem removes mail item with given id.
@@ -109,6 +109,12 @@ type MailRepo interface {
 	// ExpiredMails returns list of expired mails.
 	ExpiredMails(ctx context.Context, realmID uint32) ([]Mail, error)
 
+	// MailsOfDeletedReceivers returns list of mails which receivers don't exist anymore, without attachments.
+	MailsOfDeletedReceivers(ctx context.Context, realmID uint32) ([]Mail, error)
+
+	// CharacterExists returns true if character with given guid exists and is not deleted.
+	CharacterExists(ctx context.Context, realmID uint32, characterGUID uint64) (bool, error)
+
 	// MailItemsIDsByMailIDs returns items ids for given mail ids.
 	MailItemsIDsByMailIDs(ctx context.Context, realmID uint32, IDs []uint) ([]uint64, error)
 
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -26,6 +27,8 @@ func NewMailMySQLRepo(db shrepo.CharactersDB) (MailRepo, error) {
 	db.SetPreparedStatement(StmtUpdateMailByID)
 	db.SetPreparedStatement(StmtSelectExpiredMails)
 	db.SetPreparedStatement(StmtUpdateMailItemsReceiverByMailID)
+	db.SetPreparedStatement(StmtCharacterExists)
+	db.SetPreparedStatement(StmtSelectMailsOfDeletedReceivers)
 
 	return &mailMySQLRepo{
 		db: db,
@@ -38,38 +41,53 @@ func (m *mailMySQLRepo) AddMail(ctx context.Context, realmID uint32, mail *Mail)
 		return err
 	}
 
+	id, err := addMailTx(ctx, tx, mail)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	mail.ID = uint(id)
+
+	return nil
+}
+
+// addMailTx creates mail and mail items within the transaction, returns id of the new mail.
+func addMailTx(ctx context.Context, tx *sql.Tx, mail *Mail) (int64, error) {
 	mailExec, err := tx.ExecContext(ctx, StmtCreateNewMail.Stmt(),
 		mail.Type, mail.Stationery, mail.TemplateID, mail.SenderGuid,
 		mail.ReceiverGuid, mail.Subject, mail.Body, len(mail.Attachments) > 0,
 		mail.ExpirationTimestamp, mail.DeliveryTimestamp, mail.MoneyToSend,
 		mail.CashOnDelivery, mail.FlagsMask)
 	if err != nil {
-		tx.Rollback()
-		return err
+		return 0, err
 	}
 
 	id, err := mailExec.LastInsertId()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	createMailItemStmt, err := tx.Prepare(StmtCreateMailItem.Stmt())
 	if err != nil {
-		tx.Rollback()
-		return err
+		return 0, err
 	}
 
 	createItemInstanceStmt, err := tx.Prepare(StmtUpsertItemInstance.Stmt())
 	if err != nil {
-		tx.Rollback()
-		return err
+		return 0, err
 	}
 
 	for _, att := range mail.Attachments {
 		_, err = createMailItemStmt.ExecContext(ctx, id, att.GUID, mail.ReceiverGuid)
 		if err != nil {
-			tx.Rollback()
-			return err
+			return 0, err
 		}
 
 		// TODO: add missing fields.
@@ -79,20 +97,11 @@ func (m *mailMySQLRepo) AddMail(ctx context.Context, realmID uint32, mail *Mail)
 			att.RandomPropertyID, att.Durability, att.Text,
 		)
 		if err != nil {
-			tx.Rollback()
-			return err
+			return 0, err
 		}
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		tx.Rollback()
-		return err
-	}
-
-	mail.ID = uint(id)
-
-	return nil
+	return id, nil
 }
 
 func (m *mailMySQLRepo) MailListForPlayer(ctx context.Context, realmID uint32, playerGUID uint64) ([]Mail, error) {
@@ -287,22 +296,55 @@ func (m *mailMySQLRepo) ExpiredMails(ctx context.Context, realmID uint32) ([]Mai
 	}
 	defer rowsMail.Close()
 
-	mails := []Mail{}
+	mails, err := scanMailsWithoutAttachments(rowsMail)
+	if err != nil {
+		return nil, fmt.Errorf("can't create expired mail object, err: %w", err)
+	}
+
+	return mails, nil
+}
 
+func (m *mailMySQLRepo) MailsOfDeletedReceivers(ctx context.Context, realmID uint32) ([]Mail, error) {
+	rowsMail, err := m.db.PreparedStatement(realmID, StmtSelectMailsOfDeletedReceivers).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rowsMail.Close()
+
+	mails, err := scanMailsWithoutAttachments(rowsMail)
+	if err != nil {
+		return nil, fmt.Errorf("can't create mail of deleted receiver object, err: %w", err)
+	}
+
+	return mails, nil
+}
+
+func (m *mailMySQLRepo) CharacterExists(ctx context.Context, realmID uint32, characterGUID uint64) (bool, error) {
+	var count int
+	err := m.db.PreparedStatement(realmID, StmtCharacterExists).QueryRowContext(ctx, characterGUID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("can't check that character exists, err: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+func scanMailsWithoutAttachments(rowsMail *sql.Rows) ([]Mail, error) {
+	mails := []Mail{}
 	for rowsMail.Next() {
 		mail := Mail{}
-		err = rowsMail.Scan(
+		err := rowsMail.Scan(
 			&mail.ID, &mail.Type, &mail.SenderGuid, &mail.ReceiverGuid, &mail.Subject, &mail.Body,
 			&mail.ExpirationTimestamp, &mail.DeliveryTimestamp, &mail.MoneyToSend,
 			&mail.CashOnDelivery, &mail.FlagsMask, &mail.Stationery, &mail.TemplateID, &mail.HasItemAttachments,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("can't create expired mail object, err: %w", err)
+			return nil, err
 		}
 		mails = append(mails, mail)
 	}
 
-	return mails, err
+	return mails, rowsMail.Err()
 }
 
 func (m *mailMySQLRepo) DeleteMailsWithoutAttachments(ctx context.Context, realmID uint32, IDs []uint) error {
@@ -315,7 +357,7 @@ func (m *mailMySQLRepo) DeleteMailsWithoutAttachments(ctx context.Context, realm
 }
 
 func (m *mailMySQLRepo) UpdateMailItemsOwner(ctx context.Context, realmID uint32, mailID uint, newReceiverID uint64) error {
-	_, err := m.db.PreparedStatement(realmID, StmtUpdateMailItemsReceiverByMailID).ExecContext(ctx, newReceiverID, mailID)
+	_, err := m.db.PreparedStatement(realmID, StmtUpdateMailItemsReceiverByMailID).ExecContext(ctx, newReceiverID, newReceiverID, mailID)
 	if err != nil {
 		return fmt.Errorf("can't update mail items receiver, err: %w", err)
 	}
@@ -28,6 +28,30 @@ func (_m *MailRepo) AddMail(ctx context.Context, realmID uint32, mail *repo.Mail
 	return r0
 }
 
+// CharacterExists provides a mock function with given fields: ctx, realmID, characterGUID
+func (_m *MailRepo) CharacterExists(ctx context.Context, realmID uint32, characterGUID uint64) (bool, error) {
+	ret := _m.Called(ctx, realmID, characterGUID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) (bool, error)); ok {
+		return rf(ctx, realmID, characterGUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32, uint64) bool); ok {
+		r0 = rf(ctx, realmID, characterGUID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32, uint64) error); ok {
+		r1 = rf(ctx, realmID, characterGUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteItemsWithIDs provides a mock function with given fields: ctx, realmID, itemIDs
 func (_m *MailRepo) DeleteItemsWithIDs(ctx context.Context, realmID uint32, itemIDs []uint64) error {
 	ret := _m.Called(ctx, realmID, itemIDs)
@@ -174,6 +198,32 @@ func (_m *MailRepo) MailListForPlayer(ctx context.Context, realmID uint32, playe
 	return r0, r1
 }
 
+// MailsOfDeletedReceivers provides a mock function with given fields: ctx, realmID
+func (_m *MailRepo) MailsOfDeletedReceivers(ctx context.Context, realmID uint32) ([]repo.Mail, error) {
+	ret := _m.Called(ctx, realmID)
+
+	var r0 []repo.Mail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) ([]repo.Mail, error)); ok {
+		return rf(ctx, realmID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint32) []repo.Mail); ok {
+		r0 = rf(ctx, realmID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repo.Mail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint32) error); ok {
+		r1 = rf(ctx, realmID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RemoveMailItem provides a mock function with given fields: ctx, realmID, mailID, mailItemGUID
 func (_m *MailRepo) RemoveMailItem(ctx context.Context, realmID uint32, mailID uint, mailItemGUID uint64) error {
 	ret := _m.Called(ctx, realmID, mailID, mailItemGUID)
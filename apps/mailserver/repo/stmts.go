@@ -53,6 +53,42 @@ const (
 
 	// StmtDeleteMailItemsByItemIDs delete mail items with item IDs.
 	StmtDeleteMailItemsByItemIDs
+
+	// StmtCharacterExists checks that character exists and is not deleted.
+	StmtCharacterExists
+
+	// StmtSelectMailsOfDeletedReceivers selects mails which receivers don't exist or are deleted.
+	StmtSelectMailsOfDeletedReceivers
+
+	// StmtCreateSystemMailJob creates system mail job if idempotency key is not used.
+	StmtCreateSystemMailJob
+
+	// StmtGetSystemMailJob returns system mail job by idempotency key.
+	StmtGetSystemMailJob
+
+	// StmtSelectSystemMailJobsWithStatus selects system mail jobs with given status.
+	StmtSelectSystemMailJobsWithStatus
+
+	// StmtUpdateSystemMailJobProgress updates status and counters of system mail job.
+	StmtUpdateSystemMailJobProgress
+
+	// StmtSelectSystemMailSentReceivers selects receivers that got mail of system mail job.
+	StmtSelectSystemMailSentReceivers
+
+	// StmtCreateSystemMailReceiver marks receiver as one that got mail of system mail job.
+	StmtCreateSystemMailReceiver
+
+	// StmtSelectSystemMailReceivers selects characters for system mail, filters are added with placeholders.
+	StmtSelectSystemMailReceivers
+
+	// StmtClaimSystemMailJob sets owner of running system mail job if it has no owner or the lease is expired.
+	StmtClaimSystemMailJob
+
+	// StmtReleaseSystemMailJob removes owner of system mail job.
+	StmtReleaseSystemMailJob
+
+	// StmtGetSystemMailJobOwner returns owner of system mail job.
+	StmtGetSystemMailJobOwner
 )
 
 // CharsPreparedStatements represents prepared statements for the characters database.
@@ -103,7 +139,7 @@ func (s CharsPreparedStatements) Stmt() string {
 	case StmtSelectExpiredMails:
 		return "SELECT id, messageType, sender, receiver, subject, body, expire_time, deliver_time, money, cod, checked, stationery, mailTemplateId, has_items FROM mail WHERE expire_time < ?"
 	case StmtUpdateMailItemsReceiverByMailID:
-		return "UPDATE mail_items SET receiver = ? WHERE mail_id = ?"
+		return "UPDATE mail_items mi LEFT JOIN item_instance ii ON ii.guid = mi.item_guid SET mi.receiver = ?, ii.owner_guid = ? WHERE mi.mail_id = ?"
 	case StmtDeleteMailsWithIDs:
 		return "DELETE FROM mail WHERE id IN (%s)"
 	case StmtSelectMailsItemsIDWithMailIDs:
@@ -112,6 +148,30 @@ func (s CharsPreparedStatements) Stmt() string {
 		return "DELETE FROM item_instance WHERE guid IN (%s)"
 	case StmtDeleteMailItemsByItemIDs:
 		return "DELETE FROM mail_items WHERE item_guid IN (%s)"
+	case StmtCharacterExists:
+		return "SELECT COUNT(*) FROM characters WHERE guid = ? AND deleteInfos_Name IS NULL"
+	case StmtSelectMailsOfDeletedReceivers:
+		return "SELECT m.id, m.messageType, m.sender, m.receiver, m.subject, m.body, m.expire_time, m.deliver_time, m.money, m.cod, m.checked, m.stationery, m.mailTemplateId, m.has_items FROM mail m LEFT JOIN characters c ON c.guid = m.receiver AND c.deleteInfos_Name IS NULL WHERE c.guid IS NULL"
+	case StmtCreateSystemMailJob:
+		return "INSERT IGNORE INTO mail_system_jobs (idempotencyKey, status, target, mail, createdAt) VALUES (?, ?, ?, ?, ?)"
+	case StmtGetSystemMailJob:
+		return "SELECT idempotencyKey, status, target, mail, total, sent, failed, error, createdAt FROM mail_system_jobs WHERE idempotencyKey = ?"
+	case StmtSelectSystemMailJobsWithStatus:
+		return "SELECT idempotencyKey, status, target, mail, total, sent, failed, error, createdAt FROM mail_system_jobs WHERE status = ? ORDER BY createdAt"
+	case StmtUpdateSystemMailJobProgress:
+		return "UPDATE mail_system_jobs SET status = ?, total = ?, sent = ?, failed = ?, error = ? WHERE idempotencyKey = ?"
+	case StmtSelectSystemMailSentReceivers:
+		return "SELECT receiver FROM mail_system_job_receivers WHERE idempotencyKey = ?"
+	case StmtCreateSystemMailReceiver:
+		return "INSERT IGNORE INTO mail_system_job_receivers (idempotencyKey, receiver, mailId) VALUES (?, ?, ?)"
+	case StmtSelectSystemMailReceivers:
+		return "SELECT c.guid FROM characters c%s WHERE c.deleteInfos_Name IS NULL%s ORDER BY c.guid"
+	case StmtClaimSystemMailJob:
+		return "UPDATE mail_system_jobs SET owner = ?, leaseExpiresAt = ? WHERE idempotencyKey = ? AND status = ? AND (owner IS NULL OR owner = ? OR leaseExpiresAt < ?)"
+	case StmtReleaseSystemMailJob:
+		return "UPDATE mail_system_jobs SET owner = NULL, leaseExpiresAt = 0 WHERE idempotencyKey = ? AND owner = ?"
+	case StmtGetSystemMailJobOwner:
+		return "SELECT owner FROM mail_system_jobs WHERE idempotencyKey = ?"
 	}
 	panic(fmt.Errorf("unk stmt %d", s))
 }
@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSystemMailJobNotFound is returned if there is no system mail job with the given idempotency key.
+var ErrSystemMailJobNotFound = errors.New("system mail job not found")
+
+type SystemMailJobStatus uint8
+
+const (
+	SystemMailJobStatusRunning SystemMailJobStatus = iota
+	SystemMailJobStatusDone
+	SystemMailJobStatusFailed
+)
+
+// SystemMailTarget selects receivers of the system mail. Receivers should match all set filters.
+type SystemMailTarget struct {
+	// CharacterGUIDs limits receivers to the given characters.
+	CharacterGUIDs []uint64 `json:"characterGuids,omitempty"`
+
+	// All should be set to target every character of the realm without filters.
+	All bool `json:"all,omitempty"`
+
+	MinLevel  uint8  `json:"minLevel,omitempty"`
+	MaxLevel  uint8  `json:"maxLevel,omitempty"`
+	GuildID   uint32 `json:"guildId,omitempty"`
+	AccountID uint32 `json:"accountId,omitempty"`
+}
+
+// IsEmpty returns true if target has no filters.
+func (t *SystemMailTarget) IsEmpty() bool {
+	return len(t.CharacterGUIDs) == 0 && t.MinLevel == 0 && t.MaxLevel == 0 && t.GuildID == 0 && t.AccountID == 0
+}
+
+// SystemMailItem is item to create for every receiver of the system mail.
+type SystemMailItem struct {
+	Entry uint32 `json:"entry"`
+	Count uint32 `json:"count"`
+}
+
+// SystemMailTemplate is mail that is sent to every receiver of the system mail.
+type SystemMailTemplate struct {
+	Type                MailType         `json:"type"`
+	Stationery          uint8            `json:"stationery"`
+	SenderGuid          uint64           `json:"senderGuid"`
+	Subject             string           `json:"subject"`
+	Body                string           `json:"body"`
+	Money               int32            `json:"money"`
+	ExpirationTimestamp int64            `json:"expirationTimestamp"`
+	Items               []SystemMailItem `json:"items,omitempty"`
+}
+
+// SystemMailJob is a system mail sent to the characters matching the target.
+type SystemMailJob struct {
+	IdempotencyKey string
+	RealmID        uint32
+	Status         SystemMailJobStatus
+
+	Target SystemMailTarget
+	Mail   SystemMailTemplate
+
+	// Total is amount of receivers, known after the job started.
+	Total uint32
+	Sent  uint32
+	// Failed is amount of receivers that didn't get the mail because of errors.
+	Failed uint32
+	Error  string
+
+	CreatedAt int64
+}
+
+// SystemMailRepo interface to interact with system mail jobs storage.
+type SystemMailRepo interface {
+	// CreateSystemMailJob creates the job if there is no job with the same idempotency key.
+	// Returns false and doesn't change the job if the key is already used.
+	CreateSystemMailJob(ctx context.Context, realmID uint32, job *SystemMailJob) (bool, error)
+
+	// SystemMailJob returns job with the given idempotency key or ErrSystemMailJobNotFound.
+	SystemMailJob(ctx context.Context, realmID uint32, idempotencyKey string) (*SystemMailJob, error)
+
+	// RunningSystemMailJobs returns jobs that are not finished, e.g. because of restart.
+	RunningSystemMailJobs(ctx context.Context, realmID uint32) ([]SystemMailJob, error)
+
+	// ClaimSystemMailJob makes owner the only one who processes the running job until leaseExpiresAt.
+	// Claim succeeds if the job has no owner, the lease is expired or the job is already owned by the owner,
+	// so the owner renews the lease with the same call. Returns false if the job is processed by someone else.
+	ClaimSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string, leaseExpiresAt int64) (bool, error)
+
+	// ReleaseSystemMailJob removes the owner from the job, so it can be claimed right away.
+	ReleaseSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string) error
+
+	// UpdateSystemMailJobProgress updates status, counters and error of the job.
+	UpdateSystemMailJobProgress(ctx context.Context, realmID uint32, job *SystemMailJob) error
+
+	// SystemMailReceivers returns guids of existing characters matching the target.
+	SystemMailReceivers(ctx context.Context, realmID uint32, target *SystemMailTarget) ([]uint64, error)
+
+	// SystemMailSentReceivers returns guids of the characters that already got mail of the job.
+	SystemMailSentReceivers(ctx context.Context, realmID uint32, idempotencyKey string) ([]uint64, error)
+
+	// AddSystemMail creates mail of the job for the receiver, sets mail ID into `mail` object.
+	// Returns false without creating mail if the receiver already got mail of the job.
+	AddSystemMail(ctx context.Context, realmID uint32, idempotencyKey string, mail *Mail) (bool, error)
+}
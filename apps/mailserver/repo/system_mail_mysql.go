@@ -0,0 +1,268 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	shrepo "github.com/walkline/ToCloud9/shared/repo"
+)
+
+type systemMailMySQLRepo struct {
+	db shrepo.CharactersDB
+}
+
+func NewSystemMailMySQLRepo(db shrepo.CharactersDB) (SystemMailRepo, error) {
+	db.SetPreparedStatement(StmtCreateSystemMailJob)
+	db.SetPreparedStatement(StmtGetSystemMailJob)
+	db.SetPreparedStatement(StmtSelectSystemMailJobsWithStatus)
+	db.SetPreparedStatement(StmtUpdateSystemMailJobProgress)
+	db.SetPreparedStatement(StmtSelectSystemMailSentReceivers)
+	db.SetPreparedStatement(StmtClaimSystemMailJob)
+	db.SetPreparedStatement(StmtReleaseSystemMailJob)
+	db.SetPreparedStatement(StmtGetSystemMailJobOwner)
+
+	return &systemMailMySQLRepo{
+		db: db,
+	}, nil
+}
+
+func (r *systemMailMySQLRepo) CreateSystemMailJob(ctx context.Context, realmID uint32, job *SystemMailJob) (bool, error) {
+	target, err := json.Marshal(&job.Target)
+	if err != nil {
+		return false, err
+	}
+
+	mail, err := json.Marshal(&job.Mail)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := r.db.PreparedStatement(realmID, StmtCreateSystemMailJob).ExecContext(
+		ctx, job.IdempotencyKey, job.Status, string(target), string(mail), job.CreatedAt,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *systemMailMySQLRepo) SystemMailJob(ctx context.Context, realmID uint32, idempotencyKey string) (*SystemMailJob, error) {
+	row := r.db.PreparedStatement(realmID, StmtGetSystemMailJob).QueryRowContext(ctx, idempotencyKey)
+	job, err := scanSystemMailJob(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSystemMailJobNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	job.RealmID = realmID
+	return job, nil
+}
+
+func (r *systemMailMySQLRepo) RunningSystemMailJobs(ctx context.Context, realmID uint32) ([]SystemMailJob, error) {
+	rows, err := r.db.PreparedStatement(realmID, StmtSelectSystemMailJobsWithStatus).QueryContext(ctx, SystemMailJobStatusRunning)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	jobs := []SystemMailJob{}
+	for rows.Next() {
+		job, err := scanSystemMailJob(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		job.RealmID = realmID
+		jobs = append(jobs, *job)
+	}
+
+	return jobs, rows.Err()
+}
+
+func (r *systemMailMySQLRepo) ClaimSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string, leaseExpiresAt int64) (bool, error) {
+	res, err := r.db.PreparedStatement(realmID, StmtClaimSystemMailJob).ExecContext(
+		ctx, owner, leaseExpiresAt, idempotencyKey, SystemMailJobStatusRunning, owner, time.Now().Unix(),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	// MySQL doesn't count rows that matched but weren't changed, that happens when
+	// the owner renews lease within the same second. Such claim is still successful.
+	if affected == 0 {
+		var currentOwner sql.NullString
+		err = r.db.PreparedStatement(realmID, StmtGetSystemMailJobOwner).QueryRowContext(ctx, idempotencyKey).Scan(&currentOwner)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return false, err
+		}
+		return currentOwner.Valid && currentOwner.String == owner, nil
+	}
+
+	return true, nil
+}
+
+func (r *systemMailMySQLRepo) ReleaseSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string) error {
+	_, err := r.db.PreparedStatement(realmID, StmtReleaseSystemMailJob).ExecContext(ctx, idempotencyKey, owner)
+	return err
+}
+
+func (r *systemMailMySQLRepo) UpdateSystemMailJobProgress(ctx context.Context, realmID uint32, job *SystemMailJob) error {
+	_, err := r.db.PreparedStatement(realmID, StmtUpdateSystemMailJobProgress).ExecContext(
+		ctx, job.Status, job.Total, job.Sent, job.Failed, job.Error, job.IdempotencyKey,
+	)
+	return err
+}
+
+func (r *systemMailMySQLRepo) SystemMailReceivers(ctx context.Context, realmID uint32, target *SystemMailTarget) ([]uint64, error) {
+	join := ""
+	where := strings.Builder{}
+	args := []interface{}{}
+
+	if target.GuildID != 0 {
+		join = " INNER JOIN guild_member gm ON gm.guid = c.guid AND gm.guildid = ?"
+		args = append(args, target.GuildID)
+	}
+
+	if target.MinLevel != 0 {
+		where.WriteString(" AND c.level >= ?")
+		args = append(args, target.MinLevel)
+	}
+
+	if target.MaxLevel != 0 {
+		where.WriteString(" AND c.level <= ?")
+		args = append(args, target.MaxLevel)
+	}
+
+	if target.AccountID != 0 {
+		where.WriteString(" AND c.account = ?")
+		args = append(args, target.AccountID)
+	}
+
+	if len(target.CharacterGUIDs) > 0 {
+		where.WriteString(fmt.Sprintf(" AND c.guid IN (%s)", strings.Join(uint64sToStrings(target.CharacterGUIDs), ",")))
+	}
+
+	rows, err := r.db.DBByRealm(realmID).QueryContext(ctx, fmt.Sprintf(StmtSelectSystemMailReceivers.Stmt(), join, where.String()), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	guids := []uint64{}
+	for rows.Next() {
+		var guid uint64
+		if err = rows.Scan(&guid); err != nil {
+			return nil, err
+		}
+		guids = append(guids, guid)
+	}
+
+	return guids, rows.Err()
+}
+
+func (r *systemMailMySQLRepo) SystemMailSentReceivers(ctx context.Context, realmID uint32, idempotencyKey string) ([]uint64, error) {
+	rows, err := r.db.PreparedStatement(realmID, StmtSelectSystemMailSentReceivers).QueryContext(ctx, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	guids := []uint64{}
+	for rows.Next() {
+		var guid uint64
+		if err = rows.Scan(&guid); err != nil {
+			return nil, err
+		}
+		guids = append(guids, guid)
+	}
+
+	return guids, rows.Err()
+}
+
+func (r *systemMailMySQLRepo) AddSystemMail(ctx context.Context, realmID uint32, idempotencyKey string, mail *Mail) (bool, error) {
+	tx, err := r.db.DBByRealm(realmID).Begin()
+	if err != nil {
+		return false, err
+	}
+
+	id, err := addMailTx(ctx, tx, mail)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	// Receiver row is created in the same transaction, so mail and items are rolled back
+	// if the receiver already got mail of the job.
+	res, err := tx.ExecContext(ctx, StmtCreateSystemMailReceiver.Stmt(), idempotencyKey, mail.ReceiverGuid, id)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, tx.Rollback()
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	mail.ID = uint(id)
+
+	return true, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSystemMailJob(row rowScanner) (*SystemMailJob, error) {
+	var (
+		job          SystemMailJob
+		target, mail string
+	)
+
+	err := row.Scan(
+		&job.IdempotencyKey, &job.Status, &target, &mail, &job.Total,
+		&job.Sent, &job.Failed, &job.Error, &job.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal([]byte(target), &job.Target); err != nil {
+		return nil, fmt.Errorf("can't read system mail job target, err: %w", err)
+	}
+
+	if err = json.Unmarshal([]byte(mail), &job.Mail); err != nil {
+		return nil, fmt.Errorf("can't read system mail job mail, err: %w", err)
+	}
+
+	return &job, nil
+}
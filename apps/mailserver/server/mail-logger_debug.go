@@ -109,3 +109,41 @@ func (g *mailDebugLoggerMiddleware) DeleteMail(ctx context.Context, params *pb.D
 	res, err = g.mailServer.DeleteMail(ctx, params)
 	return
 }
+
+func (g *mailDebugLoggerMiddleware) SendSystemMail(ctx context.Context, params *pb.SendSystemMailRequest) (res *pb.SendSystemMailResponse, err error) {
+	defer func(t time.Time) {
+		g.logger.Debug().
+			Uint32("realmID", params.RealmID).
+			Str("idempotencyKey", params.IdempotencyKey).
+			Err(err).
+			Msgf("Handled SendSystemMail for %v.", time.Since(t))
+	}(time.Now())
+
+	res, err = g.mailServer.SendSystemMail(ctx, params)
+	return
+}
+
+func (g *mailDebugLoggerMiddleware) SystemMailProgress(ctx context.Context, params *pb.SystemMailProgressRequest) (res *pb.SystemMailProgressResponse, err error) {
+	defer func(t time.Time) {
+		g.logger.Debug().
+			Uint32("realmID", params.RealmID).
+			Str("idempotencyKey", params.IdempotencyKey).
+			Err(err).
+			Msgf("Handled SystemMailProgress for %v.", time.Since(t))
+	}(time.Now())
+
+	res, err = g.mailServer.SystemMailProgress(ctx, params)
+	return
+}
+
+func (g *mailDebugLoggerMiddleware) ReturnMailToSender(ctx context.Context, params *pb.ReturnMailToSenderRequest) (res *pb.ReturnMailToSenderResponse, err error) {
+	defer func(t time.Time) {
+		g.logger.Debug().
+			Int32("mailID", params.MailID).
+			Err(err).
+			Msgf("Handled ReturnMailToSender for %v.", time.Since(t))
+	}(time.Now())
+
+	res, err = g.mailServer.ReturnMailToSender(ctx, params)
+	return
+}
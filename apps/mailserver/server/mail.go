@@ -2,6 +2,10 @@ package server
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/walkline/ToCloud9/apps/mailserver"
 	"github.com/walkline/ToCloud9/apps/mailserver/repo"
@@ -11,11 +15,12 @@ import (
 
 type MailServer struct {
 	pb.UnimplementedMailServiceServer
-	service *service.MailService
+	service    *service.MailService
+	systemMail *service.SystemMailService
 }
 
-func NewMailServer(mailService *service.MailService) pb.MailServiceServer {
-	return &MailServer{service: mailService}
+func NewMailServer(mailService *service.MailService, systemMailService *service.SystemMailService) pb.MailServiceServer {
+	return &MailServer{service: mailService, systemMail: systemMailService}
 }
 
 func (m *MailServer) Send(ctx context.Context, request *pb.SendRequest) (*pb.SendResponse, error) {
@@ -53,6 +58,9 @@ func (m *MailServer) Send(ctx context.Context, request *pb.SendRequest) (*pb.Sen
 	}
 	err := m.service.SendMail(ctx, request.RealmID, &mail)
 	if err != nil {
+		if errors.Is(err, service.ErrReceiverNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
 
@@ -200,3 +208,20 @@ func (m *MailServer) DeleteMail(ctx context.Context, request *pb.DeleteMailReque
 		Api: mailserver.Ver,
 	}, nil
 }
+
+func (m *MailServer) ReturnMailToSender(ctx context.Context, request *pb.ReturnMailToSenderRequest) (*pb.ReturnMailToSenderResponse, error) {
+	err := m.service.ReturnMailToSender(ctx, request.RealmID, uint(request.MailID), request.PlayerGuid)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotEnoughRight):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, service.ErrMailCantBeReturned):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &pb.ReturnMailToSenderResponse{
+		Api: mailserver.Ver,
+	}, nil
+}
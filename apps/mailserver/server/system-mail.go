@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/walkline/ToCloud9/apps/mailserver"
+	"github.com/walkline/ToCloud9/apps/mailserver/repo"
+	"github.com/walkline/ToCloud9/apps/mailserver/service"
+	"github.com/walkline/ToCloud9/gen/mail/pb"
+)
+
+func (m *MailServer) SendSystemMail(ctx context.Context, request *pb.SendSystemMailRequest) (*pb.SendSystemMailResponse, error) {
+	job := repo.SystemMailJob{
+		IdempotencyKey: request.IdempotencyKey,
+		RealmID:        request.RealmID,
+		Mail: repo.SystemMailTemplate{
+			Type:                repo.MailType(request.Type),
+			Stationery:          uint8(request.Stationery),
+			SenderGuid:          request.SenderGuid,
+			Subject:             request.Subject,
+			Body:                request.Body,
+			Money:               request.Money,
+			ExpirationTimestamp: request.ExpirationTimestamp,
+		},
+	}
+
+	if request.Target != nil {
+		job.Target = repo.SystemMailTarget{
+			CharacterGUIDs: request.Target.CharacterGuids,
+			All:            request.Target.All,
+			MinLevel:       uint8(request.Target.MinLevel),
+			MaxLevel:       uint8(request.Target.MaxLevel),
+			GuildID:        request.Target.GuildID,
+			AccountID:      request.Target.AccountID,
+		}
+	}
+
+	for _, item := range request.Items {
+		job.Mail.Items = append(job.Mail.Items, repo.SystemMailItem{
+			Entry: item.Entry,
+			Count: item.Count,
+		})
+	}
+
+	res, created, err := m.systemMail.Send(ctx, &job)
+	if err != nil {
+		return nil, systemMailError(err)
+	}
+
+	return &pb.SendSystemMailResponse{
+		Api:     mailserver.Ver,
+		Job:     systemMailJobToPB(res),
+		Created: created,
+	}, nil
+}
+
+func (m *MailServer) SystemMailProgress(ctx context.Context, request *pb.SystemMailProgressRequest) (*pb.SystemMailProgressResponse, error) {
+	job, err := m.systemMail.Progress(ctx, request.RealmID, request.IdempotencyKey)
+	if err != nil {
+		return nil, systemMailError(err)
+	}
+
+	return &pb.SystemMailProgressResponse{
+		Api: mailserver.Ver,
+		Job: systemMailJobToPB(job),
+	}, nil
+}
+
+func systemMailError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNoIdempotencyKey),
+		errors.Is(err, service.ErrIdempotencyKeyTooLong),
+		errors.Is(err, service.ErrNoTarget),
+		errors.Is(err, service.ErrInvalidItem),
+		errors.Is(err, service.ErrTooManyItems),
+		errors.Is(err, service.ErrNegativeMoney):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repo.ErrSystemMailJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func systemMailJobToPB(job *repo.SystemMailJob) *pb.SystemMailJob {
+	return &pb.SystemMailJob{
+		IdempotencyKey: job.IdempotencyKey,
+		Status:         pb.SystemMailJob_Status(job.Status),
+		Total:          job.Total,
+		Sent:           job.Sent,
+		Failed:         job.Failed,
+		Error:          job.Error,
+		CreatedAt:      job.CreatedAt,
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 type Cleaner interface {
 	ProcessExpiredMails(ctx context.Context, realmID uint32) error
+	ProcessBouncedMails(ctx context.Context, realmID uint32) error
 }
 
 type MailsCleanupTicker struct {
@@ -35,6 +36,10 @@ func (t *MailsCleanupTicker) Start(ctx context.Context) {
 				if err := t.cleaner.ProcessExpiredMails(ctx, realm); err != nil {
 					log.Error().Err(err).Uint32("realmID", realm).Msg("Failed to process expired mails")
 				}
+
+				if err := t.cleaner.ProcessBouncedMails(ctx, realm); err != nil {
+					log.Error().Err(err).Uint32("realmID", realm).Msg("Failed to process mails of deleted characters")
+				}
 			}
 
 		case <-ctx.Done():
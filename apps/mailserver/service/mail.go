@@ -15,6 +15,13 @@ import (
 var (
 	ErrNotEnoughRight = errors.New("not enough rights")
 	ErrNoReceiver     = errors.New("receiver can't be null")
+
+	// ErrReceiverNotFound is returned if receiver of player mail doesn't exist and mail can't be bounced back to the sender.
+	ErrReceiverNotFound = errors.New("receiver not found")
+
+	// ErrMailCantBeReturned is returned on attempt to return mail that is not player mail,
+	// is already returned, is cash on delivery payment or is not delivered yet.
+	ErrMailCantBeReturned = errors.New("mail can't be returned to sender")
 )
 
 type MailService struct {
@@ -32,6 +39,36 @@ func (s *MailService) SendMail(ctx context.Context, realmID uint32, mail *repo.M
 	if mail.ReceiverGuid == 0 {
 		return ErrNoReceiver
 	}
+
+	s.setMailDefaults(mail)
+
+	exists, err := s.repo.CharacterExists(ctx, realmID, mail.ReceiverGuid)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if mail.Type != repo.MailTypePlayerToPlayer {
+			return s.dropMail(ctx, realmID, mail)
+		}
+
+		if err = s.bounceMail(ctx, realmID, mail); err != nil {
+			return err
+		}
+	}
+
+	err = s.repo.AddMail(ctx, realmID, mail)
+	if err != nil {
+		return err
+	}
+
+	s.publishIncomingMail(realmID, mail)
+
+	return nil
+}
+
+// setMailDefaults sets default values of the mail fields that are not set.
+func (s *MailService) setMailDefaults(mail *repo.Mail) {
 	if mail.ExpirationTimestamp == 0 {
 		mail.ExpirationTimestamp = time.Now().Add(s.defaultMailExpirationTime).Unix()
 	}
@@ -45,13 +82,11 @@ func (s *MailService) SendMail(ctx context.Context, realmID uint32, mail *repo.M
 	if mail.Stationery == 0 {
 		mail.Stationery = uint8(repo.MailStationeryTypeDefault)
 	}
+}
 
-	err := s.repo.AddMail(ctx, realmID, mail)
-	if err != nil {
-		return err
-	}
-
-	err = s.ev.IncomingMail(&events.MailEventIncomingMailPayload{
+// publishIncomingMail notifies gateway of the receiver about the new mail.
+func (s *MailService) publishIncomingMail(realmID uint32, mail *repo.Mail) {
+	err := s.ev.IncomingMail(&events.MailEventIncomingMailPayload{
 		RealmID:           realmID,
 		SenderGUID:        mail.SenderGuid,
 		ReceiverGUID:      mail.ReceiverGuid,
@@ -61,6 +96,51 @@ func (s *MailService) SendMail(ctx context.Context, realmID uint32, mail *repo.M
 	if err != nil {
 		log.Warn().Err(err).Msg("can't send incoming mail")
 	}
+}
+
+// bounceMail readdresses not stored mail to the sender, since the receiver doesn't exist anymore (e.g. deleted).
+// Only mail that would be returned on expiration can be bounced.
+func (s *MailService) bounceMail(ctx context.Context, realmID uint32, mail *repo.Mail) error {
+	if !canBeReturned(mail) || !hasItemsOrMoney(mail) {
+		return ErrReceiverNotFound
+	}
+
+	exists, err := s.repo.CharacterExists(ctx, realmID, mail.SenderGuid)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return ErrReceiverNotFound
+	}
+
+	readdressToSender(mail, s.defaultMailExpirationTime)
+	for i := range mail.Attachments {
+		mail.Attachments[i].OwnerGUID = mail.ReceiverGuid
+	}
+
+	return nil
+}
+
+// dropMail discards not stored non-player mail (auction, creature, etc.) of the receiver that doesn't exist anymore.
+// There is nobody to return such mail to, so attached items are deleted to not leave them orphaned.
+func (s *MailService) dropMail(ctx context.Context, realmID uint32, mail *repo.Mail) error {
+	attachmentIDs := make([]uint64, len(mail.Attachments))
+	for i, attachment := range mail.Attachments {
+		attachmentIDs[i] = attachment.GUID
+	}
+
+	if len(attachmentIDs) > 0 {
+		if err := s.repo.DeleteItemsWithIDs(ctx, realmID, attachmentIDs); err != nil {
+			return err
+		}
+	}
+
+	log.Debug().
+		Uint32("realmID", realmID).
+		Uint64("receiver", mail.ReceiverGuid).
+		Uint8("type", uint8(mail.Type)).
+		Msg("Mail receiver doesn't exist anymore, dropping non-player mail")
 
 	return nil
 }
@@ -111,7 +191,13 @@ func (s *MailService) RemoveMailItem(ctx context.Context, realmID uint32, mailID
 			Subject:      mail.Subject,
 			MoneyToSend:  mail.CashOnDelivery,
 		})
-		if err != nil {
+		if errors.Is(err, ErrReceiverNotFound) {
+			log.Warn().
+				Uint32("realmID", realmID).
+				Uint64("receiver", mail.SenderGuid).
+				Uint("mailID", mailID).
+				Msg("Cash on delivery receiver doesn't exist anymore, skipping payment")
+		} else if err != nil {
 			return fmt.Errorf("failed to send cash on delivery mail, err: %w", err)
 		}
 
@@ -161,6 +247,35 @@ func (s *MailService) DeleteMail(ctx context.Context, realmID uint32, mailID uin
 	return s.repo.DeleteMailsWithoutAttachments(ctx, realmID, []uint{mailID})
 }
 
+// ReturnMailToSender returns mail with its money and items to the sender on request of the receiver.
+// Cash on delivery is cleared, so the sender gets its items back for free.
+// If the sender doesn't exist anymore, the mail and its items are deleted.
+func (s *MailService) ReturnMailToSender(ctx context.Context, realmID uint32, mailID uint, receiverGUID *uint64) error {
+	mail, err := s.repo.MailByID(ctx, realmID, mailID)
+	if err != nil {
+		return err
+	}
+
+	if receiverGUID != nil && mail.ReceiverGuid != *receiverGUID {
+		return ErrNotEnoughRight
+	}
+
+	if !canBeReturned(mail) || mail.DeliveryTimestamp > time.Now().Unix() {
+		return ErrMailCantBeReturned
+	}
+
+	exists, err := s.repo.CharacterExists(ctx, realmID, mail.SenderGuid)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return s.DeleteMail(ctx, realmID, mailID)
+	}
+
+	return s.returnMailToSender(ctx, realmID, mail)
+}
+
 func (s *MailService) ProcessExpiredMails(ctx context.Context, realmID uint32) error {
 	mails, err := s.repo.ExpiredMails(ctx, realmID)
 	if err != nil {
@@ -170,7 +285,7 @@ func (s *MailService) ProcessExpiredMails(ctx context.Context, realmID uint32) e
 	mailsIDsWithoutAttachments := []uint{}
 	mailsWithAttachments := []repo.Mail{}
 	for i := range mails {
-		if mails[i].MoneyToSend != 0 || mails[i].HasItemAttachments {
+		if hasItemsOrMoney(&mails[i]) {
 			mailsWithAttachments = append(mailsWithAttachments, mails[i])
 		} else {
 			mailsIDsWithoutAttachments = append(mailsIDsWithoutAttachments, mails[i].ID)
@@ -184,41 +299,80 @@ func (s *MailService) ProcessExpiredMails(ctx context.Context, realmID uint32) e
 		}
 	}
 
+	mailsIDsToDelete, err := s.returnMailsOrCollectIDs(ctx, realmID, mailsWithAttachments)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteMailsWithItems(ctx, realmID, mailsIDsToDelete)
+}
+
+// ProcessBouncedMails returns mails of deleted characters to the senders.
+// Mails that can't be returned are deleted with their items.
+func (s *MailService) ProcessBouncedMails(ctx context.Context, realmID uint32) error {
+	mails, err := s.repo.MailsOfDeletedReceivers(ctx, realmID)
+	if err != nil {
+		return err
+	}
+
+	mailsIDsToDelete, err := s.returnMailsOrCollectIDs(ctx, realmID, mails)
+	if err != nil {
+		return err
+	}
+
+	return s.deleteMailsWithItems(ctx, realmID, mailsIDsToDelete)
+}
+
+// returnMailsOrCollectIDs returns mails to the senders, returns IDs of mails that can't be returned.
+func (s *MailService) returnMailsOrCollectIDs(ctx context.Context, realmID uint32, mails []repo.Mail) ([]uint, error) {
 	mailsIDsToDelete := []uint{}
-	for _, mail := range mailsWithAttachments {
-		if mail.FlagsMask&uint16(repo.MailFlagReturned) > 0 {
+	for i := range mails {
+		mail := &mails[i]
+		if !canBeReturned(mail) || !hasItemsOrMoney(mail) {
 			mailsIDsToDelete = append(mailsIDsToDelete, mail.ID)
 			continue
 		}
 
-		mail.FlagsMask |= uint16(repo.MailFlagReturned)
-		mail.ReceiverGuid = mail.SenderGuid
-		mail.ExpirationTimestamp = time.Now().Add(time.Second * time.Duration(mail.ExpirationTimestamp-mail.DeliveryTimestamp)).Unix()
-		err = s.repo.UpdateMailWithoutAttachments(ctx, realmID, &mail)
+		exists, err := s.repo.CharacterExists(ctx, realmID, mail.SenderGuid)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if mail.HasItemAttachments {
-			err = s.repo.UpdateMailItemsOwner(ctx, realmID, mail.ID, mail.SenderGuid)
-			if err != nil {
-				return err
-			}
+		if !exists {
+			mailsIDsToDelete = append(mailsIDsToDelete, mail.ID)
+			continue
 		}
 
-		err = s.ev.IncomingMail(&events.MailEventIncomingMailPayload{
-			RealmID:           realmID,
-			SenderGUID:        mail.SenderGuid,
-			ReceiverGUID:      mail.ReceiverGuid,
-			DeliveryTimestamp: mail.DeliveryTimestamp,
-			MailID:            uint32(mail.ID),
-		})
-		if err != nil {
-			log.Warn().Err(err).Msg("can't send incoming mail")
+		if err = s.returnMailToSender(ctx, realmID, mail); err != nil {
+			return nil, err
 		}
+	}
+
+	return mailsIDsToDelete, nil
+}
 
+// returnMailToSender readdresses stored mail and its items to the sender and notifies the sender.
+func (s *MailService) returnMailToSender(ctx context.Context, realmID uint32, mail *repo.Mail) error {
+	readdressToSender(mail, s.defaultMailExpirationTime)
+
+	err := s.repo.UpdateMailWithoutAttachments(ctx, realmID, mail)
+	if err != nil {
+		return err
 	}
 
+	if mail.HasItemAttachments {
+		err = s.repo.UpdateMailItemsOwner(ctx, realmID, mail.ID, mail.ReceiverGuid)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.publishIncomingMail(realmID, mail)
+
+	return nil
+}
+
+func (s *MailService) deleteMailsWithItems(ctx context.Context, realmID uint32, mailsIDsToDelete []uint) error {
 	if len(mailsIDsToDelete) == 0 {
 		return nil
 	}
@@ -240,10 +394,32 @@ func (s *MailService) ProcessExpiredMails(ctx context.Context, realmID uint32) e
 		}
 	}
 
-	err = s.repo.DeleteMailsWithoutAttachments(ctx, realmID, mailsIDsToDelete)
-	if err != nil {
-		return err
+	return s.repo.DeleteMailsWithoutAttachments(ctx, realmID, mailsIDsToDelete)
+}
+
+// canBeReturned returns true for player mails that are not returned yet and are not cash on delivery payments.
+func canBeReturned(mail *repo.Mail) bool {
+	return mail.Type == repo.MailTypePlayerToPlayer &&
+		mail.SenderGuid != 0 &&
+		mail.FlagsMask&uint16(repo.MailFlagReturned|repo.MailFlagCashOnDelivery) == 0
+}
+
+func hasItemsOrMoney(mail *repo.Mail) bool {
+	return mail.MoneyToSend != 0 || mail.HasItemAttachments || len(mail.Attachments) > 0
+}
+
+// readdressToSender swaps sender and receiver of the mail, marks it as returned and unread
+// and drops cash on delivery. Returned mail gets the same lifetime the original one had.
+func readdressToSender(mail *repo.Mail, defaultExpirationTime time.Duration) {
+	lifetime := time.Second * time.Duration(mail.ExpirationTimestamp-mail.DeliveryTimestamp)
+	if lifetime <= 0 {
+		lifetime = defaultExpirationTime
 	}
 
-	return nil
+	now := time.Now()
+	mail.SenderGuid, mail.ReceiverGuid = mail.ReceiverGuid, mail.SenderGuid
+	mail.FlagsMask = mail.FlagsMask&^uint16(repo.MailFlagRead) | uint16(repo.MailFlagReturned)
+	mail.CashOnDelivery = 0
+	mail.DeliveryTimestamp = now.Unix()
+	mail.ExpirationTimestamp = now.Add(lifetime).Unix()
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/walkline/ToCloud9/apps/mailserver/repo"
 	"github.com/walkline/ToCloud9/apps/mailserver/repo/mocks"
+	"github.com/walkline/ToCloud9/shared/events"
 	eventsMock "github.com/walkline/ToCloud9/shared/events/mocks"
 )
 
@@ -104,6 +105,7 @@ func TestMailService_SendMail(t *testing.T) {
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
 			mailRepo := &mocks.MailRepo{}
+			mailRepo.On("CharacterExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
 			mailRepo.On("AddMail", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
 				assert.Equal(t, *tt.expMail, *m)
 				return true
@@ -151,6 +153,7 @@ func TestMailService_RemoveMailItemWithCOD(t *testing.T) {
 
 	mailRepo := &mocks.MailRepo{}
 
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
 	mailRepo.On("MailByID", mock.Anything, mock.Anything, mail.ID).Return(mail, nil)
 	mailRepo.On("RemoveMailItem", mock.Anything, mock.Anything, mail.ID, mail.Attachments[0].GUID).Return(nil)
 	mailRepo.On("UpdateMailWithoutAttachments", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
@@ -179,3 +182,212 @@ func TestMailService_RemoveMailItemWithCOD(t *testing.T) {
 	err := s.RemoveMailItem(context.Background(), 1, mail.ID, mail.Attachments[0].GUID, &mail.ReceiverGuid, true)
 	assert.NoError(t, err)
 }
+
+func TestMailService_ReturnMailToSender(t *testing.T) {
+	mail := &repo.Mail{
+		ID:                  42,
+		Type:                repo.MailTypePlayerToPlayer,
+		SenderGuid:          2,
+		ReceiverGuid:        1,
+		Subject:             "Hi there",
+		FlagsMask:           uint16(repo.MailFlagRead),
+		MoneyToSend:         10,
+		CashOnDelivery:      500,
+		Stationery:          uint8(repo.MailStationeryTypeDefault),
+		DeliveryTimestamp:   time.Now().Add(-time.Hour).Unix(),
+		ExpirationTimestamp: time.Now().Add(time.Hour).Unix(),
+		HasItemAttachments:  true,
+		Attachments:         []repo.ItemAttachment{{GUID: 7, OwnerGUID: 1, Entry: 4}},
+	}
+
+	mailRepo := &mocks.MailRepo{}
+	mailRepo.On("MailByID", mock.Anything, mock.Anything, mail.ID).Return(mail, nil)
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, uint64(2)).Return(true, nil)
+	mailRepo.On("UpdateMailWithoutAttachments", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
+		assert.Equal(t, uint64(1), m.SenderGuid)
+		assert.Equal(t, uint64(2), m.ReceiverGuid)
+		assert.Equal(t, int32(0), m.CashOnDelivery)
+		assert.Equal(t, int32(10), m.MoneyToSend)
+		assert.Equal(t, uint16(repo.MailFlagReturned), m.FlagsMask)
+		assert.InDelta(t, time.Now().Add(2*time.Hour).Unix(), m.ExpirationTimestamp, 1)
+		return true
+	})).Return(nil)
+	mailRepo.On("UpdateMailItemsOwner", mock.Anything, mock.Anything, mail.ID, uint64(2)).Return(nil)
+
+	eventsProducer := &eventsMock.MailServiceProducer{}
+	eventsProducer.On("IncomingMail", mock.MatchedBy(func(p *events.MailEventIncomingMailPayload) bool {
+		return p.ReceiverGUID == 2 && p.MailID == 42
+	})).Return(nil)
+
+	s := &MailService{
+		repo: mailRepo,
+		ev:   eventsProducer,
+	}
+
+	wrongReceiver := uint64(3)
+	assert.ErrorIs(t, s.ReturnMailToSender(context.Background(), 1, mail.ID, &wrongReceiver), ErrNotEnoughRight)
+
+	receiver := uint64(1)
+	assert.NoError(t, s.ReturnMailToSender(context.Background(), 1, mail.ID, &receiver))
+	mailRepo.AssertExpectations(t)
+	eventsProducer.AssertExpectations(t)
+
+	// Mail is returned already.
+	assert.ErrorIs(t, s.ReturnMailToSender(context.Background(), 1, mail.ID, nil), ErrMailCantBeReturned)
+}
+
+func TestMailService_SendMailToDeletedReceiver(t *testing.T) {
+	tests := map[string]struct {
+		mail    *repo.Mail
+		bounced bool
+		dropped bool
+	}{
+		"player mail with items is bounced": {
+			mail: &repo.Mail{
+				Type:           repo.MailTypePlayerToPlayer,
+				SenderGuid:     2,
+				ReceiverGuid:   1,
+				CashOnDelivery: 100,
+				Attachments:    []repo.ItemAttachment{{GUID: 7, OwnerGUID: 1, Entry: 4}},
+			},
+			bounced: true,
+		},
+		"player mail without items and money is rejected": {
+			mail: &repo.Mail{
+				Type:         repo.MailTypePlayerToPlayer,
+				SenderGuid:   2,
+				ReceiverGuid: 1,
+			},
+		},
+		"auction mail is dropped with its items": {
+			mail: &repo.Mail{
+				Type:         repo.MailTypeAuction,
+				SenderGuid:   2,
+				ReceiverGuid: 1,
+				Attachments:  []repo.ItemAttachment{{GUID: 7, OwnerGUID: 1, Entry: 4}},
+			},
+			dropped: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mailRepo := &mocks.MailRepo{}
+			mailRepo.On("CharacterExists", mock.Anything, mock.Anything, uint64(1)).Return(false, nil)
+			mailRepo.On("CharacterExists", mock.Anything, mock.Anything, uint64(2)).Return(true, nil)
+			mailRepo.On("AddMail", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
+				assert.Equal(t, uint64(2), m.ReceiverGuid)
+				assert.Equal(t, uint64(1), m.SenderGuid)
+				assert.Equal(t, int32(0), m.CashOnDelivery)
+				assert.Equal(t, uint16(repo.MailFlagReturned), m.FlagsMask)
+				assert.Equal(t, uint64(2), m.Attachments[0].OwnerGUID)
+				return true
+			})).Return(nil)
+			mailRepo.On("DeleteItemsWithIDs", mock.Anything, mock.Anything, []uint64{7}).Return(nil)
+
+			eventsProducer := &eventsMock.MailServiceProducer{}
+			eventsProducer.On("IncomingMail", mock.Anything).Return(nil)
+
+			s := &MailService{
+				repo:                      mailRepo,
+				ev:                        eventsProducer,
+				defaultMailExpirationTime: time.Hour,
+			}
+
+			err := s.SendMail(context.Background(), 1, tt.mail)
+			if tt.bounced {
+				assert.NoError(t, err)
+				mailRepo.AssertCalled(t, "AddMail", mock.Anything, mock.Anything, mock.Anything)
+			} else if tt.dropped {
+				assert.NoError(t, err)
+				mailRepo.AssertCalled(t, "DeleteItemsWithIDs", mock.Anything, mock.Anything, []uint64{7})
+				mailRepo.AssertNotCalled(t, "AddMail", mock.Anything, mock.Anything, mock.Anything)
+			} else {
+				assert.ErrorIs(t, err, ErrReceiverNotFound)
+				mailRepo.AssertNotCalled(t, "AddMail", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestMailService_AuctionBuyoutForDeletedSeller(t *testing.T) {
+	const (
+		sellerGUID = uint64(1)
+		buyerGUID  = uint64(2)
+		itemGUID   = uint64(7)
+	)
+
+	sellerMoneyMail := &repo.Mail{
+		Type:         repo.MailTypeAuction,
+		SenderGuid:   2,
+		ReceiverGuid: sellerGUID,
+		MoneyToSend:  1000,
+	}
+	buyerItemMail := &repo.Mail{
+		Type:               repo.MailTypeAuction,
+		SenderGuid:         2,
+		ReceiverGuid:       buyerGUID,
+		HasItemAttachments: true,
+		Attachments:        []repo.ItemAttachment{{GUID: itemGUID, OwnerGUID: buyerGUID, Entry: 4}},
+	}
+
+	mailRepo := &mocks.MailRepo{}
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, sellerGUID).Return(false, nil)
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, buyerGUID).Return(true, nil)
+	mailRepo.On("AddMail", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
+		return m.ReceiverGuid == buyerGUID
+	})).Return(nil)
+
+	eventsProducer := &eventsMock.MailServiceProducer{}
+	eventsProducer.On("IncomingMail", mock.Anything).Return(nil)
+
+	s := &MailService{
+		repo:                      mailRepo,
+		ev:                        eventsProducer,
+		defaultMailExpirationTime: time.Hour,
+	}
+
+	assert.NoError(t, s.SendMail(context.Background(), 1, sellerMoneyMail))
+	assert.NoError(t, s.SendMail(context.Background(), 1, buyerItemMail))
+
+	mailRepo.AssertNumberOfCalls(t, "AddMail", 1)
+	mailRepo.AssertNotCalled(t, "DeleteItemsWithIDs", mock.Anything, mock.Anything, mock.Anything)
+	eventsProducer.AssertNumberOfCalls(t, "IncomingMail", 1)
+}
+
+func TestMailService_ProcessBouncedMails(t *testing.T) {
+	mails := []repo.Mail{
+		// Returned to existing sender.
+		{ID: 1, Type: repo.MailTypePlayerToPlayer, SenderGuid: 2, ReceiverGuid: 1, MoneyToSend: 5},
+		// Sender is deleted too.
+		{ID: 2, Type: repo.MailTypePlayerToPlayer, SenderGuid: 3, ReceiverGuid: 1, HasItemAttachments: true},
+		// Already returned mail can't be returned again.
+		{ID: 3, Type: repo.MailTypePlayerToPlayer, SenderGuid: 2, ReceiverGuid: 1, MoneyToSend: 5, FlagsMask: uint16(repo.MailFlagReturned)},
+		// Nothing to return.
+		{ID: 4, Type: repo.MailTypePlayerToPlayer, SenderGuid: 2, ReceiverGuid: 1},
+	}
+
+	mailRepo := &mocks.MailRepo{}
+	mailRepo.On("MailsOfDeletedReceivers", mock.Anything, mock.Anything).Return(mails, nil)
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, uint64(2)).Return(true, nil)
+	mailRepo.On("CharacterExists", mock.Anything, mock.Anything, uint64(3)).Return(false, nil)
+	mailRepo.On("UpdateMailWithoutAttachments", mock.Anything, mock.Anything, mock.MatchedBy(func(m *repo.Mail) bool {
+		return m.ID == 1 && m.ReceiverGuid == 2
+	})).Return(nil)
+	mailRepo.On("MailItemsIDsByMailIDs", mock.Anything, mock.Anything, []uint{2, 3, 4}).Return([]uint64{10}, nil)
+	mailRepo.On("DeleteItemsWithIDs", mock.Anything, mock.Anything, []uint64{10}).Return(nil)
+	mailRepo.On("DeleteMailItemsWithIDs", mock.Anything, mock.Anything, []uint64{10}).Return(nil)
+	mailRepo.On("DeleteMailsWithoutAttachments", mock.Anything, mock.Anything, []uint{2, 3, 4}).Return(nil)
+
+	eventsProducer := &eventsMock.MailServiceProducer{}
+	eventsProducer.On("IncomingMail", mock.Anything).Return(nil)
+
+	s := &MailService{
+		repo:                      mailRepo,
+		ev:                        eventsProducer,
+		defaultMailExpirationTime: time.Hour,
+	}
+
+	assert.NoError(t, s.ProcessBouncedMails(context.Background(), 1))
+	mailRepo.AssertExpectations(t)
+	eventsProducer.AssertNumberOfCalls(t, "IncomingMail", 1)
+}
@@ -0,0 +1,539 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/mailserver/repo"
+	guidPB "github.com/walkline/ToCloud9/gen/guid/pb"
+)
+
+const (
+	// MaxMailItems is max amount of item stacks in a single mail.
+	MaxMailItems = 12
+
+	// maxIdempotencyKeyLen is size of the idempotency key column.
+	maxIdempotencyKeyLen = 64
+
+	// maxJobErrorLen is size of the job error column.
+	maxJobErrorLen = 255
+
+	// systemMailJobLeaseTTL is how long the job stays claimed by the instance without lease renewal.
+	// Jobs of the crashed instance are resumed by other instances after the lease expires.
+	systemMailJobLeaseTTL = time.Minute
+)
+
+// errSystemMailJobLeaseLost is returned if the job was claimed by another instance while it was processed.
+var errSystemMailJobLeaseLost = errors.New("system mail job lease is lost")
+
+var (
+	ErrNoIdempotencyKey      = errors.New("idempotency key can't be empty")
+	ErrIdempotencyKeyTooLong = fmt.Errorf("idempotency key can't be longer than %d", maxIdempotencyKeyLen)
+	ErrNoTarget              = errors.New("target has no filters, all characters flag should be set to target every character")
+	ErrInvalidItem           = errors.New("unknown item entry or zero count")
+	ErrTooManyItems          = fmt.Errorf("items don't fit in %d mail slots", MaxMailItems)
+	ErrNegativeMoney         = errors.New("money can't be negative")
+)
+
+// ItemGuidProvider provides guids for the items created for system mails.
+type ItemGuidProvider interface {
+	ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint64, error)
+}
+
+// SystemMailService sends system mails with newly created items to the characters matching the target.
+// Mails are sent in background with the rate limit shared by all jobs. Every receiver is stored with
+// the mail in one transaction, so retries with the same idempotency key and jobs resumed after
+// restart never send the mail twice to the same character. Before processing the job, the service
+// claims it with lease, so only one mail server instance processes the job at a time.
+type SystemMailService struct {
+	repo      repo.SystemMailRepo
+	mails     *MailService
+	templates *repo.ItemTemplateCache
+	guids     ItemGuidProvider
+
+	// batchSize is amount of receivers that get item guids with one guid service request.
+	batchSize int
+	limiter   *time.Ticker
+
+	// owner identifies the service instance in the claimed jobs.
+	owner    string
+	leaseTTL time.Duration
+
+	ctx context.Context
+	wg  sync.WaitGroup
+
+	mu      sync.Mutex
+	running map[string]struct{}
+}
+
+// NewSystemMailService creates SystemMailService. mailsPerSecond <= 0 disables throttling.
+func NewSystemMailService(r repo.SystemMailRepo, mails *MailService, templates *repo.ItemTemplateCache, guids ItemGuidProvider, mailsPerSecond int) *SystemMailService {
+	s := &SystemMailService{
+		repo:      r,
+		mails:     mails,
+		templates: templates,
+		guids:     guids,
+		batchSize: 100,
+		owner:     uuid.New().String(),
+		leaseTTL:  systemMailJobLeaseTTL,
+		ctx:       context.Background(),
+		running:   map[string]struct{}{},
+	}
+
+	if mailsPerSecond > 0 {
+		s.limiter = time.NewTicker(time.Second / time.Duration(mailsPerSecond))
+		s.batchSize = mailsPerSecond
+	}
+
+	return s
+}
+
+// Start resumes unfinished jobs of the realms that are not claimed by other instances and keeps
+// checking for jobs with expired leases, e.g. of crashed instances. Jobs are stopped when ctx is done.
+func (s *SystemMailService) Start(ctx context.Context, realms []uint32) error {
+	s.ctx = ctx
+
+	if err := s.resume(ctx, realms); err != nil {
+		return err
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.leaseTTL)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.resume(ctx, realms); err != nil {
+					log.Error().Err(err).Msg("Can't resume system mail jobs")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (s *SystemMailService) resume(ctx context.Context, realms []uint32) error {
+	for _, realmID := range realms {
+		jobs, err := s.repo.RunningSystemMailJobs(ctx, realmID)
+		if err != nil {
+			return fmt.Errorf("can't load running system mail jobs of realm %d, err: %w", realmID, err)
+		}
+
+		for i := range jobs {
+			if s.start(jobs[i]) {
+				log.Info().
+					Uint32("realmID", realmID).
+					Str("idempotencyKey", jobs[i].IdempotencyKey).
+					Uint32("sent", jobs[i].Sent).
+					Msg("Resumed system mail job")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Wait waits until running jobs are stopped.
+func (s *SystemMailService) Wait() {
+	s.wg.Wait()
+	if s.limiter != nil {
+		s.limiter.Stop()
+	}
+}
+
+// Send validates and starts the job. If there is a job with the same idempotency key,
+// it's returned instead and false is returned. Such job is restarted if some mails weren't sent,
+// so receivers that didn't get the mail get it.
+func (s *SystemMailService) Send(ctx context.Context, job *repo.SystemMailJob) (*repo.SystemMailJob, bool, error) {
+	if err := s.validate(job); err != nil {
+		return nil, false, err
+	}
+
+	if job.Mail.Stationery == 0 {
+		job.Mail.Stationery = uint8(repo.MailStationeryTypeGM)
+	}
+
+	if job.Mail.ExpirationTimestamp == 0 {
+		job.Mail.ExpirationTimestamp = time.Now().Add(s.mails.defaultMailExpirationTime).Unix()
+	}
+
+	job.Status = repo.SystemMailJobStatusRunning
+	job.CreatedAt = time.Now().Unix()
+
+	created, err := s.repo.CreateSystemMailJob(ctx, job.RealmID, job)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if created {
+		s.start(*job)
+		return job, true, nil
+	}
+
+	existing, err := s.repo.SystemMailJob(ctx, job.RealmID, job.IdempotencyKey)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if existing.Status == repo.SystemMailJobStatusFailed || (existing.Status == repo.SystemMailJobStatusDone && existing.Failed > 0) {
+		existing.Status = repo.SystemMailJobStatusRunning
+		existing.Failed = 0
+		existing.Error = ""
+		if err = s.repo.UpdateSystemMailJobProgress(ctx, existing.RealmID, existing); err != nil {
+			return nil, false, err
+		}
+		s.start(*existing)
+	}
+
+	return existing, false, nil
+}
+
+// Progress returns job with the given idempotency key.
+func (s *SystemMailService) Progress(ctx context.Context, realmID uint32, idempotencyKey string) (*repo.SystemMailJob, error) {
+	return s.repo.SystemMailJob(ctx, realmID, idempotencyKey)
+}
+
+func (s *SystemMailService) validate(job *repo.SystemMailJob) error {
+	if job.IdempotencyKey == "" {
+		return ErrNoIdempotencyKey
+	}
+
+	if len(job.IdempotencyKey) > maxIdempotencyKeyLen {
+		return ErrIdempotencyKeyTooLong
+	}
+
+	if job.Target.IsEmpty() && !job.Target.All {
+		return ErrNoTarget
+	}
+
+	if job.Mail.Money < 0 {
+		return ErrNegativeMoney
+	}
+
+	stacks, err := s.itemStacks(job.Mail.Items)
+	if err != nil {
+		return err
+	}
+
+	if len(stacks) > MaxMailItems {
+		return ErrTooManyItems
+	}
+
+	return nil
+}
+
+// itemStacks splits items into stacks according to the item templates.
+func (s *SystemMailService) itemStacks(items []repo.SystemMailItem) ([]repo.ItemAttachment, error) {
+	stacks := []repo.ItemAttachment{}
+	for _, item := range items {
+		tmpl := s.templates.Get(item.Entry)
+		if tmpl == nil || item.Count == 0 {
+			return nil, fmt.Errorf("%w: entry %d, count %d", ErrInvalidItem, item.Entry, item.Count)
+		}
+
+		stackSize := tmpl.Stackable
+		if stackSize == 0 {
+			stackSize = 1
+		}
+
+		for left := item.Count; left > 0; {
+			count := left
+			if count > stackSize {
+				count = stackSize
+			}
+			left -= count
+
+			stacks = append(stacks, repo.ItemAttachment{
+				Entry:      uint(item.Entry),
+				Count:      int(count),
+				Durability: int(tmpl.MaxDurability),
+			})
+
+			// Stop early, the caller rejects such items anyway.
+			if len(stacks) > MaxMailItems {
+				return stacks, nil
+			}
+		}
+	}
+	return stacks, nil
+}
+
+// start claims the job and processes it in background.
+// Returns false if the job is already processed by this or another instance.
+func (s *SystemMailService) start(job repo.SystemMailJob) bool {
+	key := fmt.Sprintf("%d:%s", job.RealmID, job.IdempotencyKey)
+
+	s.mu.Lock()
+	if _, found := s.running[key]; found {
+		s.mu.Unlock()
+		return false
+	}
+	s.running[key] = struct{}{}
+	s.mu.Unlock()
+
+	claimed, err := s.repo.ClaimSystemMailJob(s.ctx, job.RealmID, job.IdempotencyKey, s.owner, s.leaseExpiresAt())
+	if err != nil || !claimed {
+		if err != nil {
+			log.Error().
+				Err(err).
+				Uint32("realmID", job.RealmID).
+				Str("idempotencyKey", job.IdempotencyKey).
+				Msg("Can't claim system mail job")
+		}
+
+		s.mu.Lock()
+		delete(s.running, key)
+		s.mu.Unlock()
+		return false
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer func() {
+			// Released with background context, so the job can be claimed by other instance right away after shutdown.
+			if err := s.repo.ReleaseSystemMailJob(context.Background(), job.RealmID, job.IdempotencyKey, s.owner); err != nil {
+				log.Error().
+					Err(err).
+					Uint32("realmID", job.RealmID).
+					Str("idempotencyKey", job.IdempotencyKey).
+					Msg("Can't release system mail job")
+			}
+
+			s.mu.Lock()
+			delete(s.running, key)
+			s.mu.Unlock()
+			s.wg.Done()
+		}()
+
+		s.run(&job)
+	}()
+
+	return true
+}
+
+func (s *SystemMailService) leaseExpiresAt() int64 {
+	return time.Now().Add(s.leaseTTL).Unix()
+}
+
+// renewLease extends the lease of the job, returns errSystemMailJobLeaseLost if the job was claimed by another instance.
+func (s *SystemMailService) renewLease(ctx context.Context, job *repo.SystemMailJob) error {
+	claimed, err := s.repo.ClaimSystemMailJob(ctx, job.RealmID, job.IdempotencyKey, s.owner, s.leaseExpiresAt())
+	if err != nil {
+		return fmt.Errorf("can't renew system mail job lease, err: %w", err)
+	}
+
+	if !claimed {
+		return errSystemMailJobLeaseLost
+	}
+
+	return nil
+}
+
+func (s *SystemMailService) run(job *repo.SystemMailJob) {
+	logger := log.With().
+		Uint32("realmID", job.RealmID).
+		Str("idempotencyKey", job.IdempotencyKey).
+		Logger()
+
+	err := s.sendMails(s.ctx, job)
+	if s.ctx.Err() != nil {
+		// Service is stopping, the job is resumed on the next start.
+		return
+	}
+
+	if errors.Is(err, errSystemMailJobLeaseLost) {
+		// Job is continued by the instance that claimed it.
+		logger.Warn().Msg("System mail job was claimed by another instance, stopping it")
+		return
+	}
+
+	if err != nil {
+		job.Status = repo.SystemMailJobStatusFailed
+		job.Error = err.Error()
+		if len(job.Error) > maxJobErrorLen {
+			job.Error = job.Error[:maxJobErrorLen]
+		}
+		logger.Error().Err(err).Msg("System mail job failed")
+	} else {
+		job.Status = repo.SystemMailJobStatusDone
+		logger.Info().
+			Uint32("sent", job.Sent).
+			Uint32("failed", job.Failed).
+			Msg("System mail job finished")
+	}
+
+	if err = s.repo.UpdateSystemMailJobProgress(s.ctx, job.RealmID, job); err != nil {
+		logger.Error().Err(err).Msg("Can't update system mail job progress")
+	}
+}
+
+func (s *SystemMailService) sendMails(ctx context.Context, job *repo.SystemMailJob) error {
+	stacks, err := s.itemStacks(job.Mail.Items)
+	if err != nil {
+		return err
+	}
+
+	receivers, err := s.repo.SystemMailReceivers(ctx, job.RealmID, &job.Target)
+	if err != nil {
+		return fmt.Errorf("can't get receivers, err: %w", err)
+	}
+
+	sentReceivers, err := s.repo.SystemMailSentReceivers(ctx, job.RealmID, job.IdempotencyKey)
+	if err != nil {
+		return fmt.Errorf("can't get receivers that got the mail, err: %w", err)
+	}
+
+	sent := make(map[uint64]struct{}, len(sentReceivers))
+	for _, guid := range sentReceivers {
+		sent[guid] = struct{}{}
+	}
+
+	pending := make([]uint64, 0, len(receivers))
+	for _, guid := range receivers {
+		if _, found := sent[guid]; !found {
+			pending = append(pending, guid)
+		}
+	}
+
+	job.Total = uint32(len(pending) + len(sent))
+	job.Sent = uint32(len(sent))
+	if err = s.repo.UpdateSystemMailJobProgress(ctx, job.RealmID, job); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(pending); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		if err = s.sendMailsBatch(ctx, job, stacks, pending[start:end]); err != nil {
+			return err
+		}
+
+		// Lease is renewed first, so progress of the job claimed by another instance is not overwritten.
+		if err = s.renewLease(ctx, job); err != nil {
+			return err
+		}
+
+		if err = s.repo.UpdateSystemMailJobProgress(ctx, job.RealmID, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *SystemMailService) sendMailsBatch(ctx context.Context, job *repo.SystemMailJob, stacks []repo.ItemAttachment, receivers []uint64) error {
+	var guids []uint64
+	if len(stacks) > 0 {
+		var err error
+		guids, err = s.guids.ItemGuids(ctx, job.RealmID, len(stacks)*len(receivers))
+		if err != nil {
+			return fmt.Errorf("can't get item guids, err: %w", err)
+		}
+
+		if len(guids) < len(stacks)*len(receivers) {
+			return fmt.Errorf("guid service returned %d item guids instead of %d", len(guids), len(stacks)*len(receivers))
+		}
+	}
+
+	for i, receiver := range receivers {
+		if s.limiter != nil {
+			select {
+			case <-s.limiter.C:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		mail := &repo.Mail{
+			Type:                job.Mail.Type,
+			Stationery:          job.Mail.Stationery,
+			SenderGuid:          job.Mail.SenderGuid,
+			ReceiverGuid:        receiver,
+			Subject:             job.Mail.Subject,
+			Body:                job.Mail.Body,
+			MoneyToSend:         job.Mail.Money,
+			ExpirationTimestamp: job.Mail.ExpirationTimestamp,
+			HasItemAttachments:  len(stacks) > 0,
+			Attachments:         make([]repo.ItemAttachment, len(stacks)),
+		}
+
+		for j, stack := range stacks {
+			stack.GUID = guids[i*len(stacks)+j]
+			stack.OwnerGUID = receiver
+			mail.Attachments[j] = stack
+		}
+
+		s.mails.setMailDefaults(mail)
+
+		added, err := s.repo.AddSystemMail(ctx, job.RealmID, job.IdempotencyKey, mail)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			job.Failed++
+			log.Warn().
+				Err(err).
+				Uint32("realmID", job.RealmID).
+				Str("idempotencyKey", job.IdempotencyKey).
+				Uint64("receiver", receiver).
+				Msg("Can't send system mail")
+			continue
+		}
+
+		// Receiver got the mail from another run of the job.
+		if !added {
+			continue
+		}
+
+		job.Sent++
+		s.mails.publishIncomingMail(job.RealmID, mail)
+	}
+
+	return nil
+}
+
+// guidServiceItemGuidProvider requests item guids from the guid service.
+type guidServiceItemGuidProvider struct {
+	client guidPB.GuidServiceClient
+}
+
+// NewGuidServiceItemGuidProvider returns ItemGuidProvider that uses guid service.
+func NewGuidServiceItemGuidProvider(client guidPB.GuidServiceClient) ItemGuidProvider {
+	return &guidServiceItemGuidProvider{client: client}
+}
+
+func (p *guidServiceItemGuidProvider) ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint64, error) {
+	resp, err := p.client.GetGUIDPool(ctx, &guidPB.GetGUIDPoolRequest{
+		RealmID:         realmID,
+		GuidType:        guidPB.GuidType_Item,
+		DesiredPoolSize: uint64(count),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	guids := make([]uint64, 0, count)
+	for _, d := range resp.ReceiverGUID {
+		for guid := d.Start; guid <= d.End && len(guids) < count; guid++ {
+			guids = append(guids, guid)
+		}
+	}
+	return guids, nil
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/walkline/ToCloud9/apps/mailserver/repo"
+	eventsMock "github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+type systemMailRepoFake struct {
+	mu        sync.Mutex
+	jobs      map[string]repo.SystemMailJob
+	receivers []uint64
+	mails     map[string]map[uint64]*repo.Mail
+	failFor   map[uint64]bool
+	owners    map[string]string
+	leases    map[string]int64
+}
+
+func newSystemMailRepoFake(receivers ...uint64) *systemMailRepoFake {
+	return &systemMailRepoFake{
+		jobs:      map[string]repo.SystemMailJob{},
+		receivers: receivers,
+		mails:     map[string]map[uint64]*repo.Mail{},
+		failFor:   map[uint64]bool{},
+		owners:    map[string]string{},
+		leases:    map[string]int64{},
+	}
+}
+
+func (r *systemMailRepoFake) CreateSystemMailJob(ctx context.Context, realmID uint32, job *repo.SystemMailJob) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, found := r.jobs[job.IdempotencyKey]; found {
+		return false, nil
+	}
+	r.jobs[job.IdempotencyKey] = *job
+	return true, nil
+}
+
+func (r *systemMailRepoFake) SystemMailJob(ctx context.Context, realmID uint32, idempotencyKey string) (*repo.SystemMailJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, found := r.jobs[idempotencyKey]
+	if !found {
+		return nil, repo.ErrSystemMailJobNotFound
+	}
+	return &job, nil
+}
+
+func (r *systemMailRepoFake) RunningSystemMailJobs(ctx context.Context, realmID uint32) ([]repo.SystemMailJob, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := []repo.SystemMailJob{}
+	for _, job := range r.jobs {
+		if job.Status == repo.SystemMailJobStatusRunning {
+			res = append(res, job)
+		}
+	}
+	return res, nil
+}
+
+func (r *systemMailRepoFake) ClaimSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string, leaseExpiresAt int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	job, found := r.jobs[idempotencyKey]
+	if !found || job.Status != repo.SystemMailJobStatusRunning {
+		return false, nil
+	}
+	current := r.owners[idempotencyKey]
+	if current != "" && current != owner && r.leases[idempotencyKey] >= time.Now().Unix() {
+		return false, nil
+	}
+	r.owners[idempotencyKey] = owner
+	r.leases[idempotencyKey] = leaseExpiresAt
+	return true, nil
+}
+
+func (r *systemMailRepoFake) ReleaseSystemMailJob(ctx context.Context, realmID uint32, idempotencyKey, owner string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.owners[idempotencyKey] == owner {
+		delete(r.owners, idempotencyKey)
+		delete(r.leases, idempotencyKey)
+	}
+	return nil
+}
+
+func (r *systemMailRepoFake) UpdateSystemMailJobProgress(ctx context.Context, realmID uint32, job *repo.SystemMailJob) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.jobs[job.IdempotencyKey] = *job
+	return nil
+}
+
+func (r *systemMailRepoFake) SystemMailReceivers(ctx context.Context, realmID uint32, target *repo.SystemMailTarget) ([]uint64, error) {
+	return r.receivers, nil
+}
+
+func (r *systemMailRepoFake) SystemMailSentReceivers(ctx context.Context, realmID uint32, idempotencyKey string) ([]uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := []uint64{}
+	for guid := range r.mails[idempotencyKey] {
+		res = append(res, guid)
+	}
+	return res, nil
+}
+
+func (r *systemMailRepoFake) AddSystemMail(ctx context.Context, realmID uint32, idempotencyKey string, mail *repo.Mail) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failFor[mail.ReceiverGuid] {
+		return false, errors.New("db error")
+	}
+	if r.mails[idempotencyKey] == nil {
+		r.mails[idempotencyKey] = map[uint64]*repo.Mail{}
+	}
+	if _, found := r.mails[idempotencyKey][mail.ReceiverGuid]; found {
+		return false, nil
+	}
+	r.mails[idempotencyKey][mail.ReceiverGuid] = mail
+	return true, nil
+}
+
+type itemGuidProviderFake struct {
+	next uint64
+}
+
+func (p *itemGuidProviderFake) ItemGuids(ctx context.Context, realmID uint32, count int) ([]uint64, error) {
+	res := make([]uint64, count)
+	for i := range res {
+		p.next++
+		res[i] = p.next
+	}
+	return res, nil
+}
+
+func newTestSystemMailService(r repo.SystemMailRepo) *SystemMailService {
+	eventsProducer := &eventsMock.MailServiceProducer{}
+	eventsProducer.On("IncomingMail", mock.Anything).Return(nil)
+
+	templates := repo.NewItemTemplateCacheWithTemplates([]repo.ItemTemplate{
+		{Entry: 100, Stackable: 20},
+		{Entry: 200, Stackable: 1, MaxDurability: 55},
+		{Entry: 300, Stackable: 0},
+	})
+
+	mails := NewMailService(nil, eventsProducer, time.Hour)
+	return NewSystemMailService(r, mails, templates, &itemGuidProviderFake{}, 0)
+}
+
+func TestSystemMailService_SendSplitsItemsIntoStacks(t *testing.T) {
+	r := newSystemMailRepoFake(1, 2)
+	s := newTestSystemMailService(r)
+
+	job, created, err := s.Send(context.Background(), &repo.SystemMailJob{
+		IdempotencyKey: "event-reward",
+		RealmID:        1,
+		Target:         repo.SystemMailTarget{All: true},
+		Mail: repo.SystemMailTemplate{
+			Subject: "Reward",
+			Items: []repo.SystemMailItem{
+				{Entry: 100, Count: 45},
+				{Entry: 200, Count: 2},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, uint8(repo.MailStationeryTypeGM), job.Mail.Stationery)
+
+	s.Wait()
+
+	progress, err := s.Progress(context.Background(), 1, "event-reward")
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SystemMailJobStatusDone, progress.Status)
+	assert.Equal(t, uint32(2), progress.Total)
+	assert.Equal(t, uint32(2), progress.Sent)
+
+	mail := r.mails["event-reward"][2]
+	assert.Equal(t, uint64(2), mail.ReceiverGuid)
+	assert.True(t, mail.HasItemAttachments)
+	assert.Len(t, mail.Attachments, 5)
+
+	counts := []int{}
+	guids := map[uint64]struct{}{}
+	for _, receiverMail := range r.mails["event-reward"] {
+		for _, item := range receiverMail.Attachments {
+			guids[item.GUID] = struct{}{}
+			assert.Equal(t, receiverMail.ReceiverGuid, item.OwnerGUID)
+		}
+	}
+	for _, item := range mail.Attachments {
+		counts = append(counts, item.Count)
+	}
+	assert.Equal(t, []int{20, 20, 5, 1, 1}, counts)
+	assert.Equal(t, 55, mail.Attachments[4].Durability)
+	assert.Len(t, guids, 10, "every item should have unique guid")
+}
+
+func TestSystemMailService_SendIsIdempotent(t *testing.T) {
+	r := newSystemMailRepoFake(1, 2, 3)
+	r.failFor[2] = true
+	s := newTestSystemMailService(r)
+
+	job := repo.SystemMailJob{
+		IdempotencyKey: "compensation",
+		RealmID:        1,
+		Target:         repo.SystemMailTarget{MinLevel: 10},
+		Mail:           repo.SystemMailTemplate{Subject: "Sorry", Money: 100},
+	}
+
+	j := job
+	_, created, err := s.Send(context.Background(), &j)
+	assert.NoError(t, err)
+	assert.True(t, created)
+	s.Wait()
+
+	progress, err := s.Progress(context.Background(), 1, "compensation")
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SystemMailJobStatusDone, progress.Status)
+	assert.Equal(t, uint32(2), progress.Sent)
+	assert.Equal(t, uint32(1), progress.Failed)
+
+	// Retry with the same key sends the mail only to the receiver that didn't get it.
+	r.failFor[2] = false
+	first := r.mails["compensation"][1]
+
+	j = job
+	_, created, err = s.Send(context.Background(), &j)
+	assert.NoError(t, err)
+	assert.False(t, created)
+	s.Wait()
+
+	progress, err = s.Progress(context.Background(), 1, "compensation")
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SystemMailJobStatusDone, progress.Status)
+	assert.Equal(t, uint32(3), progress.Total)
+	assert.Equal(t, uint32(3), progress.Sent)
+	assert.Equal(t, uint32(0), progress.Failed)
+	assert.Len(t, r.mails["compensation"], 3)
+	assert.Same(t, first, r.mails["compensation"][1])
+}
+
+func TestSystemMailService_SendValidation(t *testing.T) {
+	tests := map[string]struct {
+		job repo.SystemMailJob
+		err error
+	}{
+		"no idempotency key": {
+			job: repo.SystemMailJob{Target: repo.SystemMailTarget{All: true}},
+			err: ErrNoIdempotencyKey,
+		},
+		"no target": {
+			job: repo.SystemMailJob{IdempotencyKey: "key"},
+			err: ErrNoTarget,
+		},
+		"unknown item": {
+			job: repo.SystemMailJob{
+				IdempotencyKey: "key",
+				Target:         repo.SystemMailTarget{GuildID: 1},
+				Mail:           repo.SystemMailTemplate{Items: []repo.SystemMailItem{{Entry: 999, Count: 1}}},
+			},
+			err: ErrInvalidItem,
+		},
+		"too many stacks": {
+			job: repo.SystemMailJob{
+				IdempotencyKey: "key",
+				Target:         repo.SystemMailTarget{AccountID: 1},
+				Mail:           repo.SystemMailTemplate{Items: []repo.SystemMailItem{{Entry: 300, Count: 13}}},
+			},
+			err: ErrTooManyItems,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newSystemMailRepoFake(1)
+			s := newTestSystemMailService(r)
+
+			_, _, err := s.Send(context.Background(), &tt.job)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Empty(t, r.jobs)
+		})
+	}
+}
+
+func TestSystemMailService_StartSkipsJobsClaimedByOtherInstance(t *testing.T) {
+	r := newSystemMailRepoFake(1, 2)
+	r.jobs["claimed"] = repo.SystemMailJob{
+		IdempotencyKey: "claimed",
+		RealmID:        1,
+		Status:         repo.SystemMailJobStatusRunning,
+		Target:         repo.SystemMailTarget{All: true},
+	}
+	r.owners["claimed"] = "other"
+	r.leases["claimed"] = time.Now().Add(time.Minute).Unix()
+
+	r.jobs["expired"] = repo.SystemMailJob{
+		IdempotencyKey: "expired",
+		RealmID:        1,
+		Status:         repo.SystemMailJobStatusRunning,
+		Target:         repo.SystemMailTarget{All: true},
+	}
+	r.owners["expired"] = "crashed"
+	r.leases["expired"] = time.Now().Add(-time.Minute).Unix()
+
+	s := newTestSystemMailService(r)
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.NoError(t, s.Start(ctx, []uint32{1}))
+
+	assert.Eventually(t, func() bool {
+		job, err := s.Progress(context.Background(), 1, "expired")
+		return err == nil && job.Status == repo.SystemMailJobStatusDone
+	}, time.Second, time.Millisecond*10)
+
+	cancel()
+	s.Wait()
+
+	claimed, err := s.Progress(context.Background(), 1, "claimed")
+	assert.NoError(t, err)
+	assert.Equal(t, repo.SystemMailJobStatusRunning, claimed.Status)
+	assert.Empty(t, r.mails["claimed"])
+	assert.Equal(t, "other", r.owners["claimed"])
+
+	assert.Len(t, r.mails["expired"], 2)
+	assert.Empty(t, r.owners["expired"], "finished job should be released")
+}
+
+func TestSystemMailService_StopsJobWhenLeaseIsLost(t *testing.T) {
+	r := newSystemMailRepoFake(1, 2, 3)
+	s := newTestSystemMailService(r)
+	s.batchSize = 1
+
+	r.jobs["takeover"] = repo.SystemMailJob{
+		IdempotencyKey: "takeover",
+		RealmID:        1,
+		Status:         repo.SystemMailJobStatusRunning,
+		Target:         repo.SystemMailTarget{All: true},
+	}
+
+	// Job is processed without claim, as if its lease expired and another instance claimed it.
+	claimed, err := r.ClaimSystemMailJob(context.Background(), 1, "takeover", "other", time.Now().Add(time.Minute).Unix())
+	assert.NoError(t, err)
+	assert.True(t, claimed)
+
+	job := r.jobs["takeover"]
+	err = s.sendMails(context.Background(), &job)
+	assert.ErrorIs(t, err, errSystemMailJobLeaseLost)
+	assert.Len(t, r.mails["takeover"], 1, "job should stop after the first batch")
+}
@@ -3,14 +3,21 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/zerolog/log"
 
 	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/config"
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
 	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/server"
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/stats"
+	"github.com/walkline/ToCloud9/shared/healthandmetrics"
 )
 
 func main() {
@@ -21,7 +28,34 @@ func main() {
 
 	log.Logger = cfg.Logger()
 
-	srv := server.NewServer(cfg.Port, cfg.Username, cfg.Password, cfg.CharDBConnection)
+	slowStatementThreshold := time.Millisecond * time.Duration(cfg.SlowStatementThresholdMs)
+	observers := proxy.StatementsObservers{
+		stats.NewPrometheusObserver(prometheus.DefaultRegisterer, slowStatementThreshold.Seconds()),
+	}
+
+	if cfg.SlowStatementThresholdMs > 0 {
+		observers = append(observers, stats.NewSlowStatementsLogger(&log.Logger, slowStatementThreshold))
+	}
+
+	if cfg.AuditLogFile != "" {
+		auditFile, err := os.OpenFile(cfg.AuditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			log.Fatal().Err(err).Str("file", cfg.AuditLogFile).Msg("failed to open audit log file")
+		}
+		defer auditFile.Close()
+
+		observers = append(observers, stats.NewWritesAuditLogger(auditFile))
+	}
+
+	healthCheckServer := healthandmetrics.NewServer(cfg.HealthCheckPort, promhttp.Handler())
+	go func() {
+		err := healthCheckServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatal().Err(err).Msg("failed to ListenAndServe health check server")
+		}
+	}()
+
+	srv := server.NewServer(cfg.Port, cfg.Username, cfg.Password, cfg.CharDBConnection, observers)
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -42,5 +76,9 @@ func main() {
 		log.Err(err).Msg("failed to start server")
 	}
 
+	if err = healthCheckServer.Shutdown(context.Background()); err != nil {
+		log.Err(err).Msg("failed to shutdown health check server")
+	}
+
 	log.Info().Msg("👍 Server successfully stopped.")
 }
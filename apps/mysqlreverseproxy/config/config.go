@@ -19,6 +19,17 @@ type Config struct {
 	Username string `yaml:"username" env:"MYSQL_USER" env-default:"root"`
 	// Password is mysql user that will be used to connect to this server.
 	Password string `yaml:"password" env:"MYSQL_PASSWORD" env-default:""`
+
+	// HealthCheckPort is port that would be used to listen for health checks and prometheus metrics requests.
+	HealthCheckPort string `yaml:"healthCheckPort" env:"HEALTH_CHECK_PORT" env-default:"8902"`
+
+	// SlowStatementThresholdMs is the statement execution time after which statement is logged and counted as slow.
+	// 0 disables slow statements logging.
+	SlowStatementThresholdMs uint32 `yaml:"slowStatementThresholdMs" env:"SLOW_STATEMENT_THRESHOLD_MS" env-default:"200"`
+
+	// AuditLogFile is path to the file where all write statements will be logged in JSON lines format.
+	// Empty value disables audit log.
+	AuditLogFile string `yaml:"auditLogFile" env:"AUDIT_LOG_FILE" env-default:""`
 }
 
 // LoadConfig loads config from file or/and env variables
@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"time"
+)
+
+// RoutingDecision describes how the realm for the statement was chosen.
+type RoutingDecision uint8
+
+const (
+	RoutingByGUID        RoutingDecision = iota // Realm extracted from the character GUID
+	RoutingByTransaction                        // Realm inherited from the transaction in progress
+	RoutingDefault                              // Realm can't be detected, default realm used
+)
+
+// String returns text representation of the routing decision.
+func (d RoutingDecision) String() string {
+	switch d {
+	case RoutingByGUID:
+		return "guid"
+	case RoutingByTransaction:
+		return "transaction"
+	default:
+		return "default"
+	}
+}
+
+// StatementStats holds information about a single statement processed by the proxy.
+type StatementStats struct {
+	ClientID     int             // Client ID
+	Query        string          // Original query
+	Args         []interface{}   // Arguments the statement was executed with (after GUIDs transformations)
+	Table        string          // Main table of the statement, empty if unknown
+	IsWrite      bool            // Flag indicating if the statement modifies data
+	RealmID      uint32          // Realm ID the statement was routed to
+	Routing      RoutingDecision // How the realm was chosen
+	GUID         uint64          // Raw character GUID found in the statement, 0 if not found
+	Duration     time.Duration   // Execution time
+	AffectedRows uint64          // Rows affected by the statement
+	ReturnedRows int             // Rows returned by the statement
+	Err          error           // Execution error if any
+}
+
+// TransactionStats holds information about a finished transaction.
+type TransactionStats struct {
+	ClientID  int           // Client ID
+	RealmID   uint32        // Realm ID of the transaction
+	Duration  time.Duration // Time between transaction start and commit/rollback
+	Committed bool          // Flag indicating if the transaction was committed or rolled back
+	Err       error         // Commit/rollback error if any
+}
+
+// StatementsObserver observes statements and transactions processed by the proxy.
+// Implementations should be safe for concurrent use, since the same observer is shared between client connections.
+type StatementsObserver interface {
+	ObserveStatement(stats StatementStats)
+	ObserveTransaction(stats TransactionStats)
+}
+
+// NoopStatementsObserver is observer that does nothing.
+type NoopStatementsObserver struct{}
+
+// ObserveStatement does nothing.
+func (NoopStatementsObserver) ObserveStatement(StatementStats) {}
+
+// ObserveTransaction does nothing.
+func (NoopStatementsObserver) ObserveTransaction(TransactionStats) {}
+
+// StatementsObservers fans out observations to the list of observers.
+type StatementsObservers []StatementsObserver
+
+// ObserveStatement passes statement stats to every observer.
+func (o StatementsObservers) ObserveStatement(stats StatementStats) {
+	for _, observer := range o {
+		observer.ObserveStatement(stats)
+	}
+}
+
+// ObserveTransaction passes transaction stats to every observer.
+func (o StatementsObservers) ObserveTransaction(stats TransactionStats) {
+	for _, observer := range o {
+		observer.ObserveTransaction(stats)
+	}
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-mysql-org/go-mysql/mysql"
 	"github.com/go-mysql-org/go-mysql/replication"
@@ -43,27 +44,41 @@ type TC9Proxy struct {
 	parser             *parser.Parser          // SQL parser
 	transactionState   TransactionState        // Current transaction state
 	transactionRealmID uint32                  // Realm ID for the current transaction
+	transactionStarted time.Time               // Time when the current transaction was started
+	observer           StatementsObserver      // Observer of executed statements and transactions
 }
 
 // NewTC9Proxy creates a new instance of TC9Proxy.
-func NewTC9Proxy(clientID int, connections map[uint32]DBConnection, parser *parser.Parser) *TC9Proxy {
+// Observer can be nil, in this case statements are not observed.
+func NewTC9Proxy(clientID int, connections map[uint32]DBConnection, parser *parser.Parser, observer StatementsObserver) *TC9Proxy {
+	if observer == nil {
+		observer = NoopStatementsObserver{}
+	}
+
 	return &TC9Proxy{
 		connectionByRealm: connections,
 		clientID:          clientID,
 		parser:            parser,
 		transactionState:  TransactionNone,
+		observer:          observer,
 	}
 }
 
 // ConnByRealm retrieves the connection for a given realm ID, or a fallback connection.
 func (p *TC9Proxy) ConnByRealm(realmID uint32) DBConnection {
+	_, conn := p.connWithRealm(realmID)
+	return conn
+}
+
+// connWithRealm retrieves the connection for a given realm ID, or a fallback connection, with realm ID of the connection.
+func (p *TC9Proxy) connWithRealm(realmID uint32) (uint32, DBConnection) {
 	if p.connectionByRealm[realmID] == nil {
 		// If no connection is found for the realm, return the first available connection.
-		for _, conn := range p.connectionByRealm {
-			return conn
+		for connRealmID, conn := range p.connectionByRealm {
+			return connRealmID, conn
 		}
 	}
-	return p.connectionByRealm[realmID]
+	return realmID, p.connectionByRealm[realmID]
 }
 
 // RunOnEveryConn runs a function on every database connection.
@@ -80,20 +95,58 @@ func (p *TC9Proxy) UseDB(string) error {
 
 // HandleQuery processes a query, handles transactions, and executes the query.
 func (p *TC9Proxy) HandleQuery(query string) (*mysql.Result, error) {
+	originalQuery := query
 	query = strings.TrimSpace(strings.ToUpper(query))
 
 	// Default connection (realm 0)
-	conn := p.ConnByRealm(0)
+	realmID, conn := p.connWithRealm(0)
 	switch query {
 	case StartTransaction:
 		p.transactionState = TransactionRequested // Start transaction flag
 		return nil, nil
 	case CommitTransaction, RollbackTransaction:
+		wasInProgress := p.transactionState == TransactionInProgress
 		p.transactionState = TransactionNone       // Reset transaction state
 		conn = p.ConnByRealm(p.transactionRealmID) // Use realm connection for commit/rollback
+
+		res, err := conn.Execute(query)
+		if wasInProgress {
+			p.observer.ObserveTransaction(TransactionStats{
+				ClientID:  p.clientID,
+				RealmID:   p.transactionRealmID,
+				Duration:  time.Since(p.transactionStarted),
+				Committed: query == CommitTransaction,
+				Err:       err,
+			})
+		}
+		return res, err
+	}
+
+	started := time.Now()
+	res, err := conn.Execute(query)
+
+	stats := newStatementStats(p.clientID, originalQuery, nil, res, err, started)
+	stats.RealmID = realmID
+	p.classifyQuery(originalQuery, &stats)
+	p.observer.ObserveStatement(stats)
+
+	return res, err
+}
+
+// classifyQuery fills in main table and write flag of the text protocol query.
+// Query that can't be parsed is observed without them.
+func (p *TC9Proxy) classifyQuery(query string, stats *StatementStats) {
+	stmtNode, err := p.parser.ParseOneStmt(query, "utf8mb4", "utf8mb4_bin")
+	if err != nil {
+		log.Trace().Err(err).Str("Query", query).Msg("can't parse query")
+		return
 	}
 
-	return conn.Execute(query)
+	v := sqlparser.NewCharGUIDFinder()
+	stmtNode.Accept(&v)
+
+	stats.Table = v.MainTableName()
+	stats.IsWrite = v.IsWriteStmt
 }
 
 // HandleFieldList retrieves a list of fields for a given table.
@@ -147,7 +200,7 @@ func (p *TC9Proxy) HandleStmtExecute(ctx interface{}, query string, args []inter
 	}
 
 	stmtWithParsedData := ctx.(*StmtWithParsedDataContext)
-	realmID, rawGUID, realGUIDCounter := extractGUIDAndRealmID(stmtWithParsedData, args, p)
+	realmID, rawGUID, realGUIDCounter, routing := extractGUIDAndRealmID(stmtWithParsedData, args, p)
 
 	if err := p.startTransactionIfNeeded(args, stmtWithParsedData, realmID); err != nil {
 		return nil, err
@@ -171,7 +224,17 @@ func (p *TC9Proxy) HandleStmtExecute(ctx interface{}, query string, args []inter
 		Uint64("Raw GUID", rawGUID).
 		Msg("ExecStmt")
 
+	started := time.Now()
 	res, err := stmt.Execute(args...)
+
+	stats := newStatementStats(p.clientID, query, args, res, err, started)
+	stats.Table = stmtWithParsedData.GuidFinder.MainTableName()
+	stats.IsWrite = stmtWithParsedData.GuidFinder.IsWriteStmt
+	stats.RealmID = realmID
+	stats.Routing = routing
+	stats.GUID = rawGUID
+	p.observer.ObserveStatement(stats)
+
 	if err != nil {
 		return nil, err
 	}
@@ -203,10 +266,11 @@ func (p *TC9Proxy) HandleOtherCommand(cmd byte, data []byte) error {
 }
 
 // extractGUIDAndRealmID extracts the GUID and realm ID from the prepared statement context.
-func extractGUIDAndRealmID(stmtWithParsedData *StmtWithParsedDataContext, args []interface{}, p *TC9Proxy) (uint32, uint64, uint32) {
+func extractGUIDAndRealmID(stmtWithParsedData *StmtWithParsedDataContext, args []interface{}, p *TC9Proxy) (uint32, uint64, uint32, RoutingDecision) {
 	var rawGUID uint64
 	var realmID uint32
 	var realGUIDCounter uint32
+	routing := RoutingByGUID
 
 	// Extract GUID from the statement arguments
 	if len(stmtWithParsedData.GuidFinder.InputGUIDIndexes) > 0 {
@@ -233,12 +297,14 @@ func extractGUIDAndRealmID(stmtWithParsedData *StmtWithParsedDataContext, args [
 	if realmID == 0 {
 		if !stmtWithParsedData.GuidFinder.IsSelectStmt && p.transactionState == TransactionInProgress {
 			realmID = p.transactionRealmID
+			routing = RoutingByTransaction
 		} else {
 			realmID = 1 // Default to realm 1 if unknown
+			routing = RoutingDefault
 		}
 	}
 
-	return realmID, rawGUID, realGUIDCounter
+	return realmID, rawGUID, realGUIDCounter, routing
 }
 
 // startTransactionIfNeeded starts a transaction if needed based on the query and state.
@@ -250,6 +316,7 @@ func (p *TC9Proxy) startTransactionIfNeeded(args []interface{}, stmtWithParsedDa
 		}
 
 		p.transactionState = TransactionInProgress
+		p.transactionStarted = time.Now()
 		switch v := args[0].(type) {
 		case uint64:
 			p.transactionRealmID = uint32(v)
@@ -272,6 +339,7 @@ func (p *TC9Proxy) startTransactionIfNeeded(args []interface{}, stmtWithParsedDa
 			return fmt.Errorf("failed to start transaction, err: %v", err)
 		}
 		p.transactionState = TransactionInProgress
+		p.transactionStarted = time.Now()
 		p.transactionRealmID = realmID
 	}
 
@@ -289,6 +357,27 @@ func updateOutputGUIDs(res *mysql.Result, stmtWithParsedData *StmtWithParsedData
 	}
 }
 
+// newStatementStats creates statement stats with execution results filled in.
+func newStatementStats(clientID int, query string, args []interface{}, res *mysql.Result, err error, started time.Time) StatementStats {
+	stats := StatementStats{
+		ClientID: clientID,
+		Query:    query,
+		Args:     args,
+		Routing:  RoutingDefault,
+		Duration: time.Since(started),
+		Err:      err,
+	}
+
+	if res != nil {
+		stats.AffectedRows = res.AffectedRows
+		if res.Resultset != nil {
+			stats.ReturnedRows = len(res.Values)
+		}
+	}
+
+	return stats
+}
+
 // EmptyReplicationHandler is a no-op handler for replication commands.
 type EmptyReplicationHandler struct{ TC9Proxy }
 
@@ -169,7 +169,7 @@ func TestHandleStmtExecute_QueriesRouting(t *testing.T) {
 				1: mockConn1,
 				2: mockConn2,
 			}
-			proxyInstance := NewTC9Proxy(1, connections, parser)
+			proxyInstance := NewTC9Proxy(1, connections, parser, nil)
 
 			_, _, stmtCtx, err := proxyInstance.HandleStmtPrepare(tt.query)
 			assert.NoError(t, err)
@@ -200,7 +200,7 @@ func TestHandleStmtExecute_StartingTransaction(t *testing.T) {
 
 	proxyInstance := NewTC9Proxy(1, map[uint32]DBConnection{
 		1: mockConn1,
-	}, parser)
+	}, parser, nil)
 
 	stmtNode, err := parser.ParseOneStmt("select * from characters where guid = ?", "utf8mb4", "utf8mb4_bin")
 	assert.NoError(t, err)
@@ -235,7 +235,7 @@ func TestHandleStmtExecute_HandlingDummyStmt(t *testing.T) {
 
 	proxyInstance := NewTC9Proxy(1, map[uint32]DBConnection{
 		1: mockConn1,
-	}, parser)
+	}, parser, nil)
 
 	v := sqlparser.NewCharGUIDFinder()
 
@@ -255,3 +255,102 @@ func TestHandleStmtExecute_HandlingDummyStmt(t *testing.T) {
 
 	assert.Equal(t, uint32(12), proxyInstance.transactionRealmID)
 }
+
+type recordingObserver struct {
+	statements   []StatementStats
+	transactions []TransactionStats
+}
+
+func (o *recordingObserver) ObserveStatement(stats StatementStats) {
+	o.statements = append(o.statements, stats)
+}
+
+func (o *recordingObserver) ObserveTransaction(stats TransactionStats) {
+	o.transactions = append(o.transactions, stats)
+}
+
+func TestHandleStmtExecute_ObservesStatementsAndTransactions(t *testing.T) {
+	lowGUID := 42
+	mockConn1 := new(MockDBConnection)
+	mockConn2 := new(MockDBConnection)
+	mockStmt1 := new(MockDBStatement)
+	mockStmt2 := new(MockDBStatement)
+
+	mockConn1.On("Prepare", mock.Anything).Return(mockStmt1, nil)
+	mockConn2.On("Prepare", mock.Anything).Return(mockStmt2, nil)
+	mockConn1.On("Execute", mock.MatchedBy(func(v string) bool { return v == StartTransaction }), mock.Anything).Return(&mysql.Result{}, nil)
+	mockConn2.On("Execute", mock.MatchedBy(func(v string) bool { return v == CommitTransaction }), mock.Anything).Return(&mysql.Result{}, nil)
+	mockStmt2.On("Execute", mock.Anything).Return(&mysql.Result{AffectedRows: 3}, nil)
+
+	observer := &recordingObserver{}
+	proxyInstance := NewTC9Proxy(1, map[uint32]DBConnection{
+		1: mockConn1,
+		2: mockConn2,
+	}, parser.New(), observer)
+
+	const updateQuery = "UPDATE character_aura SET remainTime = ? WHERE guid = ?"
+	const deleteQuery = "DELETE FROM character_aura WHERE spell = ?"
+
+	_, _, updateCtx, err := proxyInstance.HandleStmtPrepare(updateQuery)
+	assert.NoError(t, err)
+	_, _, deleteCtx, err := proxyInstance.HandleStmtPrepare(deleteQuery)
+	assert.NoError(t, err)
+
+	_, err = proxyInstance.HandleQuery(StartTransaction)
+	assert.NoError(t, err)
+
+	rawGUID := guid.NewCrossrealmPlayerGUID(2, guid.LowType(lowGUID)).GetRawValue()
+	_, err = proxyInstance.HandleStmtExecute(updateCtx, updateQuery, []interface{}{uint32(100), rawGUID})
+	assert.NoError(t, err)
+
+	_, err = proxyInstance.HandleStmtExecute(deleteCtx, deleteQuery, []interface{}{uint32(1)})
+	assert.NoError(t, err)
+
+	_, err = proxyInstance.HandleQuery(CommitTransaction)
+	assert.NoError(t, err)
+
+	if assert.Len(t, observer.statements, 2) {
+		assert.Equal(t, "character_aura", observer.statements[0].Table)
+		assert.True(t, observer.statements[0].IsWrite)
+		assert.Equal(t, uint32(2), observer.statements[0].RealmID)
+		assert.Equal(t, RoutingByGUID, observer.statements[0].Routing)
+		assert.Equal(t, rawGUID, observer.statements[0].GUID)
+		assert.Equal(t, uint64(3), observer.statements[0].AffectedRows)
+
+		assert.Equal(t, uint32(2), observer.statements[1].RealmID)
+		assert.Equal(t, RoutingByTransaction, observer.statements[1].Routing)
+		assert.Zero(t, observer.statements[1].GUID)
+	}
+
+	if assert.Len(t, observer.transactions, 1) {
+		assert.Equal(t, uint32(2), observer.transactions[0].RealmID)
+		assert.True(t, observer.transactions[0].Committed)
+		assert.NoError(t, observer.transactions[0].Err)
+	}
+}
+
+func TestHandleQuery_ObservesTextProtocolWrites(t *testing.T) {
+	const insertQuery = "INSERT INTO character_aura (guid, spell) VALUES (42, 'Rejuvenation')"
+
+	mockConn := new(MockDBConnection)
+	mockConn.On("Execute", mock.Anything, mock.Anything).Return(&mysql.Result{AffectedRows: 1}, nil)
+
+	observer := &recordingObserver{}
+	proxyInstance := NewTC9Proxy(1, map[uint32]DBConnection{1: mockConn}, parser.New(), observer)
+
+	_, err := proxyInstance.HandleQuery(insertQuery)
+	assert.NoError(t, err)
+
+	_, err = proxyInstance.HandleQuery("SELECT 1")
+	assert.NoError(t, err)
+
+	if assert.Len(t, observer.statements, 2) {
+		assert.Equal(t, insertQuery, observer.statements[0].Query)
+		assert.Equal(t, "character_aura", observer.statements[0].Table)
+		assert.True(t, observer.statements[0].IsWrite)
+		assert.Equal(t, uint32(1), observer.statements[0].RealmID)
+		assert.Equal(t, uint64(1), observer.statements[0].AffectedRows)
+
+		assert.False(t, observer.statements[1].IsWrite)
+	}
+}
@@ -23,9 +23,11 @@ type Server struct {
 	authProvider *RemoteThrottleProvider
 
 	connectionCfgPerRealm map[uint32]*gomysql.Config
+
+	observer proxy.StatementsObserver
 }
 
-func NewServer(port, user, pass string, realmsConnectionStrings map[uint32]string) *Server {
+func NewServer(port, user, pass string, realmsConnectionStrings map[uint32]string, observer proxy.StatementsObserver) *Server {
 	remoteProvider := &RemoteThrottleProvider{server.NewInMemoryProvider()}
 	remoteProvider.AddUser(user, pass)
 
@@ -44,6 +46,7 @@ func NewServer(port, user, pass string, realmsConnectionStrings map[uint32]strin
 		port:                  port,
 		authProvider:          remoteProvider,
 		connectionCfgPerRealm: cfgs,
+		observer:              observer,
 	}
 }
 
@@ -78,7 +81,7 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 			}
 
 			svr := server.NewServer("8.4.4", mysql.DEFAULT_COLLATION_ID, mysql.AUTH_CACHING_SHA2_PASSWORD, test_keys.PubPem, tlsConf)
-			conn, err := server.NewCustomizedConn(c, svr, s.authProvider, proxy.NewTC9Proxy(connID, connectionByRealm, parser.New()))
+			conn, err := server.NewCustomizedConn(c, svr, s.authProvider, proxy.NewTC9Proxy(connID, connectionByRealm, parser.New(), s.observer))
 			if err != nil {
 				log.Fatal().Err(err).Int("connID", connID).Msg("failed to create customized connection")
 			}
@@ -52,6 +52,7 @@ type CharGUIDFinder struct {
 	InputGUIDIndexes   []int
 	OutputGUIDIndexes  []int
 	IsSelectStmt       bool
+	IsWriteStmt        bool
 	prefix             string
 	tableExpSource     string
 	tableNames         []string
@@ -76,6 +77,9 @@ func (v *CharGUIDFinder) Enter(in ast.Node) (ast.Node, bool) {
 	switch node := in.(type) {
 	case *ast.InsertStmt:
 		v.isInsert = true
+		v.IsWriteStmt = true
+	case *ast.UpdateStmt, *ast.DeleteStmt:
+		v.IsWriteStmt = true
 	case *ast.SelectStmt:
 		v.IsSelectStmt = true
 	case *ast.TableSource:
@@ -156,6 +160,14 @@ func (v *CharGUIDFinder) handleParamMarkerExpr() {
 	v.inputParams = append(v.inputParams, column)
 }
 
+// MainTableName returns the first table name found in the statement or empty string if there are no tables.
+func (v *CharGUIDFinder) MainTableName() string {
+	if len(v.tableNames) == 0 {
+		return ""
+	}
+	return v.tableNames[0]
+}
+
 func (v *CharGUIDFinder) FillInGUIDIndexes() {
 	searchColumnNames := make(map[string]struct{})
 	for _, name := range v.tableNames {
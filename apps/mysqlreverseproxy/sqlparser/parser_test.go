@@ -74,3 +74,33 @@ func TestCharGUIDFinder_NoGUID(t *testing.T) {
 	assert.Empty(t, charGUIDFinder.InputGUIDIndexes, "Should not find any input GUID index")
 	assert.Empty(t, charGUIDFinder.OutputGUIDIndexes, "Should not find any output GUID index")
 }
+
+func TestCharGUIDFinder_WriteStatementAndMainTable(t *testing.T) {
+	testCases := []struct {
+		sql       string
+		isWrite   bool
+		mainTable string
+	}{
+		{sql: `SELECT name FROM characters WHERE guid = ?`, isWrite: false, mainTable: "characters"},
+		{sql: `INSERT INTO character_aura (guid, spell) VALUES (?, ?)`, isWrite: true, mainTable: "character_aura"},
+		{sql: `UPDATE characters SET name = ? WHERE guid = ?`, isWrite: true, mainTable: "characters"},
+		{sql: `DELETE FROM mail WHERE receiver = ?`, isWrite: true, mainTable: "mail"},
+		{sql: `SELECT ? AS no_op`, isWrite: false, mainTable: ""},
+	}
+
+	p := parser.New()
+	for _, tt := range testCases {
+		t.Run(tt.sql, func(t *testing.T) {
+			stmt, err := p.ParseOneStmt(tt.sql, "", "")
+			if err != nil {
+				t.Fatalf("Failed to parse SQL: %v", err)
+			}
+
+			charGUIDFinder := NewCharGUIDFinder()
+			stmt.Accept(&charGUIDFinder)
+
+			assert.Equal(t, tt.isWrite, charGUIDFinder.IsWriteStmt)
+			assert.Equal(t, tt.mainTable, charGUIDFinder.MainTableName())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package stats
+
+import (
+	"io"
+
+	"github.com/rs/zerolog"
+
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
+)
+
+// WritesAuditLogger is statements observer that writes structured (JSON lines) log of every write statement.
+type WritesAuditLogger struct {
+	logger zerolog.Logger
+}
+
+// NewWritesAuditLogger creates audit logger that writes to the given writer.
+func NewWritesAuditLogger(w io.Writer) *WritesAuditLogger {
+	return &WritesAuditLogger{
+		logger: zerolog.New(w).With().Timestamp().Logger(),
+	}
+}
+
+// ObserveStatement logs statement if it modifies data.
+func (l *WritesAuditLogger) ObserveStatement(stats proxy.StatementStats) {
+	if !stats.IsWrite {
+		return
+	}
+
+	l.logger.Log().
+		Int("clientID", stats.ClientID).
+		Uint32("realmID", stats.RealmID).
+		Str("routing", stats.Routing.String()).
+		Uint64("guid", stats.GUID).
+		Str("table", stats.Table).
+		Str("query", stats.Query).
+		Interface("args", stats.Args).
+		Uint64("affectedRows", stats.AffectedRows).
+		Dur("duration", stats.Duration).
+		AnErr("error", stats.Err).
+		Msg("write")
+}
+
+// ObserveTransaction logs transaction result.
+func (l *WritesAuditLogger) ObserveTransaction(stats proxy.TransactionStats) {
+	l.logger.Log().
+		Int("clientID", stats.ClientID).
+		Uint32("realmID", stats.RealmID).
+		Bool("committed", stats.Committed).
+		Dur("duration", stats.Duration).
+		AnErr("error", stats.Err).
+		Msg("transaction")
+}
@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
+)
+
+// dbConnectionStub executes every query successfully.
+type dbConnectionStub struct{}
+
+func (dbConnectionStub) Execute(string, ...interface{}) (*mysql.Result, error) {
+	return &mysql.Result{AffectedRows: 1}, nil
+}
+
+func (dbConnectionStub) Prepare(string) (proxy.DBStatement, error) { return nil, nil }
+
+func (dbConnectionStub) FieldList(string, string) ([]*mysql.Field, error) { return nil, nil }
+
+func TestWritesAuditLogger_TextProtocolWrite(t *testing.T) {
+	const deleteQuery = "DELETE FROM mail WHERE subject = 'Hello'"
+
+	buf := &bytes.Buffer{}
+	p := proxy.NewTC9Proxy(1, map[uint32]proxy.DBConnection{1: dbConnectionStub{}}, parser.New(), NewWritesAuditLogger(buf))
+
+	_, err := p.HandleQuery("SELECT * FROM mail")
+	assert.NoError(t, err)
+	assert.Zero(t, buf.Len(), "reads are not audited")
+
+	_, err = p.HandleQuery(deleteQuery)
+	assert.NoError(t, err)
+
+	record := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	assert.Equal(t, "write", record["message"])
+	assert.Equal(t, deleteQuery, record["query"])
+	assert.Equal(t, "mail", record["table"])
+	assert.Equal(t, 1.0, record["realmID"])
+}
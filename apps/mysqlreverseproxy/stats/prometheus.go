@@ -0,0 +1,111 @@
+package stats
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
+)
+
+const unknownTableLabel = "unknown"
+
+// PrometheusObserver is statements observer that exposes statements and transactions stats as prometheus metrics.
+type PrometheusObserver struct {
+	statementDuration   *prometheus.HistogramVec
+	statementRows       *prometheus.CounterVec
+	statementErrors     *prometheus.CounterVec
+	routingDecisions    *prometheus.CounterVec
+	slowStatements      *prometheus.CounterVec
+	transactionDuration *prometheus.HistogramVec
+
+	slowStatementThreshold float64
+}
+
+// NewPrometheusObserver creates prometheus observer and registers its metrics in the given registerer.
+// Statements that took more than slowStatementThresholdSecs are counted as slow, 0 disables slow statements counting.
+func NewPrometheusObserver(registerer prometheus.Registerer, slowStatementThresholdSecs float64) *PrometheusObserver {
+	o := &PrometheusObserver{
+		statementDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mysqlproxy_statement_duration_seconds",
+			Help:    "The statements execution time by table and realm",
+			Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"table", "realm", "write"}),
+		statementRows: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mysqlproxy_statement_rows_total",
+			Help: "The number of rows affected or returned by statements",
+		}, []string{"table", "realm", "kind"}),
+		statementErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mysqlproxy_statement_errors_total",
+			Help: "The number of failed statements",
+		}, []string{"table", "realm"}),
+		routingDecisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mysqlproxy_statement_routing_total",
+			Help: "The number of statements routed to realm by routing decision",
+		}, []string{"realm", "decision"}),
+		slowStatements: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "mysqlproxy_slow_statements_total",
+			Help: "The number of statements that took more than slow statement threshold",
+		}, []string{"table", "realm"}),
+		transactionDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "mysqlproxy_transaction_duration_seconds",
+			Help:    "The transactions duration by realm and result",
+			Buckets: []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10},
+		}, []string{"realm", "result"}),
+		slowStatementThreshold: slowStatementThresholdSecs,
+	}
+
+	registerer.MustRegister(
+		o.statementDuration,
+		o.statementRows,
+		o.statementErrors,
+		o.routingDecisions,
+		o.slowStatements,
+		o.transactionDuration,
+	)
+
+	return o
+}
+
+// ObserveStatement updates statements metrics.
+func (o *PrometheusObserver) ObserveStatement(stats proxy.StatementStats) {
+	table := stats.Table
+	if table == "" {
+		table = unknownTableLabel
+	}
+	realm := strconv.FormatUint(uint64(stats.RealmID), 10)
+
+	o.statementDuration.WithLabelValues(table, realm, strconv.FormatBool(stats.IsWrite)).Observe(stats.Duration.Seconds())
+	o.routingDecisions.WithLabelValues(realm, stats.Routing.String()).Inc()
+
+	if stats.Err != nil {
+		o.statementErrors.WithLabelValues(table, realm).Inc()
+		return
+	}
+
+	if stats.AffectedRows > 0 {
+		o.statementRows.WithLabelValues(table, realm, "affected").Add(float64(stats.AffectedRows))
+	}
+
+	if stats.ReturnedRows > 0 {
+		o.statementRows.WithLabelValues(table, realm, "returned").Add(float64(stats.ReturnedRows))
+	}
+
+	if o.slowStatementThreshold > 0 && stats.Duration.Seconds() >= o.slowStatementThreshold {
+		o.slowStatements.WithLabelValues(table, realm).Inc()
+	}
+}
+
+// ObserveTransaction updates transactions metrics.
+func (o *PrometheusObserver) ObserveTransaction(stats proxy.TransactionStats) {
+	result := "rollback"
+	if stats.Err != nil {
+		result = "error"
+	} else if stats.Committed {
+		result = "commit"
+	}
+
+	o.transactionDuration.
+		WithLabelValues(strconv.FormatUint(uint64(stats.RealmID), 10), result).
+		Observe(stats.Duration.Seconds())
+}
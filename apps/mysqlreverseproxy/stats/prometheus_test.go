@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
+)
+
+func TestPrometheusObserver_ObserveStatement(t *testing.T) {
+	o := NewPrometheusObserver(prometheus.NewRegistry(), 0.1)
+
+	o.ObserveStatement(proxy.StatementStats{
+		Table:        "characters",
+		IsWrite:      true,
+		RealmID:      2,
+		Routing:      proxy.RoutingByGUID,
+		Duration:     time.Millisecond * 200,
+		AffectedRows: 1,
+	})
+	o.ObserveStatement(proxy.StatementStats{
+		RealmID:  1,
+		Routing:  proxy.RoutingDefault,
+		Duration: time.Millisecond,
+		Err:      errors.New("failed"),
+	})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.routingDecisions.WithLabelValues("2", "guid")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.routingDecisions.WithLabelValues("1", "default")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.statementRows.WithLabelValues("characters", "2", "affected")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.slowStatements.WithLabelValues("characters", "2")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(o.statementErrors.WithLabelValues(unknownTableLabel, "1")))
+	assert.Equal(t, 2, testutil.CollectAndCount(o.statementDuration))
+}
+
+func TestPrometheusObserver_ObserveTransaction(t *testing.T) {
+	o := NewPrometheusObserver(prometheus.NewRegistry(), 0)
+
+	o.ObserveTransaction(proxy.TransactionStats{RealmID: 1, Committed: true, Duration: time.Millisecond})
+	o.ObserveTransaction(proxy.TransactionStats{RealmID: 1, Duration: time.Millisecond})
+
+	assert.Equal(t, 2, testutil.CollectAndCount(o.transactionDuration))
+}
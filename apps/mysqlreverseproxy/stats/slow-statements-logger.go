@@ -0,0 +1,56 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"github.com/walkline/ToCloud9/apps/mysqlreverseproxy/proxy"
+)
+
+// SlowStatementsLogger is statements observer that logs statements that took more than the threshold.
+type SlowStatementsLogger struct {
+	logger    *zerolog.Logger
+	threshold time.Duration
+}
+
+// NewSlowStatementsLogger creates slow statements logger.
+func NewSlowStatementsLogger(logger *zerolog.Logger, threshold time.Duration) *SlowStatementsLogger {
+	return &SlowStatementsLogger{
+		logger:    logger,
+		threshold: threshold,
+	}
+}
+
+// ObserveStatement logs statement if it's slow.
+func (l *SlowStatementsLogger) ObserveStatement(stats proxy.StatementStats) {
+	if stats.Duration < l.threshold {
+		return
+	}
+
+	l.logger.Warn().
+		Int("clientID", stats.ClientID).
+		Uint32("realmID", stats.RealmID).
+		Str("routing", stats.Routing.String()).
+		Str("table", stats.Table).
+		Str("query", stats.Query).
+		Dur("duration", stats.Duration).
+		Uint64("affectedRows", stats.AffectedRows).
+		Int("returnedRows", stats.ReturnedRows).
+		Err(stats.Err).
+		Msg("Slow statement")
+}
+
+// ObserveTransaction logs transaction if it's slow.
+func (l *SlowStatementsLogger) ObserveTransaction(stats proxy.TransactionStats) {
+	if stats.Duration < l.threshold {
+		return
+	}
+
+	l.logger.Warn().
+		Int("clientID", stats.ClientID).
+		Uint32("realmID", stats.RealmID).
+		Bool("committed", stats.Committed).
+		Dur("duration", stats.Duration).
+		Msg("Slow transaction")
+}
@@ -10,20 +10,25 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	nats "github.com/nats-io/nats.go"
-	redis "github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
 	"github.com/walkline/ToCloud9/apps/servers-registry/config"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/adaptive"
 	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/binpack"
 	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
 	"github.com/walkline/ToCloud9/apps/servers-registry/server"
 	"github.com/walkline/ToCloud9/apps/servers-registry/service"
+	pbChar "github.com/walkline/ToCloud9/gen/characters/pb"
+	pbGuild "github.com/walkline/ToCloud9/gen/guilds/pb"
 	"github.com/walkline/ToCloud9/gen/servers-registry/pb"
 	"github.com/walkline/ToCloud9/shared/events"
 	"github.com/walkline/ToCloud9/shared/healthandmetrics"
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 func main() {
@@ -54,14 +59,12 @@ func main() {
 	}
 	defer nc.Close()
 
-	opt, err := redis.ParseURL(conf.RedisConnection)
+	rdb, err := redisclient.NewClient(conf.RedisConnection, conf.Redis)
 	if err != nil {
 		log.Fatal().Err(err).Msg("can't connect to the redis")
 	}
 
-	rdb := redis.NewClient(opt)
-	pingRes := rdb.Ping(context.Background())
-	if pingRes.Err() != nil {
+	if err = redisclient.WaitReady(mainContext, rdb, time.Minute); err != nil {
 		log.Fatal().Err(err).Msg("can't connect to redis")
 	}
 	defer rdb.Close()
@@ -73,44 +76,126 @@ func main() {
 	go metricsConsumer.Start()
 
 	supportedRealms := conf.RealmsID
+	election := service.NewLeaderElection(
+		repo.NewLeaderLeaseRedis(rdb),
+		replicaID(),
+		time.Duration(conf.LeaderElection.LeaseTTLSecs)*time.Second,
+	)
+
 	layerStore := repo.NewLayerRedisStore(rdb)
-	gameServersService, err := service.NewGameServer(
+	gatewayService, err := service.NewGateway(
 		mainContext,
-		repo.NewGameServerRedisRepo(rdb),
+		repo.NewGatewayRedisRepo(rdb),
 		healthChecker,
 		metricsConsumer,
-		binpack.NewBinPackBalancer(binpack.DefaultMapsWeight), // TODO: implement providing custom maps weight list.
 		events.NewServerRegistryProducerNatsJSON(nc, "0.0.1"),
-		layerStore,
+		gatewaySelection(conf),
 		supportedRealms,
 	)
 	if err != nil {
-		log.Fatal().Err(err).Msg("can't create game server service")
+		log.Fatal().Err(err).Msg("can't create gateway service")
 	}
 
-	gatewayService, err := service.NewGateway(
+	gameServersRepo := repo.NewGameServerRedisRepo(rdb)
+
+	var mapMigrator *service.MapMigrator
+	if migration := conf.MapMigration; migration.Enabled {
+		mapMigrator = service.NewMapMigrator(
+			gameServersRepo,
+			repo.NewMapMigrationRedisRepo(rdb),
+			layerStore,
+			gatewayService,
+			events.NewServerRegistryProducerNatsJSON(nc, "0.0.1"),
+			service.MapMigrationSettings{
+				LoadTimeout:           time.Duration(migration.LoadTimeoutSecs) * time.Second,
+				RedirectBatchSize:     migration.RedirectBatchSize,
+				RedirectBatchInterval: time.Duration(migration.RedirectBatchIntervalSecs) * time.Second,
+				RedirectTimeout:       time.Duration(migration.RedirectTimeoutSecs) * time.Second,
+			},
+			supportedRealms,
+		)
+		election.RunWhileLeader(mapMigrator.Run)
+	}
+
+	partitionedMaps, err := conf.PartitionedMaps()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't parse partitioned maps")
+	}
+
+	mapBalancer, rebalancer := mapDistributor(conf)
+	gameServersService, err := service.NewGameServer(
 		mainContext,
-		repo.NewGatewayRedisRepo(rdb),
+		gameServersRepo,
 		healthChecker,
 		metricsConsumer,
+		mapBalancer,
 		events.NewServerRegistryProducerNatsJSON(nc, "0.0.1"),
-		[]uint32{1},
+		layerStore,
+		mapMigrator,
+		partitionedMaps,
+		supportedRealms,
 	)
 	if err != nil {
-		log.Fatal().Err(err).Msg("can't create gateway service")
+		log.Fatal().Err(err).Msg("can't create game server service")
 	}
 
-	layerService := service.NewLayer(gameServersService, layerStore)
+	layerService := service.NewLayer(gameServersService, gatewayService, layerPlacementPolicy(conf, layerStore), layerStore)
 	startupLayers := conf.Layering.Maps
 	if len(startupLayers) > 0 {
 		for _, realmID := range supportedRealms {
-			if err := layerService.UpdateConfiguration(mainContext, realmID, startupLayers); err != nil {
+			// Layers counts could be changed by autoscaler or API, startup configuration is only the initial one.
+			if _, err := layerService.InitConfiguration(mainContext, realmID, startupLayers); err != nil {
 				log.Fatal().Err(err).Uint32("realmID", realmID).Msg("can't apply layer configuration")
 			}
 		}
 	}
 
-	registryService := server.NewServersRegistry(gameServersService, gatewayService, layerService)
+	if autoscaling := conf.Layering.Autoscaling; autoscaling.Enabled {
+		bounds, err := autoscaling.MapBounds()
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't parse layer autoscaling maps")
+		}
+
+		settings := service.LayerAutoscalerSettings{
+			Maps:                     make(map[uint32]service.LayerAutoscalingBounds, len(bounds)),
+			ScaleUpPlayersPerLayer:   autoscaling.ScaleUpPlayersPerLayer,
+			ScaleDownPlayersPerLayer: autoscaling.ScaleDownPlayersPerLayer,
+			ScaleUpWindow:            time.Duration(autoscaling.ScaleUpWindowSecs) * time.Second,
+			ScaleDownWindow:          time.Duration(autoscaling.ScaleDownWindowSecs) * time.Second,
+			Cooldown:                 time.Duration(autoscaling.CooldownSecs) * time.Second,
+		}
+		for mapID, b := range bounds {
+			settings.Maps[mapID] = service.LayerAutoscalingBounds{Min: b.Min, Max: b.Max}
+		}
+
+		autoscaler, err := service.NewLayerAutoscaler(layerService, events.NewServerRegistryProducerNatsJSON(nc, "0.0.1"), supportedRealms, settings)
+		if err != nil {
+			log.Fatal().Err(err).Msg("can't create layer autoscaler")
+		}
+		election.RunWhileLeader(func(ctx context.Context) {
+			autoscaler.Run(ctx, time.Duration(autoscaling.CheckIntervalSecs)*time.Second)
+		})
+	}
+
+	var mapRebalancer *service.MapRebalancer
+	if rebalancer != nil {
+		adaptive := conf.MapBalancing.Adaptive
+		mapRebalancer = service.NewMapRebalancer(gameServersService, gatewayService, rebalancer, repo.NewMapWeightsRedisRepo(rdb), supportedRealms, adaptive.DryRun)
+		election.RunWhileLeader(func(ctx context.Context) {
+			mapRebalancer.Run(ctx, time.Duration(adaptive.CheckIntervalSecs)*time.Second)
+		})
+	}
+
+	election.RunWhileLeader(gatewayService.MonitorServers)
+	election.RunWhileLeader(gameServersService.MonitorServers)
+
+	electionDone := make(chan struct{})
+	go func() {
+		election.Run(mainContext)
+		close(electionDone)
+	}()
+
+	registryService := server.NewServersRegistry(gameServersService, gatewayService, layerService, mapRebalancer, mapMigrator)
 	if conf.LogLevel == zerolog.DebugLevel {
 		registryService = server.NewServersRegistryDebugLoggerMiddleware(registryService, log.Logger)
 	}
@@ -130,6 +215,11 @@ func main() {
 		fmt.Println("")
 		log.Info().Msgf("🧨 Got signal %v, attempting graceful shutdown...", sig)
 		grpcServer.GracefulStop()
+
+		// Releases leadership, so another replica takes it over without waiting for the lease expiration.
+		cancel()
+		<-electionDone
+
 		wg.Done()
 	}()
 
@@ -143,3 +233,83 @@ func main() {
 
 	log.Info().Msg("👍 Server successfully stopped.")
 }
+
+// replicaID returns unique ID of the replica for the leader election.
+func replicaID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "servers-registry"
+	}
+	return hostname + "-" + uuid.NewString()[:8]
+}
+
+// gatewaySelection returns settings of the gateway selection for the new players.
+func gatewaySelection(conf *config.Config) service.GatewaySelectionSettings {
+	networks, err := conf.GatewaySelection.Networks()
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't parse gateway client networks")
+	}
+
+	settings := service.GatewaySelectionSettings{DefaultCapacity: conf.GatewaySelection.DefaultCapacity}
+	for _, network := range networks {
+		settings.ClientNetworks = append(settings.ClientNetworks, service.ClientNetwork{
+			Network:  network.Network,
+			Locality: service.Locality{Region: network.Region, Zone: network.Zone},
+		})
+	}
+	return settings
+}
+
+// mapDistributor returns map distributor of the configured strategy and rebalancer if the strategy supports it.
+func mapDistributor(conf *config.Config) (mapbalancing.MapDistributor, mapbalancing.Rebalancer) {
+	weights := binpack.DefaultMapsWeight // TODO: implement providing custom maps weight list.
+
+	strategy := conf.MapBalancing.Strategy
+	if strategy == config.MapBalancingStrategyBinpack {
+		return binpack.NewBinPackBalancer(weights), nil
+	}
+
+	if strategy != config.MapBalancingStrategyAdaptive {
+		log.Fatal().Str("strategy", strategy).Msg("unknown map balancing strategy")
+	}
+
+	adaptiveConf := conf.MapBalancing.Adaptive
+	balancer := adaptive.NewBalancer(weights, adaptive.Settings{
+		DiffPercentile95SLO: adaptiveConf.DiffPercentile95SLOMs,
+		LearningRate:        adaptiveConf.LearningRate,
+		MaxMovesPerPlan:     adaptiveConf.MaxMovesPerCheck,
+	})
+	return balancer, balancer
+}
+
+func layerPlacementPolicy(conf *config.Config, layerStore repo.LayerStore) service.LayerPlacementPolicy {
+	placement := conf.Layering.Placement
+	if placement.Policy == config.LayerPlacementPolicyLeastLoaded {
+		return service.LeastLoadedLayerPlacement{}
+	}
+
+	if placement.Policy != config.LayerPlacementPolicySocialAffinity {
+		log.Fatal().Str("policy", placement.Policy).Msg("unknown layer placement policy")
+	}
+
+	charConn, err := grpc.Dial(placement.SocialAffinity.CharServiceAddress, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to characters service")
+	}
+
+	guildsConn, err := grpc.Dial(placement.SocialAffinity.GuildsServiceAddress, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal().Err(err).Msg("can't connect to guilds service")
+	}
+
+	return service.NewSocialAffinityLayerPlacement(
+		service.NewGRPCSocialGraph(pbChar.NewCharactersServiceClient(charConn), pbGuild.NewGuildServiceClient(guildsConn)),
+		layerStore,
+		service.SocialAffinitySettings{
+			GuildMemberWeight:  placement.SocialAffinity.GuildMemberWeight,
+			FriendWeight:       placement.SocialAffinity.FriendWeight,
+			MaxPlayersPerLayer: placement.SocialAffinity.MaxPlayersPerLayer,
+			Timeout:            time.Duration(placement.SocialAffinity.TimeoutMs) * time.Millisecond,
+		},
+	)
+}
@@ -1,7 +1,14 @@
 package config
 
 import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
 	"github.com/walkline/ToCloud9/shared/config"
+	"github.com/walkline/ToCloud9/shared/redisclient"
+	"github.com/walkline/ToCloud9/shared/wow/grid"
 )
 
 // Config is config of application
@@ -14,6 +21,9 @@ type Config struct {
 	// RedisConnection is connection string for the redis connection
 	RedisConnection string `yaml:"redisUrl" env:"REDIS_URL" env-default:"redis://:@redis:6379/0"`
 
+	// Redis is config of the redis sentinel or cluster connection and retries on failover.
+	Redis redisclient.Config `yaml:"redis"`
+
 	// NatsURL is nats connection url
 	NatsURL string `yaml:"natsUrl" env:"NATS_URL" env-default:"nats://nats:4222"`
 
@@ -21,10 +31,207 @@ type Config struct {
 	RealmsID []uint32 `yaml:"realmsID" env:"REALMs_ID" env-default:"1"`
 
 	Layering LayeringConfig `yaml:"layering"`
+
+	Partitioning PartitioningConfig `yaml:"partitioning"`
+
+	MapBalancing MapBalancingConfig `yaml:"mapBalancing"`
+
+	MapMigration MapMigrationConfig `yaml:"mapMigration"`
+
+	LeaderElection LeaderElectionConfig `yaml:"leaderElection"`
+
+	GatewaySelection GatewaySelectionConfig `yaml:"gatewaySelection"`
+}
+
+// GatewaySelectionConfig is config of the gateway selection for the new players.
+type GatewaySelectionConfig struct {
+	// DefaultCapacity is max players count of the gateways that didn't report their capacity.
+	DefaultCapacity uint32 `yaml:"defaultCapacity" env:"GATEWAY_DEFAULT_CAPACITY" env-default:"3000"`
+
+	// ClientNetworks is comma separated CIDRs of the clients per locality "region" or "region/zone",
+	// players from these networks are sent to the gateways of their locality.
+	ClientNetworks map[string]string `yaml:"clientNetworks" env:"GATEWAY_CLIENT_NETWORKS" env-separator:";"`
+}
+
+// ClientNetwork is network of the clients from the same region and optional zone.
+type ClientNetwork struct {
+	Network *net.IPNet
+	Region  string
+	Zone    string
+}
+
+// Networks parses client networks.
+func (c GatewaySelectionConfig) Networks() ([]ClientNetwork, error) {
+	var res []ClientNetwork
+	for locality, cidrs := range c.ClientNetworks {
+		region, zone, _ := strings.Cut(locality, "/")
+		if region == "" {
+			return nil, fmt.Errorf("client networks %q have empty region", cidrs)
+		}
+
+		for _, cidr := range strings.Split(cidrs, ",") {
+			_, network, err := net.ParseCIDR(strings.TrimSpace(cidr))
+			if err != nil {
+				return nil, fmt.Errorf("invalid client network of %q: %w", locality, err)
+			}
+			res = append(res, ClientNetwork{Network: network, Region: region, Zone: zone})
+		}
+	}
+	return res, nil
+}
+
+// LeaderElectionConfig is config of the leader election between servers registry replicas.
+// Only the leader health checks servers and rebalances maps, every replica serves requests.
+type LeaderElectionConfig struct {
+	// LeaseTTLSecs is time after which leadership of dead leader is taken by another replica.
+	LeaseTTLSecs int `yaml:"leaseTtlSecs" env:"LEADER_LEASE_TTL_SECS" env-default:"9"`
+}
+
+// MapMigrationConfig is config of the staged maps migration between running game servers.
+type MapMigrationConfig struct {
+	// Enabled moves maps one by one with players redirect, otherwise moved maps disconnect their players at once.
+	Enabled bool `yaml:"enabled" env:"MAP_MIGRATION_ENABLED" env-default:"true"`
+
+	LoadTimeoutSecs           int    `yaml:"loadTimeoutSecs" env:"MAP_MIGRATION_LOAD_TIMEOUT_SECS" env-default:"60"`
+	RedirectBatchSize         uint32 `yaml:"redirectBatchSize" env:"MAP_MIGRATION_REDIRECT_BATCH_SIZE" env-default:"20"`
+	RedirectBatchIntervalSecs int    `yaml:"redirectBatchIntervalSecs" env:"MAP_MIGRATION_REDIRECT_BATCH_INTERVAL_SECS" env-default:"5"`
+	RedirectTimeoutSecs       int    `yaml:"redirectTimeoutSecs" env:"MAP_MIGRATION_REDIRECT_TIMEOUT_SECS" env-default:"120"`
+}
+
+// Map balancing strategies of the game servers.
+const (
+	MapBalancingStrategyBinpack  = "binpack"
+	MapBalancingStrategyAdaptive = "adaptive"
+)
+
+// MapBalancingConfig is config of the maps distribution between game servers.
+type MapBalancingConfig struct {
+	// Strategy is "binpack" or "adaptive".
+	Strategy string `yaml:"strategy" env:"MAP_BALANCING_STRATEGY" env-default:"binpack"`
+
+	Adaptive AdaptiveMapBalancingConfig `yaml:"adaptive"`
+}
+
+// AdaptiveMapBalancingConfig is config of the adaptive map balancing strategy.
+type AdaptiveMapBalancingConfig struct {
+	// DiffPercentile95SLOMs is 95 percentile of the tick diff, game servers above it are rebalanced.
+	DiffPercentile95SLOMs uint32 `yaml:"diffPercentile95SloMs" env:"MAP_BALANCING_DIFF_P95_SLO_MS" env-default:"60"`
+
+	CheckIntervalSecs int     `yaml:"checkIntervalSecs" env:"MAP_BALANCING_CHECK_INTERVAL_SECS" env-default:"60"`
+	MaxMovesPerCheck  int     `yaml:"maxMovesPerCheck" env:"MAP_BALANCING_MAX_MOVES_PER_CHECK" env-default:"2"`
+	LearningRate      float64 `yaml:"learningRate" env:"MAP_BALANCING_LEARNING_RATE" env-default:"0.2"`
+
+	// DryRun only logs planned moves without applying them.
+	DryRun bool `yaml:"dryRun" env:"MAP_BALANCING_DRY_RUN" env-default:"false"`
 }
 
 type LayeringConfig struct {
 	Maps map[uint32]uint32 `yaml:"maps" env:"LAYER_MAPS" env-separator:";"`
+
+	Autoscaling LayerAutoscalingConfig `yaml:"autoscaling"`
+
+	Placement LayerPlacementConfig `yaml:"placement"`
+}
+
+// PartitioningConfig is config of the maps that are split into regions simulated by different game servers.
+type PartitioningConfig struct {
+	// Maps is regions count per map ID, every region is equal range of the map grids by X axis.
+	Maps map[uint32]uint32 `yaml:"maps" env:"PARTITIONED_MAPS" env-separator:";"`
+}
+
+// PartitionedMaps returns regions count of the partitioned maps.
+// Map can't be partitioned and layered at the same time.
+func (c *Config) PartitionedMaps() (map[uint32]uint32, error) {
+	res := make(map[uint32]uint32, len(c.Partitioning.Maps))
+	for mapID, regions := range c.Partitioning.Maps {
+		if regions < 2 {
+			continue
+		}
+		if regions > grid.MaxNumberOfGrids {
+			return nil, fmt.Errorf("map %d has %d regions, max is %d", mapID, regions, grid.MaxNumberOfGrids)
+		}
+		if layers := c.Layering.Maps[mapID]; layers > 1 {
+			return nil, fmt.Errorf("map %d can't be partitioned and have %d layers", mapID, layers)
+		}
+		res[mapID] = regions
+	}
+	return res, nil
+}
+
+// Layer placement policies of characters that are not bound to a group layer.
+const (
+	LayerPlacementPolicyLeastLoaded    = "leastLoaded"
+	LayerPlacementPolicySocialAffinity = "socialAffinity"
+)
+
+// LayerPlacementConfig is config of the layer placement.
+type LayerPlacementConfig struct {
+	// Policy is one of LayerPlacementPolicyLeastLoaded and LayerPlacementPolicySocialAffinity.
+	Policy string `yaml:"policy" env:"LAYER_PLACEMENT_POLICY" env-default:"leastLoaded"`
+
+	SocialAffinity SocialAffinityConfig `yaml:"socialAffinity"`
+}
+
+// SocialAffinityConfig is config of the placement that puts characters to the layers of their guild members and friends.
+type SocialAffinityConfig struct {
+	// CharServiceAddress is address of characters service
+	CharServiceAddress string `yaml:"charServiceAddress" env:"CHAR_SERVICE_ADDRESS" env-default:"localhost:8991"`
+
+	// GuildsServiceAddress is address of guilds service
+	GuildsServiceAddress string `yaml:"guildsServiceAddress" env:"GUILDS_SERVICE_ADDRESS" env-default:"localhost:8995"`
+
+	GuildMemberWeight uint32 `yaml:"guildMemberWeight" env:"LAYER_AFFINITY_GUILD_MEMBER_WEIGHT" env-default:"1"`
+	FriendWeight      uint32 `yaml:"friendWeight" env:"LAYER_AFFINITY_FRIEND_WEIGHT" env-default:"2"`
+
+	// MaxPlayersPerLayer is players count on the map that stops placing acquaintances to the layer, 0 disables it.
+	MaxPlayersPerLayer uint32 `yaml:"maxPlayersPerLayer" env:"LAYER_AFFINITY_MAX_PLAYERS_PER_LAYER" env-default:"400"`
+
+	TimeoutMs int `yaml:"timeoutMs" env:"LAYER_AFFINITY_TIMEOUT_MS" env-default:"200"`
+}
+
+// LayerAutoscalingConfig is config of the layers autoscaler.
+type LayerAutoscalingConfig struct {
+	Enabled bool `yaml:"enabled" env:"LAYER_AUTOSCALING_ENABLED" env-default:"false"`
+
+	// Maps contains "min-max" layers bounds of the maps that should be autoscaled.
+	Maps map[uint32]string `yaml:"maps" env:"LAYER_AUTOSCALING_MAPS" env-separator:";"`
+
+	CheckIntervalSecs        int    `yaml:"checkIntervalSecs" env:"LAYER_AUTOSCALING_CHECK_INTERVAL_SECS" env-default:"15"`
+	ScaleUpPlayersPerLayer   uint32 `yaml:"scaleUpPlayersPerLayer" env:"LAYER_AUTOSCALING_SCALE_UP_PLAYERS_PER_LAYER" env-default:"300"`
+	ScaleDownPlayersPerLayer uint32 `yaml:"scaleDownPlayersPerLayer" env:"LAYER_AUTOSCALING_SCALE_DOWN_PLAYERS_PER_LAYER" env-default:"150"`
+	ScaleUpWindowSecs        int    `yaml:"scaleUpWindowSecs" env:"LAYER_AUTOSCALING_SCALE_UP_WINDOW_SECS" env-default:"120"`
+	ScaleDownWindowSecs      int    `yaml:"scaleDownWindowSecs" env:"LAYER_AUTOSCALING_SCALE_DOWN_WINDOW_SECS" env-default:"600"`
+	CooldownSecs             int    `yaml:"cooldownSecs" env:"LAYER_AUTOSCALING_COOLDOWN_SECS" env-default:"300"`
+}
+
+// LayerBounds is min and max layers count of the map.
+type LayerBounds struct {
+	Min uint32
+	Max uint32
+}
+
+// MapBounds parses layers bounds of the autoscaled maps.
+func (c LayerAutoscalingConfig) MapBounds() (map[uint32]LayerBounds, error) {
+	res := make(map[uint32]LayerBounds, len(c.Maps))
+	for mapID, value := range c.Maps {
+		minStr, maxStr, found := strings.Cut(value, "-")
+		if !found {
+			return nil, fmt.Errorf("map %d has invalid layers bounds %q, expected min-max", mapID, value)
+		}
+
+		min, err := strconv.ParseUint(strings.TrimSpace(minStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("map %d has invalid min layers: %w", mapID, err)
+		}
+
+		max, err := strconv.ParseUint(strings.TrimSpace(maxStr), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("map %d has invalid max layers: %w", mapID, err)
+		}
+
+		res[mapID] = LayerBounds{Min: uint32(min), Max: uint32(max)}
+	}
+	return res, nil
 }
 
 // LoadConfig loads config from env variables
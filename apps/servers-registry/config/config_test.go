@@ -33,3 +33,100 @@ func TestLayeringConfigFromYAML(t *testing.T) {
 	require.NoError(t, cleanenv.ReadConfig(path, &layering))
 	require.Equal(t, map[uint32]uint32{1: 2, 531: 3}, layering.Maps)
 }
+
+func TestLayerAutoscalingConfigFromEnvironment(t *testing.T) {
+	t.Setenv("LAYER_AUTOSCALING_ENABLED", "true")
+	t.Setenv("LAYER_AUTOSCALING_MAPS", "0:1-4;571:2-6")
+
+	var autoscaling LayerAutoscalingConfig
+	require.NoError(t, cleanenv.ReadEnv(&autoscaling))
+	require.True(t, autoscaling.Enabled)
+	require.Equal(t, uint32(300), autoscaling.ScaleUpPlayersPerLayer)
+	require.Equal(t, uint32(150), autoscaling.ScaleDownPlayersPerLayer)
+
+	bounds, err := autoscaling.MapBounds()
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]LayerBounds{0: {Min: 1, Max: 4}, 571: {Min: 2, Max: 6}}, bounds)
+}
+
+func TestLayerAutoscalingConfigInvalidBounds(t *testing.T) {
+	_, err := LayerAutoscalingConfig{Maps: map[uint32]string{0: "4"}}.MapBounds()
+	require.Error(t, err)
+
+	_, err = LayerAutoscalingConfig{Maps: map[uint32]string{0: "a-4"}}.MapBounds()
+	require.Error(t, err)
+}
+
+func TestLayerPlacementConfigDefaults(t *testing.T) {
+	var placement LayerPlacementConfig
+	require.NoError(t, cleanenv.ReadEnv(&placement))
+	require.Equal(t, LayerPlacementPolicyLeastLoaded, placement.Policy)
+	require.Equal(t, uint32(2), placement.SocialAffinity.FriendWeight)
+	require.Equal(t, uint32(400), placement.SocialAffinity.MaxPlayersPerLayer)
+}
+
+func TestMapBalancingConfigFromEnvironment(t *testing.T) {
+	t.Setenv("MAP_BALANCING_STRATEGY", MapBalancingStrategyAdaptive)
+	t.Setenv("MAP_BALANCING_DRY_RUN", "true")
+
+	var balancing MapBalancingConfig
+	require.NoError(t, cleanenv.ReadEnv(&balancing))
+	require.Equal(t, MapBalancingStrategyAdaptive, balancing.Strategy)
+	require.True(t, balancing.Adaptive.DryRun)
+	require.Equal(t, uint32(60), balancing.Adaptive.DiffPercentile95SLOMs)
+	require.Equal(t, 2, balancing.Adaptive.MaxMovesPerCheck)
+}
+
+func TestMapMigrationConfigDefaults(t *testing.T) {
+	var migration MapMigrationConfig
+	require.NoError(t, cleanenv.ReadEnv(&migration))
+	require.True(t, migration.Enabled)
+	require.Equal(t, uint32(20), migration.RedirectBatchSize)
+	require.Equal(t, 120, migration.RedirectTimeoutSecs)
+}
+
+func TestPartitionedMaps(t *testing.T) {
+	t.Setenv("PARTITIONED_MAPS", "0:2;1:4;530:1")
+
+	var partitioning PartitioningConfig
+	require.NoError(t, cleanenv.ReadEnv(&partitioning))
+
+	c := Config{Partitioning: partitioning, Layering: LayeringConfig{Maps: map[uint32]uint32{530: 2, 571: 1}}}
+	maps, err := c.PartitionedMaps()
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]uint32{0: 2, 1: 4}, maps)
+
+	c.Layering.Maps[0] = 2
+	_, err = c.PartitionedMaps()
+	require.Error(t, err, "layered map can't be partitioned")
+
+	c = Config{Partitioning: PartitioningConfig{Maps: map[uint32]uint32{0: 65}}}
+	_, err = c.PartitionedMaps()
+	require.Error(t, err, "more regions than grids")
+}
+
+func TestGatewaySelectionConfigFromEnvironment(t *testing.T) {
+	t.Setenv("GATEWAY_CLIENT_NETWORKS", "eu/a:10.0.0.0/16,10.1.0.0/16;us:2001:db8::/32")
+
+	var selection GatewaySelectionConfig
+	require.NoError(t, cleanenv.ReadEnv(&selection))
+	require.Equal(t, uint32(3000), selection.DefaultCapacity)
+
+	networks, err := selection.Networks()
+	require.NoError(t, err)
+	require.Len(t, networks, 3)
+
+	localities := map[string]string{}
+	for _, network := range networks {
+		localities[network.Network.String()] = network.Region + "/" + network.Zone
+	}
+	require.Equal(t, map[string]string{"10.0.0.0/16": "eu/a", "10.1.0.0/16": "eu/a", "2001:db8::/32": "us/"}, localities)
+}
+
+func TestGatewaySelectionConfigInvalidNetworks(t *testing.T) {
+	_, err := GatewaySelectionConfig{ClientNetworks: map[string]string{"eu": "10.0.0.0"}}.Networks()
+	require.Error(t, err)
+
+	_, err = GatewaySelectionConfig{ClientNetworks: map[string]string{"/a": "10.0.0.0/8"}}.Networks()
+	require.Error(t, err)
+}
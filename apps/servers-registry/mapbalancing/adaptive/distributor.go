@@ -0,0 +1,321 @@
+package adaptive
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/binpack"
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+// Settings configures adaptive balancer.
+type Settings struct {
+	// DiffPercentile95SLO is 95 percentile of the tick diff in ms that game server shouldn't exceed.
+	// Maps are moved only from the game servers that exceed it.
+	DiffPercentile95SLO uint32
+
+	// LearningRate is weight of the new observation in the learned maps weights, from 0 to 1.
+	LearningRate float64
+
+	// MaxMovesPerPlan limits maps moves of one plan.
+	MaxMovesPerPlan int
+}
+
+// balancer learns maps weights as share of the game servers tick diff that players of the map cause.
+// Weights are measured in ms of the tick diff, so they can be compared with the SLO.
+// Maps that were never observed are not moved and use base weights when they are assigned.
+//
+// Unlike binpack, it keeps current assignments and only assigns maps without server,
+// so maps are moved only by plans when game servers are overloaded.
+type balancer struct {
+	settings Settings
+	base     binpack.MapsWeight
+	initial  mapbalancing.MapDistributor
+
+	mu      sync.RWMutex
+	learned map[uint32]float64
+}
+
+func NewBalancer(base binpack.MapsWeight, settings Settings) mapbalancing.Rebalancer {
+	if settings.LearningRate <= 0 || settings.LearningRate > 1 {
+		settings.LearningRate = 0.2
+	}
+
+	return &balancer{
+		settings: settings,
+		base:     base,
+		initial:  binpack.NewBinPackBalancer(base),
+		learned:  map[uint32]float64{},
+	}
+}
+
+func (b *balancer) Observe(loads []mapbalancing.ServerLoad) {
+	observed := map[uint32]float64{}
+	for _, load := range loads {
+		players := uint32(0)
+		for _, count := range load.MapPlayers {
+			players += count
+		}
+		if players == 0 {
+			continue
+		}
+
+		diff := float64(load.Server.Diff.Percentile95)
+		for mapID, count := range load.MapPlayers {
+			observed[mapID] += diff * float64(count) / float64(players)
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Maps that became empty decay too, otherwise their weight would stay as it was at the peak.
+	for mapID := range b.learned {
+		if _, found := observed[mapID]; !found {
+			observed[mapID] = 0
+		}
+	}
+
+	for mapID, weight := range observed {
+		previous, found := b.learned[mapID]
+		if !found {
+			b.learned[mapID] = weight
+			continue
+		}
+		b.learned[mapID] = previous + b.settings.LearningRate*(weight-previous)
+	}
+}
+
+func (b *balancer) LearnedWeights() map[uint32]float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	res := make(map[uint32]float64, len(b.learned))
+	for mapID, weight := range b.learned {
+		res[mapID] = weight
+	}
+	return res
+}
+
+func (b *balancer) SetLearnedWeights(weights map[uint32]float64) {
+	learned := make(map[uint32]float64, len(weights))
+	for mapID, weight := range weights {
+		learned[mapID] = weight
+	}
+
+	b.mu.Lock()
+	b.learned = learned
+	b.mu.Unlock()
+}
+
+// weights returns learned weights of the maps. If withBase is true, maps that were
+// never observed have base weights, otherwise they are missing.
+func (b *balancer) weights(withBase bool) binpack.MapsWeight {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	res := make(binpack.MapsWeight, len(b.base))
+	if withBase {
+		for mapID, weight := range b.base {
+			res[mapID] = weight
+		}
+	}
+	for mapID, weight := range b.learned {
+		res[mapID] = uint32(math.Round(weight))
+	}
+	return res
+}
+
+func (b *balancer) Distribute(servers []repo.GameServer) []repo.GameServer {
+	balanced := false
+	for i := range servers {
+		if servers[i].IsAllMapsAvailable() && len(servers[i].AssignedMapsToHandle) > 0 {
+			balanced = true
+			break
+		}
+	}
+
+	// Nothing to keep, first distribution is done by base weights.
+	if !balanced {
+		return b.initial.Distribute(servers)
+	}
+
+	weights := b.weights(true)
+	unassigned := make(map[uint32]struct{}, len(weights))
+	for mapID := range weights {
+		unassigned[mapID] = struct{}{}
+	}
+
+	toBalance := []int{}
+	for i := range servers {
+		if servers[i].IsAllMapsAvailable() {
+			toBalance = append(toBalance, i)
+			continue
+		}
+
+		servers[i].AssignedMapsToHandle = append([]uint32(nil), servers[i].AvailableMaps...)
+		for _, mapID := range servers[i].AvailableMaps {
+			delete(unassigned, mapID)
+		}
+	}
+
+	if len(toBalance) == 0 {
+		return servers
+	}
+
+	// Keep current assignments. Layered maps are assigned to several servers,
+	// they are kept only once here and replicated by the layers configuration later.
+	for _, i := range toBalance {
+		kept := []uint32{}
+		for _, mapID := range servers[i].AssignedMapsToHandle {
+			if _, found := unassigned[mapID]; found {
+				kept = append(kept, mapID)
+				delete(unassigned, mapID)
+			}
+		}
+		servers[i].AssignedMapsToHandle = kept
+	}
+
+	orphans := make([]uint32, 0, len(unassigned))
+	for mapID := range unassigned {
+		orphans = append(orphans, mapID)
+	}
+	sort.Slice(orphans, func(i, j int) bool {
+		if weights[orphans[i]] != weights[orphans[j]] {
+			return weights[orphans[i]] > weights[orphans[j]]
+		}
+		return orphans[i] < orphans[j]
+	})
+
+	loads := make(map[int]uint32, len(toBalance))
+	for _, i := range toBalance {
+		for _, mapID := range servers[i].AssignedMapsToHandle {
+			loads[i] += weights[mapID]
+		}
+	}
+
+	for _, mapID := range orphans {
+		target := toBalance[0]
+		for _, i := range toBalance[1:] {
+			if loads[i] < loads[target] || (loads[i] == loads[target] && servers[i].ID < servers[target].ID) {
+				target = i
+			}
+		}
+		servers[target].AssignedMapsToHandle = append(servers[target].AssignedMapsToHandle, mapID)
+		loads[target] += weights[mapID]
+	}
+
+	for _, i := range toBalance {
+		maps := servers[i].AssignedMapsToHandle
+		sort.Slice(maps, func(ii, jj int) bool { return maps[ii] < maps[jj] })
+	}
+
+	return servers
+}
+
+func (b *balancer) Plan(servers []repo.GameServer) []mapbalancing.MapMove {
+	// Base weights are not in ms of tick diff, so only observed maps are moved.
+	weights := b.weights(false)
+
+	holders := map[uint32]int{}
+	for _, server := range servers {
+		for _, mapID := range server.AssignedMapsToHandle {
+			holders[mapID]++
+		}
+	}
+
+	// Estimated tick diff of the servers, it's changed by planned moves.
+	loads := map[string]uint32{}
+	candidates := []repo.GameServer{}
+	for _, server := range servers {
+		if !server.IsAllMapsAvailable() {
+			continue
+		}
+		loads[server.ID] = server.Diff.Percentile95
+		candidates = append(candidates, server)
+	}
+
+	overloaded := []repo.GameServer{}
+	for _, server := range candidates {
+		if server.Diff.Percentile95 > b.settings.DiffPercentile95SLO {
+			overloaded = append(overloaded, server)
+		}
+	}
+	sort.Slice(overloaded, func(i, j int) bool {
+		return overloaded[i].Diff.Percentile95 > overloaded[j].Diff.Percentile95
+	})
+
+	moves := []mapbalancing.MapMove{}
+	for _, server := range overloaded {
+		movable := []uint32{}
+		for _, mapID := range server.AssignedMapsToHandle {
+			// Layered maps are placed by the layers configuration.
+			if holders[mapID] == 1 && weights[mapID] > 0 {
+				movable = append(movable, mapID)
+			}
+		}
+
+		for _, mapID := range b.mapsToMove(movable, loads[server.ID]-b.settings.DiffPercentile95SLO, weights) {
+			if b.settings.MaxMovesPerPlan > 0 && len(moves) >= b.settings.MaxMovesPerPlan {
+				return moves
+			}
+
+			target := b.coolestServer(candidates, loads, server.ID, weights[mapID])
+			if target == "" {
+				continue
+			}
+
+			moves = append(moves, mapbalancing.MapMove{
+				MapID:        mapID,
+				FromServerID: server.ID,
+				ToServerID:   target,
+				Weight:       weights[mapID],
+			})
+			loads[server.ID] -= min(weights[mapID], loads[server.ID])
+			loads[target] += weights[mapID]
+		}
+	}
+
+	return moves
+}
+
+// mapsToMove chooses maps that unload server by excess with the fewest moves. The lightest map that is
+// enough alone is preferred, so fewer players are moved. Otherwise, maps are taken from the heaviest.
+func (b *balancer) mapsToMove(maps []uint32, excess uint32, weights binpack.MapsWeight) []uint32 {
+	sort.Slice(maps, func(i, j int) bool {
+		if weights[maps[i]] != weights[maps[j]] {
+			return weights[maps[i]] < weights[maps[j]]
+		}
+		return maps[i] < maps[j]
+	})
+
+	for _, mapID := range maps {
+		if weights[mapID] >= excess {
+			return []uint32{mapID}
+		}
+	}
+
+	res := []uint32{}
+	moved := uint32(0)
+	for i := len(maps) - 1; i >= 0 && moved < excess; i-- {
+		res = append(res, maps[i])
+		moved += weights[maps[i]]
+	}
+	return res
+}
+
+// coolestServer returns server with the lowest load that stays under SLO with the additional weight.
+func (b *balancer) coolestServer(servers []repo.GameServer, loads map[string]uint32, exceptID string, weight uint32) string {
+	target := ""
+	for _, server := range servers {
+		if server.ID == exceptID || loads[server.ID]+weight > b.settings.DiffPercentile95SLO {
+			continue
+		}
+		if target == "" || loads[server.ID] < loads[target] || (loads[server.ID] == loads[target] && server.ID < target) {
+			target = server.ID
+		}
+	}
+	return target
+}
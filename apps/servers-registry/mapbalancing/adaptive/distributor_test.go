@@ -0,0 +1,133 @@
+package adaptive
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+func newTestBalancer() *balancer {
+	return NewBalancer(map[uint32]uint32{0: 100, 1: 100, 30: 1, 33: 1, 34: 1}, Settings{
+		DiffPercentile95SLO: 50,
+		LearningRate:        0.5,
+		MaxMovesPerPlan:     2,
+	}).(*balancer)
+}
+
+func TestBalancerLearnsWeightsFromPlayersAndDiff(t *testing.T) {
+	b := newTestBalancer()
+
+	b.Observe([]mapbalancing.ServerLoad{
+		{Server: repo.GameServer{ID: "a", Diff: repo.DiffData{Percentile95: 80}}, MapPlayers: map[uint32]uint32{0: 300, 30: 100}},
+		{Server: repo.GameServer{ID: "b", Diff: repo.DiffData{Percentile95: 20}}, MapPlayers: map[uint32]uint32{1: 50}},
+	})
+	assert.Equal(t, uint32(60), b.weights(false)[0])
+	assert.Equal(t, uint32(20), b.weights(false)[30])
+	assert.Equal(t, uint32(20), b.weights(false)[1])
+
+	// Empty map decays with the learning rate.
+	b.Observe([]mapbalancing.ServerLoad{
+		{Server: repo.GameServer{ID: "a", Diff: repo.DiffData{Percentile95: 40}}, MapPlayers: map[uint32]uint32{0: 100}},
+	})
+	assert.Equal(t, uint32(50), b.weights(false)[0])
+	assert.Equal(t, uint32(10), b.weights(false)[30])
+
+	weights := b.weights(true)
+	assert.Equal(t, uint32(1), weights[33], "not observed maps use base weights")
+	_, found := b.weights(false)[33]
+	assert.False(t, found)
+}
+
+func TestBalancerDistributeKeepsAssignments(t *testing.T) {
+	b := newTestBalancer()
+
+	servers := b.Distribute([]repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0, 30}},
+		{ID: "b", AssignedMapsToHandle: []uint32{1, 33}},
+		{ID: "c"},
+		{ID: "d", AvailableMaps: []uint32{33}},
+	})
+
+	assert.Equal(t, []uint32{0, 30}, servers[0].AssignedMapsToHandle)
+	assert.Equal(t, []uint32{1}, servers[1].AssignedMapsToHandle, "map 33 is handled by the dedicated server")
+	assert.Equal(t, []uint32{34}, servers[2].AssignedMapsToHandle, "orphan map goes to the least loaded server")
+	assert.Equal(t, []uint32{33}, servers[3].AssignedMapsToHandle)
+}
+
+func TestBalancerDistributeFirstTimeUsesBinpack(t *testing.T) {
+	b := newTestBalancer()
+
+	servers := b.Distribute([]repo.GameServer{{ID: "a"}, {ID: "b"}})
+	assigned := 0
+	for _, server := range servers {
+		assert.NotEmpty(t, server.AssignedMapsToHandle)
+		assigned += len(server.AssignedMapsToHandle)
+	}
+	assert.Equal(t, 5, assigned)
+}
+
+func TestBalancerPlan(t *testing.T) {
+	servers := []repo.GameServer{
+		{ID: "a", Diff: repo.DiffData{Percentile95: 90}, AssignedMapsToHandle: []uint32{0, 30, 33}},
+		{ID: "b", Diff: repo.DiffData{Percentile95: 30}, AssignedMapsToHandle: []uint32{1}},
+		{ID: "c", Diff: repo.DiffData{Percentile95: 10}, AssignedMapsToHandle: []uint32{34}},
+	}
+
+	tests := map[string]struct {
+		observe []mapbalancing.ServerLoad
+		servers []repo.GameServer
+		want    []mapbalancing.MapMove
+	}{
+		"lightest map that unloads server is moved to the coolest server": {
+			observe: []mapbalancing.ServerLoad{
+				{Server: servers[0], MapPlayers: map[uint32]uint32{0: 40, 30: 45, 33: 5}},
+			},
+			servers: servers,
+			want:    []mapbalancing.MapMove{{MapID: 0, FromServerID: "a", ToServerID: "c", Weight: 40}},
+		},
+		"several heaviest maps if no map is enough alone": {
+			observe: []mapbalancing.ServerLoad{
+				{Server: servers[0], MapPlayers: map[uint32]uint32{0: 30, 30: 30, 33: 30}},
+			},
+			servers: []repo.GameServer{
+				{ID: "a", Diff: repo.DiffData{Percentile95: 90}, AssignedMapsToHandle: []uint32{0, 30, 33}},
+				{ID: "b", Diff: repo.DiffData{Percentile95: 10}, AssignedMapsToHandle: []uint32{1}},
+				{ID: "c", Diff: repo.DiffData{Percentile95: 10}, AssignedMapsToHandle: []uint32{34}},
+			},
+			want: []mapbalancing.MapMove{
+				{MapID: 33, FromServerID: "a", ToServerID: "b", Weight: 30},
+				{MapID: 30, FromServerID: "a", ToServerID: "c", Weight: 30},
+			},
+		},
+		"no plan under SLO": {
+			observe: []mapbalancing.ServerLoad{
+				{Server: repo.GameServer{Diff: repo.DiffData{Percentile95: 40}}, MapPlayers: map[uint32]uint32{0: 10, 30: 10}},
+			},
+			servers: []repo.GameServer{
+				{ID: "a", Diff: repo.DiffData{Percentile95: 40}, AssignedMapsToHandle: []uint32{0, 30}},
+				{ID: "b", Diff: repo.DiffData{Percentile95: 0}},
+			},
+			want: []mapbalancing.MapMove{},
+		},
+		"layered and not observed maps are not moved": {
+			observe: []mapbalancing.ServerLoad{
+				{Server: servers[0], MapPlayers: map[uint32]uint32{0: 90}},
+			},
+			servers: []repo.GameServer{
+				{ID: "a", Diff: repo.DiffData{Percentile95: 90}, AssignedMapsToHandle: []uint32{0, 30}},
+				{ID: "b", Diff: repo.DiffData{Percentile95: 10}, AssignedMapsToHandle: []uint32{0}},
+			},
+			want: []mapbalancing.MapMove{},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			b := newTestBalancer()
+			b.Observe(tt.observe)
+			assert.Equal(t, tt.want, b.Plan(tt.servers))
+		})
+	}
+}
@@ -5,3 +5,40 @@ import "github.com/walkline/ToCloud9/apps/servers-registry/repo"
 type MapDistributor interface {
 	Distribute(servers []repo.GameServer) []repo.GameServer
 }
+
+// ServerLoad is observed load of the game server.
+type ServerLoad struct {
+	// Server contains tick diff metrics of the game server.
+	Server repo.GameServer
+
+	// MapPlayers is players count per map ID on the game server.
+	MapPlayers map[uint32]uint32
+}
+
+// MapMove is map that should be moved from one game server to another.
+type MapMove struct {
+	MapID        uint32
+	FromServerID string
+	ToServerID   string
+
+	// Weight is learned weight of the map.
+	Weight uint32
+}
+
+// Rebalancer is MapDistributor that learns maps weights from observed load
+// and moves maps of running game servers when they are overloaded.
+type Rebalancer interface {
+	MapDistributor
+
+	// Observe updates learned maps weights with the observed load of game servers.
+	Observe(loads []ServerLoad)
+
+	// Plan returns maps moves that would unload overloaded game servers, the servers are not changed.
+	Plan(servers []repo.GameServer) []MapMove
+
+	// LearnedWeights returns learned maps weights, so they can be shared between servers registry replicas.
+	LearnedWeights() map[uint32]float64
+
+	// SetLearnedWeights replaces learned maps weights with the shared ones.
+	SetLearnedWeights(weights map[uint32]float64)
+}
@@ -3,6 +3,8 @@ package repo
 import (
 	"context"
 	"sort"
+
+	"github.com/walkline/ToCloud9/shared/wow/grid"
 )
 
 type DiffData struct {
@@ -36,6 +38,29 @@ type GameServer struct {
 	// but we are still waiting for confirmation from GameServer,
 	// that these maps are loaded and game server is ready to handle them.
 	AssignedButPendingMaps []uint32
+
+	// ReleasingMaps list of assigned maps that are migrating to another game server.
+	// New players of these maps go to the destination server, while current players are redirected.
+	ReleasingMaps []uint32
+
+	// Regions list of regions of the partitioned maps that this server simulates.
+	// Partitioned map is assigned to several servers, each of them handles own grids of the map.
+	Regions []MapRegion
+
+	// AwaitingDistribution is true for the registered server that didn't get maps from the leader replica yet.
+	AwaitingDistribution bool
+}
+
+// MapRegion is range of grids by X axis of the partitioned map.
+type MapRegion struct {
+	MapID    uint32
+	GridXMin uint32
+	GridXMax uint32
+}
+
+// Grids returns grids range of the region.
+func (r MapRegion) Grids() grid.Range {
+	return grid.Range{Min: r.GridXMin, Max: r.GridXMax}
 }
 
 func (g *GameServer) HealthCheckAddress() string {
@@ -55,12 +80,28 @@ func (g *GameServer) CanHandleMap(id uint32) bool {
 				return false
 			}
 		}
+		for _, releasingMap := range g.ReleasingMaps {
+			if releasingMap == id {
+				return false
+			}
+		}
 		return true
 	}
 
 	return false
 }
 
+// MapRegions returns regions of the map that server handles, empty if the map is not partitioned.
+func (g *GameServer) MapRegions(mapID uint32) []MapRegion {
+	var res []MapRegion
+	for _, region := range g.Regions {
+		if region.MapID == mapID {
+			res = append(res, region)
+		}
+	}
+	return res
+}
+
 func (g *GameServer) IsAllMapsAvailable() bool {
 	return len(g.AvailableMaps) == 0
 }
@@ -70,6 +111,8 @@ func (g *GameServer) Copy() GameServer {
 	cp.AvailableMaps = append([]uint32(nil), g.AvailableMaps...)
 	cp.AssignedMapsToHandle = append([]uint32(nil), g.AssignedMapsToHandle...)
 	cp.AssignedButPendingMaps = append([]uint32(nil), g.AssignedButPendingMaps...)
+	cp.ReleasingMaps = append([]uint32(nil), g.ReleasingMaps...)
+	cp.Regions = append([]MapRegion(nil), g.Regions...)
 	return cp
 }
 
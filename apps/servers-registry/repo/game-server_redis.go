@@ -11,6 +11,8 @@ import (
 
 	redis "github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 // modifier-name-code, e.g. red-onyxia-7k
@@ -25,11 +27,14 @@ var aliasRaidBosses = [...]string{
 	"thrall", "jaina", "sylvanas", "tyrande", "uther", "medivh", "grom",
 }
 
+// updateFailoverRetriesCount is count of the update transaction retries while redis master is switching.
+const updateFailoverRetriesCount = 5
+
 type gameServerRedisRepo struct {
-	rdb *redis.Client
+	rdb redis.UniversalClient
 }
 
-func NewGameServerRedisRepo(rdb *redis.Client) GameServerRepo {
+func NewGameServerRedisRepo(rdb redis.UniversalClient) GameServerRepo {
 	return &gameServerRedisRepo{rdb: rdb}
 }
 
@@ -64,32 +69,35 @@ func (g *gameServerRedisRepo) Upsert(ctx context.Context, server *GameServer) er
 
 func (g *gameServerRedisRepo) Update(ctx context.Context, id string, f func(*GameServer) *GameServer) error {
 	key := g.key(id)
-	for attempt := 0; attempt < 5; attempt++ {
-		err := g.rdb.Watch(ctx, func(tx *redis.Tx) error {
-			value, err := tx.Get(ctx, key).Bytes()
-			if err != nil {
+	// Transaction is not retried by the client, so the whole optimistic lock loop is retried if redis master is switching.
+	return redisclient.RetryOnFailover(ctx, updateFailoverRetriesCount, func() error {
+		for attempt := 0; attempt < 5; attempt++ {
+			err := g.rdb.Watch(ctx, func(tx *redis.Tx) error {
+				value, err := tx.Get(ctx, key).Bytes()
+				if err != nil {
+					return err
+				}
+				server := &GameServer{}
+				if err := json.Unmarshal(value, server); err != nil {
+					return err
+				}
+				updated := f(server)
+				data, err := json.Marshal(updated)
+				if err != nil {
+					return err
+				}
+				_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+					pipe.Set(ctx, g.key(updated.ID), data, 0)
+					return nil
+				})
 				return err
-			}
-			server := &GameServer{}
-			if err := json.Unmarshal(value, server); err != nil {
-				return err
-			}
-			updated := f(server)
-			data, err := json.Marshal(updated)
-			if err != nil {
+			}, key)
+			if !errors.Is(err, redis.TxFailedErr) {
 				return err
 			}
-			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
-				pipe.Set(ctx, g.key(updated.ID), data, 0)
-				return nil
-			})
-			return err
-		}, key)
-		if !errors.Is(err, redis.TxFailedErr) {
-			return err
 		}
-	}
-	return redis.TxFailedErr
+		return redis.TxFailedErr
+	})
 }
 
 func (g *gameServerRedisRepo) Remove(ctx context.Context, id string) error {
@@ -127,26 +135,9 @@ func (g *gameServerRedisRepo) ListOfCrossRealms(ctx context.Context) ([]GameServ
 }
 
 func (g *gameServerRedisRepo) ListAll(ctx context.Context) ([]GameServer, error) {
-	pattern := "ws:*"
-
-	var cursor uint64
-	var keys []string
-
-	// Use SCAN to find all keys matching the pattern
-	for {
-		// Scan with the current cursor value
-		var newKeys []string
-		var err error
-		newKeys, cursor, err = g.rdb.Scan(ctx, cursor, pattern, 10).Result()
-		if err != nil {
-			return nil, err
-		}
-
-		keys = append(keys, newKeys...)
-
-		if cursor == 0 {
-			break
-		}
+	keys, err := redisclient.ScanKeys(ctx, g.rdb, "ws:*")
+	if err != nil {
+		return nil, err
 	}
 
 	// Retrieve values for all matching keys
@@ -177,12 +168,12 @@ func (g *gameServerRedisRepo) listForRealmOrCrossRealm(ctx context.Context, real
 		return []GameServer{}, nil
 	}
 
-	mGetRes := g.rdb.MGet(ctx, res.Val()...)
-	if mGetRes.Err() != nil {
-		return nil, mGetRes.Err()
+	// Game servers keys are in different cluster slots, so can't use MGET in cluster mode.
+	resInterface, err := redisclient.GetMany(ctx, g.rdb, res.Val()...)
+	if err != nil {
+		return nil, err
 	}
 
-	resInterface := mGetRes.Val()
 	result := make([]GameServer, 0, len(resInterface))
 	for i := range resInterface {
 		if resInterface[i] == nil {
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	redis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
+)
+
+// testRedisClients returns standalone and cluster clients of the separate miniredis servers.
+func testRedisClients(t *testing.T) map[string]redis.UniversalClient {
+	clients := map[string]redis.UniversalClient{}
+	for _, mode := range []string{redisclient.ModeStandalone, redisclient.ModeCluster} {
+		mr := miniredis.RunT(t)
+		rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{Mode: mode, MaxRetries: 1})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = rdb.Close() })
+		clients[mode] = rdb
+	}
+	return clients
+}
+
+func TestGameServerRedisRepo(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			repository := NewGameServerRedisRepo(rdb)
+
+			first := &GameServer{Address: "10.0.0.1:8085", RealmID: 1, AvailableMaps: []uint32{0, 1}}
+			second := &GameServer{Address: "10.0.0.2:8085", RealmID: 1}
+			crossRealm := &GameServer{Address: "10.0.0.3:8085", IsCrossRealm: true}
+			for _, server := range []*GameServer{first, second, crossRealm} {
+				require.NoError(t, repository.Upsert(ctx, server))
+			}
+
+			servers, err := repository.ListByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{first.ID, second.ID}, gameServerIDs(servers))
+
+			servers, err = repository.ListOfCrossRealms(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []string{crossRealm.ID}, gameServerIDs(servers))
+
+			servers, err = repository.ListAll(ctx)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{first.ID, second.ID, crossRealm.ID}, gameServerIDs(servers))
+
+			require.NoError(t, repository.Update(ctx, first.ID, func(server *GameServer) *GameServer {
+				server.AssignedMapsToHandle = []uint32{0}
+				return server
+			}))
+			server, err := repository.One(ctx, first.ID)
+			require.NoError(t, err)
+			require.Equal(t, []uint32{0}, server.AssignedMapsToHandle)
+
+			require.NoError(t, repository.Remove(ctx, second.ID))
+			servers, err = repository.ListByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.Equal(t, []string{first.ID}, gameServerIDs(servers))
+
+			server, err = repository.One(ctx, second.ID)
+			require.NoError(t, err)
+			require.Nil(t, server)
+		})
+	}
+}
+
+func TestGatewayRedisRepo(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			repository := NewGatewayRedisRepo(rdb)
+
+			first, err := repository.Add(ctx, &GatewayServer{HealthCheckAddr: "10.0.0.1:8900", RealmID: 1})
+			require.NoError(t, err)
+			second, err := repository.Add(ctx, &GatewayServer{HealthCheckAddr: "10.0.0.2:8900", RealmID: 1})
+			require.NoError(t, err)
+
+			require.NoError(t, repository.Update(ctx, first.ID, func(server GatewayServer) GatewayServer {
+				server.ActiveConnections = 10
+				return server
+			}))
+
+			gateways, err := repository.ListByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.Len(t, gateways, 2)
+
+			err = repository.Update(ctx, "missing", func(server GatewayServer) GatewayServer { return server })
+			require.ErrorIs(t, err, ErrGatewayNotFound)
+
+			require.NoError(t, repository.Remove(ctx, second.HealthCheckAddr))
+			gateways, err = repository.ListByRealm(ctx, 1)
+			require.NoError(t, err)
+			require.Len(t, gateways, 1)
+			require.Equal(t, 10, gateways[0].ActiveConnections)
+		})
+	}
+}
+
+func gameServerIDs(servers []GameServer) []string {
+	ids := make([]string, len(servers))
+	for i := range servers {
+		ids[i] = servers[i].ID
+	}
+	return ids
+}
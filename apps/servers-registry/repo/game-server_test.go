@@ -19,6 +19,15 @@ func TestGameServerCopyDoesNotShareMapSlices(t *testing.T) {
 	require.Equal(t, []uint32{3}, original.AssignedButPendingMaps)
 }
 
+func TestGameServerCanHandleMap(t *testing.T) {
+	server := GameServer{AssignedMapsToHandle: []uint32{0, 1, 530}, AssignedButPendingMaps: []uint32{1}, ReleasingMaps: []uint32{530}}
+
+	require.True(t, server.CanHandleMap(0))
+	require.False(t, server.CanHandleMap(1), "pending map")
+	require.False(t, server.CanHandleMap(530), "releasing map")
+	require.False(t, server.CanHandleMap(571))
+}
+
 func TestGameServerAliasIsDeterministicAndReadable(t *testing.T) {
 	repository := &gameServerRedisRepo{}
 	first := repository.generateAlias("10.0.0.1:9601")
@@ -41,7 +41,7 @@ func (g *gatewayInMemRepo) Update(ctx context.Context, id string, f func(Gateway
 		}
 	}
 
-	return nil
+	return ErrGatewayNotFound
 }
 
 func (g *gatewayInMemRepo) Remove(ctx context.Context, healthCheckAddress string) error {
@@ -1,6 +1,12 @@
 package repo
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrGatewayNotFound is returned if gateway with the given ID is not registered.
+var ErrGatewayNotFound = errors.New("gateway not found")
 
 type GatewayServer struct {
 	ID                string
@@ -8,6 +14,22 @@ type GatewayServer struct {
 	HealthCheckAddr   string
 	RealmID           uint32
 	ActiveConnections int
+
+	// Capacity is max players count of the gateway, 0 if gateway didn't report it.
+	Capacity uint32
+
+	// Region and Zone are locality labels of the gateway.
+	Region string
+	Zone   string
+
+	// Draining gateway keeps its players, but isn't given to the new ones.
+	Draining bool
+
+	// Sessions is count of the game sessions of the gateway, while draining it's count of the sessions left to hand off.
+	Sessions int
+
+	// MapPlayers is players count of the gateway per game server ID and map ID.
+	MapPlayers map[string]map[uint32]int
 }
 
 func (g *GatewayServer) HealthCheckAddress() string {
@@ -20,6 +42,7 @@ func (g *GatewayServer) MetricsAddress() string {
 
 type GatewayRepo interface {
 	Add(context.Context, *GatewayServer) (*GatewayServer, error)
+	// Update changes gateway with f, returns ErrGatewayNotFound if gateway doesn't exist.
 	Update(ctx context.Context, id string, f func(GatewayServer) GatewayServer) error
 	Remove(ctx context.Context, healthCheckAddress string) error
 	ListByRealm(ctx context.Context, realmID uint32) ([]GatewayServer, error)
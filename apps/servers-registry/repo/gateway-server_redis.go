@@ -11,13 +11,15 @@ import (
 
 	redis "github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
 type gatewayRedisRepo struct {
-	rdb *redis.Client
+	rdb redis.UniversalClient
 }
 
-func NewGatewayRedisRepo(rdb *redis.Client) GatewayRepo {
+func NewGatewayRedisRepo(rdb redis.UniversalClient) GatewayRepo {
 	return &gatewayRedisRepo{rdb: rdb}
 }
 
@@ -45,27 +47,44 @@ func (g *gatewayRedisRepo) Add(ctx context.Context, server *GatewayServer) (*Gat
 	return server, nil
 }
 
+// Update changes gateway with optimistic lock, so metrics updates don't override draining flag and vice versa.
 func (g *gatewayRedisRepo) Update(ctx context.Context, id string, f func(GatewayServer) GatewayServer) error {
-	res := g.rdb.Get(ctx, g.key(id))
-	if res.Err() != nil {
-		return res.Err()
-	}
-
-	v := &GatewayServer{}
-	err := json.Unmarshal([]byte(res.Val()), v)
-	if err != nil {
-		return err
-	}
-
-	newV := f(*v)
-	d, err := json.Marshal(newV)
-	if err != nil {
-		return err
-	}
-
-	key := g.key(newV.ID)
-	status := g.rdb.Set(ctx, key, d, 0)
-	return status.Err()
+	key := g.key(id)
+	// Transaction is not retried by the client, so the whole optimistic lock loop is retried if redis master is switching.
+	return redisclient.RetryOnFailover(ctx, updateFailoverRetriesCount, func() error {
+		for attempt := 0; attempt < 5; attempt++ {
+			err := g.rdb.Watch(ctx, func(tx *redis.Tx) error {
+				value, err := tx.Get(ctx, key).Bytes()
+				if errors.Is(err, redis.Nil) {
+					return ErrGatewayNotFound
+				}
+				if err != nil {
+					return err
+				}
+
+				v := GatewayServer{}
+				if err = json.Unmarshal(value, &v); err != nil {
+					return err
+				}
+
+				newV := f(v)
+				d, err := json.Marshal(newV)
+				if err != nil {
+					return err
+				}
+
+				_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+					pipe.Set(ctx, g.key(newV.ID), d, 0)
+					return nil
+				})
+				return err
+			}, key)
+			if !errors.Is(err, redis.TxFailedErr) {
+				return err
+			}
+		}
+		return redis.TxFailedErr
+	})
 }
 
 func (g *gatewayRedisRepo) Remove(ctx context.Context, healthCheckAddress string) error {
@@ -103,12 +122,11 @@ func (g *gatewayRedisRepo) ListByRealm(ctx context.Context, realmID uint32) ([]G
 		return []GatewayServer{}, nil
 	}
 
-	mGetRes := g.rdb.MGet(ctx, res.Val()...)
-	if mGetRes.Err() != nil {
-		return nil, mGetRes.Err()
+	resInterface, err := redisclient.GetMany(ctx, g.rdb, res.Val()...)
+	if err != nil {
+		return nil, err
 	}
 
-	resInterface := mGetRes.Val()
 	result := make([]GatewayServer, 0, len(resInterface))
 	for i := range resInterface {
 		if resInterface[i] == nil {
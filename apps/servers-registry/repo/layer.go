@@ -12,4 +12,9 @@ type LayerStore interface {
 	SetGroupBinding(ctx context.Context, realmID, groupID, mapID uint32, gameServerID string) error
 	ReplaceGroupBinding(ctx context.Context, realmID, groupID, mapID uint32, previousGameServerID, replacementGameServerID string) (boundGameServerID string, err error)
 	LockRealm(ctx context.Context, realmID uint32) (unlock func(), err error)
+
+	// SetCharacterLayer remembers game server that was selected for the character on the map.
+	SetCharacterLayer(ctx context.Context, realmID uint32, charGUID uint64, mapID uint32, gameServerID string) error
+	// CharacterLayers returns game server IDs of the given characters that were last placed on the map.
+	CharacterLayers(ctx context.Context, realmID, mapID uint32, charGUIDs []uint64) (map[uint64]string, error)
 }
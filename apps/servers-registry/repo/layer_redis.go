@@ -7,16 +7,24 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	redis "github.com/redis/go-redis/v9"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
 )
 
-type layerRedisStore struct{ rdb *redis.Client }
+type layerRedisStore struct{ rdb redis.UniversalClient }
 
 const groupBindingTTL = 24 * time.Hour
 
-func NewLayerRedisStore(rdb *redis.Client) LayerStore { return &layerRedisStore{rdb: rdb} }
+// characterLayerTTL limits lifetime of character placements, characters that are online
+// long enough are placed again on the next map change or relogin.
+const characterLayerTTL = 12 * time.Hour
+
+func NewLayerRedisStore(rdb redis.UniversalClient) LayerStore { return &layerRedisStore{rdb: rdb} }
 
 func (s *layerRedisStore) Configuration(ctx context.Context, realmID uint32) (map[uint32]uint32, error) {
 	value, err := s.rdb.Get(ctx, s.configurationKey(realmID)).Bytes()
@@ -109,6 +117,37 @@ return current or ''`
 	return result, err
 }
 
+func (s *layerRedisStore) SetCharacterLayer(ctx context.Context, realmID uint32, charGUID uint64, mapID uint32, serverID string) error {
+	return s.rdb.Set(ctx, s.characterKey(realmID, charGUID), fmt.Sprintf("%d:%s", mapID, serverID), characterLayerTTL).Err()
+}
+
+func (s *layerRedisStore) CharacterLayers(ctx context.Context, realmID, mapID uint32, charGUIDs []uint64) (map[uint64]string, error) {
+	res := map[uint64]string{}
+	if len(charGUIDs) == 0 {
+		return res, nil
+	}
+
+	keys := make([]string, len(charGUIDs))
+	for i, guid := range charGUIDs {
+		keys[i] = s.characterKey(realmID, guid)
+	}
+
+	values, err := s.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%d:", mapID)
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok || !strings.HasPrefix(str, prefix) {
+			continue
+		}
+		res[charGUIDs[i]] = strings.TrimPrefix(str, prefix)
+	}
+	return res, nil
+}
+
 func (*layerRedisStore) configurationKey(realmID uint32) string {
 	return fmt.Sprintf("layer:config:%d", realmID)
 }
@@ -116,3 +155,8 @@ func (*layerRedisStore) configurationKey(realmID uint32) string {
 func (*layerRedisStore) groupKey(realmID, groupID, mapID uint32) string {
 	return fmt.Sprintf("layer:group:%d:%d:%d", realmID, groupID, mapID)
 }
+
+// characterKey has realm hash tag, so characters of the realm are in the same cluster slot and can be fetched with MGET.
+func (*layerRedisStore) characterKey(realmID uint32, charGUID uint64) string {
+	return fmt.Sprintf("layer:char:%s:%d", redisclient.HashTag(strconv.FormatUint(uint64(realmID), 10)), charGUID)
+}
@@ -0,0 +1,60 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLayerRedisStoreCharacterLayers(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			store := NewLayerRedisStore(rdb)
+
+			require.NoError(t, store.SetCharacterLayer(ctx, 1, 10, 0, "first"))
+			require.NoError(t, store.SetCharacterLayer(ctx, 1, 11, 0, "second"))
+			require.NoError(t, store.SetCharacterLayer(ctx, 1, 12, 1, "first"))
+			require.NoError(t, store.SetCharacterLayer(ctx, 2, 13, 0, "first"))
+
+			layers, err := store.CharacterLayers(ctx, 1, 0, []uint64{10, 11, 12, 13, 14})
+			require.NoError(t, err)
+			require.Equal(t, map[uint64]string{10: "first", 11: "second"}, layers)
+		})
+	}
+}
+
+func TestLayerRedisStoreGroupBindings(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			store := NewLayerRedisStore(rdb)
+
+			unlock, err := store.LockRealm(ctx, 1)
+			require.NoError(t, err)
+			defer unlock()
+
+			bound, err := store.BindGroup(ctx, 1, 5, 0, "first")
+			require.NoError(t, err)
+			require.Equal(t, "first", bound)
+
+			bound, err = store.BindGroup(ctx, 1, 5, 0, "second")
+			require.NoError(t, err)
+			require.Equal(t, "first", bound, "existing binding is kept")
+
+			bound, err = store.ReplaceGroupBinding(ctx, 1, 5, 0, "stale", "second")
+			require.NoError(t, err)
+			require.Equal(t, "first", bound, "binding is replaced only if it's stale")
+
+			bound, err = store.ReplaceGroupBinding(ctx, 1, 5, 0, "first", "second")
+			require.NoError(t, err)
+			require.Equal(t, "second", bound)
+		})
+	}
+}
+
+func TestLayerCharacterKeysOfRealmShareHashTag(t *testing.T) {
+	store := &layerRedisStore{}
+	require.Equal(t, "layer:char:{1}:10", store.characterKey(1, 10))
+}
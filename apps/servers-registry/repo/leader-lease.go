@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// LeaderLease is the lease of leadership between servers-registry replicas.
+type LeaderLease interface {
+	// Acquire takes the lease for the holder if it's free or extends it if the holder already has it.
+	// Returns false if the lease is held by another holder.
+	Acquire(ctx context.Context, holderID string, ttl time.Duration) (bool, error)
+
+	// Release frees the lease if it's held by the holder, so another replica can take it without waiting for ttl.
+	Release(ctx context.Context, holderID string) error
+
+	// Holder returns ID of the current lease holder, empty if the lease is free.
+	Holder(ctx context.Context) (string, error)
+}
@@ -0,0 +1,59 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const leaderLeaseKey = "servers-registry:leader"
+
+type leaderLeaseRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLeaderLeaseRedis(rdb redis.UniversalClient) LeaderLease {
+	return &leaderLeaseRedis{rdb: rdb}
+}
+
+// acquireLeaseScript extends the lease of the holder or takes the free lease.
+var acquireLeaseScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current == ARGV[1] then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  return 1
+end
+if current then
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1`)
+
+// releaseLeaseScript deletes the lease only if it's held by the holder.
+var releaseLeaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0`)
+
+func (l *leaderLeaseRedis) Acquire(ctx context.Context, holderID string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireLeaseScript.Run(ctx, l.rdb, []string{leaderLeaseKey}, holderID, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return acquired == 1, nil
+}
+
+func (l *leaderLeaseRedis) Release(ctx context.Context, holderID string) error {
+	return releaseLeaseScript.Run(ctx, l.rdb, []string{leaderLeaseKey}, holderID).Err()
+}
+
+func (l *leaderLeaseRedis) Holder(ctx context.Context) (string, error) {
+	holder, err := l.rdb.Get(ctx, leaderLeaseKey).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return holder, err
+}
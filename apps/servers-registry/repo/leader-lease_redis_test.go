@@ -0,0 +1,63 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/shared/redisclient"
+)
+
+func TestLeaderLeaseRedis(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			lease := NewLeaderLeaseRedis(rdb)
+
+			acquired, err := lease.Acquire(ctx, "first", time.Minute)
+			require.NoError(t, err)
+			require.True(t, acquired)
+
+			acquired, err = lease.Acquire(ctx, "second", time.Minute)
+			require.NoError(t, err)
+			require.False(t, acquired, "lease is held by the first replica")
+
+			acquired, err = lease.Acquire(ctx, "first", time.Minute)
+			require.NoError(t, err)
+			require.True(t, acquired, "holder extends own lease")
+
+			require.NoError(t, lease.Release(ctx, "second"))
+			holder, err := lease.Holder(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "first", holder, "only holder releases the lease")
+
+			require.NoError(t, lease.Release(ctx, "first"))
+			acquired, err = lease.Acquire(ctx, "second", time.Minute)
+			require.NoError(t, err)
+			require.True(t, acquired)
+		})
+	}
+}
+
+func TestLeaderLeaseRedisExpires(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb, err := redisclient.NewClient("redis://"+mr.Addr()+"/0", redisclient.Config{})
+	require.NoError(t, err)
+	defer rdb.Close()
+
+	ctx := context.Background()
+	lease := NewLeaderLeaseRedis(rdb)
+
+	acquired, err := lease.Acquire(ctx, "first", time.Second)
+	require.NoError(t, err)
+	require.True(t, acquired)
+
+	mr.FastForward(2 * time.Second)
+
+	acquired, err = lease.Acquire(ctx, "second", time.Second)
+	require.NoError(t, err)
+	require.True(t, acquired, "lease of dead leader is taken after ttl")
+}
@@ -0,0 +1,69 @@
+package repo
+
+import (
+	"context"
+	"time"
+)
+
+// Statuses of MapMigration.
+const (
+	MapMigrationStatusRunning = "running"
+	MapMigrationStatusDone    = "done"
+
+	// MapMigrationStatusFailed means that some of the steps were rolled back.
+	MapMigrationStatusFailed = "failed"
+)
+
+// Statuses of MapMigrationStep.
+const (
+	MapMigrationStepPending = "pending"
+
+	// MapMigrationStepLoading means that destination server loads the map.
+	MapMigrationStepLoading = "loading"
+
+	// MapMigrationStepRedirecting means that gateways redirect players of the map to the destination server.
+	MapMigrationStepRedirecting = "redirecting"
+
+	MapMigrationStepDone = "done"
+
+	// MapMigrationStepSkipped means that assignments changed before the step started, so there was nothing to move.
+	MapMigrationStepSkipped = "skipped"
+
+	// MapMigrationStepRolledBack means that map stayed on the source server.
+	MapMigrationStepRolledBack = "rolledBack"
+)
+
+// MapMigration is a list of maps moves between game servers of the realm that are done one by one.
+type MapMigration struct {
+	ID string
+
+	// If it's cross-realm then RealmID should be 0.
+	RealmID      uint32
+	IsCrossRealm bool
+
+	Status string
+	Steps  []MapMigrationStep
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// MapMigrationStep is move of one map from one game server to another.
+type MapMigrationStep struct {
+	MapID        uint32
+	FromServerID string
+	ToServerID   string
+
+	Status string
+
+	// PlayersLeft is players count on the map of the source server at the last check.
+	PlayersLeft uint32
+
+	// Error is reason of the rollback, or of the roll forward if the step failed while redirecting players.
+	Error string
+}
+
+type MapMigrationRepo interface {
+	Save(ctx context.Context, migration *MapMigration) error
+	ListByRealm(ctx context.Context, realmID uint32, isCrossRealm bool) ([]MapMigration, error)
+}
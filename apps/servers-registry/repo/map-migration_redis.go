@@ -0,0 +1,66 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+// mapMigrationTTL is how long migrations of the realm are kept after the last change.
+const mapMigrationTTL = 24 * time.Hour
+
+type mapMigrationRedisRepo struct {
+	rdb redis.UniversalClient
+}
+
+func NewMapMigrationRedisRepo(rdb redis.UniversalClient) MapMigrationRepo {
+	return &mapMigrationRedisRepo{rdb: rdb}
+}
+
+func (r *mapMigrationRedisRepo) Save(ctx context.Context, migration *MapMigration) error {
+	data, err := json.Marshal(migration)
+	if err != nil {
+		return err
+	}
+
+	key := r.key(migration.RealmID, migration.IsCrossRealm)
+	_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, key, migration.ID, data)
+		pipe.Expire(ctx, key, mapMigrationTTL)
+		return nil
+	})
+	return err
+}
+
+func (r *mapMigrationRedisRepo) ListByRealm(ctx context.Context, realmID uint32, isCrossRealm bool) ([]MapMigration, error) {
+	values, err := r.rdb.HVals(ctx, r.key(realmID, isCrossRealm)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]MapMigration, 0, len(values))
+	for _, value := range values {
+		migration := MapMigration{}
+		if err = json.Unmarshal([]byte(value), &migration); err != nil {
+			return nil, err
+		}
+		res = append(res, migration)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+
+	return res, nil
+}
+
+func (r *mapMigrationRedisRepo) key(realmID uint32, isCrossRealm bool) string {
+	if isCrossRealm {
+		return "crossrealm:migrations"
+	}
+	return fmt.Sprintf("realm:%d:migrations", realmID)
+}
@@ -0,0 +1,12 @@
+package repo
+
+import "context"
+
+// MapWeightsRepo shares learned maps weights of the adaptive balancer between servers-registry replicas.
+type MapWeightsRepo interface {
+	// LearnedWeights returns learned weights per map ID, empty if nothing was learned yet.
+	LearnedWeights(ctx context.Context) (map[uint32]float64, error)
+
+	// SetLearnedWeights replaces learned weights.
+	SetLearnedWeights(ctx context.Context, weights map[uint32]float64) error
+}
@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+const learnedMapWeightsKey = "mapbalancing:learned-weights"
+
+type mapWeightsRedisRepo struct {
+	rdb redis.UniversalClient
+}
+
+func NewMapWeightsRedisRepo(rdb redis.UniversalClient) MapWeightsRepo {
+	return &mapWeightsRedisRepo{rdb: rdb}
+}
+
+func (r *mapWeightsRedisRepo) LearnedWeights(ctx context.Context) (map[uint32]float64, error) {
+	value, err := r.rdb.Get(ctx, learnedMapWeightsKey).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return map[uint32]float64{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	weights := map[uint32]float64{}
+	if err = json.Unmarshal(value, &weights); err != nil {
+		return nil, err
+	}
+	return weights, nil
+}
+
+func (r *mapWeightsRedisRepo) SetLearnedWeights(ctx context.Context, weights map[uint32]float64) error {
+	value, err := json.Marshal(weights)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, learnedMapWeightsKey, value, 0).Err()
+}
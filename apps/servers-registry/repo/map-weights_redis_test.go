@@ -0,0 +1,28 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapWeightsRedisRepo(t *testing.T) {
+	for mode, rdb := range testRedisClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			weightsRepo := NewMapWeightsRedisRepo(rdb)
+
+			weights, err := weightsRepo.LearnedWeights(ctx)
+			require.NoError(t, err)
+			require.Empty(t, weights)
+
+			require.NoError(t, weightsRepo.SetLearnedWeights(ctx, map[uint32]float64{0: 12.5, 571: 40}))
+
+			// Another replica reads the same weights.
+			weights, err = NewMapWeightsRedisRepo(rdb).LearnedWeights(ctx)
+			require.NoError(t, err)
+			require.Equal(t, map[uint32]float64{0: 12.5, 571: 40}, weights)
+		})
+	}
+}
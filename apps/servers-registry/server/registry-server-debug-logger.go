@@ -42,6 +42,18 @@ func (s *serversRegistryDebugLoggerMiddleware) GetLayerStats(ctx context.Context
 	return s.realService.GetLayerStats(ctx, request)
 }
 
+func (s *serversRegistryDebugLoggerMiddleware) GetMapRegions(ctx context.Context, request *pb.GetMapRegionsRequest) (*pb.GetMapRegionsResponse, error) {
+	return s.realService.GetMapRegions(ctx, request)
+}
+
+func (s *serversRegistryDebugLoggerMiddleware) ListMapMigrations(ctx context.Context, request *pb.ListMapMigrationsRequest) (*pb.ListMapMigrationsResponse, error) {
+	return s.realService.ListMapMigrations(ctx, request)
+}
+
+func (s *serversRegistryDebugLoggerMiddleware) PlanMapsRebalance(ctx context.Context, request *pb.PlanMapsRebalanceRequest) (*pb.PlanMapsRebalanceResponse, error) {
+	return s.realService.PlanMapsRebalance(ctx, request)
+}
+
 func (s *serversRegistryDebugLoggerMiddleware) AvailableGameServersForMapAndRealm(ctx context.Context, request *pb.AvailableGameServersForMapAndRealmRequest) (resp *pb.AvailableGameServersForMapAndRealmResponse, err error) {
 	defer func(t time.Time) {
 		event := s.logger.Debug().
@@ -131,3 +143,17 @@ func (s *serversRegistryDebugLoggerMiddleware) ListGatewaysForRealm(ctx context.
 	resp, err = s.realService.ListGatewaysForRealm(ctx, request)
 	return
 }
+
+func (s *serversRegistryDebugLoggerMiddleware) SetGatewayDraining(ctx context.Context, request *pb.SetGatewayDrainingRequest) (resp *pb.SetGatewayDrainingResponse, err error) {
+	defer func(t time.Time) {
+		s.logger.Debug().
+			Str("timeTook", time.Since(t).String()).
+			Str("id", request.Id).
+			Bool("draining", request.Draining).
+			Err(err).
+			Msg("Handled set gateway draining")
+	}(time.Now())
+
+	resp, err = s.realService.SetGatewayDraining(ctx, request)
+	return
+}
@@ -2,13 +2,17 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
 	"github.com/walkline/ToCloud9/apps/servers-registry/service"
@@ -22,13 +26,24 @@ type serversRegistryService struct {
 	gService  service.GameServer
 	lbService service.Gateway
 	lService  service.Layer
+
+	// rebalancer is nil if adaptive map balancing is disabled.
+	rebalancer *service.MapRebalancer
+
+	// migrator is nil if maps migration is disabled.
+	migrator *service.MapMigrator
 }
 
-func NewServersRegistry(gService service.GameServer, lbService service.Gateway, lService service.Layer) pb.ServersRegistryServiceServer {
+func NewServersRegistry(
+	gService service.GameServer, lbService service.Gateway, lService service.Layer,
+	rebalancer *service.MapRebalancer, migrator *service.MapMigrator,
+) pb.ServersRegistryServiceServer {
 	return &serversRegistryService{
-		gService:  gService,
-		lbService: lbService,
-		lService:  lService,
+		gService:   gService,
+		lbService:  lbService,
+		lService:   lService,
+		rebalancer: rebalancer,
+		migrator:   migrator,
 	}
 }
 
@@ -61,12 +76,32 @@ func (s *serversRegistryService) RegisterGameServer(ctx context.Context, request
 		Id:           gameServer.ID,
 		AssignedMaps: gameServer.AssignedMapsToHandle,
 		Alias:        gameServer.Alias,
+		Regions:      toPBRegions(gameServer.Regions),
 	}, nil
 }
 
 func (s *serversRegistryService) AvailableGameServersForMapAndRealm(ctx context.Context, request *pb.AvailableGameServersForMapAndRealmRequest) (*pb.AvailableGameServersForMapAndRealmResponse, error) {
+	if request.PositionX != nil && request.PreferredGameServerAlias == "" {
+		regions, err := s.gService.MapRegions(ctx, request.MapID, request.RealmID, request.IsCrossRealm)
+		if err != nil {
+			return nil, err
+		}
+
+		if server := service.ServerForPosition(regions, request.GetPositionX()); server != nil {
+			return &pb.AvailableGameServersForMapAndRealmResponse{
+				Api: ver,
+				GameServers: []*pb.Server{{
+					ID: server.ID, Address: server.Address, RealmID: server.RealmID, IsCrossRealm: server.IsCrossRealm,
+					GrpcAddress: server.GRPCAddress, Alias: server.Alias,
+				}},
+			}, nil
+		}
+	}
+
 	if !request.IsCrossRealm {
-		selection, err := s.lService.Select(ctx, request.RealmID, request.MapID, request.GroupID, request.PreferredGameServerAlias)
+		selection, err := s.lService.Select(ctx, request.RealmID, request.MapID, request.GroupID, request.PreferredGameServerAlias, service.LayerCharacter{
+			GUID: request.CharacterGUID, GuildID: request.GuildID,
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -224,6 +259,10 @@ func (s *serversRegistryService) RegisterGateway(ctx context.Context, request *p
 		Address:         fmt.Sprintf("%s:%d", request.PreferredHostName, request.GamePort),
 		HealthCheckAddr: fmt.Sprintf("%s:%d", ip, request.HealthPort),
 		RealmID:         request.RealmID,
+		Capacity:        request.Capacity,
+		Region:          request.Region,
+		Zone:            request.Zone,
+		Draining:        request.Draining,
 	}
 
 	server, err := s.lbService.Register(ctx, lbServer)
@@ -240,8 +279,11 @@ func (s *serversRegistryService) RegisterGateway(ctx context.Context, request *p
 func (s *serversRegistryService) GatewaysForRealms(ctx context.Context, request *pb.GatewaysForRealmsRequest) (*pb.GatewaysForRealmsResponse, error) {
 	servers := make([]*pb.Server, 0, len(request.RealmIDs))
 
+	// Nil IP of the unknown client selects gateway without locality.
+	clientIP := net.ParseIP(request.ClientIP)
+
 	for _, realmID := range request.RealmIDs {
-		server, err := s.lbService.GatewayForRealm(ctx, realmID)
+		server, err := s.lbService.GatewayForRealm(ctx, realmID, clientIP)
 		if err != nil {
 			return nil, err
 		}
@@ -275,6 +317,10 @@ func (s *serversRegistryService) ListGatewaysForRealm(ctx context.Context, reque
 			HealthAddress:     servers[i].HealthCheckAddr,
 			RealmID:           servers[i].RealmID,
 			ActiveConnections: uint32(servers[i].ActiveConnections),
+			Capacity:          servers[i].Capacity,
+			Region:            servers[i].Region,
+			Zone:              servers[i].Zone,
+			Draining:          servers[i].Draining,
 		}
 	}
 
@@ -284,6 +330,23 @@ func (s *serversRegistryService) ListGatewaysForRealm(ctx context.Context, reque
 	}, nil
 }
 
+func (s *serversRegistryService) SetGatewayDraining(ctx context.Context, request *pb.SetGatewayDrainingRequest) (*pb.SetGatewayDrainingResponse, error) {
+	err := s.lbService.SetDraining(ctx, request.Id, request.Draining)
+	if errors.Is(err, repo.ErrGatewayNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, service.ErrGatewayDrainingIrreversible) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetGatewayDrainingResponse{
+		Api: ver,
+	}, nil
+}
+
 func (s *serversRegistryService) BindGroupToGameServer(ctx context.Context, request *pb.BindGroupToGameServerRequest) (*pb.BindGroupToGameServerResponse, error) {
 	if err := s.lService.BindGroup(ctx, request.RealmID, request.GroupID, request.MapID, request.GameServerID); err != nil {
 		return nil, err
@@ -331,6 +394,95 @@ func (s *serversRegistryService) GetLayerStats(ctx context.Context, request *pb.
 	return response, nil
 }
 
+func (s *serversRegistryService) PlanMapsRebalance(ctx context.Context, request *pb.PlanMapsRebalanceRequest) (*pb.PlanMapsRebalanceResponse, error) {
+	if s.rebalancer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "adaptive map balancing is disabled")
+	}
+
+	moves, err := s.rebalancer.Plan(ctx, request.RealmID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.PlanMapsRebalanceResponse{Api: ver, Moves: make([]*pb.PlanMapsRebalanceResponse_MapMove, 0, len(moves))}
+	for _, move := range moves {
+		response.Moves = append(response.Moves, &pb.PlanMapsRebalanceResponse_MapMove{
+			MapID:            move.MapID,
+			FromGameServerID: move.FromServerID,
+			ToGameServerID:   move.ToServerID,
+			Weight:           move.Weight,
+		})
+	}
+	return response, nil
+}
+
+func (s *serversRegistryService) ListMapMigrations(ctx context.Context, request *pb.ListMapMigrationsRequest) (*pb.ListMapMigrationsResponse, error) {
+	if s.migrator == nil {
+		return nil, status.Error(codes.FailedPrecondition, "maps migration is disabled")
+	}
+
+	migrations, err := s.migrator.Migrations(ctx, request.RealmID, request.IsCrossRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.ListMapMigrationsResponse{Api: ver, Migrations: make([]*pb.MapMigration, 0, len(migrations))}
+	for _, migration := range migrations {
+		pbMigration := &pb.MapMigration{
+			Id:        migration.ID,
+			Status:    migration.Status,
+			CreatedAt: migration.CreatedAt.Unix(),
+			UpdatedAt: migration.UpdatedAt.Unix(),
+			Steps:     make([]*pb.MapMigration_Step, 0, len(migration.Steps)),
+		}
+		for _, step := range migration.Steps {
+			pbMigration.Steps = append(pbMigration.Steps, &pb.MapMigration_Step{
+				MapID:            step.MapID,
+				FromGameServerID: step.FromServerID,
+				ToGameServerID:   step.ToServerID,
+				Status:           step.Status,
+				PlayersLeft:      step.PlayersLeft,
+				Error:            step.Error,
+			})
+		}
+		response.Migrations = append(response.Migrations, pbMigration)
+	}
+	return response, nil
+}
+
+func (s *serversRegistryService) GetMapRegions(ctx context.Context, request *pb.GetMapRegionsRequest) (*pb.GetMapRegionsResponse, error) {
+	regions, err := s.gService.MapRegions(ctx, request.MapID, request.RealmID, request.IsCrossRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetMapRegionsResponse{Api: ver, Regions: make([]*pb.MapRegion, 0, len(regions))}
+	for _, region := range regions {
+		response.Regions = append(response.Regions, &pb.MapRegion{
+			MapID:    region.Region.MapID,
+			GridXMin: region.Region.GridXMin,
+			GridXMax: region.Region.GridXMax,
+			GameServer: &pb.Server{
+				ID:           region.Server.ID,
+				Address:      region.Server.Address,
+				RealmID:      region.Server.RealmID,
+				IsCrossRealm: region.Server.IsCrossRealm,
+				GrpcAddress:  region.Server.GRPCAddress,
+				Alias:        region.Server.Alias,
+			},
+		})
+	}
+	return response, nil
+}
+
+func toPBRegions(regions []repo.MapRegion) []*pb.MapRegion {
+	res := make([]*pb.MapRegion, 0, len(regions))
+	for _, region := range regions {
+		res = append(res, &pb.MapRegion{MapID: region.MapID, GridXMin: region.GridXMin, GridXMax: region.GridXMax})
+	}
+	return res
+}
+
 func removePortFromAddress(address string) string {
 	for i := len(address) - 1; i >= 0; i-- {
 		if address[i] == ':' {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"time"
 
 	"github.com/rs/zerolog/log"
 
@@ -23,6 +24,18 @@ type GameServer interface {
 	ListAll(ctx context.Context) ([]repo.GameServer, error)
 	MapsLoadedForServer(ctx context.Context, serverID string, maps []uint32) (*repo.GameServer, error)
 	RedistributeRealm(ctx context.Context, realmID uint32) error
+
+	// MoveMaps applies maps moves of the realm and returns moves that were applied.
+	// Move is skipped if source server doesn't handle the map anymore or destination can't handle it.
+	// If maps migration is enabled, applied moves are staged and executed by the migration.
+	MoveMaps(ctx context.Context, realmID uint32, moves []mapbalancing.MapMove) ([]mapbalancing.MapMove, error)
+
+	// MapRegions returns regions of the partitioned map with ready game servers ordered by grids.
+	MapRegions(ctx context.Context, mapID uint32, realmID uint32, isCrossRealm bool) ([]MapRegionServer, error)
+
+	// MonitorServers health checks and reads metrics of the registered game servers until ctx is done.
+	// Should be run only by the leader replica.
+	MonitorServers(ctx context.Context)
 }
 
 func (g *gameServerImpl) RedistributeRealm(ctx context.Context, realmID uint32) error {
@@ -41,6 +54,68 @@ func (g *gameServerImpl) RedistributeRealm(ctx context.Context, realmID uint32)
 	return err
 }
 
+func (g *gameServerImpl) MoveMaps(ctx context.Context, realmID uint32, moves []mapbalancing.MapMove) ([]mapbalancing.MapMove, error) {
+	if g.layers != nil {
+		unlock, err := g.layers.LockRealm(ctx, realmID)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	servers, err := g.ListForRealm(ctx, realmID)
+	if err != nil {
+		return nil, err
+	}
+
+	serversBefore := copyServers(servers)
+
+	index := make(map[string]int, len(servers))
+	for i := range servers {
+		index[servers[i].ID] = i
+	}
+
+	applied := []mapbalancing.MapMove{}
+	for _, move := range moves {
+		from, fromFound := index[move.FromServerID]
+		to, toFound := index[move.ToServerID]
+		if !fromFound || !toFound || from == to {
+			continue
+		}
+
+		if !containsMap(servers[from].AssignedMapsToHandle, move.MapID) ||
+			containsMap(servers[to].AssignedMapsToHandle, move.MapID) ||
+			!mapAvailable(servers[to], move.MapID) {
+			continue
+		}
+
+		servers[from].AssignedMapsToHandle = removeMap(servers[from].AssignedMapsToHandle, move.MapID)
+		servers[to].AssignedMapsToHandle = append(servers[to].AssignedMapsToHandle, move.MapID)
+		sort.Slice(servers[to].AssignedMapsToHandle, func(i, j int) bool {
+			return servers[to].AssignedMapsToHandle[i] < servers[to].AssignedMapsToHandle[j]
+		})
+		applied = append(applied, move)
+	}
+
+	if len(applied) == 0 {
+		return applied, nil
+	}
+
+	if _, err = g.publishAssignments(ctx, serversBefore, servers); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+func copyServers(servers []repo.GameServer) []repo.GameServer {
+	res := make([]repo.GameServer, len(servers))
+	for i, server := range servers {
+		res[i] = server.Copy()
+	}
+	return res
+}
+
 func applyLayerAssignments(servers []repo.GameServer, config map[uint32]uint32) {
 	for mapID, count := range config {
 		if count < 2 {
@@ -143,8 +218,20 @@ type gameServerImpl struct {
 	mapBalancer mapbalancing.MapDistributor
 	eProducer   events.ServerRegistryProducer
 	layers      repo.LayerStore
+
+	// migrator stages maps moves between running servers, if nil maps are moved at once.
+	migrator *MapMigrator
+
+	// partitionedMaps is regions count per partitioned map.
+	partitionedMaps map[uint32]uint32
+
+	realmIDs []uint32
+	monitor  *serversMonitor
 }
 
+// awaitingDistributionInterval is interval of checking for registered servers that need maps.
+const awaitingDistributionInterval = time.Second
+
 func NewGameServer(
 	ctx context.Context,
 	r repo.GameServerRepo,
@@ -153,15 +240,20 @@ func NewGameServer(
 	mapBalancer mapbalancing.MapDistributor,
 	eProducer events.ServerRegistryProducer,
 	layers repo.LayerStore,
+	migrator *MapMigrator,
+	partitionedMaps map[uint32]uint32,
 	supportedRealmIDs []uint32,
 ) (GameServer, error) {
 	service := &gameServerImpl{
-		r:           r,
-		checker:     checker,
-		metrics:     metrics,
-		mapBalancer: mapBalancer,
-		eProducer:   eProducer,
-		layers:      layers,
+		r:               r,
+		checker:         checker,
+		metrics:         metrics,
+		mapBalancer:     mapBalancer,
+		eProducer:       eProducer,
+		layers:          layers,
+		migrator:        migrator,
+		partitionedMaps: partitionedMaps,
+		realmIDs:        supportedRealmIDs,
 	}
 
 	checker.AddFailedObserver(func(object healthandmetrics.HealthCheckObject, err error) {
@@ -176,83 +268,52 @@ func NewGameServer(
 		}
 	})
 
-	for _, id := range supportedRealmIDs {
-		servers, err := r.ListByRealm(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-
-		for i := range servers {
-			if err = checker.AddHealthCheckObject(&servers[i]); err != nil {
-				return nil, err
-			}
-
-			err = metrics.AddMetricsObservable(&servers[i])
+	service.monitor = newServersMonitor(checker, metrics, func(ctx context.Context) ([]monitoredServer, error) {
+		var servers []repo.GameServer
+		for _, id := range supportedRealmIDs {
+			realmServers, err := r.ListByRealm(ctx, id)
 			if err != nil {
 				return nil, err
 			}
+			servers = append(servers, realmServers...)
 		}
-	}
 
-	servers, err := r.ListOfCrossRealms(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	for i := range servers {
-		if err = checker.AddHealthCheckObject(&servers[i]); err != nil {
+		crossRealmServers, err := r.ListOfCrossRealms(ctx)
+		if err != nil {
 			return nil, err
 		}
+		servers = append(servers, crossRealmServers...)
 
-		err = metrics.AddMetricsObservable(&servers[i])
-		if err != nil {
-			return nil, err
+		res := make([]monitoredServer, len(servers))
+		for i := range servers {
+			res[i] = &servers[i]
 		}
-	}
+		return res, nil
+	})
 
 	return service, nil
 }
 
+// Register stores the server, maps are distributed to it by the leader replica, see distributeAwaitingServers.
 func (g *gameServerImpl) Register(ctx context.Context, server *repo.GameServer) error {
 	sort.Slice(server.AvailableMaps, func(i, j int) bool {
 		return server.AvailableMaps[i] <= server.AvailableMaps[j]
 	})
+	server.AwaitingDistribution = true
 
-	if err := g.checker.AddHealthCheckObject(server); err != nil {
-		return err
-	}
-
-	if err := g.metrics.AddMetricsObservable(server); err != nil {
+	if err := g.monitor.Add(server); err != nil {
 		return err
 	}
 
-	if err := g.r.Upsert(ctx, server); err != nil {
-		return err
-	}
-
-	var wsList []repo.GameServer
-	var err error
-
-	if server.IsCrossRealm {
-		wsList, err = g.ListOfCrossRealms(ctx)
-	} else {
-		wsList, err = g.ListForRealm(ctx, server.RealmID)
-	}
-
+	// Leader can distribute maps of the realm at the same time.
+	unlock, err := g.lockRealm(ctx, server.RealmID, server.IsCrossRealm)
 	if err != nil {
 		return err
 	}
+	defer unlock()
 
-	res, err := g.distributeMapsToServers(ctx, wsList)
-	if err != nil {
-		return fmt.Errorf("failed to register game server during maps ditribution, err: %w", err)
-	}
-
-	for _, gameServer := range res {
-		if gameServer.ID == server.ID {
-			server.AssignedMapsToHandle = gameServer.AssignedMapsToHandle
-			break
-		}
+	if err = g.r.Upsert(ctx, server); err != nil {
+		return err
 	}
 
 	err = g.eProducer.GSAdded(&events.ServerRegistryEventGSAddedPayload{
@@ -264,6 +325,8 @@ func (g *gameServerImpl) Register(ctx context.Context, server *repo.GameServer)
 			AvailableMaps:           server.AvailableMaps,
 			OldAssignedMapsToHandle: []uint32{},
 			NewAssignedMapsToHandle: server.AssignedMapsToHandle,
+			OldRegions:              []events.MapRegion{},
+			NewRegions:              toEventRegions(server.Regions),
 		},
 	})
 	if err != nil {
@@ -273,6 +336,90 @@ func (g *gameServerImpl) Register(ctx context.Context, server *repo.GameServer)
 	return nil
 }
 
+func (g *gameServerImpl) MonitorServers(ctx context.Context) {
+	go g.distributeAwaitingServers(ctx)
+	g.monitor.Run(ctx)
+}
+
+// distributeAwaitingServers distributes maps of the realms with registered servers that didn't get maps yet until ctx is done.
+func (g *gameServerImpl) distributeAwaitingServers(ctx context.Context) {
+	ticker := time.NewTicker(awaitingDistributionInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, realmID := range g.realmIDs {
+			if err := g.distributeIfAwaiting(ctx, realmID, false); err != nil {
+				log.Error().Err(err).Uint32("realmID", realmID).Msg("can't distribute maps to registered servers")
+			}
+		}
+
+		if err := g.distributeIfAwaiting(ctx, crossRealmLockID, true); err != nil {
+			log.Error().Err(err).Msg("can't distribute maps to registered cross realm servers")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// distributeIfAwaiting distributes maps of the realm if some of its servers await distribution.
+func (g *gameServerImpl) distributeIfAwaiting(ctx context.Context, realmID uint32, isCrossRealm bool) error {
+	list := func() ([]repo.GameServer, error) {
+		if isCrossRealm {
+			return g.ListOfCrossRealms(ctx)
+		}
+		return g.ListForRealm(ctx, realmID)
+	}
+
+	// Checks without the lock first, most of the time there is nothing to distribute.
+	servers, err := list()
+	if err != nil || !hasAwaitingDistribution(servers) {
+		return err
+	}
+
+	unlock, err := g.lockRealm(ctx, realmID, isCrossRealm)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	servers, err = list()
+	if err != nil || !hasAwaitingDistribution(servers) {
+		return err
+	}
+
+	_, err = g.distributeMapsToServers(ctx, servers)
+	return err
+}
+
+func hasAwaitingDistribution(servers []repo.GameServer) bool {
+	for _, server := range servers {
+		if server.AwaitingDistribution {
+			return true
+		}
+	}
+	return false
+}
+
+// crossRealmLockID is realm ID of the lock of cross realm servers assignments, IDs of the real realms start from 1.
+const crossRealmLockID = 0
+
+// lockRealm locks assignments of the realm servers or of all cross realm servers.
+// Without shared layers store there is only one replica and nothing to lock.
+func (g *gameServerImpl) lockRealm(ctx context.Context, realmID uint32, isCrossRealm bool) (func(), error) {
+	if g.layers == nil {
+		return func() {}, nil
+	}
+
+	if isCrossRealm {
+		realmID = crossRealmLockID
+	}
+	return g.layers.LockRealm(ctx, realmID)
+}
+
 func (g *gameServerImpl) AvailableForMapAndRealm(ctx context.Context, mapID uint32, realmID uint32, isCrossRealm bool) ([]repo.GameServer, error) {
 	var (
 		servers []repo.GameServer
@@ -399,8 +546,14 @@ func (g *gameServerImpl) onServerUnhealthy(server *repo.GameServer, err error) {
 		log.Error().Err(err).Msg("can't remove gameserver from metrics consumer")
 	}
 
-	var wsList []repo.GameServer
+	unlock, err := g.lockRealm(context.Background(), server.RealmID, server.IsCrossRealm)
+	if err != nil {
+		log.Error().Err(err).Msg("can't lock realm")
+		return
+	}
+	defer unlock()
 
+	var wsList []repo.GameServer
 	if server.IsCrossRealm {
 		wsList, err = g.ListOfCrossRealms(context.Background())
 	} else {
@@ -420,12 +573,13 @@ func (g *gameServerImpl) onServerUnhealthy(server *repo.GameServer, err error) {
 }
 
 func (g *gameServerImpl) distributeMapsToServers(ctx context.Context, servers []repo.GameServer) ([]repo.GameServer, error) {
-	serversBefore := make([]repo.GameServer, len(servers))
-	for i, server := range servers {
-		serversBefore[i] = server.Copy()
-	}
+	serversBefore := copyServers(servers)
+	return g.publishAssignments(ctx, serversBefore, g.mapBalancer.Distribute(servers))
+}
 
-	distributed := g.mapBalancer.Distribute(servers)
+// publishAssignments applies layers configuration to the distributed servers, stores new assignments
+// and notifies about reassigned maps.
+func (g *gameServerImpl) publishAssignments(ctx context.Context, serversBefore, distributed []repo.GameServer) ([]repo.GameServer, error) {
 	if len(distributed) > 0 && !distributed[0].IsCrossRealm && g.layers != nil {
 		config, err := g.layers.Configuration(ctx, distributed[0].RealmID)
 		if err != nil {
@@ -434,6 +588,15 @@ func (g *gameServerImpl) distributeMapsToServers(ctx context.Context, servers []
 		applyLayerAssignments(distributed, config)
 	}
 
+	if len(g.partitionedMaps) > 0 {
+		applyRegionAssignments(distributed, g.partitionedMaps)
+	}
+
+	var migration *repo.MapMigration
+	if g.migrator != nil && len(distributed) > 0 {
+		migration = g.migrator.Stage(serversBefore, distributed)
+	}
+
 	res := make([]events.GameServer, len(distributed))
 	for i := range distributed {
 		res[i] = events.GameServer{
@@ -442,33 +605,36 @@ func (g *gameServerImpl) distributeMapsToServers(ctx context.Context, servers []
 			RealmID:                 distributed[i].RealmID,
 			AvailableMaps:           distributed[i].AvailableMaps,
 			NewAssignedMapsToHandle: distributed[i].AssignedMapsToHandle,
+			NewRegions:              toEventRegions(distributed[i].Regions),
 		}
 
 		for _, server := range serversBefore {
 			if server.ID == distributed[i].ID {
 				res[i].OldAssignedMapsToHandle = server.AssignedMapsToHandle
+				res[i].OldRegions = toEventRegions(server.Regions)
 				break
 			}
 		}
 	}
 
 	for i := range distributed {
-		// Mark new maps as pending.
+		// Mark new maps as pending, game servers load them after the event and confirm.
 		for _, server := range res {
 			if server.ID == distributed[i].ID {
-				// No need to have confirmation for assignment on startup.
-				if len(server.OldAssignedMapsToHandle) > 0 {
-					distributed[i].AssignedButPendingMaps = server.OnlyNewMaps()
-				}
+				distributed[i].AssignedButPendingMaps = server.OnlyNewMaps()
 				break
 			}
 		}
+		distributed[i].AwaitingDistribution = false
 
 		assigned := append([]uint32(nil), distributed[i].AssignedMapsToHandle...)
 		pending := append([]uint32(nil), distributed[i].AssignedButPendingMaps...)
+		regions := append([]repo.MapRegion(nil), distributed[i].Regions...)
 		if err := g.r.Update(ctx, distributed[i].ID, func(latest *repo.GameServer) *repo.GameServer {
 			latest.AssignedMapsToHandle = assigned
 			latest.AssignedButPendingMaps = pending
+			latest.Regions = regions
+			latest.AwaitingDistribution = false
 			return latest
 		}); err != nil {
 			return nil, err
@@ -482,6 +648,12 @@ func (g *gameServerImpl) distributeMapsToServers(ctx context.Context, servers []
 		return nil, fmt.Errorf("can't send event for maps reaasigned, err %w", err)
 	}
 
+	if migration != nil {
+		if err = g.migrator.Enqueue(ctx, migration); err != nil {
+			return nil, fmt.Errorf("can't enqueue maps migration, err %w", err)
+		}
+	}
+
 	return distributed, nil
 }
 
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -46,3 +47,29 @@ func countMapHosts(servers []repo.GameServer, mapID uint32) int {
 	}
 	return count
 }
+
+// lockRecordingLayerStore records IDs of the locked realms.
+type lockRecordingLayerStore struct {
+	*layerStoreStub
+	locked []uint32
+}
+
+func (s *lockRecordingLayerStore) LockRealm(_ context.Context, realmID uint32) (func(), error) {
+	s.locked = append(s.locked, realmID)
+	return func() {}, nil
+}
+
+func TestLockRealmLocksCrossRealmServers(t *testing.T) {
+	store := &lockRecordingLayerStore{layerStoreStub: newLayerStoreStub()}
+	g := &gameServerImpl{layers: store}
+
+	unlock, err := g.lockRealm(context.Background(), 2, false)
+	require.NoError(t, err)
+	unlock()
+
+	unlock, err = g.lockRealm(context.Background(), 2, true)
+	require.NoError(t, err)
+	unlock()
+
+	require.Equal(t, []uint32{2, crossRealmLockID}, store.locked)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/binpack"
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+	"github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+func TestRegisterLeavesDistributionToLeader(t *testing.T) {
+	ctx := context.Background()
+	gameServers := repo.NewGameServerInMemRepo()
+
+	producer := mocks.NewServerRegistryProducer(t)
+	producer.On("GSAdded", mock.Anything).Return(nil).Once()
+	producer.On("GSMapsReassigned", mock.Anything).Return(nil).Once()
+
+	g := &gameServerImpl{
+		r:           gameServers,
+		mapBalancer: binpack.NewBinPackBalancer(binpack.DefaultMapsWeight),
+		eProducer:   producer,
+		realmIDs:    []uint32{1},
+		monitor:     newServersMonitor(nil, nil, nil),
+	}
+
+	require.NoError(t, g.Register(ctx, &repo.GameServer{ID: "a", RealmID: 1, AvailableMaps: []uint32{1, 0}}))
+
+	stored, err := gameServers.One(ctx, "a")
+	require.NoError(t, err)
+	require.True(t, stored.AwaitingDistribution)
+	require.Empty(t, stored.AssignedMapsToHandle)
+
+	require.NoError(t, g.distributeIfAwaiting(ctx, 1, false))
+
+	stored, err = gameServers.One(ctx, "a")
+	require.NoError(t, err)
+	require.False(t, stored.AwaitingDistribution)
+	require.Equal(t, []uint32{0, 1}, stored.AssignedMapsToHandle)
+	// Game server loads maps after the event, so they wait for confirmation.
+	require.Equal(t, []uint32{0, 1}, stored.AssignedButPendingMaps)
+
+	// Nothing to distribute anymore.
+	require.NoError(t, g.distributeIfAwaiting(ctx, 1, false))
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"net"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+// GatewaySelectionSettings configures selection of the gateway for the new players.
+type GatewaySelectionSettings struct {
+	// ClientNetworks are networks of the clients with their locality.
+	ClientNetworks []ClientNetwork
+
+	// DefaultCapacity is capacity of the gateways that didn't report it.
+	DefaultCapacity uint32
+}
+
+// Locality is region and optional zone of the gateway or client.
+type Locality struct {
+	Region string
+	Zone   string
+}
+
+// ClientNetwork is network of the clients from the same locality.
+type ClientNetwork struct {
+	Network *net.IPNet
+	Locality
+}
+
+// ClientLocality returns locality of the most specific network that contains the IP, empty if it's unknown.
+func (s GatewaySelectionSettings) ClientLocality(ip net.IP) Locality {
+	res, bestPrefix := Locality{}, -1
+	if ip == nil {
+		return res
+	}
+
+	for _, network := range s.ClientNetworks {
+		if !network.Network.Contains(ip) {
+			continue
+		}
+		if prefix, _ := network.Network.Mask.Size(); prefix > bestPrefix {
+			res, bestPrefix = network.Locality, prefix
+		}
+	}
+	return res
+}
+
+// selectGateway selects gateway for the new player of the client locality.
+// Draining gateways are skipped. Gateways with free capacity are preferred, then gateways closer to the client.
+// Gateway is selected randomly weighted by its free capacity, so players of the same locality spread between gateways
+// while their active connections are not refreshed yet. If every gateway is full, the least loaded is selected.
+// randIntn returns random number in [0,n).
+func selectGateway(gateways []repo.GatewayServer, client Locality, defaultCapacity uint32, randIntn func(n int) int) *repo.GatewayServer {
+	var (
+		candidates []int
+		freeSlots  []int
+		bestScore  = -1
+		leastIndex = -1
+		leastLoad  float64
+	)
+
+	for i := range gateways {
+		if gateways[i].Draining {
+			continue
+		}
+
+		capacity := gateways[i].Capacity
+		if capacity == 0 {
+			capacity = defaultCapacity
+		}
+
+		load := float64(gateways[i].ActiveConnections) / float64(max(capacity, 1))
+		if leastIndex < 0 || load < leastLoad {
+			leastIndex, leastLoad = i, load
+		}
+
+		free := int(capacity) - gateways[i].ActiveConnections
+		if free <= 0 {
+			continue
+		}
+
+		score := localityScore(client, gateways[i])
+		if score < bestScore {
+			continue
+		}
+		if score > bestScore {
+			bestScore = score
+			candidates, freeSlots = candidates[:0], freeSlots[:0]
+		}
+		candidates = append(candidates, i)
+		freeSlots = append(freeSlots, free)
+	}
+
+	if len(candidates) == 0 {
+		if leastIndex < 0 {
+			return nil
+		}
+		return &gateways[leastIndex]
+	}
+
+	total := 0
+	for _, free := range freeSlots {
+		total += free
+	}
+
+	slot := randIntn(total)
+	for i, free := range freeSlots {
+		if slot < free {
+			return &gateways[candidates[i]]
+		}
+		slot -= free
+	}
+	return &gateways[candidates[len(candidates)-1]]
+}
+
+// localityScore is 2 if gateway is in the zone of the client, 1 if it's in the region of the client and 0 otherwise.
+func localityScore(client Locality, gateway repo.GatewayServer) int {
+	if client.Region == "" || client.Region != gateway.Region {
+		return 0
+	}
+	if client.Zone != "" && client.Zone == gateway.Zone {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+func firstSlot(int) int { return 0 }
+
+func TestSelectGatewayPrefersClientLocality(t *testing.T) {
+	gateways := []repo.GatewayServer{
+		{ID: "us", Region: "us", Capacity: 100},
+		{ID: "eu-b", Region: "eu", Zone: "b", Capacity: 100},
+		{ID: "eu-a", Region: "eu", Zone: "a", Capacity: 100},
+	}
+
+	require.Equal(t, "eu-a", selectGateway(gateways, Locality{Region: "eu", Zone: "a"}, 0, firstSlot).ID)
+	require.Equal(t, "eu-b", selectGateway(gateways, Locality{Region: "eu"}, 0, firstSlot).ID)
+	require.Equal(t, "us", selectGateway(gateways, Locality{}, 0, firstSlot).ID, "unknown locality")
+}
+
+func TestSelectGatewaySkipsDrainingAndFullGateways(t *testing.T) {
+	gateways := []repo.GatewayServer{
+		{ID: "draining", Region: "eu", Capacity: 100, Draining: true},
+		{ID: "full", Region: "eu", Capacity: 100, ActiveConnections: 100},
+		{ID: "remote", Region: "us", Capacity: 100, ActiveConnections: 10},
+	}
+	require.Equal(t, "remote", selectGateway(gateways, Locality{Region: "eu"}, 0, firstSlot).ID)
+
+	gateways[2].ActiveConnections = 150
+	require.Equal(t, "full", selectGateway(gateways, Locality{Region: "eu"}, 0, firstSlot).ID, "least loaded if every gateway is full")
+
+	require.Nil(t, selectGateway(gateways[:1], Locality{}, 0, firstSlot), "only draining gateways")
+}
+
+func TestSelectGatewayWeightsByFreeCapacity(t *testing.T) {
+	gateways := []repo.GatewayServer{
+		{ID: "small", Capacity: 10},
+		{ID: "default", ActiveConnections: 70},
+	}
+
+	picks := map[string]int{}
+	for slot := 0; slot < 40; slot++ {
+		picks[selectGateway(gateways, Locality{}, 100, func(n int) int {
+			require.Equal(t, 40, n)
+			return slot
+		}).ID]++
+	}
+	require.Equal(t, map[string]int{"small": 10, "default": 30}, picks)
+}
+
+func TestClientLocalityUsesMostSpecificNetwork(t *testing.T) {
+	_, wide, _ := net.ParseCIDR("10.0.0.0/8")
+	_, narrow, _ := net.ParseCIDR("10.1.0.0/16")
+	settings := GatewaySelectionSettings{ClientNetworks: []ClientNetwork{
+		{Network: wide, Locality: Locality{Region: "eu"}},
+		{Network: narrow, Locality: Locality{Region: "eu", Zone: "b"}},
+	}}
+
+	require.Equal(t, Locality{Region: "eu", Zone: "b"}, settings.ClientLocality(net.ParseIP("10.1.2.3")))
+	require.Equal(t, Locality{Region: "eu"}, settings.ClientLocality(net.ParseIP("10.2.2.3")))
+	require.Equal(t, Locality{}, settings.ClientLocality(net.ParseIP("192.168.0.1")))
+	require.Equal(t, Locality{}, settings.ClientLocality(nil))
+}
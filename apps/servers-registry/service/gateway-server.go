@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
-	"sort"
+	"errors"
+	"math/rand"
+	"net"
 
 	"github.com/rs/zerolog/log"
 
@@ -11,10 +13,27 @@ import (
 	"github.com/walkline/ToCloud9/shared/healthandmetrics"
 )
 
+// ErrGatewayDrainingIrreversible is returned on attempt to cancel drain of the gateway,
+// draining gateway doesn't accept new connections anymore, so it can't get players back.
+var ErrGatewayDrainingIrreversible = errors.New("gateway drain can't be cancelled")
+
 type Gateway interface {
 	Register(ctx context.Context, server *repo.GatewayServer) (*repo.GatewayServer, error)
-	GatewayForRealm(ctx context.Context, realmID uint32) (*repo.GatewayServer, error)
+	// GatewayForRealm selects gateway of the realm for the new player with the given IP, IP can be nil.
+	GatewayForRealm(ctx context.Context, realmID uint32, clientIP net.IP) (*repo.GatewayServer, error)
 	GatewaysForRealm(ctx context.Context, realmID uint32) ([]repo.GatewayServer, error)
+	MapPopulation
+
+	// ServersMapPlayers returns players count per map per game server ID reported by gateways of all realms.
+	ServersMapPlayers(ctx context.Context) (map[string]map[uint32]uint32, error)
+
+	// MonitorServers health checks and reads metrics of the registered gateways until ctx is done.
+	// Should be run only by the leader replica.
+	MonitorServers(ctx context.Context)
+
+	// SetDraining marks gateway as draining, draining gateway is not selected for the new players.
+	// Returns ErrGatewayDrainingIrreversible on attempt to unmark draining gateway.
+	SetDraining(ctx context.Context, id string, draining bool) error
 }
 
 type gatewayImpl struct {
@@ -22,18 +41,23 @@ type gatewayImpl struct {
 	checker   healthandmetrics.HealthChecker
 	eProducer events.ServerRegistryProducer
 	metrics   healthandmetrics.MetricsConsumer
+	realms    []uint32
+	monitor   *serversMonitor
+	selection GatewaySelectionSettings
 }
 
 func NewGateway(
 	ctx context.Context, r repo.GatewayRepo, checker healthandmetrics.HealthChecker,
 	metrics healthandmetrics.MetricsConsumer, eProducer events.ServerRegistryProducer,
-	supportedRealmIDs []uint32,
+	selection GatewaySelectionSettings, supportedRealmIDs []uint32,
 ) (Gateway, error) {
 	service := &gatewayImpl{
 		r:         r,
 		checker:   checker,
 		eProducer: eProducer,
 		metrics:   metrics,
+		realms:    supportedRealmIDs,
+		selection: selection,
 	}
 	checker.AddFailedObserver(func(object healthandmetrics.HealthCheckObject, err error) {
 		if gs, ok := object.(*repo.GatewayServer); ok {
@@ -47,35 +71,25 @@ func NewGateway(
 		}
 	})
 
-	for _, id := range supportedRealmIDs {
-		servers, err := r.ListByRealm(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-
-		for i := range servers {
-			err = checker.AddHealthCheckObject(&servers[i])
+	service.monitor = newServersMonitor(checker, metrics, func(ctx context.Context) ([]monitoredServer, error) {
+		var res []monitoredServer
+		for _, id := range supportedRealmIDs {
+			servers, err := r.ListByRealm(ctx, id)
 			if err != nil {
 				return nil, err
 			}
-
-			err = metrics.AddMetricsObservable(&servers[i])
-			if err != nil {
-				return nil, err
+			for i := range servers {
+				res = append(res, &servers[i])
 			}
 		}
-	}
+		return res, nil
+	})
 
 	return service, nil
 }
 
 func (b *gatewayImpl) Register(ctx context.Context, server *repo.GatewayServer) (*repo.GatewayServer, error) {
-	err := b.checker.AddHealthCheckObject(server)
-	if err != nil {
-		return nil, err
-	}
-
-	err = b.metrics.AddMetricsObservable(server)
+	err := b.monitor.Add(server)
 	if err != nil {
 		return nil, err
 	}
@@ -98,27 +112,99 @@ func (b *gatewayImpl) Register(ctx context.Context, server *repo.GatewayServer)
 	return server, nil
 }
 
-func (b *gatewayImpl) GatewayForRealm(ctx context.Context, realmID uint32) (*repo.GatewayServer, error) {
-	balancers, err := b.r.ListByRealm(ctx, realmID)
+func (b *gatewayImpl) MonitorServers(ctx context.Context) {
+	b.monitor.Run(ctx)
+}
+
+func (b *gatewayImpl) GatewayForRealm(ctx context.Context, realmID uint32, clientIP net.IP) (*repo.GatewayServer, error) {
+	gateways, err := b.r.ListByRealm(ctx, realmID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(balancers) == 0 {
-		return nil, nil
-	}
+	return selectGateway(gateways, b.selection.ClientLocality(clientIP), b.selection.DefaultCapacity, rand.Intn), nil
+}
 
-	sort.Slice(balancers, func(i, j int) bool {
-		return balancers[i].ActiveConnections < balancers[j].ActiveConnections
+func (b *gatewayImpl) SetDraining(ctx context.Context, id string, draining bool) error {
+	var (
+		realmID      uint32
+		irreversible bool
+	)
+	err := b.r.Update(ctx, id, func(server repo.GatewayServer) repo.GatewayServer {
+		// f can be called again if gateway is changed concurrently.
+		irreversible = server.Draining && !draining
+		if irreversible {
+			return server
+		}
+		server.Draining = draining
+		realmID = server.RealmID
+		return server
 	})
+	if err != nil {
+		return err
+	}
 
-	return &balancers[0], nil
+	if irreversible {
+		return ErrGatewayDrainingIrreversible
+	}
+
+	log.Info().Str("id", id).Bool("draining", draining).Msg("Gateway draining changed")
+
+	// Gateway listens to this event to start draining when it's asked by operator rather than by the signal.
+	err = b.eProducer.GatewayDrainingChanged(&events.ServerRegistryEventGWDrainingChangedPayload{
+		ID:       id,
+		RealmID:  realmID,
+		Draining: draining,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("can't produce gateway draining changed event")
+	}
+	return nil
 }
 
 func (b *gatewayImpl) GatewaysForRealm(ctx context.Context, realmID uint32) ([]repo.GatewayServer, error) {
 	return b.r.ListByRealm(ctx, realmID)
 }
 
+// MapPlayers sums players of the map reported by gateways of all realms,
+// cross realm game servers have players from several realms.
+func (b *gatewayImpl) MapPlayers(ctx context.Context, mapID uint32) (map[string]uint32, error) {
+	servers, err := b.ServersMapPlayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := map[string]uint32{}
+	for serverID, maps := range servers {
+		if players := maps[mapID]; players > 0 {
+			res[serverID] = players
+		}
+	}
+	return res, nil
+}
+
+func (b *gatewayImpl) ServersMapPlayers(ctx context.Context) (map[string]map[uint32]uint32, error) {
+	res := map[string]map[uint32]uint32{}
+	for _, realmID := range b.realms {
+		gateways, err := b.r.ListByRealm(ctx, realmID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, gateway := range gateways {
+			for serverID, maps := range gateway.MapPlayers {
+				if res[serverID] == nil {
+					res[serverID] = map[uint32]uint32{}
+				}
+				for mapID, players := range maps {
+					res[serverID][mapID] += uint32(players)
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
 func (b *gatewayImpl) onServerUnhealthy(server *repo.GatewayServer, err error) {
 	log.Warn().
 		Err(err).
@@ -149,6 +235,8 @@ func (b *gatewayImpl) onServerUnhealthy(server *repo.GatewayServer, err error) {
 func (b *gatewayImpl) onMetricsUpdate(server *repo.GatewayServer, m *healthandmetrics.MetricsRead) {
 	err := b.r.Update(context.Background(), server.ID, func(s repo.GatewayServer) repo.GatewayServer {
 		s.ActiveConnections = m.ActiveConnections
+		s.Sessions = m.Sessions
+		s.MapPlayers = m.MapPlayers
 		return s
 	})
 	if err != nil {
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+	"github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+func TestSetDrainingCantBeCancelled(t *testing.T) {
+	gateways := repo.NewGatewayInMemRepo()
+	gateway, err := gateways.Add(context.Background(), &repo.GatewayServer{HealthCheckAddr: "gw:8900", RealmID: 1})
+	require.NoError(t, err)
+
+	producer := mocks.NewServerRegistryProducer(t)
+	producer.On("GatewayDrainingChanged", mock.Anything).Return(nil).Once()
+
+	service := &gatewayImpl{r: gateways, eProducer: producer}
+	require.NoError(t, service.SetDraining(context.Background(), gateway.ID, true))
+	require.ErrorIs(t, service.SetDraining(context.Background(), gateway.ID, false), ErrGatewayDrainingIrreversible)
+
+	servers, err := gateways.ListByRealm(context.Background(), 1)
+	require.NoError(t, err)
+	require.Len(t, servers, 1)
+	require.True(t, servers[0].Draining)
+}
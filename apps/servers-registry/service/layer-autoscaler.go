@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// Reasons of layers scaling decisions that are sent with events.
+const (
+	LayerScalingReasonOverpopulated  = "overpopulated"
+	LayerScalingReasonUnderpopulated = "underpopulated"
+	LayerScalingReasonBelowMin       = "below min layers"
+	LayerScalingReasonAboveMax       = "above max layers"
+)
+
+// LayerAutoscalingBounds limits layers count of the autoscaled map.
+type LayerAutoscalingBounds struct {
+	Min uint32
+	Max uint32
+}
+
+// LayerAutoscalerSettings configures LayerAutoscaler.
+type LayerAutoscalerSettings struct {
+	// Maps contains bounds of the maps that should be autoscaled.
+	Maps map[uint32]LayerAutoscalingBounds
+
+	// ScaleUpPlayersPerLayer is average players count per layer that adds a layer.
+	ScaleUpPlayersPerLayer uint32
+
+	// ScaleDownPlayersPerLayer is players count per layer that layers would have after removing a layer.
+	// Layer is removed only if the population fits below this value, it should be lower than
+	// ScaleUpPlayersPerLayer, so the map doesn't flap between layers counts.
+	ScaleDownPlayersPerLayer uint32
+
+	// ScaleUpWindow and ScaleDownWindow is how long the population should stay over or under threshold.
+	ScaleUpWindow   time.Duration
+	ScaleDownWindow time.Duration
+
+	// Cooldown is min time between two scaling decisions of the map.
+	Cooldown time.Duration
+}
+
+// LayerAutoscaler adds layers to the overpopulated maps and removes layers of the underpopulated ones.
+// Layers count is changed with the layers configuration, so players of the removed layer are moved
+// with the same maps reassignment flow that is used for the manual configuration changes.
+type LayerAutoscaler struct {
+	layers    Layer
+	eProducer events.ServerRegistryProducer
+	realms    []uint32
+	settings  LayerAutoscalerSettings
+
+	now    func() time.Time
+	states map[layerScalingKey]*layerScalingState
+}
+
+type layerScalingKey struct {
+	realmID uint32
+	mapID   uint32
+}
+
+// layerScalingState tracks how long the map population stays over or under thresholds.
+type layerScalingState struct {
+	// layers is layers count that windows were measured with.
+	layers     uint32
+	overSince  time.Time
+	underSince time.Time
+	lastScaled time.Time
+}
+
+// NewLayerAutoscaler creates LayerAutoscaler for the given realms.
+func NewLayerAutoscaler(layers Layer, eProducer events.ServerRegistryProducer, realms []uint32, settings LayerAutoscalerSettings) (*LayerAutoscaler, error) {
+	if settings.ScaleDownPlayersPerLayer >= settings.ScaleUpPlayersPerLayer {
+		return nil, fmt.Errorf("scale down threshold %d should be lower than scale up threshold %d",
+			settings.ScaleDownPlayersPerLayer, settings.ScaleUpPlayersPerLayer)
+	}
+
+	for mapID, bounds := range settings.Maps {
+		if bounds.Min == 0 || bounds.Max < bounds.Min {
+			return nil, fmt.Errorf("map %d has invalid layers bounds %d-%d", mapID, bounds.Min, bounds.Max)
+		}
+	}
+
+	return &LayerAutoscaler{
+		layers:    layers,
+		eProducer: eProducer,
+		realms:    realms,
+		settings:  settings,
+		now:       time.Now,
+		states:    map[layerScalingKey]*layerScalingState{},
+	}, nil
+}
+
+// Run checks populations of the maps with the given interval until ctx is done.
+func (a *LayerAutoscaler) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, realmID := range a.realms {
+				if err := a.Check(ctx, realmID); err != nil {
+					log.Error().Err(err).Uint32("realmID", realmID).Msg("Failed to autoscale layers")
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Check updates population windows of the autoscaled maps of the realm and scales maps that need it.
+func (a *LayerAutoscaler) Check(ctx context.Context, realmID uint32) error {
+	config, err := a.layers.Configuration(ctx, realmID)
+	if err != nil {
+		return err
+	}
+
+	for mapID, bounds := range a.settings.Maps {
+		if err = a.checkMap(ctx, realmID, mapID, configuredLayers(config, mapID), bounds); err != nil {
+			return fmt.Errorf("autoscale map %d: %w", mapID, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *LayerAutoscaler) checkMap(ctx context.Context, realmID, mapID, current uint32, bounds LayerAutoscalingBounds) error {
+	_, stats, err := a.layers.Stats(ctx, realmID, mapID)
+	if err != nil {
+		return err
+	}
+
+	players := uint32(0)
+	for _, stat := range stats {
+		players += stat.Players
+	}
+
+	now := a.now()
+	state := a.state(realmID, mapID, current)
+
+	desired, reason := current, ""
+	switch {
+	case current < bounds.Min:
+		desired, reason = bounds.Min, LayerScalingReasonBelowMin
+	case current > bounds.Max:
+		desired, reason = bounds.Max, LayerScalingReasonAboveMax
+	case a.overpopulated(current, uint32(len(stats)), players) && current < bounds.Max:
+		state.underSince = time.Time{}
+		if state.overSince.IsZero() {
+			state.overSince = now
+		}
+		if now.Sub(state.overSince) >= a.settings.ScaleUpWindow && now.Sub(state.lastScaled) >= a.settings.Cooldown {
+			desired, reason = current+1, LayerScalingReasonOverpopulated
+		}
+	case a.underpopulated(current, players) && current > bounds.Min:
+		state.overSince = time.Time{}
+		if state.underSince.IsZero() {
+			state.underSince = now
+		}
+		if now.Sub(state.underSince) >= a.settings.ScaleDownWindow && now.Sub(state.lastScaled) >= a.settings.Cooldown {
+			desired, reason = current-1, LayerScalingReasonUnderpopulated
+		}
+	default:
+		state.overSince, state.underSince = time.Time{}, time.Time{}
+	}
+
+	if desired == current {
+		return nil
+	}
+
+	scaled, err := a.layers.ScaleMapLayers(ctx, realmID, mapID, current, desired)
+	if err != nil {
+		return err
+	}
+
+	state.overSince, state.underSince = time.Time{}, time.Time{}
+	if !scaled {
+		// Another registry replica or an admin changed layers, windows are measured again on the next check.
+		return nil
+	}
+
+	state.layers = desired
+	state.lastScaled = now
+
+	log.Info().
+		Uint32("realmID", realmID).
+		Uint32("mapID", mapID).
+		Uint32("oldLayers", current).
+		Uint32("newLayers", desired).
+		Uint32("players", players).
+		Str("reason", reason).
+		Msg("Scaled map layers")
+
+	err = a.eProducer.LayersScaled(&events.ServerRegistryEventLayersScaledPayload{
+		RealmID:   realmID,
+		MapID:     mapID,
+		OldLayers: current,
+		NewLayers: desired,
+		Players:   players,
+		Reason:    reason,
+	})
+	if err != nil {
+		log.Error().Err(err).Uint32("mapID", mapID).Msg("can't produce layers scaled event")
+	}
+
+	return nil
+}
+
+// overpopulated returns true if layers that host the map have too many players on average.
+// Map isn't scaled up while some of the configured layers have no server to run on.
+func (a *LayerAutoscaler) overpopulated(configured, hosting, players uint32) bool {
+	if hosting == 0 || hosting < configured {
+		return false
+	}
+	return players/hosting >= a.settings.ScaleUpPlayersPerLayer
+}
+
+// underpopulated returns true if players fit into one layer less with population under the scale down threshold.
+func (a *LayerAutoscaler) underpopulated(configured, players uint32) bool {
+	if configured < 2 {
+		return false
+	}
+	return players/(configured-1) < a.settings.ScaleDownPlayersPerLayer
+}
+
+func (a *LayerAutoscaler) state(realmID, mapID, layers uint32) *layerScalingState {
+	key := layerScalingKey{realmID: realmID, mapID: mapID}
+	state, found := a.states[key]
+	if !found {
+		state = &layerScalingState{layers: layers}
+		a.states[key] = state
+	}
+
+	if state.layers != layers {
+		// Layers were changed outside the autoscaler.
+		state.layers = layers
+		state.overSince, state.underSince = time.Time{}, time.Time{}
+	}
+
+	return state
+}
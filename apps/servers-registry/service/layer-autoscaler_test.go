@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/shared/events"
+	eventsMock "github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+type layerAutoscalerLayerFake struct {
+	Layer
+	layers  map[uint32]uint32
+	players map[uint32][]uint32
+}
+
+func (l *layerAutoscalerLayerFake) Configuration(context.Context, uint32) (map[uint32]uint32, error) {
+	return l.layers, nil
+}
+
+func (l *layerAutoscalerLayerFake) ScaleMapLayers(_ context.Context, _, mapID, expectedLayers, layers uint32) (bool, error) {
+	if configuredLayers(l.layers, mapID) != expectedLayers {
+		return false, nil
+	}
+	l.layers[mapID] = layers
+	return true, nil
+}
+
+func (l *layerAutoscalerLayerFake) Stats(_ context.Context, _, mapID uint32) (uint32, []LayerStat, error) {
+	stats := make([]LayerStat, len(l.players[mapID]))
+	for i, players := range l.players[mapID] {
+		stats[i] = LayerStat{Players: players}
+	}
+	return configuredLayers(l.layers, mapID), stats, nil
+}
+
+type layerAutoscalerTest struct {
+	layers   *layerAutoscalerLayerFake
+	producer *eventsMock.ServerRegistryProducer
+	scaler   *LayerAutoscaler
+	now      time.Time
+}
+
+func newLayerAutoscalerTest(t *testing.T, bounds LayerAutoscalingBounds) *layerAutoscalerTest {
+	test := &layerAutoscalerTest{
+		layers:   &layerAutoscalerLayerFake{layers: map[uint32]uint32{}, players: map[uint32][]uint32{}},
+		producer: &eventsMock.ServerRegistryProducer{},
+		now:      time.Unix(1000, 0),
+	}
+
+	scaler, err := NewLayerAutoscaler(test.layers, test.producer, []uint32{1}, LayerAutoscalerSettings{
+		Maps:                     map[uint32]LayerAutoscalingBounds{0: bounds},
+		ScaleUpPlayersPerLayer:   300,
+		ScaleDownPlayersPerLayer: 150,
+		ScaleUpWindow:            2 * time.Minute,
+		ScaleDownWindow:          10 * time.Minute,
+		Cooldown:                 5 * time.Minute,
+	})
+	require.NoError(t, err)
+	scaler.now = func() time.Time { return test.now }
+	test.scaler = scaler
+	return test
+}
+
+func (a *layerAutoscalerTest) checkAfter(t *testing.T, d time.Duration) {
+	a.now = a.now.Add(d)
+	require.NoError(t, a.scaler.Check(context.Background(), 1))
+}
+
+func TestLayerAutoscalerScalesUpAfterWindow(t *testing.T) {
+	test := newLayerAutoscalerTest(t, LayerAutoscalingBounds{Min: 1, Max: 3})
+	test.layers.players[0] = []uint32{350}
+	test.producer.On("LayersScaled", &events.ServerRegistryEventLayersScaledPayload{
+		RealmID: 1, MapID: 0, OldLayers: 1, NewLayers: 2, Players: 350, Reason: LayerScalingReasonOverpopulated,
+	}).Return(nil).Once()
+
+	test.checkAfter(t, 0)
+	test.checkAfter(t, time.Minute)
+	assert.Equal(t, uint32(1), configuredLayers(test.layers.layers, 0), "population should stay high for the whole window")
+
+	test.checkAfter(t, time.Minute)
+	assert.Equal(t, uint32(2), test.layers.layers[0])
+	test.producer.AssertExpectations(t)
+}
+
+func TestLayerAutoscalerResetsWindowWhenPopulationDrops(t *testing.T) {
+	test := newLayerAutoscalerTest(t, LayerAutoscalingBounds{Min: 1, Max: 3})
+	test.layers.players[0] = []uint32{350}
+
+	test.checkAfter(t, 0)
+	test.layers.players[0] = []uint32{200}
+	test.checkAfter(t, time.Minute)
+	test.layers.players[0] = []uint32{350}
+	test.checkAfter(t, time.Minute)
+
+	assert.Equal(t, uint32(1), configuredLayers(test.layers.layers, 0))
+	test.producer.AssertNotCalled(t, "LayersScaled", mock.Anything)
+}
+
+func TestLayerAutoscalerScalesDownWithHysteresis(t *testing.T) {
+	test := newLayerAutoscalerTest(t, LayerAutoscalingBounds{Min: 1, Max: 3})
+	test.layers.layers[0] = 3
+	// 400 players would be 200 per layer with 2 layers, that is above the scale down threshold.
+	test.layers.players[0] = []uint32{140, 130, 130}
+
+	test.checkAfter(t, 0)
+	test.checkAfter(t, time.Hour)
+	assert.Equal(t, uint32(3), test.layers.layers[0])
+
+	test.layers.players[0] = []uint32{100, 100, 80}
+	test.producer.On("LayersScaled", &events.ServerRegistryEventLayersScaledPayload{
+		RealmID: 1, MapID: 0, OldLayers: 3, NewLayers: 2, Players: 280, Reason: LayerScalingReasonUnderpopulated,
+	}).Return(nil).Once()
+
+	test.checkAfter(t, time.Minute)
+	test.checkAfter(t, 10*time.Minute)
+	assert.Equal(t, uint32(2), test.layers.layers[0])
+	test.producer.AssertExpectations(t)
+}
+
+func TestLayerAutoscalerRespectsBounds(t *testing.T) {
+	test := newLayerAutoscalerTest(t, LayerAutoscalingBounds{Min: 2, Max: 2})
+	test.layers.players[0] = []uint32{10}
+	test.producer.On("LayersScaled", &events.ServerRegistryEventLayersScaledPayload{
+		RealmID: 1, MapID: 0, OldLayers: 1, NewLayers: 2, Players: 10, Reason: LayerScalingReasonBelowMin,
+	}).Return(nil).Once()
+
+	test.checkAfter(t, 0)
+	assert.Equal(t, uint32(2), test.layers.layers[0])
+
+	// Max layers are reached, so the map isn't scaled up however high population is.
+	test.layers.players[0] = []uint32{900, 900}
+	test.checkAfter(t, time.Hour)
+	test.checkAfter(t, time.Hour)
+	assert.Equal(t, uint32(2), test.layers.layers[0])
+	test.producer.AssertExpectations(t)
+}
+
+func TestLayerAutoscalerDoesNotScaleUpWithoutServers(t *testing.T) {
+	test := newLayerAutoscalerTest(t, LayerAutoscalingBounds{Min: 1, Max: 3})
+	test.layers.layers[0] = 2
+	// Only one layer of two is hosted.
+	test.layers.players[0] = []uint32{700}
+
+	test.checkAfter(t, 0)
+	test.checkAfter(t, time.Hour)
+	assert.Equal(t, uint32(2), test.layers.layers[0])
+	test.producer.AssertNotCalled(t, "LayersScaled", mock.Anything)
+}
+
+func TestNewLayerAutoscalerValidatesSettings(t *testing.T) {
+	_, err := NewLayerAutoscaler(nil, nil, nil, LayerAutoscalerSettings{ScaleUpPlayersPerLayer: 100, ScaleDownPlayersPerLayer: 100})
+	assert.Error(t, err)
+
+	_, err = NewLayerAutoscaler(nil, nil, nil, LayerAutoscalerSettings{
+		Maps:                     map[uint32]LayerAutoscalingBounds{0: {Min: 3, Max: 2}},
+		ScaleUpPlayersPerLayer:   300,
+		ScaleDownPlayersPerLayer: 150,
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+// LayerCharacter is character that is placed to the layer.
+// Zero GUID means that the caller didn't provide the character.
+type LayerCharacter struct {
+	GUID    uint64
+	GuildID uint64
+}
+
+// LayerCandidate is game server that hosts layer of the map with players count on the map.
+type LayerCandidate struct {
+	Server  repo.GameServer
+	Players uint32
+}
+
+// LayerPlacementPolicy chooses layer for the character that has no group binding or preferred layer.
+type LayerPlacementPolicy interface {
+	Place(ctx context.Context, realmID, mapID uint32, character LayerCharacter, candidates []LayerCandidate) (*repo.GameServer, error)
+}
+
+// LeastLoadedLayerPlacement places character to the layer with the fewest players on the map.
+type LeastLoadedLayerPlacement struct{}
+
+func (LeastLoadedLayerPlacement) Place(_ context.Context, _, _ uint32, _ LayerCharacter, candidates []LayerCandidate) (*repo.GameServer, error) {
+	return leastPopulatedCandidate(candidates), nil
+}
+
+// SocialGraph provides online acquaintances of the character.
+type SocialGraph interface {
+	OnlineGuildMembers(ctx context.Context, realmID uint32, guildID uint64) ([]uint64, error)
+	OnlineFriends(ctx context.Context, realmID uint32, charGUID uint64) ([]uint64, error)
+}
+
+// SocialAffinitySettings configures SocialAffinityLayerPlacement.
+type SocialAffinitySettings struct {
+	// GuildMemberWeight and FriendWeight is score that online guild member or friend adds to their layer.
+	// Character that is both friend and guild member adds the higher weight.
+	GuildMemberWeight uint32
+	FriendWeight      uint32
+
+	// MaxPlayersPerLayer is players count on the map that makes layer full for the affinity,
+	// such layers are used only by least loaded fallback. Zero disables the limit.
+	MaxPlayersPerLayer uint32
+
+	// Timeout limits time of social graph requests, placement falls back to least loaded layer on timeout.
+	Timeout time.Duration
+}
+
+// SocialAffinityLayerPlacement places character to the layer with the most online guild members and friends,
+// so players that know each other see each other. Falls back to the least loaded layer if there is no acquaintance
+// on the map or all their layers are full.
+type SocialAffinityLayerPlacement struct {
+	graph    SocialGraph
+	store    repo.LayerStore
+	settings SocialAffinitySettings
+}
+
+func NewSocialAffinityLayerPlacement(graph SocialGraph, store repo.LayerStore, settings SocialAffinitySettings) *SocialAffinityLayerPlacement {
+	return &SocialAffinityLayerPlacement{
+		graph:    graph,
+		store:    store,
+		settings: settings,
+	}
+}
+
+func (p *SocialAffinityLayerPlacement) Place(ctx context.Context, realmID, mapID uint32, character LayerCharacter, candidates []LayerCandidate) (*repo.GameServer, error) {
+	if character.GUID == 0 || len(candidates) < 2 {
+		return leastPopulatedCandidate(candidates), nil
+	}
+
+	scores, err := p.scores(ctx, realmID, mapID, character)
+	if err != nil {
+		log.Warn().Err(err).Uint64("charGUID", character.GUID).Msg("can't get social affinity of character, using least loaded layer")
+		return leastPopulatedCandidate(candidates), nil
+	}
+
+	var best *LayerCandidate
+	for i := range candidates {
+		candidate := &candidates[i]
+		if scores[candidate.Server.ID] == 0 {
+			continue
+		}
+		if p.settings.MaxPlayersPerLayer > 0 && candidate.Players >= p.settings.MaxPlayersPerLayer {
+			continue
+		}
+		if best == nil || betterAffinity(candidate, best, scores) {
+			best = candidate
+		}
+	}
+
+	if best == nil {
+		return leastPopulatedCandidate(candidates), nil
+	}
+
+	server := best.Server
+	return &server, nil
+}
+
+// scores returns affinity score of the character per game server ID.
+func (p *SocialAffinityLayerPlacement) scores(ctx context.Context, realmID, mapID uint32, character LayerCharacter) (map[string]uint32, error) {
+	if p.settings.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.settings.Timeout)
+		defer cancel()
+	}
+
+	weights := map[uint64]uint32{}
+	addWeight := func(guids []uint64, weight uint32) {
+		for _, guid := range guids {
+			if guid != character.GUID && weights[guid] < weight {
+				weights[guid] = weight
+			}
+		}
+	}
+
+	if character.GuildID != 0 {
+		members, err := p.graph.OnlineGuildMembers(ctx, realmID, character.GuildID)
+		if err != nil {
+			return nil, err
+		}
+		addWeight(members, p.settings.GuildMemberWeight)
+	}
+
+	friends, err := p.graph.OnlineFriends(ctx, realmID, character.GUID)
+	if err != nil {
+		return nil, err
+	}
+	addWeight(friends, p.settings.FriendWeight)
+
+	if len(weights) == 0 {
+		return map[string]uint32{}, nil
+	}
+
+	guids := make([]uint64, 0, len(weights))
+	for guid := range weights {
+		guids = append(guids, guid)
+	}
+
+	layers, err := p.store.CharacterLayers(ctx, realmID, mapID, guids)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := map[string]uint32{}
+	for guid, serverID := range layers {
+		scores[serverID] += weights[guid]
+	}
+	return scores, nil
+}
+
+func betterAffinity(candidate, best *LayerCandidate, scores map[string]uint32) bool {
+	candidateScore, bestScore := scores[candidate.Server.ID], scores[best.Server.ID]
+	if candidateScore != bestScore {
+		return candidateScore > bestScore
+	}
+	return lessPopulated(candidate, best)
+}
+
+func leastPopulatedCandidate(candidates []LayerCandidate) *repo.GameServer {
+	index := 0
+	for i := 1; i < len(candidates); i++ {
+		if lessPopulated(&candidates[i], &candidates[index]) {
+			index = i
+		}
+	}
+	server := candidates[index].Server
+	return &server
+}
+
+func lessPopulated(a, b *LayerCandidate) bool {
+	return a.Players < b.Players || (a.Players == b.Players && a.Server.ID < b.Server.ID)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+type socialGraphStub struct {
+	guildMembers map[uint64][]uint64
+	friends      map[uint64][]uint64
+	err          error
+}
+
+func (g *socialGraphStub) OnlineGuildMembers(_ context.Context, _ uint32, guildID uint64) ([]uint64, error) {
+	return g.guildMembers[guildID], g.err
+}
+
+func (g *socialGraphStub) OnlineFriends(_ context.Context, _ uint32, charGUID uint64) ([]uint64, error) {
+	return g.friends[charGUID], g.err
+}
+
+func newSocialAffinityTestLayers(t *testing.T, graph SocialGraph, population mapPopulationStub) (Layer, *layerStoreStub) {
+	store := newLayerStoreStub()
+	require.NoError(t, store.SetConfiguration(context.Background(), 1, map[uint32]uint32{1: 3}))
+	placement := NewSocialAffinityLayerPlacement(graph, store, SocialAffinitySettings{
+		GuildMemberWeight:  1,
+		FriendWeight:       2,
+		MaxPlayersPerLayer: 100,
+	})
+	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{
+		{ID: "layer-1", Alias: "a"},
+		{ID: "layer-2", Alias: "b"},
+		{ID: "layer-3", Alias: "c"},
+	}}, population, placement, store)
+	return layers, store
+}
+
+func TestSocialAffinityPlacementFollowsGuildAndFriends(t *testing.T) {
+	graph := &socialGraphStub{
+		guildMembers: map[uint64][]uint64{7: {11, 12, 13}},
+		friends:      map[uint64][]uint64{10: {14}},
+	}
+	layers, store := newSocialAffinityTestLayers(t, graph, mapPopulationStub{1: {"layer-1": 60, "layer-2": 50, "layer-3": 5}})
+
+	// Two guild members are on layer-1, a friend is on layer-2 and one guild member is on another map.
+	require.NoError(t, store.SetCharacterLayer(context.Background(), 1, 11, 1, "layer-1"))
+	require.NoError(t, store.SetCharacterLayer(context.Background(), 1, 12, 1, "layer-1"))
+	require.NoError(t, store.SetCharacterLayer(context.Background(), 1, 13, 0, "layer-2"))
+	require.NoError(t, store.SetCharacterLayer(context.Background(), 1, 14, 1, "layer-2"))
+
+	selection, err := layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{GUID: 10, GuildID: 7})
+	require.NoError(t, err)
+	require.Equal(t, "layer-2", selection.Server.ID, "friend weights as two guild members, equal scores are broken by population")
+
+	graph.friends = nil
+	selection, err = layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{GUID: 10, GuildID: 7})
+	require.NoError(t, err)
+	require.Equal(t, "layer-1", selection.Server.ID)
+
+	placed, err := store.CharacterLayers(context.Background(), 1, 1, []uint64{10})
+	require.NoError(t, err)
+	require.Equal(t, map[uint64]string{10: "layer-1"}, placed)
+}
+
+func TestSocialAffinityPlacementIsBoundedByLayerCapacity(t *testing.T) {
+	graph := &socialGraphStub{guildMembers: map[uint64][]uint64{7: {11}}}
+	layers, store := newSocialAffinityTestLayers(t, graph, mapPopulationStub{1: {"layer-1": 100, "layer-2": 60, "layer-3": 30}})
+	require.NoError(t, store.SetCharacterLayer(context.Background(), 1, 11, 1, "layer-1"))
+
+	selection, err := layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{GUID: 10, GuildID: 7})
+	require.NoError(t, err)
+	require.Equal(t, "layer-3", selection.Server.ID)
+}
+
+func TestSocialAffinityPlacementFallsBackToLeastLoaded(t *testing.T) {
+	population := mapPopulationStub{1: {"layer-1": 50, "layer-2": 20, "layer-3": 30}}
+
+	layers, _ := newSocialAffinityTestLayers(t, &socialGraphStub{err: errors.New("guilds service is down")}, population)
+	selection, err := layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{GUID: 10, GuildID: 7})
+	require.NoError(t, err)
+	require.Equal(t, "layer-2", selection.Server.ID)
+
+	layers, _ = newSocialAffinityTestLayers(t, &socialGraphStub{}, population)
+	selection, err = layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{GUID: 10})
+	require.NoError(t, err)
+	require.Equal(t, "layer-2", selection.Server.ID)
+}
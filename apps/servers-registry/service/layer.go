@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/rs/zerolog/log"
+
 	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
 )
 
@@ -28,23 +30,47 @@ type LayerStat struct {
 }
 
 type Layer interface {
-	Select(context.Context, uint32, uint32, uint32, string) (LayerSelection, error)
+	Select(context.Context, uint32, uint32, uint32, string, LayerCharacter) (LayerSelection, error)
 	BindGroup(context.Context, uint32, uint32, uint32, string) error
 	Configuration(context.Context, uint32) (map[uint32]uint32, error)
 	UpdateConfiguration(context.Context, uint32, map[uint32]uint32) error
+	// InitConfiguration sets layers configuration of the realm only if there is none stored yet,
+	// so restarted replicas don't reset layers counts changed at runtime. Returns false if configuration exists.
+	InitConfiguration(ctx context.Context, realmID uint32, config map[uint32]uint32) (bool, error)
+	ScaleMapLayers(ctx context.Context, realmID, mapID, expectedLayers, layers uint32) (bool, error)
 	Stats(context.Context, uint32, uint32) (uint32, []LayerStat, error)
 }
 
+// MapPopulation provides players count of the map per game server ID.
+type MapPopulation interface {
+	MapPlayers(ctx context.Context, mapID uint32) (map[string]uint32, error)
+}
+
 type layerService struct {
-	servers GameServer
-	store   repo.LayerStore
+	servers    GameServer
+	population MapPopulation
+	placement  LayerPlacementPolicy
+	store      repo.LayerStore
+}
+
+func NewLayer(servers GameServer, population MapPopulation, placement LayerPlacementPolicy, store repo.LayerStore) Layer {
+	return &layerService{servers: servers, population: population, placement: placement, store: store}
 }
 
-func NewLayer(servers GameServer, store repo.LayerStore) Layer {
-	return &layerService{servers: servers, store: store}
+func (l *layerService) Select(ctx context.Context, realmID, mapID, groupID uint32, preferredAlias string, character LayerCharacter) (LayerSelection, error) {
+	selection, err := l.selectLayer(ctx, realmID, mapID, groupID, preferredAlias, character)
+	if err != nil || selection.Server == nil || character.GUID == 0 {
+		return selection, err
+	}
+
+	// Placements of characters are used by the social affinity placement of their acquaintances.
+	if err = l.store.SetCharacterLayer(ctx, realmID, character.GUID, mapID, selection.Server.ID); err != nil {
+		log.Warn().Err(err).Uint64("charGUID", character.GUID).Msg("can't save character layer")
+	}
+	return selection, nil
 }
 
-func (l *layerService) Select(ctx context.Context, realmID, mapID, groupID uint32, preferredAlias string) (LayerSelection, error) {
+func (l *layerService) selectLayer(ctx context.Context, realmID, mapID, groupID uint32, preferredAlias string, character LayerCharacter) (LayerSelection, error) {
 	servers, err := l.servers.AvailableForMapAndRealm(ctx, mapID, realmID, false)
 	if err != nil {
 		return LayerSelection{}, err
@@ -63,7 +89,11 @@ func (l *layerService) Select(ctx context.Context, realmID, mapID, groupID uint3
 		return LayerSelection{Status: LayerSelectionNotFound}, nil
 	}
 	if groupID == 0 {
-		return LayerSelection{Status: LayerSelectionOK, Server: leastLoaded(servers)}, nil
+		selected, err := l.place(ctx, realmID, mapID, character, servers)
+		if err != nil {
+			return LayerSelection{}, err
+		}
+		return LayerSelection{Status: LayerSelectionOK, Server: selected}, nil
 	}
 
 	boundID, err := l.store.GroupBinding(ctx, realmID, groupID, mapID)
@@ -74,7 +104,12 @@ func (l *layerService) Select(ctx context.Context, realmID, mapID, groupID uint3
 		return LayerSelection{Status: LayerSelectionOK, Server: server}, nil
 	}
 
-	selected := leastLoaded(servers)
+	// The first member that enters the map chooses layer of the whole group.
+	selected, err := l.place(ctx, realmID, mapID, character, servers)
+	if err != nil {
+		return LayerSelection{}, err
+	}
+
 	var winner string
 	if boundID == "" {
 		winner, err = l.store.BindGroup(ctx, realmID, groupID, mapID, selected.ID)
@@ -120,6 +155,73 @@ func (l *layerService) UpdateConfiguration(ctx context.Context, realmID uint32,
 	return l.servers.RedistributeRealm(ctx, realmID)
 }
 
+func (l *layerService) InitConfiguration(ctx context.Context, realmID uint32, config map[uint32]uint32) (bool, error) {
+	for mapID, count := range config {
+		if count == 0 {
+			return false, fmt.Errorf("map %d has zero layers", mapID)
+		}
+	}
+
+	unlock, err := l.store.LockRealm(ctx, realmID)
+	if err != nil {
+		return false, err
+	}
+
+	stored, err := l.store.Configuration(ctx, realmID)
+	if err != nil {
+		unlock()
+		return false, err
+	}
+
+	if len(stored) > 0 {
+		unlock()
+		return false, nil
+	}
+
+	err = l.store.SetConfiguration(ctx, realmID, config)
+	// RedistributeRealm takes the realm lock by itself.
+	unlock()
+	if err != nil {
+		return false, err
+	}
+
+	return true, l.servers.RedistributeRealm(ctx, realmID)
+}
+
+// ScaleMapLayers sets layers count of the map only if the current count equals expectedLayers,
+// so scaling decisions of several registry replicas don't stack. Returns false if the count was changed by someone else.
+func (l *layerService) ScaleMapLayers(ctx context.Context, realmID, mapID, expectedLayers, layers uint32) (bool, error) {
+	if layers == 0 {
+		return false, fmt.Errorf("map %d has zero layers", mapID)
+	}
+
+	unlock, err := l.store.LockRealm(ctx, realmID)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := l.store.Configuration(ctx, realmID)
+	if err != nil {
+		unlock()
+		return false, err
+	}
+
+	if configuredLayers(config, mapID) != expectedLayers {
+		unlock()
+		return false, nil
+	}
+
+	config[mapID] = layers
+	err = l.store.SetConfiguration(ctx, realmID, config)
+	// RedistributeRealm takes the realm lock by itself.
+	unlock()
+	if err != nil {
+		return false, err
+	}
+
+	return true, l.servers.RedistributeRealm(ctx, realmID)
+}
+
 func (l *layerService) Stats(ctx context.Context, realmID, mapID uint32) (uint32, []LayerStat, error) {
 	config, err := l.store.Configuration(ctx, realmID)
 	if err != nil {
@@ -129,28 +231,39 @@ func (l *layerService) Stats(ctx context.Context, realmID, mapID uint32) (uint32
 	if err != nil {
 		return 0, nil, err
 	}
+	players, err := l.population.MapPlayers(ctx, mapID)
+	if err != nil {
+		return 0, nil, err
+	}
 	stats := make([]LayerStat, 0, len(servers))
 	for _, server := range servers {
-		stats = append(stats, LayerStat{Players: server.ActiveConnections, Server: server})
+		stats = append(stats, LayerStat{Players: players[server.ID], Server: server})
 	}
 	sort.Slice(stats, func(i, j int) bool { return stats[i].Server.Alias < stats[j].Server.Alias })
-	configured := config[mapID]
-	if configured == 0 {
-		configured = 1
+	return configuredLayers(config, mapID), stats, nil
+}
+
+// configuredLayers returns layers count of the map, maps without configuration have a single layer.
+func configuredLayers(config map[uint32]uint32, mapID uint32) uint32 {
+	if config[mapID] == 0 {
+		return 1
 	}
-	return configured, stats, nil
+	return config[mapID]
 }
 
-func leastLoaded(servers []repo.GameServer) *repo.GameServer {
-	index := 0
-	for i := 1; i < len(servers); i++ {
-		if servers[i].ActiveConnections < servers[index].ActiveConnections ||
-			(servers[i].ActiveConnections == servers[index].ActiveConnections && servers[i].ID < servers[index].ID) {
-			index = i
-		}
+// place chooses layer with the placement policy. Layers are compared by players count on the map only,
+// so a server with a busy dungeon is still a good layer for a continent.
+func (l *layerService) place(ctx context.Context, realmID, mapID uint32, character LayerCharacter, servers []repo.GameServer) (*repo.GameServer, error) {
+	players, err := l.population.MapPlayers(ctx, mapID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]LayerCandidate, len(servers))
+	for i := range servers {
+		candidates[i] = LayerCandidate{Server: servers[i], Players: players[servers[i].ID]}
 	}
-	server := servers[index]
-	return &server
+	return l.placement.Place(ctx, realmID, mapID, character, candidates)
 }
 
 func serverByID(servers []repo.GameServer, id string) *repo.GameServer {
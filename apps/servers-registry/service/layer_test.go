@@ -6,17 +6,24 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
 	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
 )
 
 type layerStoreStub struct {
-	mu       sync.Mutex
-	config   map[uint32]map[uint32]uint32
-	bindings map[[3]uint32]string
+	mu         sync.Mutex
+	config     map[uint32]map[uint32]uint32
+	bindings   map[[3]uint32]string
+	characters map[uint64]characterLayerStub
+}
+
+type characterLayerStub struct {
+	mapID  uint32
+	server string
 }
 
 func newLayerStoreStub() *layerStoreStub {
-	return &layerStoreStub{config: map[uint32]map[uint32]uint32{}, bindings: map[[3]uint32]string{}}
+	return &layerStoreStub{config: map[uint32]map[uint32]uint32{}, bindings: map[[3]uint32]string{}, characters: map[uint64]characterLayerStub{}}
 }
 func (s *layerStoreStub) Configuration(_ context.Context, realm uint32) (map[uint32]uint32, error) {
 	s.mu.Lock()
@@ -65,6 +72,23 @@ func (s *layerStoreStub) ReplaceGroupBinding(_ context.Context, realm, group, ma
 func (s *layerStoreStub) LockRealm(context.Context, uint32) (func(), error) {
 	return func() {}, nil
 }
+func (s *layerStoreStub) SetCharacterLayer(_ context.Context, _ uint32, charGUID uint64, mapID uint32, server string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.characters[charGUID] = characterLayerStub{mapID: mapID, server: server}
+	return nil
+}
+func (s *layerStoreStub) CharacterLayers(_ context.Context, _, mapID uint32, charGUIDs []uint64) (map[uint64]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := map[uint64]string{}
+	for _, guid := range charGUIDs {
+		if placement, found := s.characters[guid]; found && placement.mapID == mapID {
+			result[guid] = placement.server
+		}
+	}
+	return result, nil
+}
 
 type layerServersStub struct{ servers []repo.GameServer }
 
@@ -88,21 +112,42 @@ func (s *layerServersStub) MapsLoadedForServer(context.Context, string, []uint32
 func (s *layerServersStub) RedistributeRealm(context.Context, uint32) error {
 	return nil
 }
+func (s *layerServersStub) MoveMaps(context.Context, uint32, []mapbalancing.MapMove) ([]mapbalancing.MapMove, error) {
+	return nil, nil
+}
+func (s *layerServersStub) MapRegions(context.Context, uint32, uint32, bool) ([]MapRegionServer, error) {
+	return nil, nil
+}
+func (s *layerServersStub) MonitorServers(context.Context) {}
+
+// mapPopulationStub is players count per map ID and game server ID.
+type mapPopulationStub map[uint32]map[string]uint32
+
+func (p mapPopulationStub) MapPlayers(_ context.Context, mapID uint32) (map[string]uint32, error) {
+	return p[mapID], nil
+}
 
 func TestRegistryReplicasShareAtomicGroupBinding(t *testing.T) {
 	store := newLayerStoreStub()
 	require.NoError(t, store.SetConfiguration(context.Background(), 1, map[uint32]uint32{1: 2}))
 	servers := &layerServersStub{servers: []repo.GameServer{
-		{ID: "layer-1", Alias: "thrall-onyxia-a", ActiveConnections: 2},
-		{ID: "layer-2", Alias: "jaina-arthas-b", ActiveConnections: 1},
+		{ID: "layer-1", Alias: "thrall-onyxia-a"},
+		{ID: "layer-2", Alias: "jaina-arthas-b"},
 	}}
-	replicaA, replicaB := NewLayer(servers, store), NewLayer(servers, store)
+	population := mapPopulationStub{1: {"layer-1": 2, "layer-2": 1}}
+	replicaA, replicaB := NewLayer(servers, population, LeastLoadedLayerPlacement{}, store), NewLayer(servers, population, LeastLoadedLayerPlacement{}, store)
 
 	var first, second LayerSelection
 	var wg sync.WaitGroup
 	wg.Add(2)
-	go func() { defer wg.Done(); first, _ = replicaA.Select(context.Background(), 1, 1, 77, "") }()
-	go func() { defer wg.Done(); second, _ = replicaB.Select(context.Background(), 1, 1, 77, "") }()
+	go func() {
+		defer wg.Done()
+		first, _ = replicaA.Select(context.Background(), 1, 1, 77, "", LayerCharacter{})
+	}()
+	go func() {
+		defer wg.Done()
+		second, _ = replicaB.Select(context.Background(), 1, 1, 77, "", LayerCharacter{})
+	}()
 	wg.Wait()
 
 	require.Equal(t, LayerSelectionOK, first.Status)
@@ -114,9 +159,9 @@ func TestStaleGroupBindingMovesToAvailableServer(t *testing.T) {
 	store := newLayerStoreStub()
 	require.NoError(t, store.SetConfiguration(context.Background(), 1, map[uint32]uint32{1: 2}))
 	require.NoError(t, store.SetGroupBinding(context.Background(), 1, 77, 1, "gone"))
-	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{{ID: "ready", Alias: "ready-alias"}}}, store)
+	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{{ID: "ready", Alias: "ready-alias"}}}, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store)
 
-	selection, err := layers.Select(context.Background(), 1, 1, 77, "")
+	selection, err := layers.Select(context.Background(), 1, 1, 77, "", LayerCharacter{})
 	require.NoError(t, err)
 	require.Equal(t, "ready", selection.Server.ID)
 	bound, err := store.GroupBinding(context.Background(), 1, 77, 1)
@@ -127,7 +172,7 @@ func TestStaleGroupBindingMovesToAvailableServer(t *testing.T) {
 func TestConfigurationIsSharedAcrossRegistryReplicas(t *testing.T) {
 	store := newLayerStoreStub()
 	servers := &layerServersStub{}
-	replicaA, replicaB := NewLayer(servers, store), NewLayer(servers, store)
+	replicaA, replicaB := NewLayer(servers, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store), NewLayer(servers, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store)
 
 	require.NoError(t, replicaA.UpdateConfiguration(context.Background(), 1, map[uint32]uint32{1: 2, 571: 3}))
 	config, err := replicaB.Configuration(context.Background(), 1)
@@ -135,13 +180,35 @@ func TestConfigurationIsSharedAcrossRegistryReplicas(t *testing.T) {
 	require.Equal(t, map[uint32]uint32{1: 2, 571: 3}, config)
 }
 
+func TestInitConfigurationKeepsStoredConfiguration(t *testing.T) {
+	store := newLayerStoreStub()
+	layers := NewLayer(&layerServersStub{}, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store)
+
+	applied, err := layers.InitConfiguration(context.Background(), 1, map[uint32]uint32{1: 2})
+	require.NoError(t, err)
+	require.True(t, applied)
+
+	// Autoscaler changed layers count, restarted replica shouldn't reset it.
+	scaled, err := layers.ScaleMapLayers(context.Background(), 1, 1, 2, 4)
+	require.NoError(t, err)
+	require.True(t, scaled)
+
+	applied, err = layers.InitConfiguration(context.Background(), 1, map[uint32]uint32{1: 2})
+	require.NoError(t, err)
+	require.False(t, applied)
+
+	config, err := layers.Configuration(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]uint32{1: 4}, config)
+}
+
 func TestPreferredGameServerSelectionDoesNotChangeGroupBinding(t *testing.T) {
 	store := newLayerStoreStub()
 	require.NoError(t, store.SetConfiguration(context.Background(), 1, map[uint32]uint32{1: 2}))
 	require.NoError(t, store.SetGroupBinding(context.Background(), 1, 77, 1, "layer-1"))
-	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{{ID: "layer-1", Alias: "thrall-onyxia-a"}, {ID: "layer-2", Alias: "jaina-arthas-b"}}}, store)
+	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{{ID: "layer-1", Alias: "thrall-onyxia-a"}, {ID: "layer-2", Alias: "jaina-arthas-b"}}}, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store)
 
-	selection, err := layers.Select(context.Background(), 1, 1, 77, "jaina-arthas-b")
+	selection, err := layers.Select(context.Background(), 1, 1, 77, "jaina-arthas-b", LayerCharacter{})
 	require.NoError(t, err)
 	require.Equal(t, "layer-2", selection.Server.ID)
 	bound, err := store.GroupBinding(context.Background(), 1, 77, 1)
@@ -155,10 +222,35 @@ func TestPreferredGameServerSelectionAcceptsAddress(t *testing.T) {
 	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{
 		{ID: "layer-1", Alias: "thrall-onyxia-a", Address: "10.0.0.1:8085"},
 		{ID: "layer-2", Alias: "jaina-arthas-b", Address: "10.0.0.2:8085"},
-	}}, store)
+	}}, mapPopulationStub{}, LeastLoadedLayerPlacement{}, store)
 
-	selection, err := layers.Select(context.Background(), 1, 1, 0, "10.0.0.2:8085")
+	selection, err := layers.Select(context.Background(), 1, 1, 0, "10.0.0.2:8085", LayerCharacter{})
 	require.NoError(t, err)
 	require.Equal(t, LayerSelectionOK, selection.Status)
 	require.Equal(t, "layer-2", selection.Server.ID)
 }
+
+func TestSelectionUsesPopulationOfTheMap(t *testing.T) {
+	store := newLayerStoreStub()
+	require.NoError(t, store.SetConfiguration(context.Background(), 1, map[uint32]uint32{1: 2}))
+	// layer-1 runs a busy dungeon, but has fewer players in Kalimdor.
+	layers := NewLayer(&layerServersStub{servers: []repo.GameServer{
+		{ID: "layer-1", Alias: "thrall-onyxia-a", ActiveConnections: 500},
+		{ID: "layer-2", Alias: "jaina-arthas-b", ActiveConnections: 120},
+	}}, mapPopulationStub{
+		1:   {"layer-1": 20, "layer-2": 120},
+		533: {"layer-1": 480},
+	}, LeastLoadedLayerPlacement{}, store)
+
+	selection, err := layers.Select(context.Background(), 1, 1, 0, "", LayerCharacter{})
+	require.NoError(t, err)
+	require.Equal(t, "layer-1", selection.Server.ID)
+
+	configured, stats, err := layers.Stats(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), configured)
+	require.Len(t, stats, 2)
+	require.Equal(t, uint32(20), stats[1].Players)
+	require.Equal(t, "layer-1", stats[1].Server.ID)
+	require.Equal(t, uint32(120), stats[0].Players)
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+// LeaderElection elects the leader between servers-registry replicas with the shared lease.
+// Only the leader runs tasks that change state in background, like health checks and maps rebalancing,
+// while every replica serves RPCs from the shared state.
+type LeaderElection struct {
+	lease repo.LeaderLease
+	id    string
+	ttl   time.Duration
+
+	// renewInterval is interval of lease renewals by the leader and acquire attempts by followers.
+	renewInterval time.Duration
+
+	isLeader atomic.Bool
+
+	mu    sync.Mutex
+	tasks []func(ctx context.Context)
+}
+
+// NewLeaderElection creates LeaderElection of the replica with the given ID.
+// Dead leader is replaced within ttl and the renew interval.
+func NewLeaderElection(lease repo.LeaderLease, id string, ttl time.Duration) *LeaderElection {
+	return &LeaderElection{
+		lease:         lease,
+		id:            id,
+		ttl:           ttl,
+		renewInterval: ttl / 3,
+	}
+}
+
+// IsLeader returns true if the replica holds the leadership.
+func (e *LeaderElection) IsLeader() bool {
+	return e.isLeader.Load()
+}
+
+// RunWhileLeader adds task that is started every time the replica becomes the leader.
+// Context of the task is canceled when the leadership is lost.
+func (e *LeaderElection) RunWhileLeader(task func(ctx context.Context)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.tasks = append(e.tasks, task)
+}
+
+// Run takes and renews the leadership until ctx is done, then releases it.
+//
+// Lease TTL starts somewhere during the Acquire call, so the leader counts it from the moment before the call
+// and stops leader tasks one renew interval before the lease can expire if renewals keep failing.
+// This way tasks of the old leader are stopped before another replica can take the lease.
+func (e *LeaderElection) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+
+	var (
+		stopTasks func()
+
+		// leaderUntil is the moment when leader tasks have to be stopped if the lease is not renewed.
+		leaderUntil time.Time
+	)
+
+	stepDown := func() {
+		log.Warn().Str("id", e.id).Msg("Lost servers registry leadership")
+		stopTasks()
+		stopTasks = nil
+	}
+
+	for {
+		acquireCtx, cancelAcquire := ctx, context.CancelFunc(func() {})
+		if stopTasks != nil {
+			// Hanging renewal shouldn't keep leader tasks running after the deadline.
+			acquireCtx, cancelAcquire = context.WithDeadline(ctx, leaderUntil)
+		}
+
+		acquireStart := time.Now()
+		acquired, err := e.lease.Acquire(acquireCtx, e.id, e.ttl)
+		cancelAcquire()
+		if err != nil {
+			log.Error().Err(err).Msg("can't acquire servers registry leadership")
+		}
+
+		switch {
+		case acquired:
+			leaderUntil = acquireStart.Add(e.ttl - e.renewInterval)
+			if stopTasks == nil {
+				log.Info().Str("id", e.id).Msg("Became servers registry leader")
+				stopTasks = e.startTasks(ctx)
+			}
+		case stopTasks != nil && (err == nil || !time.Now().Before(leaderUntil)):
+			// Lease is taken by another replica or can expire soon without renewal.
+			stepDown()
+		}
+
+		var (
+			deadlineTimer *time.Timer
+			deadline      <-chan time.Time
+		)
+		if stopTasks != nil {
+			deadlineTimer = time.NewTimer(time.Until(leaderUntil))
+			deadline = deadlineTimer.C
+		}
+
+		select {
+		case <-ticker.C:
+		case <-deadline:
+			// Renewals failed until the deadline.
+			stepDown()
+		case <-ctx.Done():
+			if stopTasks != nil {
+				stopTasks()
+
+				releaseCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+				if err = e.lease.Release(releaseCtx, e.id); err != nil {
+					log.Error().Err(err).Msg("can't release servers registry leadership")
+				}
+				cancel()
+			}
+			return
+		}
+
+		if deadlineTimer != nil {
+			deadlineTimer.Stop()
+		}
+	}
+}
+
+// startTasks starts leader tasks and returns function that stops them and waits until they return.
+func (e *LeaderElection) startTasks(ctx context.Context) func() {
+	e.isLeader.Store(true)
+
+	e.mu.Lock()
+	tasks := append([]func(ctx context.Context){}, e.tasks...)
+	e.mu.Unlock()
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	wg := sync.WaitGroup{}
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task func(ctx context.Context)) {
+			defer wg.Done()
+			task(leaderCtx)
+		}(task)
+	}
+
+	return func() {
+		e.isLeader.Store(false)
+		cancel()
+		wg.Wait()
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// leaderLeaseStub is in memory lease without expiration.
+type leaderLeaseStub struct {
+	mu     sync.Mutex
+	holder string
+}
+
+func (l *leaderLeaseStub) Acquire(_ context.Context, holderID string, _ time.Duration) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.holder == "" {
+		l.holder = holderID
+	}
+	return l.holder == holderID, nil
+}
+
+func (l *leaderLeaseStub) Release(_ context.Context, holderID string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.holder == holderID {
+		l.holder = ""
+	}
+	return nil
+}
+
+func (l *leaderLeaseStub) Holder(context.Context) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.holder, nil
+}
+
+func (l *leaderLeaseStub) steal(holderID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.holder = holderID
+}
+
+func TestLeaderElectionRunsTasksOnlyOnLeader(t *testing.T) {
+	lease := &leaderLeaseStub{}
+	first := NewLeaderElection(lease, "first", 30*time.Millisecond)
+	second := NewLeaderElection(lease, "second", 30*time.Millisecond)
+
+	running := make(chan string, 10)
+	stopped := make(chan string, 10)
+	for _, election := range []*LeaderElection{first, second} {
+		id := election.id
+		election.RunWhileLeader(func(ctx context.Context) {
+			running <- id
+			<-ctx.Done()
+			stopped <- id
+		})
+	}
+
+	firstCtx, stopFirst := context.WithCancel(context.Background())
+	firstDone := make(chan struct{})
+	go func() {
+		first.Run(firstCtx)
+		close(firstDone)
+	}()
+	require.Equal(t, "first", <-running)
+	require.True(t, first.IsLeader())
+
+	secondCtx, stopSecond := context.WithCancel(context.Background())
+	defer stopSecond()
+	go second.Run(secondCtx)
+
+	// Graceful shutdown releases the lease, so the follower takes over with the next attempt.
+	stopFirst()
+	<-firstDone
+	require.Equal(t, "first", <-stopped)
+	require.False(t, first.IsLeader())
+
+	select {
+	case id := <-running:
+		require.Equal(t, "second", id)
+	case <-time.After(time.Second):
+		t.Fatal("follower didn't take over the leadership")
+	}
+	require.True(t, second.IsLeader())
+}
+
+func TestLeaderElectionStepsDownWhenLeaseIsLost(t *testing.T) {
+	lease := &leaderLeaseStub{}
+	election := NewLeaderElection(lease, "first", 30*time.Millisecond)
+
+	stopped := make(chan struct{})
+	election.RunWhileLeader(func(ctx context.Context) {
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go election.Run(ctx)
+
+	require.Eventually(t, election.IsLeader, time.Second, time.Millisecond)
+
+	lease.steal("second")
+
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("leader tasks are not stopped after the lease is lost")
+	}
+	require.False(t, election.IsLeader())
+}
+
+// failingLeaderLease is lease that can start failing or hanging renewals of the holder.
+type failingLeaderLease struct {
+	leaderLeaseStub
+
+	mu          sync.Mutex
+	fail        bool
+	hang        bool
+	lastAcquire time.Time
+}
+
+func (l *failingLeaderLease) Acquire(ctx context.Context, holderID string, ttl time.Duration) (bool, error) {
+	l.mu.Lock()
+	fail, hang := l.fail, l.hang
+	if !fail && !hang {
+		l.lastAcquire = time.Now()
+	}
+	l.mu.Unlock()
+
+	switch {
+	case hang:
+		<-ctx.Done()
+		return false, ctx.Err()
+	case fail:
+		return false, errors.New("redis is down")
+	}
+	return l.leaderLeaseStub.Acquire(ctx, holderID, ttl)
+}
+
+func (l *failingLeaderLease) setState(fail, hang bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fail, l.hang = fail, hang
+}
+
+func (l *failingLeaderLease) lastSuccessfulAcquire() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lastAcquire
+}
+
+func TestLeaderElectionStepsDownBeforeLeaseExpires(t *testing.T) {
+	const ttl = 90 * time.Millisecond
+
+	for name, hang := range map[string]bool{"failing renewals": false, "hanging renewals": true} {
+		t.Run(name, func(t *testing.T) {
+			lease := &failingLeaderLease{}
+			election := NewLeaderElection(lease, "first", ttl)
+
+			stoppedAt := make(chan time.Time, 1)
+			election.RunWhileLeader(func(ctx context.Context) {
+				<-ctx.Done()
+				stoppedAt <- time.Now()
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go election.Run(ctx)
+
+			require.Eventually(t, election.IsLeader, time.Second, time.Millisecond)
+
+			lease.setState(!hang, hang)
+
+			select {
+			case at := <-stoppedAt:
+				// Lease can't expire earlier than ttl after the start of the last successful renewal.
+				require.Less(t, at.Sub(lease.lastSuccessfulAcquire()), ttl)
+			case <-time.After(time.Second):
+				t.Fatal("leader tasks are not stopped while renewals fail")
+			}
+			require.False(t, election.IsLeader())
+		})
+	}
+}
@@ -0,0 +1,732 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+)
+
+// MapMigrationSettings configures MapMigrator.
+type MapMigrationSettings struct {
+	// LoadTimeout limits waiting for the destination server to load the map, the step is rolled back after it.
+	LoadTimeout time.Duration
+
+	// RedirectBatchSize is max players count that every gateway redirects per batch.
+	RedirectBatchSize uint32
+
+	// RedirectBatchInterval is time between two batches of redirects.
+	RedirectBatchInterval time.Duration
+
+	// RedirectTimeout limits redirecting of the map players. Players that are left after it are disconnected
+	// by releasing of the map on the source server and reconnected by their gateways.
+	RedirectTimeout time.Duration
+}
+
+// MapMigrator moves maps between game servers one by one, so players of the moved map
+// are redirected in batches instead of being disconnected at once.
+//
+// Every step assigns map to the destination server and waits until it's loaded there,
+// then new players of the map go to the destination server and current players are
+// redirected by gateways. When the map has no players left, it's released on the source server.
+type MapMigrator struct {
+	r          repo.GameServerRepo
+	migrations repo.MapMigrationRepo
+	layers     repo.LayerStore
+	population ServersMapPopulation
+	eProducer  events.ServerRegistryProducer
+	settings   MapMigrationSettings
+
+	// realms are realms which migrations are resumed from the repository.
+	realms []uint32
+
+	// pollInterval is interval of checks of the destination server loading state.
+	pollInterval time.Duration
+
+	// resumeInterval is interval of loading migrations that were staged by other servers registry replicas.
+	resumeInterval time.Duration
+
+	mu      sync.Mutex
+	running bool
+	queue   []*repo.MapMigration
+	queued  map[string]struct{}
+	notify  chan struct{}
+}
+
+// NewMapMigrator creates MapMigrator, layers store is used to lock the realm while assignments change and can be nil.
+func NewMapMigrator(
+	r repo.GameServerRepo, migrations repo.MapMigrationRepo, layers repo.LayerStore,
+	population ServersMapPopulation, eProducer events.ServerRegistryProducer, settings MapMigrationSettings,
+	supportedRealmIDs []uint32,
+) *MapMigrator {
+	return &MapMigrator{
+		r:              r,
+		migrations:     migrations,
+		layers:         layers,
+		population:     population,
+		eProducer:      eProducer,
+		settings:       settings,
+		realms:         supportedRealmIDs,
+		pollInterval:   time.Second,
+		resumeInterval: 5 * time.Second,
+		queued:         map[string]struct{}{},
+		notify:         make(chan struct{}, 1),
+	}
+}
+
+// Stage replaces moves of maps between alive servers in the new assignments with migration steps,
+// so moved maps stay on their source servers until the migration moves them.
+// Returns nil if there is nothing to migrate.
+func (m *MapMigrator) Stage(before, after []repo.GameServer) *repo.MapMigration {
+	steps := stageMapMoves(before, after)
+	if len(steps) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	return &repo.MapMigration{
+		ID:           uuid.NewString(),
+		RealmID:      after[0].RealmID,
+		IsCrossRealm: after[0].IsCrossRealm,
+		Status:       repo.MapMigrationStatusRunning,
+		Steps:        steps,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// Enqueue stores migration and schedules it after migrations that are already queued.
+// If migrator is not running on this replica, migration is executed by the leader replica.
+func (m *MapMigrator) Enqueue(ctx context.Context, migration *repo.MapMigration) error {
+	if err := m.migrations.Save(ctx, migration); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	if m.running {
+		m.push(migration)
+	}
+	m.mu.Unlock()
+
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Resume schedules running migrations of the repository that are not queued yet,
+// they were interrupted by the leader change or staged by another replica.
+func (m *MapMigrator) Resume(ctx context.Context) error {
+	var running []repo.MapMigration
+	for _, realmID := range m.realms {
+		migrations, err := m.migrations.ListByRealm(ctx, realmID, false)
+		if err != nil {
+			return err
+		}
+		running = append(running, migrations...)
+	}
+
+	migrations, err := m.migrations.ListByRealm(ctx, 0, true)
+	if err != nil {
+		return err
+	}
+	running = append(running, migrations...)
+
+	sort.SliceStable(running, func(i, j int) bool {
+		return running[i].CreatedAt.Before(running[j].CreatedAt)
+	})
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i := range running {
+		if running[i].Status == repo.MapMigrationStatusRunning {
+			m.push(&running[i])
+		}
+	}
+	return nil
+}
+
+// push adds migration to the queue if it's not queued yet, should be called under the lock.
+func (m *MapMigrator) push(migration *repo.MapMigration) {
+	if _, queued := m.queued[migration.ID]; queued {
+		return
+	}
+	m.queued[migration.ID] = struct{}{}
+	m.queue = append(m.queue, migration)
+}
+
+// Migrations returns migrations of the realm with their progress.
+func (m *MapMigrator) Migrations(ctx context.Context, realmID uint32, isCrossRealm bool) ([]repo.MapMigration, error) {
+	return m.migrations.ListByRealm(ctx, realmID, isCrossRealm)
+}
+
+// Run executes queued and resumed migrations until ctx is done.
+// Should be run only by the leader replica.
+func (m *MapMigrator) Run(ctx context.Context) {
+	m.mu.Lock()
+	m.running = true
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		m.running = false
+		m.queue = nil
+		m.queued = map[string]struct{}{}
+		m.mu.Unlock()
+	}()
+
+	ticker := time.NewTicker(m.resumeInterval)
+	defer ticker.Stop()
+
+	if err := m.Resume(ctx); err != nil {
+		log.Error().Err(err).Msg("can't resume map migrations")
+	}
+
+	for {
+		migration := m.next()
+		if migration == nil {
+			select {
+			case <-m.notify:
+				continue
+			case <-ticker.C:
+				if err := m.Resume(ctx); err != nil {
+					log.Error().Err(err).Msg("can't resume map migrations")
+				}
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		m.Migrate(ctx, migration)
+
+		m.mu.Lock()
+		delete(m.queued, migration.ID)
+		m.mu.Unlock()
+	}
+}
+
+func (m *MapMigrator) next() *repo.MapMigration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.queue) == 0 {
+		return nil
+	}
+
+	migration := m.queue[0]
+	m.queue = m.queue[1:]
+	return migration
+}
+
+// Migrate executes steps of the migration one by one. Failed steps are rolled back
+// and don't stop the migration. Steps that failed after players redirect started are rolled forward instead,
+// since some players are on the destination server already. If ctx is done (e.g. leadership is lost),
+// migration is stopped as is and stays running, so the next leader resumes it, rolls back the interrupted
+// loading step or continues the interrupted redirecting step.
+func (m *MapMigrator) Migrate(ctx context.Context, migration *repo.MapMigration) {
+	for i := range migration.Steps {
+		if ctx.Err() != nil {
+			m.logStopped(migration)
+			return
+		}
+
+		var err error
+		step := &migration.Steps[i]
+		switch step.Status {
+		case repo.MapMigrationStepLoading:
+			m.rollbackInterrupted(ctx, migration, step)
+			continue
+		case repo.MapMigrationStepRedirecting:
+			err = m.resumeRedirecting(ctx, migration, step)
+		case repo.MapMigrationStepPending:
+			err = m.migrateStep(ctx, migration, step)
+		default:
+			continue
+		}
+
+		if err == nil {
+			continue
+		}
+
+		if ctx.Err() != nil {
+			m.logStopped(migration)
+			return
+		}
+
+		step.Error = err.Error()
+		if step.Status == repo.MapMigrationStepRedirecting {
+			if !m.rollForwardFailed(ctx, migration, step, err) {
+				// Assignments are not changed, the step is retried when the migration is resumed.
+				return
+			}
+			continue
+		}
+
+		log.Error().
+			Err(err).
+			Str("migrationID", migration.ID).
+			Uint32("mapID", step.MapID).
+			Str("from", step.FromServerID).
+			Str("to", step.ToServerID).
+			Msg("Map migration step failed, rolling back")
+
+		if err = m.rollback(ctx, migration, step); err != nil {
+			log.Error().Err(err).Str("migrationID", migration.ID).Uint32("mapID", step.MapID).Msg("can't roll back map migration step")
+		}
+		step.Status = repo.MapMigrationStepRolledBack
+		m.save(ctx, migration)
+	}
+
+	if ctx.Err() != nil {
+		m.logStopped(migration)
+		return
+	}
+
+	migration.Status = repo.MapMigrationStatusDone
+	for _, step := range migration.Steps {
+		if step.Status == repo.MapMigrationStepRolledBack {
+			migration.Status = repo.MapMigrationStatusFailed
+		}
+	}
+	m.save(ctx, migration)
+
+	log.Info().Str("migrationID", migration.ID).Str("status", migration.Status).Msg("Map migration finished")
+}
+
+func (m *MapMigrator) logStopped(migration *repo.MapMigration) {
+	log.Warn().Str("migrationID", migration.ID).Msg("Map migration is stopped, the next leader resumes it")
+}
+
+// rollbackInterrupted rolls back loading step that was in progress when the previous leader stopped.
+func (m *MapMigrator) rollbackInterrupted(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) {
+	log.Warn().Str("migrationID", migration.ID).Uint32("mapID", step.MapID).Msg("Rolling back interrupted map migration step")
+
+	if err := m.rollback(ctx, migration, step); err != nil {
+		log.Error().Err(err).Str("migrationID", migration.ID).Uint32("mapID", step.MapID).Msg("can't roll back map migration step")
+	}
+	step.Error = "interrupted by servers registry leader change"
+	step.Status = repo.MapMigrationStepRolledBack
+	m.save(ctx, migration)
+}
+
+// rollForwardFailed completes step that failed while its players were redirected.
+// Returns false if assignments can't be changed.
+func (m *MapMigrator) rollForwardFailed(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep, stepErr error) bool {
+	log.Error().
+		Err(stepErr).
+		Str("migrationID", migration.ID).
+		Uint32("mapID", step.MapID).
+		Str("from", step.FromServerID).
+		Str("to", step.ToServerID).
+		Msg("Map players redirect failed, rolling forward")
+
+	moved, err := m.rollForward(ctx, migration, step)
+	if err != nil {
+		log.Error().Err(err).Str("migrationID", migration.ID).Uint32("mapID", step.MapID).Msg("can't roll forward map migration step")
+		m.save(ctx, migration)
+		return false
+	}
+
+	if moved {
+		step.Status = repo.MapMigrationStepDone
+	} else {
+		step.Status = repo.MapMigrationStepRolledBack
+	}
+	m.save(ctx, migration)
+	return true
+}
+
+// resumeRedirecting continues redirecting of the step that was interrupted when the previous leader stopped.
+func (m *MapMigrator) resumeRedirecting(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) error {
+	log.Warn().Str("migrationID", migration.ID).Uint32("mapID", step.MapID).Msg("Resuming interrupted map players redirect")
+
+	destination, err := m.r.One(ctx, step.ToServerID)
+	if err != nil {
+		return err
+	}
+	if destination == nil {
+		return errors.New("destination game server is gone")
+	}
+
+	return m.finishRedirecting(ctx, migration, step, destination)
+}
+
+func (m *MapMigrator) migrateStep(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) error {
+	started, err := m.assignToDestination(ctx, migration, step)
+	if err != nil {
+		return err
+	}
+	if !started {
+		step.Status = repo.MapMigrationStepSkipped
+		m.save(ctx, migration)
+		return nil
+	}
+
+	step.Status = repo.MapMigrationStepLoading
+	m.save(ctx, migration)
+
+	destination, err := m.waitForMapLoaded(ctx, step.ToServerID, step.MapID)
+	if err != nil {
+		return err
+	}
+
+	err = m.reassign(ctx, migration, func(servers []repo.GameServer) {
+		if from := serverIndex(servers, step.FromServerID); from >= 0 {
+			servers[from].ReleasingMaps = addMap(servers[from].ReleasingMaps, step.MapID)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	step.Status = repo.MapMigrationStepRedirecting
+	m.save(ctx, migration)
+
+	return m.finishRedirecting(ctx, migration, step, destination)
+}
+
+// finishRedirecting redirects players of the map to the destination server and releases the map on the source server.
+func (m *MapMigrator) finishRedirecting(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep, destination *repo.GameServer) error {
+	if err := m.redirectPlayers(ctx, migration, step, destination); err != nil {
+		return err
+	}
+
+	if err := m.releaseOnSource(ctx, migration, step); err != nil {
+		return err
+	}
+
+	step.Status = repo.MapMigrationStepDone
+	step.PlayersLeft = 0
+	m.save(ctx, migration)
+
+	return nil
+}
+
+// assignToDestination assigns map to the destination server, it returns false if the step is not actual anymore.
+func (m *MapMigrator) assignToDestination(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) (bool, error) {
+	started := false
+	err := m.reassign(ctx, migration, func(servers []repo.GameServer) {
+		from, to := serverIndex(servers, step.FromServerID), serverIndex(servers, step.ToServerID)
+		if from < 0 || to < 0 ||
+			!containsMap(servers[from].AssignedMapsToHandle, step.MapID) ||
+			containsMap(servers[to].AssignedMapsToHandle, step.MapID) ||
+			!mapAvailable(servers[to], step.MapID) {
+			return
+		}
+
+		servers[to].AssignedMapsToHandle = addMap(servers[to].AssignedMapsToHandle, step.MapID)
+		servers[to].AssignedButPendingMaps = append(servers[to].AssignedButPendingMaps, step.MapID)
+		started = true
+	})
+	return started, err
+}
+
+func (m *MapMigrator) waitForMapLoaded(ctx context.Context, serverID string, mapID uint32) (*repo.GameServer, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, m.settings.LoadTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(m.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		server, err := m.r.One(timeoutCtx, serverID)
+		if err != nil {
+			return nil, err
+		}
+		if server == nil {
+			return nil, errors.New("destination game server is gone")
+		}
+		if !containsMap(server.AssignedButPendingMaps, mapID) {
+			return server, nil
+		}
+
+		select {
+		case <-timeoutCtx.Done():
+			return nil, fmt.Errorf("destination game server didn't load map: %w", timeoutCtx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// redirectPlayers asks gateways to redirect players of the map in batches until the source server has no players
+// on the map or redirect timeout is reached.
+func (m *MapMigrator) redirectPlayers(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep, destination *repo.GameServer) error {
+	deadline := time.Now().Add(m.settings.RedirectTimeout)
+	for {
+		players, err := m.population.ServersMapPlayers(ctx)
+		if err != nil {
+			return err
+		}
+
+		step.PlayersLeft = players[step.FromServerID][step.MapID]
+		m.save(ctx, migration)
+
+		if step.PlayersLeft == 0 {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			log.Warn().
+				Str("migrationID", migration.ID).
+				Uint32("mapID", step.MapID).
+				Uint32("playersLeft", step.PlayersLeft).
+				Msg("Map players redirect timed out, releasing map with players left")
+			return nil
+		}
+
+		err = m.eProducer.MapPlayersRedirect(&events.ServerRegistryEventMapPlayersRedirectPayload{
+			MigrationID:             migration.ID,
+			RealmID:                 migration.RealmID,
+			IsCrossRealm:            migration.IsCrossRealm,
+			MapID:                   step.MapID,
+			FromGameServerID:        step.FromServerID,
+			ToGameServerID:          destination.ID,
+			ToGameServerAddress:     destination.Address,
+			ToGameServerGRPCAddress: destination.GRPCAddress,
+			ToGameServerAlias:       destination.Alias,
+			BatchSize:               m.settings.RedirectBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("can't produce map players redirect event: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(m.settings.RedirectBatchInterval):
+		}
+	}
+}
+
+func (m *MapMigrator) releaseOnSource(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) error {
+	return m.reassign(ctx, migration, func(servers []repo.GameServer) {
+		if from := serverIndex(servers, step.FromServerID); from >= 0 {
+			servers[from].AssignedMapsToHandle = removeMap(servers[from].AssignedMapsToHandle, step.MapID)
+			servers[from].ReleasingMaps = removeMap(servers[from].ReleasingMaps, step.MapID)
+		}
+	})
+}
+
+// rollback returns map of the step to the source server, it's used only before players redirect starts.
+// If the source server is gone, the map is kept on the destination server.
+func (m *MapMigrator) rollback(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) error {
+	return m.reassign(ctx, migration, func(servers []repo.GameServer) {
+		from := serverIndex(servers, step.FromServerID)
+		if from < 0 {
+			return
+		}
+		servers[from].ReleasingMaps = removeMap(servers[from].ReleasingMaps, step.MapID)
+
+		if to := serverIndex(servers, step.ToServerID); to >= 0 {
+			servers[to].AssignedMapsToHandle = removeMap(servers[to].AssignedMapsToHandle, step.MapID)
+			servers[to].AssignedButPendingMaps = removeMap(servers[to].AssignedButPendingMaps, step.MapID)
+		}
+	})
+}
+
+// rollForward moves map of the step to the destination server without waiting for the rest of the players,
+// they are disconnected by releasing of the map on the source server and reconnected by their gateways.
+// Players that are redirected already stay on the destination server. If the destination server is gone,
+// the map stays on the source server. Returns true if the map is moved.
+func (m *MapMigrator) rollForward(ctx context.Context, migration *repo.MapMigration, step *repo.MapMigrationStep) (bool, error) {
+	moved := false
+	err := m.reassign(ctx, migration, func(servers []repo.GameServer) {
+		from, to := serverIndex(servers, step.FromServerID), serverIndex(servers, step.ToServerID)
+		if to >= 0 && containsMap(servers[to].AssignedMapsToHandle, step.MapID) {
+			moved = true
+			if from >= 0 {
+				servers[from].AssignedMapsToHandle = removeMap(servers[from].AssignedMapsToHandle, step.MapID)
+				servers[from].ReleasingMaps = removeMap(servers[from].ReleasingMaps, step.MapID)
+			}
+			return
+		}
+
+		if from >= 0 {
+			servers[from].ReleasingMaps = removeMap(servers[from].ReleasingMaps, step.MapID)
+		}
+	})
+	return moved, err
+}
+
+// reassign changes assignments of the migration realm servers with f, stores changed servers
+// and notifies about reassigned maps.
+func (m *MapMigrator) reassign(ctx context.Context, migration *repo.MapMigration, f func(servers []repo.GameServer)) error {
+	if m.layers != nil && !migration.IsCrossRealm {
+		unlock, err := m.layers.LockRealm(ctx, migration.RealmID)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	var (
+		servers []repo.GameServer
+		err     error
+	)
+	if migration.IsCrossRealm {
+		servers, err = m.r.ListOfCrossRealms(ctx)
+	} else {
+		servers, err = m.r.ListByRealm(ctx, migration.RealmID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Repository can share maps slices with the returned servers, so f changes copies.
+	before, servers := copyServers(servers), copyServers(servers)
+	f(servers)
+
+	changed := []events.GameServer{}
+	for i := range servers {
+		if sameMaps(before[i].AssignedMapsToHandle, servers[i].AssignedMapsToHandle) &&
+			sameMaps(before[i].AssignedButPendingMaps, servers[i].AssignedButPendingMaps) &&
+			sameMaps(before[i].ReleasingMaps, servers[i].ReleasingMaps) {
+			continue
+		}
+
+		assigned := append([]uint32(nil), servers[i].AssignedMapsToHandle...)
+		pending := append([]uint32(nil), servers[i].AssignedButPendingMaps...)
+		releasing := append([]uint32(nil), servers[i].ReleasingMaps...)
+		err = m.r.Update(ctx, servers[i].ID, func(latest *repo.GameServer) *repo.GameServer {
+			latest.AssignedMapsToHandle = assigned
+			latest.AssignedButPendingMaps = pending
+			latest.ReleasingMaps = releasing
+			return latest
+		})
+		if err != nil {
+			return err
+		}
+
+		if !sameMaps(before[i].AssignedMapsToHandle, servers[i].AssignedMapsToHandle) {
+			changed = append(changed, events.GameServer{
+				ID:                      servers[i].ID,
+				Address:                 servers[i].Address,
+				RealmID:                 servers[i].RealmID,
+				IsCrossRealm:            servers[i].IsCrossRealm,
+				AvailableMaps:           servers[i].AvailableMaps,
+				OldAssignedMapsToHandle: before[i].AssignedMapsToHandle,
+				NewAssignedMapsToHandle: servers[i].AssignedMapsToHandle,
+			})
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	err = m.eProducer.GSMapsReassigned(&events.ServerRegistryEventGSMapsReassignedPayload{
+		Servers: changed,
+	})
+	if err != nil {
+		return fmt.Errorf("can't send event for maps reassigned, err %w", err)
+	}
+
+	return nil
+}
+
+func (m *MapMigrator) save(ctx context.Context, migration *repo.MapMigration) {
+	migration.UpdatedAt = time.Now()
+	if err := m.migrations.Save(ctx, migration); err != nil {
+		log.Error().Err(err).Str("migrationID", migration.ID).Msg("can't save map migration")
+	}
+}
+
+// stageMapMoves finds maps that left one server and were assigned to another one. Such moves are
+// reverted in after and returned as migration steps. Maps of removed servers and maps that were
+// only added or removed (e.g. by layers configuration) are not migrated.
+func stageMapMoves(before, after []repo.GameServer) []repo.MapMigrationStep {
+	previous := make(map[string][]uint32, len(before))
+	for _, server := range before {
+		previous[server.ID] = server.AssignedMapsToHandle
+	}
+
+	lost := map[uint32][]int{}
+	gained := map[uint32][]int{}
+	for i := range after {
+		old, found := previous[after[i].ID]
+		if !found {
+			continue
+		}
+
+		for _, mapID := range old {
+			if !containsMap(after[i].AssignedMapsToHandle, mapID) {
+				lost[mapID] = append(lost[mapID], i)
+			}
+		}
+
+		for _, mapID := range after[i].AssignedMapsToHandle {
+			// Players of partitioned maps cross regions by themselves, so regions are not migrated.
+			if !containsMap(old, mapID) && len(after[i].MapRegions(mapID)) == 0 {
+				gained[mapID] = append(gained[mapID], i)
+			}
+		}
+	}
+
+	mapIDs := make([]uint32, 0, len(lost))
+	for mapID := range lost {
+		mapIDs = append(mapIDs, mapID)
+	}
+	sort.Slice(mapIDs, func(i, j int) bool { return mapIDs[i] < mapIDs[j] })
+
+	steps := []repo.MapMigrationStep{}
+	for _, mapID := range mapIDs {
+		sources, destinations := lost[mapID], gained[mapID]
+		for k := 0; k < len(sources) && k < len(destinations); k++ {
+			from, to := sources[k], destinations[k]
+			after[from].AssignedMapsToHandle = addMap(after[from].AssignedMapsToHandle, mapID)
+			after[to].AssignedMapsToHandle = removeMap(after[to].AssignedMapsToHandle, mapID)
+
+			steps = append(steps, repo.MapMigrationStep{
+				MapID:        mapID,
+				FromServerID: after[from].ID,
+				ToServerID:   after[to].ID,
+				Status:       repo.MapMigrationStepPending,
+			})
+		}
+	}
+
+	return steps
+}
+
+func serverIndex(servers []repo.GameServer, id string) int {
+	for i := range servers {
+		if servers[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// addMap adds map to the sorted maps list.
+func addMap(maps []uint32, mapID uint32) []uint32 {
+	if containsMap(maps, mapID) {
+		return maps
+	}
+	maps = append(maps, mapID)
+	sort.Slice(maps, func(i, j int) bool { return maps[i] < maps[j] })
+	return maps
+}
+
+func sameMaps(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/events/mocks"
+)
+
+type mapMigrationRepoStub struct {
+	mu         sync.Mutex
+	migrations map[string]repo.MapMigration
+}
+
+func (s *mapMigrationRepoStub) Save(_ context.Context, migration *repo.MapMigration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.migrations == nil {
+		s.migrations = map[string]repo.MapMigration{}
+	}
+	cp := *migration
+	cp.Steps = append([]repo.MapMigrationStep(nil), migration.Steps...)
+	s.migrations[migration.ID] = cp
+	return nil
+}
+
+func (s *mapMigrationRepoStub) ListByRealm(_ context.Context, realmID uint32, isCrossRealm bool) ([]repo.MapMigration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := []repo.MapMigration{}
+	for _, migration := range s.migrations {
+		if migration.RealmID == realmID && migration.IsCrossRealm == isCrossRealm {
+			res = append(res, migration)
+		}
+	}
+	return res, nil
+}
+
+// redirectedPopulation has players on the source server until the first redirect.
+type redirectedPopulation struct {
+	mu         sync.Mutex
+	players    map[string]map[uint32]uint32
+	redirected bool
+}
+
+func (p *redirectedPopulation) ServersMapPlayers(context.Context) (map[string]map[uint32]uint32, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.redirected {
+		return map[string]map[uint32]uint32{}, nil
+	}
+	return p.players, nil
+}
+
+func (p *redirectedPopulation) redirect() {
+	p.mu.Lock()
+	p.redirected = true
+	p.mu.Unlock()
+}
+
+func newTestMapMigrator(t *testing.T, servers []repo.GameServer, loadMaps bool) (*MapMigrator, repo.GameServerRepo, *redirectedPopulation, *mocks.ServerRegistryProducer) {
+	gameServers := repo.NewGameServerInMemRepo()
+	for i := range servers {
+		require.NoError(t, gameServers.Upsert(context.Background(), &servers[i]))
+	}
+
+	population := &redirectedPopulation{players: map[string]map[uint32]uint32{"a": {1: 3}}}
+
+	producer := mocks.NewServerRegistryProducer(t)
+	producer.On("GSMapsReassigned", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		if !loadMaps {
+			return
+		}
+		// Game servers load new maps right away.
+		for _, server := range args.Get(0).(*events.ServerRegistryEventGSMapsReassignedPayload).Servers {
+			newMaps := server.OnlyNewMaps()
+			_ = gameServers.Update(context.Background(), server.ID, func(s *repo.GameServer) *repo.GameServer {
+				pending := []uint32{}
+				for _, mapID := range s.AssignedButPendingMaps {
+					if !containsMap(newMaps, mapID) {
+						pending = append(pending, mapID)
+					}
+				}
+				s.AssignedButPendingMaps = pending
+				return s
+			})
+		}
+	}).Maybe()
+	producer.On("MapPlayersRedirect", mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		population.redirect()
+	}).Maybe()
+
+	migrator := NewMapMigrator(gameServers, &mapMigrationRepoStub{}, nil, population, producer, MapMigrationSettings{
+		LoadTimeout:           50 * time.Millisecond,
+		RedirectBatchSize:     2,
+		RedirectBatchInterval: time.Millisecond,
+		RedirectTimeout:       time.Second,
+	}, []uint32{1})
+	migrator.pollInterval = time.Millisecond
+
+	return migrator, gameServers, population, producer
+}
+
+func TestStageMapMoves(t *testing.T) {
+	before := []repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0, 1, 530}},
+		{ID: "b", AssignedMapsToHandle: []uint32{571}},
+		{ID: "new"},
+	}
+	after := []repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0}},
+		{ID: "b", AssignedMapsToHandle: []uint32{1, 571, 609}},
+		{ID: "new", AssignedMapsToHandle: []uint32{530}},
+	}
+
+	steps := stageMapMoves(before, after)
+
+	require.Equal(t, []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+		{MapID: 530, FromServerID: "a", ToServerID: "new", Status: repo.MapMigrationStepPending},
+	}, steps)
+	require.Equal(t, []uint32{0, 1, 530}, after[0].AssignedMapsToHandle, "moved maps stay on the source")
+	require.Equal(t, []uint32{571, 609}, after[1].AssignedMapsToHandle, "not moved maps are assigned at once")
+	require.Empty(t, after[2].AssignedMapsToHandle)
+}
+
+func TestStageMapMovesIgnoresRemovedServers(t *testing.T) {
+	before := []repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0}},
+		{ID: "dead", AssignedMapsToHandle: []uint32{1}},
+	}
+	after := []repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0, 1}},
+	}
+
+	require.Empty(t, stageMapMoves(before, after))
+	require.Equal(t, []uint32{0, 1}, after[0].AssignedMapsToHandle)
+}
+
+func TestMapMigratorMovesMapAfterRedirect(t *testing.T) {
+	migrator, gameServers, _, producer := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}},
+		{ID: "b", RealmID: 1, Address: "b:8085", AssignedMapsToHandle: []uint32{571}},
+	}, true)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Status: repo.MapMigrationStatusRunning, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+	}}
+	require.NoError(t, migrator.Enqueue(context.Background(), migration))
+	require.Nil(t, migrator.next(), "migration is executed by the leader")
+
+	require.NoError(t, migrator.Resume(context.Background()))
+	migrator.Migrate(context.Background(), migrator.next())
+
+	a, _ := gameServers.One(context.Background(), "a")
+	b, _ := gameServers.One(context.Background(), "b")
+	require.Equal(t, []uint32{0}, a.AssignedMapsToHandle)
+	require.Empty(t, a.ReleasingMaps)
+	require.Equal(t, []uint32{1, 571}, b.AssignedMapsToHandle)
+	require.Empty(t, b.AssignedButPendingMaps)
+
+	producer.AssertCalled(t, "MapPlayersRedirect", &events.ServerRegistryEventMapPlayersRedirectPayload{
+		MigrationID:         "m",
+		RealmID:             1,
+		MapID:               1,
+		FromGameServerID:    "a",
+		ToGameServerID:      "b",
+		ToGameServerAddress: "b:8085",
+		BatchSize:           2,
+	})
+
+	migrations, err := migrator.Migrations(context.Background(), 1, false)
+	require.NoError(t, err)
+	require.Len(t, migrations, 1)
+	require.Equal(t, repo.MapMigrationStatusDone, migrations[0].Status)
+	require.Equal(t, repo.MapMigrationStepDone, migrations[0].Steps[0].Status)
+}
+
+func TestMapMigratorRollsBackWhenMapIsNotLoaded(t *testing.T) {
+	migrator, gameServers, _, producer := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{571}},
+	}, false)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+	}}
+	migrator.Migrate(context.Background(), migration)
+
+	a, _ := gameServers.One(context.Background(), "a")
+	b, _ := gameServers.One(context.Background(), "b")
+	require.Equal(t, []uint32{0, 1}, a.AssignedMapsToHandle)
+	require.Equal(t, []uint32{571}, b.AssignedMapsToHandle)
+	require.Empty(t, b.AssignedButPendingMaps)
+
+	require.Equal(t, repo.MapMigrationStatusFailed, migration.Status)
+	require.Equal(t, repo.MapMigrationStepRolledBack, migration.Steps[0].Status)
+	require.NotEmpty(t, migration.Steps[0].Error)
+	producer.AssertNotCalled(t, "MapPlayersRedirect", mock.Anything)
+}
+
+func TestMapMigratorSkipsOutdatedStep(t *testing.T) {
+	migrator, _, _, producer := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{571}},
+	}, true)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+	}}
+	migrator.Migrate(context.Background(), migration)
+
+	require.Equal(t, repo.MapMigrationStatusDone, migration.Status)
+	require.Equal(t, repo.MapMigrationStepSkipped, migration.Steps[0].Status)
+	producer.AssertNotCalled(t, "GSMapsReassigned", mock.Anything)
+}
+
+func TestMapMigratorResumesRedirectInterruptedByLeaderChange(t *testing.T) {
+	migrator, gameServers, _, producer := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}, ReleasingMaps: []uint32{1}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{1, 571}},
+	}, true)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Status: repo.MapMigrationStatusRunning, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepRedirecting},
+	}}
+	migrator.Migrate(context.Background(), migration)
+
+	a, _ := gameServers.One(context.Background(), "a")
+	b, _ := gameServers.One(context.Background(), "b")
+	require.Equal(t, []uint32{0}, a.AssignedMapsToHandle)
+	require.Empty(t, a.ReleasingMaps)
+	require.Equal(t, []uint32{1, 571}, b.AssignedMapsToHandle)
+
+	producer.AssertCalled(t, "MapPlayersRedirect", mock.Anything)
+	require.Equal(t, repo.MapMigrationStatusDone, migration.Status)
+	require.Equal(t, repo.MapMigrationStepDone, migration.Steps[0].Status)
+}
+
+func TestMapMigratorRollsForwardWhenRedirectFails(t *testing.T) {
+	migrator, gameServers, _, producer := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{571}},
+	}, true)
+
+	// The first batch is redirected, then events can't be sent anymore and players are left on both servers.
+	for _, call := range producer.ExpectedCalls {
+		if call.Method == "MapPlayersRedirect" {
+			call.Unset()
+		}
+	}
+	producer.On("MapPlayersRedirect", mock.Anything).Return(nil).Once()
+	producer.On("MapPlayersRedirect", mock.Anything).Return(errors.New("nats is down")).Once()
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Status: repo.MapMigrationStatusRunning, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+	}}
+	migrator.Migrate(context.Background(), migration)
+
+	a, _ := gameServers.One(context.Background(), "a")
+	b, _ := gameServers.One(context.Background(), "b")
+	require.Equal(t, []uint32{0}, a.AssignedMapsToHandle, "players left on the source are reconnected to the destination")
+	require.Empty(t, a.ReleasingMaps)
+	require.Equal(t, []uint32{1, 571}, b.AssignedMapsToHandle, "redirected players stay on the destination")
+
+	producer.AssertNumberOfCalls(t, "MapPlayersRedirect", 2)
+	require.Equal(t, repo.MapMigrationStatusDone, migration.Status)
+	require.Equal(t, repo.MapMigrationStepDone, migration.Steps[0].Status)
+	require.NotEmpty(t, migration.Steps[0].Error)
+}
+
+func TestMapMigratorKeepsMapOnSourceWhenDestinationIsGoneWhileRedirecting(t *testing.T) {
+	migrator, gameServers, _, _ := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}, ReleasingMaps: []uint32{1}},
+	}, true)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Status: repo.MapMigrationStatusRunning, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepRedirecting},
+	}}
+	migrator.Migrate(context.Background(), migration)
+
+	a, _ := gameServers.One(context.Background(), "a")
+	require.Equal(t, []uint32{0, 1}, a.AssignedMapsToHandle)
+	require.Empty(t, a.ReleasingMaps)
+
+	require.Equal(t, repo.MapMigrationStatusFailed, migration.Status)
+	require.Equal(t, repo.MapMigrationStepRolledBack, migration.Steps[0].Status)
+}
+
+func TestMapMigratorStopsWhenContextIsDone(t *testing.T) {
+	migrator, gameServers, _, _ := newTestMapMigrator(t, []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1, 2}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{571}},
+	}, false)
+
+	migration := &repo.MapMigration{ID: "m", RealmID: 1, Status: repo.MapMigrationStatusRunning, Steps: []repo.MapMigrationStep{
+		{MapID: 1, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+		{MapID: 2, FromServerID: "a", ToServerID: "b", Status: repo.MapMigrationStepPending},
+	}}
+
+	// Leadership is lost while the destination loads the map.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	migrator.Migrate(ctx, migration)
+
+	require.Equal(t, repo.MapMigrationStatusRunning, migration.Status)
+	require.Equal(t, repo.MapMigrationStepLoading, migration.Steps[0].Status)
+	require.Empty(t, migration.Steps[0].Error)
+	require.Equal(t, repo.MapMigrationStepPending, migration.Steps[1].Status)
+
+	// The next leader rolls back the interrupted step and continues.
+	migrator.Migrate(context.Background(), migration)
+
+	b, _ := gameServers.One(context.Background(), "b")
+	require.Equal(t, []uint32{571}, b.AssignedMapsToHandle)
+	require.Equal(t, repo.MapMigrationStepRolledBack, migration.Steps[0].Status)
+	require.Equal(t, repo.MapMigrationStepRolledBack, migration.Steps[1].Status)
+	require.Equal(t, repo.MapMigrationStatusFailed, migration.Status)
+}
+
+func TestStageMapMovesIgnoresPartitionedMaps(t *testing.T) {
+	before := []repo.GameServer{
+		{ID: "a", AssignedMapsToHandle: []uint32{0}, Regions: []repo.MapRegion{{MapID: 0, GridXMin: 0, GridXMax: 63}}},
+		{ID: "b"},
+	}
+	after := []repo.GameServer{
+		{ID: "a"},
+		{ID: "b", AssignedMapsToHandle: []uint32{0}, Regions: []repo.MapRegion{{MapID: 0, GridXMin: 0, GridXMax: 63}}},
+	}
+
+	require.Empty(t, stageMapMoves(before, after))
+	require.Equal(t, []uint32{0}, after[1].AssignedMapsToHandle)
+}
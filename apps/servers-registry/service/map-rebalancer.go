@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+// ServersMapPopulation provides players count per map of every game server.
+type ServersMapPopulation interface {
+	ServersMapPlayers(ctx context.Context) (map[string]map[uint32]uint32, error)
+}
+
+// MapRebalancer feeds observed load of the game servers to the rebalancer
+// and moves maps from the overloaded game servers according to its plans.
+// Learned weights are shared through the repository, so every replica plans with the leader's observations.
+type MapRebalancer struct {
+	servers    GameServer
+	population ServersMapPopulation
+	balancer   mapbalancing.Rebalancer
+	weights    repo.MapWeightsRepo
+	realms     []uint32
+
+	// dryRun disables applying of the plans, they are only logged.
+	dryRun bool
+}
+
+// NewMapRebalancer creates MapRebalancer for the given realms.
+func NewMapRebalancer(servers GameServer, population ServersMapPopulation, balancer mapbalancing.Rebalancer, weights repo.MapWeightsRepo, realms []uint32, dryRun bool) *MapRebalancer {
+	return &MapRebalancer{
+		servers:    servers,
+		population: population,
+		balancer:   balancer,
+		weights:    weights,
+		realms:     realms,
+		dryRun:     dryRun,
+	}
+}
+
+// Run observes game servers load and rebalances realms with the given interval until ctx is done.
+func (r *MapRebalancer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Observe(ctx); err != nil {
+				log.Error().Err(err).Msg("Failed to observe game servers load")
+				continue
+			}
+
+			for _, realmID := range r.realms {
+				if err := r.Rebalance(ctx, realmID); err != nil {
+					log.Error().Err(err).Uint32("realmID", realmID).Msg("Failed to rebalance maps")
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Observe passes current tick diff and players of the game servers to the rebalancer.
+func (r *MapRebalancer) Observe(ctx context.Context) error {
+	servers, err := r.servers.ListAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	players, err := r.population.ServersMapPlayers(ctx)
+	if err != nil {
+		return err
+	}
+
+	loads := make([]mapbalancing.ServerLoad, 0, len(servers))
+	for _, server := range servers {
+		loads = append(loads, mapbalancing.ServerLoad{
+			Server:     server,
+			MapPlayers: players[server.ID],
+		})
+	}
+
+	// Leader could change since the last observation, so it continues from the shared weights.
+	if err = r.loadWeights(ctx); err != nil {
+		return err
+	}
+
+	r.balancer.Observe(loads)
+	return r.weights.SetLearnedWeights(ctx, r.balancer.LearnedWeights())
+}
+
+// loadWeights replaces learned weights of the rebalancer with the shared ones.
+func (r *MapRebalancer) loadWeights(ctx context.Context) error {
+	weights, err := r.weights.LearnedWeights(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.balancer.SetLearnedWeights(weights)
+	return nil
+}
+
+// Plan returns maps moves that rebalancer proposes for the realm without applying them.
+func (r *MapRebalancer) Plan(ctx context.Context, realmID uint32) ([]mapbalancing.MapMove, error) {
+	servers, err := r.servers.ListForRealm(ctx, realmID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Weights are observed by the leader, plan can be requested from any replica.
+	if err = r.loadWeights(ctx); err != nil {
+		return nil, err
+	}
+
+	return r.balancer.Plan(servers), nil
+}
+
+// Rebalance plans maps moves of the realm and applies them, unless rebalancer is in dry run mode.
+func (r *MapRebalancer) Rebalance(ctx context.Context, realmID uint32) error {
+	moves, err := r.Plan(ctx, realmID)
+	if err != nil {
+		return err
+	}
+
+	if len(moves) == 0 {
+		return nil
+	}
+
+	if r.dryRun {
+		for _, move := range moves {
+			log.Info().
+				Uint32("realmID", realmID).
+				Uint32("mapID", move.MapID).
+				Str("from", move.FromServerID).
+				Str("to", move.ToServerID).
+				Uint32("weight", move.Weight).
+				Msg("Dry run: map move planned")
+		}
+		return nil
+	}
+
+	applied, err := r.servers.MoveMaps(ctx, realmID, moves)
+	if err != nil {
+		return err
+	}
+
+	for _, move := range applied {
+		log.Info().
+			Uint32("realmID", realmID).
+			Uint32("mapID", move.MapID).
+			Str("from", move.FromServerID).
+			Str("to", move.ToServerID).
+			Uint32("weight", move.Weight).
+			Msg("Moved map to rebalance game servers")
+	}
+
+	return nil
+}
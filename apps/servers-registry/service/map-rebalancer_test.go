@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/adaptive"
+	"github.com/walkline/ToCloud9/apps/servers-registry/mapbalancing/binpack"
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+type mapWeightsRepoStub struct {
+	mu      sync.Mutex
+	weights map[uint32]float64
+}
+
+func (s *mapWeightsRepoStub) LearnedWeights(context.Context) (map[uint32]float64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := map[uint32]float64{}
+	for mapID, weight := range s.weights {
+		res[mapID] = weight
+	}
+	return res, nil
+}
+
+func (s *mapWeightsRepoStub) SetLearnedWeights(_ context.Context, weights map[uint32]float64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.weights = weights
+	return nil
+}
+
+// rebalancedServersStub lists all servers of the layerServersStub.
+type rebalancedServersStub struct{ *layerServersStub }
+
+func (s rebalancedServersStub) ListAll(ctx context.Context) ([]repo.GameServer, error) {
+	return s.ListForRealm(ctx, 1)
+}
+
+func TestMapRebalancerPlansWithWeightsObservedByLeader(t *testing.T) {
+	servers := rebalancedServersStub{&layerServersStub{servers: []repo.GameServer{
+		{ID: "a", RealmID: 1, AssignedMapsToHandle: []uint32{0, 1}, Diff: repo.DiffData{Percentile95: 80}},
+		{ID: "b", RealmID: 1, AssignedMapsToHandle: []uint32{530}, Diff: repo.DiffData{Percentile95: 10}},
+	}}}
+	population := &redirectedPopulation{players: map[string]map[uint32]uint32{
+		"a": {0: 10, 1: 30},
+		"b": {530: 5},
+	}}
+	weights := &mapWeightsRepoStub{}
+	settings := adaptive.Settings{DiffPercentile95SLO: 75}
+
+	leader := NewMapRebalancer(servers, population, adaptive.NewBalancer(binpack.DefaultMapsWeight, settings), weights, []uint32{1}, true)
+	follower := NewMapRebalancer(servers, population, adaptive.NewBalancer(binpack.DefaultMapsWeight, settings), weights, []uint32{1}, true)
+
+	require.NoError(t, leader.Observe(context.Background()))
+
+	moves, err := follower.Plan(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, []mapbalancing.MapMove{{MapID: 0, FromServerID: "a", ToServerID: "b", Weight: 20}}, moves)
+}
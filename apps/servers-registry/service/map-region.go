@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+	"github.com/walkline/ToCloud9/shared/events"
+	"github.com/walkline/ToCloud9/shared/wow/grid"
+)
+
+// MapRegionServer is region of the partitioned map with game server that simulates it.
+type MapRegionServer struct {
+	Region repo.MapRegion
+	Server repo.GameServer
+}
+
+func (g *gameServerImpl) MapRegions(ctx context.Context, mapID uint32, realmID uint32, isCrossRealm bool) ([]MapRegionServer, error) {
+	servers, err := g.AvailableForMapAndRealm(ctx, mapID, realmID, isCrossRealm)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []MapRegionServer{}
+	for _, server := range servers {
+		for _, region := range server.MapRegions(mapID) {
+			res = append(res, MapRegionServer{Region: region, Server: server})
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Region.GridXMin < res[j].Region.GridXMin
+	})
+
+	return res, nil
+}
+
+// ServerForPosition returns game server that simulates region with the X world coordinate,
+// nil if map is not partitioned or region has no ready game server.
+func ServerForPosition(regions []MapRegionServer, x float32) *repo.GameServer {
+	gridX := grid.XByPosition(x)
+	for i := range regions {
+		if regions[i].Region.Grids().Contains(gridX) {
+			return &regions[i].Server
+		}
+	}
+	return nil
+}
+
+// applyRegionAssignments splits partitioned maps into regions and assigns them to the servers that can load the map.
+// Regions are assigned in order of servers IDs, so assignments don't depend on the servers load.
+// Partitioned maps overwrite layers of the same map.
+func applyRegionAssignments(servers []repo.GameServer, partitioned map[uint32]uint32) {
+	for i := range servers {
+		var regions []repo.MapRegion
+		for _, region := range servers[i].Regions {
+			if partitioned[region.MapID] > 1 {
+				regions = append(regions, region)
+			}
+		}
+		servers[i].Regions = regions
+	}
+
+	mapIDs := make([]uint32, 0, len(partitioned))
+	for mapID, count := range partitioned {
+		if count > 1 {
+			mapIDs = append(mapIDs, mapID)
+		}
+	}
+	sort.Slice(mapIDs, func(i, j int) bool { return mapIDs[i] < mapIDs[j] })
+
+	for _, mapID := range mapIDs {
+		candidates := []int{}
+		for i := range servers {
+			servers[i].AssignedMapsToHandle = removeMap(servers[i].AssignedMapsToHandle, mapID)
+			servers[i].Regions = removeMapRegions(servers[i].Regions, mapID)
+			if mapAvailable(servers[i], mapID) {
+				candidates = append(candidates, i)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		sort.Slice(candidates, func(i, j int) bool { return servers[candidates[i]].ID < servers[candidates[j]].ID })
+
+		for i, grids := range grid.Split(partitioned[mapID]) {
+			server := &servers[candidates[i%len(candidates)]]
+			server.AssignedMapsToHandle = addMap(server.AssignedMapsToHandle, mapID)
+			server.Regions = append(server.Regions, repo.MapRegion{MapID: mapID, GridXMin: grids.Min, GridXMax: grids.Max})
+		}
+	}
+}
+
+func removeMapRegions(regions []repo.MapRegion, mapID uint32) []repo.MapRegion {
+	var result []repo.MapRegion
+	for _, region := range regions {
+		if region.MapID != mapID {
+			result = append(result, region)
+		}
+	}
+	return result
+}
+
+func toEventRegions(regions []repo.MapRegion) []events.MapRegion {
+	res := make([]events.MapRegion, 0, len(regions))
+	for _, region := range regions {
+		res = append(res, events.MapRegion{MapID: region.MapID, GridXMin: region.GridXMin, GridXMax: region.GridXMax})
+	}
+	return res
+}
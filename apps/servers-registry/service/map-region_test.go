@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/walkline/ToCloud9/apps/servers-registry/repo"
+)
+
+func TestApplyRegionAssignments(t *testing.T) {
+	servers := []repo.GameServer{
+		{ID: "c", AssignedMapsToHandle: []uint32{1}},
+		{ID: "a", AssignedMapsToHandle: []uint32{0, 530}, Regions: []repo.MapRegion{{MapID: 530, GridXMin: 0, GridXMax: 63}}},
+		{ID: "b", AvailableMaps: []uint32{530, 571}},
+	}
+
+	applyRegionAssignments(servers, map[uint32]uint32{1: 2, 571: 1})
+
+	require.Equal(t, []uint32{1}, servers[0].AssignedMapsToHandle)
+	require.Equal(t, []repo.MapRegion{{MapID: 1, GridXMin: 32, GridXMax: 63}}, servers[0].Regions)
+
+	require.Equal(t, []uint32{0, 1, 530}, servers[1].AssignedMapsToHandle)
+	require.Equal(t, []repo.MapRegion{{MapID: 1, GridXMin: 0, GridXMax: 31}}, servers[1].Regions, "not partitioned map has no regions")
+
+	require.Empty(t, servers[2].AssignedMapsToHandle, "server can't load the map")
+	require.Empty(t, servers[2].Regions)
+}
+
+func TestApplyRegionAssignmentsWithLessServersThanRegions(t *testing.T) {
+	servers := []repo.GameServer{{ID: "a"}, {ID: "b"}}
+
+	applyRegionAssignments(servers, map[uint32]uint32{0: 3})
+
+	require.Equal(t, []uint32{0}, servers[0].AssignedMapsToHandle)
+	require.Equal(t, []repo.MapRegion{{MapID: 0, GridXMin: 0, GridXMax: 20}, {MapID: 0, GridXMin: 42, GridXMax: 63}}, servers[0].Regions)
+	require.Equal(t, []repo.MapRegion{{MapID: 0, GridXMin: 21, GridXMax: 41}}, servers[1].Regions)
+}
+
+func TestServerForPosition(t *testing.T) {
+	regions := []MapRegionServer{
+		{Region: repo.MapRegion{MapID: 0, GridXMin: 0, GridXMax: 31}, Server: repo.GameServer{ID: "east"}},
+		{Region: repo.MapRegion{MapID: 0, GridXMin: 32, GridXMax: 63}, Server: repo.GameServer{ID: "west"}},
+	}
+
+	require.Equal(t, "east", ServerForPosition(regions, 100).ID)
+	require.Equal(t, "west", ServerForPosition(regions, -100).ID)
+	require.Nil(t, ServerForPosition(regions[:1], -100), "region without ready server")
+	require.Nil(t, ServerForPosition(nil, 0), "not partitioned map")
+}
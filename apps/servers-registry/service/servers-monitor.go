@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/walkline/ToCloud9/shared/healthandmetrics"
+)
+
+// monitorSyncInterval is interval of loading servers that were registered by other servers registry replicas.
+const monitorSyncInterval = 5 * time.Second
+
+type monitoredServer interface {
+	healthandmetrics.HealthCheckObject
+	healthandmetrics.MetricsObservable
+}
+
+// serversMonitor health checks and reads metrics of the stored servers while the replica is the leader.
+// Servers can be registered by any replica, so the leader loads them from the repository periodically.
+type serversMonitor struct {
+	checker healthandmetrics.HealthChecker
+	metrics healthandmetrics.MetricsConsumer
+	list    func(ctx context.Context) ([]monitoredServer, error)
+
+	mu      sync.Mutex
+	active  bool
+	servers map[string]monitoredServer
+}
+
+func newServersMonitor(
+	checker healthandmetrics.HealthChecker, metrics healthandmetrics.MetricsConsumer,
+	list func(ctx context.Context) ([]monitoredServer, error),
+) *serversMonitor {
+	return &serversMonitor{
+		checker: checker,
+		metrics: metrics,
+		list:    list,
+		servers: map[string]monitoredServer{},
+	}
+}
+
+// Add starts monitoring of the registered server if the monitor is running.
+func (m *serversMonitor) Add(server monitoredServer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.active {
+		return nil
+	}
+
+	return m.add(server)
+}
+
+// Run monitors stored servers until ctx is done.
+func (m *serversMonitor) Run(ctx context.Context) {
+	m.mu.Lock()
+	m.active = true
+	m.mu.Unlock()
+
+	defer m.stop()
+
+	ticker := time.NewTicker(monitorSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.sync(ctx); err != nil {
+			log.Error().Err(err).Msg("can't sync monitored servers")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// sync starts monitoring of the new stored servers and stops monitoring of the removed ones.
+func (m *serversMonitor) sync(ctx context.Context) error {
+	stored, err := m.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	storedAddresses := make(map[string]struct{}, len(stored))
+	for _, server := range stored {
+		storedAddresses[server.HealthCheckAddress()] = struct{}{}
+		if err = m.add(server); err != nil {
+			return err
+		}
+	}
+
+	for address, server := range m.servers {
+		if _, found := storedAddresses[address]; !found {
+			m.remove(server)
+		}
+	}
+
+	return nil
+}
+
+func (m *serversMonitor) stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.active = false
+	for _, server := range m.servers {
+		m.remove(server)
+	}
+}
+
+// add starts monitoring of the server, checker and metrics consumer ignore already added servers.
+func (m *serversMonitor) add(server monitoredServer) error {
+	if err := m.checker.AddHealthCheckObject(server); err != nil {
+		return err
+	}
+
+	if err := m.metrics.AddMetricsObservable(server); err != nil {
+		return err
+	}
+
+	m.servers[server.HealthCheckAddress()] = server
+	return nil
+}
+
+func (m *serversMonitor) remove(server monitoredServer) {
+	if err := m.checker.RemoveHealthCheckObject(server); err != nil {
+		log.Error().Err(err).Msg("can't remove server from health checker")
+	}
+
+	if err := m.metrics.RemoveMetricsObservable(server); err != nil {
+		log.Error().Err(err).Msg("can't remove server from metrics consumer")
+	}
+
+	delete(m.servers, server.HealthCheckAddress())
+}
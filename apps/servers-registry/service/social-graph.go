@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	pbChar "github.com/walkline/ToCloud9/gen/characters/pb"
+	pbGuild "github.com/walkline/ToCloud9/gen/guilds/pb"
+)
+
+const socialGraphAPIVer = "0.0.1"
+
+// grpcSocialGraph is SocialGraph that takes guild rosters from guilds service and friends from characters service.
+type grpcSocialGraph struct {
+	chars  pbChar.CharactersServiceClient
+	guilds pbGuild.GuildServiceClient
+}
+
+func NewGRPCSocialGraph(chars pbChar.CharactersServiceClient, guilds pbGuild.GuildServiceClient) SocialGraph {
+	return &grpcSocialGraph{
+		chars:  chars,
+		guilds: guilds,
+	}
+}
+
+func (g *grpcSocialGraph) OnlineGuildMembers(ctx context.Context, realmID uint32, guildID uint64) ([]uint64, error) {
+	resp, err := g.guilds.GetRosterInfo(ctx, &pbGuild.GetRosterInfoParams{
+		Api:     socialGraphAPIVer,
+		RealmID: realmID,
+		GuildID: guildID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Guild == nil {
+		return nil, nil
+	}
+
+	res := []uint64{}
+	for _, member := range resp.Guild.Members {
+		// Zero status is offline.
+		if member.Status != 0 {
+			res = append(res, member.Guid)
+		}
+	}
+	return res, nil
+}
+
+func (g *grpcSocialGraph) OnlineFriends(ctx context.Context, realmID uint32, charGUID uint64) ([]uint64, error) {
+	resp, err := g.chars.GetFriendsList(ctx, &pbChar.GetFriendsListRequest{
+		Api:        socialGraphAPIVer,
+		RealmID:    realmID,
+		PlayerGUID: charGUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := []uint64{}
+	for _, friend := range resp.Friends {
+		// Friends from other realms can't be on layers of this realm.
+		if friend.Status != 0 && (friend.RealmID == 0 || friend.RealmID == realmID) {
+			res = append(res, friend.Guid)
+		}
+	}
+	return res, nil
+}
@@ -53,6 +53,12 @@ TC9_API void TC9ProcessEventsHooks();
 TC9_API uint64_t TC9GetNextAvailableCharacterGuid(int realmID);
 TC9_API uint64_t TC9GetNextAvailableItemGuid(int realmID);
 TC9_API uint64_t TC9GetNextAvailableInstanceGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailablePetGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailableMailGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailableCorpseGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailableEquipmentSetGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailableGroupGuid(int realmID);
+TC9_API uint64_t TC9GetNextAvailableArenaTeamGuid(int realmID);
 
 /* Map loading notification */
 TC9_API void TC9ReadyToAcceptPlayersFromMaps(uint32_t* maps, int mapsLen);
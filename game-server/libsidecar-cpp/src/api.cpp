@@ -237,6 +237,9 @@ TC9_API void TC9GracefulShutdown() {
             g_state.nats_publisher->Stop();
         }
 
+        // Give prefetched, but not used GUIDs back to the service
+        tc9::GuidManager::Instance().ReturnUnusedGuids();
+
         if (g_state.grpc_clients) {
             g_state.grpc_clients->Shutdown();
         }
@@ -709,6 +712,90 @@ TC9_API uint64_t TC9GetNextAvailableInstanceGuid(int realmID) {
     }
 }
 
+TC9_API uint64_t TC9GetNextAvailablePetGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_PET, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailablePetGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
+TC9_API uint64_t TC9GetNextAvailableMailGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_MAIL, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailableMailGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
+TC9_API uint64_t TC9GetNextAvailableCorpseGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_CORPSE, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailableCorpseGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
+TC9_API uint64_t TC9GetNextAvailableEquipmentSetGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_EQUIPMENT_SET, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailableEquipmentSetGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
+TC9_API uint64_t TC9GetNextAvailableGroupGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_GROUP, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailableGroupGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
+TC9_API uint64_t TC9GetNextAvailableArenaTeamGuid(int realmID) {
+    if (!g_state.initialized) {
+        return 0;
+    }
+
+    try {
+        auto& guid_mgr = tc9::GuidManager::Instance();
+        return guid_mgr.GetNextGuid(tc9::GUID_TYPE_ARENA_TEAM, static_cast<uint32_t>(realmID));
+    } catch (const std::exception& e) {
+        spdlog::error("GetNextAvailableArenaTeamGuid failed: {}", e.what());
+        return 0;
+    }
+}
+
 // Event hook registration functions
 // These accept old Go-style callbacks and register internal TC9 callbacks that bridge to them
 
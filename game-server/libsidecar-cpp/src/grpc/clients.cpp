@@ -195,6 +195,42 @@ bool GrpcClients::RequestGUIDPool(
     return true;
 }
 
+bool GrpcClients::ReturnGUIDPool(
+    uint32_t realm_id,
+    int guid_type,
+    const std::vector<std::pair<uint64_t, uint64_t>>& ranges) {
+
+    if (!connected_ || !guid_stub_) {
+        spdlog::error("GUID client not connected");
+        return false;
+    }
+
+    v1::ReturnGUIDPoolRequest request;
+    request.set_api(LIB_VERSION);
+    request.set_realmid(realm_id);
+    request.set_guidtype(static_cast<v1::GuidType>(guid_type));
+    for (const auto& [start, end] : ranges) {
+        auto* diapason = request.add_diapasons();
+        diapason->set_start(start);
+        diapason->set_end(end);
+    }
+
+    v1::ReturnGUIDPoolResponse response;
+    grpc::ClientContext context;
+    context.set_deadline(Deadline());
+
+    grpc::Status status = guid_stub_->ReturnGUIDPool(&context, request, &response);
+
+    if (!status.ok()) {
+        spdlog::error("ReturnGUIDPool RPC failed: {} - {}",
+                     status.error_code(), status.error_message());
+        return false;
+    }
+
+    spdlog::info("✅ Returned {} unused GUIDs of type {}", response.guidsreturned(), guid_type);
+    return true;
+}
+
 bool GrpcClients::PlayerLeftBattleground(
     uint32_t realm_id,
     uint64_t player_guid,
@@ -41,10 +41,16 @@ public:
     // GUID Provider Client
     bool RequestGUIDPool(
         uint32_t realm_id,
-        int guid_type,  // 0=Character, 1=Item, 2=Instance
+        int guid_type,  // Matches v1::GuidType
         uint64_t desired_pool_size,
         std::vector<std::pair<uint64_t, uint64_t>>& out_ranges);
 
+    // Returns not used GUIDs ranges (inclusive) back to the GUID Provider
+    bool ReturnGUIDPool(
+        uint32_t realm_id,
+        int guid_type,
+        const std::vector<std::pair<uint64_t, uint64_t>>& ranges);
+
     // Matchmaking Client (async notifications)
     bool PlayerLeftBattleground(
         uint32_t realm_id,
@@ -135,6 +135,38 @@ void GuidIterator::MoveToNextRange() {
     }
 }
 
+std::vector<GuidRange> GuidIterator::TakeUnused() {
+    std::lock_guard<std::mutex> lock(ranges_mutex_);
+
+    uint64_t current = thread_safe_
+        ? current_guid_atomic_.load(std::memory_order_relaxed)
+        : current_guid_;
+
+    std::vector<GuidRange> unused;
+    if (current_range_idx_ < ranges_.size()) {
+        uint64_t range_end = ranges_[current_range_idx_].end;
+        if (current < range_end) {
+            unused.emplace_back(current, range_end);
+        }
+    }
+
+    for (size_t i = current_range_idx_ + 1; i < ranges_.size(); ++i) {
+        unused.push_back(ranges_[i]);
+    }
+
+    ranges_.clear();
+    current_range_idx_ = 0;
+    if (thread_safe_) {
+        current_guid_atomic_.store(0, std::memory_order_relaxed);
+        current_range_end_atomic_.store(0, std::memory_order_relaxed);
+    } else {
+        current_guid_ = 0;
+        current_range_end_ = 0;
+    }
+
+    return unused;
+}
+
 // GuidManager Implementation
 
 GuidManager& GuidManager::Instance() {
@@ -144,9 +176,9 @@ GuidManager& GuidManager::Instance() {
 
 GuidManager::GuidManager() {
     // Create iterators (items are thread-safe, others are not)
-    character_guids_ = std::make_unique<GuidIterator>(0, false);  // Character
-    item_guids_ = std::make_unique<GuidIterator>(1, true);        // Item (THREAD-SAFE)
-    instance_guids_ = std::make_unique<GuidIterator>(2, false);   // Instance
+    for (int type = 0; type < GUID_TYPE_MAX; ++type) {
+        guids_[type] = std::make_unique<GuidIterator>(type, type == GUID_TYPE_ITEM);
+    }
 
     spdlog::debug("GuidManager created");
 }
@@ -168,68 +200,70 @@ void GuidManager::Initialize(GrpcClients* clients, uint32_t realm_id) {
     spdlog::info("GuidManager initialized for realm {}", realm_id);
 
     // Pre-fetch initial GUID pools for all types
-    RefillGuidPool(0, realm_id);  // Characters
-    RefillGuidPool(1, realm_id);  // Items
-    RefillGuidPool(2, realm_id);  // Instances
+    for (int type = 0; type < GUID_TYPE_MAX; ++type) {
+        RefillGuidPool(type, realm_id);
+    }
 
     initialized_ = true;
 }
 
 uint64_t GuidManager::GetNextCharacterGuid(uint32_t realm_id) {
-    if (!initialized_) {
-        spdlog::error("GuidManager not initialized");
-        return 0;
-    }
-
-    uint32_t realm = realm_id ? realm_id : default_realm_id_;
-    uint64_t guid = character_guids_->Next(realm);
+    return GetNextGuid(GUID_TYPE_CHARACTER, realm_id);
+}
 
-    // Check if we need to refill (async)
-    if (character_guids_->NeedsRefill()) {
-        std::thread([this, realm]() {
-            RefillGuidPool(0, realm);
-        }).detach();
-    }
+uint64_t GuidManager::GetNextItemGuid(uint32_t realm_id) {
+    return GetNextGuid(GUID_TYPE_ITEM, realm_id);
+}
 
-    return guid;
+uint64_t GuidManager::GetNextInstanceGuid(uint32_t realm_id) {
+    return GetNextGuid(GUID_TYPE_INSTANCE, realm_id);
 }
 
-uint64_t GuidManager::GetNextItemGuid(uint32_t realm_id) {
+uint64_t GuidManager::GetNextGuid(GuidType guid_type, uint32_t realm_id) {
     if (!initialized_) {
         spdlog::error("GuidManager not initialized");
         return 0;
     }
 
+    if (guid_type < 0 || guid_type >= GUID_TYPE_MAX) {
+        spdlog::error("Invalid GUID type: {}", static_cast<int>(guid_type));
+        return 0;
+    }
+
     uint32_t realm = realm_id ? realm_id : default_realm_id_;
-    uint64_t guid = item_guids_->Next(realm);
+    uint64_t guid = guids_[guid_type]->Next(realm);
 
     // Check if we need to refill (async)
-    if (item_guids_->NeedsRefill()) {
-        std::thread([this, realm]() {
-            RefillGuidPool(1, realm);
+    if (guids_[guid_type]->NeedsRefill()) {
+        std::thread([this, guid_type, realm]() {
+            RefillGuidPool(guid_type, realm);
         }).detach();
     }
 
     return guid;
 }
 
-uint64_t GuidManager::GetNextInstanceGuid(uint32_t realm_id) {
-    if (!initialized_) {
-        spdlog::error("GuidManager not initialized");
-        return 0;
+void GuidManager::ReturnUnusedGuids() {
+    if (!initialized_ || !grpc_clients_) {
+        return;
     }
 
-    uint32_t realm = realm_id ? realm_id : default_realm_id_;
-    uint64_t guid = instance_guids_->Next(realm);
+    for (int type = 0; type < GUID_TYPE_MAX; ++type) {
+        std::vector<GuidRange> unused = guids_[type]->TakeUnused();
+        if (unused.empty()) {
+            continue;
+        }
 
-    // Check if we need to refill (async)
-    if (instance_guids_->NeedsRefill()) {
-        std::thread([this, realm]() {
-            RefillGuidPool(2, realm);
-        }).detach();
-    }
+        // Service ranges are inclusive
+        std::vector<std::pair<uint64_t, uint64_t>> ranges;
+        for (const auto& range : unused) {
+            ranges.push_back({range.start, range.end - 1});
+        }
 
-    return guid;
+        if (!grpc_clients_->ReturnGUIDPool(default_realm_id_, type, ranges)) {
+            spdlog::warn("Failed to return unused GUIDs for type {}", type);
+        }
+    }
 }
 
 void GuidManager::RefillGuidPool(int guid_type, uint32_t realm_id) {
@@ -250,18 +284,10 @@ void GuidManager::RefillGuidPool(int guid_type, uint32_t realm_id) {
 
     if (success && !ranges.empty()) {
         // Add ranges to appropriate iterator
-        switch (guid_type) {
-            case 0:  // Character
-                character_guids_->AddRanges(ranges);
-                break;
-            case 1:  // Item
-                item_guids_->AddRanges(ranges);
-                break;
-            case 2:  // Instance
-                instance_guids_->AddRanges(ranges);
-                break;
-            default:
-                spdlog::error("Invalid GUID type: {}", guid_type);
+        if (guid_type >= 0 && guid_type < GUID_TYPE_MAX) {
+            guids_[guid_type]->AddRanges(ranges);
+        } else {
+            spdlog::error("Invalid GUID type: {}", guid_type);
         }
     } else {
         spdlog::error("Failed to fetch GUID pool for type {}", guid_type);
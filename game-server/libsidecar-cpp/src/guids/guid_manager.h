@@ -41,10 +41,14 @@ public:
     // Get total available GUIDs
     size_t AvailableCount() const;
 
+    // Takes all not yet used ranges out of the iterator.
+    // Iterator returns 0 until new ranges are added.
+    std::vector<GuidRange> TakeUnused();
+
 private:
     void MoveToNextRange();
 
-    int guid_type_;                     // 0=Character, 1=Item, 2=Instance
+    int guid_type_;                     // Matches v1::GuidType
     bool thread_safe_;                  // Use atomics if true
 
     // GUID ranges and current position
@@ -65,6 +69,20 @@ private:
     static constexpr size_t REFILL_THRESHOLD = 1000;
 };
 
+// GUID types, values match v1::GuidType
+enum GuidType : int {
+    GUID_TYPE_CHARACTER = 0,
+    GUID_TYPE_ITEM = 1,
+    GUID_TYPE_INSTANCE = 2,
+    GUID_TYPE_PET = 3,
+    GUID_TYPE_MAIL = 4,
+    GUID_TYPE_CORPSE = 5,
+    GUID_TYPE_EQUIPMENT_SET = 6,
+    GUID_TYPE_GROUP = 7,
+    GUID_TYPE_ARENA_TEAM = 8,
+    GUID_TYPE_MAX
+};
+
 // Manages GUID generation for all types
 class GuidManager {
 public:
@@ -77,6 +95,10 @@ public:
     uint64_t GetNextCharacterGuid(uint32_t realm_id);
     uint64_t GetNextItemGuid(uint32_t realm_id);        // THREAD-SAFE
     uint64_t GetNextInstanceGuid(uint32_t realm_id);
+    uint64_t GetNextGuid(GuidType guid_type, uint32_t realm_id);
+
+    // Return not used GUIDs of all types back to the service (on shutdown)
+    void ReturnUnusedGuids();
 
     // Check if initialization is needed
     bool IsInitialized() const { return initialized_; }
@@ -98,10 +120,8 @@ private:
     uint32_t default_realm_id_ = 0;
     bool initialized_ = false;
 
-    // Three separate iterators for each GUID type
-    std::unique_ptr<GuidIterator> character_guids_;  // Thread-unsafe (fast)
-    std::unique_ptr<GuidIterator> item_guids_;       // Thread-safe (atomics)
-    std::unique_ptr<GuidIterator> instance_guids_;   // Thread-unsafe (fast)
+    // Separate iterator for each GUID type, items are thread-safe (atomics), others are not (fast)
+    std::unique_ptr<GuidIterator> guids_[GUID_TYPE_MAX];
 
     // Pool size to request from service
     static constexpr uint64_t POOL_SIZE = 10000;
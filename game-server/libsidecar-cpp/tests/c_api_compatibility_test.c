@@ -97,6 +97,9 @@ int main(void) {
     uint64_t instance_guid = TC9GetNextAvailableInstanceGuid(0);
     printf("  TC9GetNextAvailableInstanceGuid(0) returned: %llu\n", instance_guid);
 
+    uint64_t mail_guid = TC9GetNextAvailableMailGuid(0);
+    printf("  TC9GetNextAvailableMailGuid(0) returned: %llu\n", mail_guid);
+
     /* Event hooks */
     printf("\nTesting event hooks...\n");
     TC9SetOnGuildMemberAddedHook(on_guild_member_added);
@@ -35,6 +35,24 @@ type Config struct {
 	// InstanceGuidsBufferSize is the size of the buffer for dungeon/raid instances guids
 	InstanceGuidsBufferSize int `yaml:"instanceGuidsBufferSize" env:"INSTANCE_GUIDS_BUFFER_SIZE" env-default:"10"`
 
+	// PetGuidsBufferSize is the size of the buffer for pets guids
+	PetGuidsBufferSize int `yaml:"petGuidsBufferSize" env:"PET_GUIDS_BUFFER_SIZE" env-default:"20"`
+
+	// MailGuidsBufferSize is the size of the buffer for mails guids
+	MailGuidsBufferSize int `yaml:"mailGuidsBufferSize" env:"MAIL_GUIDS_BUFFER_SIZE" env-default:"100"`
+
+	// CorpseGuidsBufferSize is the size of the buffer for corpses guids
+	CorpseGuidsBufferSize int `yaml:"corpseGuidsBufferSize" env:"CORPSE_GUIDS_BUFFER_SIZE" env-default:"20"`
+
+	// EquipmentSetGuidsBufferSize is the size of the buffer for equipment sets guids
+	EquipmentSetGuidsBufferSize int `yaml:"equipmentSetGuidsBufferSize" env:"EQUIPMENT_SET_GUIDS_BUFFER_SIZE" env-default:"10"`
+
+	// GroupGuidsBufferSize is the size of the buffer for groups guids
+	GroupGuidsBufferSize int `yaml:"groupGuidsBufferSize" env:"GROUP_GUIDS_BUFFER_SIZE" env-default:"20"`
+
+	// ArenaTeamGuidsBufferSize is the size of the buffer for arena teams guids
+	ArenaTeamGuidsBufferSize int `yaml:"arenaTeamGuidsBufferSize" env:"ARENA_TEAM_GUIDS_BUFFER_SIZE" env-default:"5"`
+
 	// NatsURL is nats connection url
 	NatsURL string `yaml:"natsUrl" env:"NATS_URL" env-default:"nats://localhost:4222"`
 }
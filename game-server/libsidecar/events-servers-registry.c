@@ -13,3 +13,17 @@ int CallOnMapsReassignedHook(uint32_t* maps_added, int maps_added_size, uint32_t
     mapsReassignedHook(maps_added, maps_added_size, maps_removed, maps_removed_size);
     return ServersRegistryHookStatusOK;
 }
+
+// MapRegionsAssignedHook
+OnMapRegionsAssignedHook mapRegionsAssignedHook;
+void SetOnMapRegionsAssignedHook(OnMapRegionsAssignedHook h) {
+    mapRegionsAssignedHook = h;
+}
+
+int CallOnMapRegionsAssignedHook(uint32_t map_id, uint32_t* grids_x_min, uint32_t* grids_x_max, int regions_size) {
+    if (mapRegionsAssignedHook == 0) {
+        return ServersRegistryHookStatusNoHook;
+    }
+    mapRegionsAssignedHook(map_id, grids_x_min, grids_x_max, regions_size);
+    return ServersRegistryHookStatusOK;
+}
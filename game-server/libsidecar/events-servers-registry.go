@@ -7,9 +7,11 @@ import "C"
 
 import (
 	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
 
 	"github.com/walkline/ToCloud9/game-server/libsidecar/consumer"
 	"github.com/walkline/ToCloud9/game-server/libsidecar/queue"
+	"github.com/walkline/ToCloud9/gen/servers-registry/pb"
 	"github.com/walkline/ToCloud9/shared/events"
 )
 
@@ -20,6 +22,14 @@ func TC9SetOnMapsReassignedHook(h C.OnMapsReassignedHook) {
 	C.SetOnMapsReassignedHook(h)
 }
 
+// TC9SetOnMapRegionsAssignedHook sets hook for regions of the partitioned maps assigning by servers registry.
+// Hook should be set before TC9InitLib to get regions assigned on registration.
+//
+//export TC9SetOnMapRegionsAssignedHook
+func TC9SetOnMapRegionsAssignedHook(h C.OnMapRegionsAssignedHook) {
+	C.SetOnMapRegionsAssignedHook(h)
+}
+
 type serversRegistryHandlerFabric struct {
 	logger zerolog.Logger
 }
@@ -32,18 +42,29 @@ func NewServerRegistryHandlerFabric(logger zerolog.Logger) consumer.ServerRegist
 
 func (g serversRegistryHandlerFabric) GameServerMapsReassigned(payload *events.ServerRegistryEventGSMapsReassignedPayload) queue.Handler {
 	for _, server := range payload.Servers {
-		if server.ID == AssignedGameServerID && len(server.OldAssignedMapsToHandle) > 0 {
+		if server.ID == AssignedGameServerID {
 			newMaps := server.OnlyNewMaps()
 			removedMaps := server.OnlyRemovedMaps()
-			if len(removedMaps) > 0 || len(newMaps) > 0 {
+			if len(server.OldAssignedMapsToHandle) == 0 {
+				// Server without maps can get them later by maps migration,
+				// but maps from the registration are already loaded on startup.
+				newMaps = withoutMaps(newMaps, RegisteredMaps)
+			}
+			regionsMaps := server.MapsWithChangedRegions()
+			if len(removedMaps) > 0 || len(newMaps) > 0 || len(regionsMaps) > 0 {
 				return eventsHandlerFunc(func() {
+					// Regions go first, so new partitioned maps are loaded with known grids.
+					for _, mapID := range regionsMaps {
+						g.handleResponse(callMapRegionsAssignedHook(mapID, server.NewRegions), "MapRegionsAssigned")
+					}
+
 					if len(newMaps) > 0 && len(removedMaps) > 0 {
 						r := C.CallOnMapsReassignedHook((*C.uint32_t)(&newMaps[0]), C.int(len(newMaps)), (*C.uint32_t)(&removedMaps[0]), C.int(len(removedMaps)))
 						g.handleResponse(int(r), "GameServerMapsReassigned")
 					} else if len(newMaps) > 0 {
 						r := C.CallOnMapsReassignedHook((*C.uint32_t)(&newMaps[0]), C.int(len(newMaps)), (*C.uint32_t)(nil), C.int(len(removedMaps)))
 						g.handleResponse(int(r), "GameServerMapsReassigned")
-					} else {
+					} else if len(removedMaps) > 0 {
 						r := C.CallOnMapsReassignedHook((*C.uint32_t)(nil), C.int(len(newMaps)), (*C.uint32_t)(&removedMaps[0]), C.int(len(removedMaps)))
 						g.handleResponse(int(r), "GameServerMapsReassigned")
 					}
@@ -55,6 +76,60 @@ func (g serversRegistryHandlerFabric) GameServerMapsReassigned(payload *events.S
 	return nil
 }
 
+// callMapRegionsAssignedHook calls hook with regions of the map from the given regions list.
+func callMapRegionsAssignedHook(mapID uint32, regions []events.MapRegion) int {
+	gridsXMin, gridsXMax := []uint32{}, []uint32{}
+	for _, region := range regions {
+		if region.MapID == mapID {
+			gridsXMin = append(gridsXMin, region.GridXMin)
+			gridsXMax = append(gridsXMax, region.GridXMax)
+		}
+	}
+
+	if len(gridsXMin) == 0 {
+		return int(C.CallOnMapRegionsAssignedHook(C.uint32_t(mapID), (*C.uint32_t)(nil), (*C.uint32_t)(nil), C.int(0)))
+	}
+
+	return int(C.CallOnMapRegionsAssignedHook(
+		C.uint32_t(mapID), (*C.uint32_t)(&gridsXMin[0]), (*C.uint32_t)(&gridsXMax[0]), C.int(len(gridsXMin)),
+	))
+}
+
+// notifyRegisteredRegions passes regions of the partitioned maps assigned on registration to the hook.
+func notifyRegisteredRegions(regions []*pb.MapRegion) {
+	eventRegions := make([]events.MapRegion, 0, len(regions))
+	mapIDs := []uint32{}
+	for _, region := range regions {
+		eventRegions = append(eventRegions, events.MapRegion{MapID: region.MapID, GridXMin: region.GridXMin, GridXMax: region.GridXMax})
+		if len(mapIDs) == 0 || mapIDs[len(mapIDs)-1] != region.MapID {
+			mapIDs = append(mapIDs, region.MapID)
+		}
+	}
+
+	for _, mapID := range mapIDs {
+		if callMapRegionsAssignedHook(mapID, eventRegions) == int(C.ServersRegistryHookStatusNoHook) {
+			log.Warn().Uint32("mapID", mapID).Msg("no bound hook for the regions of the partitioned map")
+		}
+	}
+}
+
+func withoutMaps(maps, excluded []uint32) []uint32 {
+	res := []uint32{}
+	for _, mapID := range maps {
+		found := false
+		for _, excludedMap := range excluded {
+			if mapID == excludedMap {
+				found = true
+				break
+			}
+		}
+		if !found {
+			res = append(res, mapID)
+		}
+	}
+	return res
+}
+
 func (g serversRegistryHandlerFabric) handleResponse(resp int, hookName string) {
 	const (
 		CallStatusOk     = 0
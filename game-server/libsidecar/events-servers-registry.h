@@ -12,4 +12,10 @@ typedef void (*OnMapsReassignedHook) (uint32_t* /*maps_added*/, int /*maps_added
 void SetOnMapsReassignedHook(OnMapsReassignedHook h);
 int CallOnMapsReassignedHook(uint32_t* maps_added, int maps_added_size, uint32_t* maps_removed, int maps_removed_size);
 
+// OnMapRegionsAssignedHook tells which grids by X axis of the partitioned map the game server simulates.
+// Region i is the inclusive grids range grids_x_min[i]..grids_x_max[i]. Zero regions_size means the whole map.
+typedef void (*OnMapRegionsAssignedHook) (uint32_t /*map_id*/, uint32_t* /*grids_x_min*/, uint32_t* /*grids_x_max*/, int /*regions_size*/);
+void SetOnMapRegionsAssignedHook(OnMapRegionsAssignedHook h);
+int CallOnMapRegionsAssignedHook(uint32_t map_id, uint32_t* grids_x_min, uint32_t* grids_x_max, int regions_size);
+
 #endif
@@ -3,6 +3,9 @@ package main
 import "C"
 
 import (
+	"context"
+	"errors"
+
 	"github.com/rs/zerolog/log"
 	"google.golang.org/grpc"
 
@@ -41,20 +44,129 @@ func TC9GetNextAvailableInstanceGuid(realmID int) uint64 {
 	return instancesGuidsIterator.Next(uint32(realmID))
 }
 
+// TC9GetNextAvailablePetGuid returns next available pet GUID. Thread unsafe.
+//
+//export TC9GetNextAvailablePetGuid
+func TC9GetNextAvailablePetGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return petsGuidsIterator.Next(uint32(realmID))
+}
+
+// TC9GetNextAvailableMailGuid returns next available mail GUID. Thread unsafe.
+//
+//export TC9GetNextAvailableMailGuid
+func TC9GetNextAvailableMailGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return mailsGuidsIterator.Next(uint32(realmID))
+}
+
+// TC9GetNextAvailableCorpseGuid returns next available corpse GUID. Thread unsafe.
+//
+//export TC9GetNextAvailableCorpseGuid
+func TC9GetNextAvailableCorpseGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return corpsesGuidsIterator.Next(uint32(realmID))
+}
+
+// TC9GetNextAvailableEquipmentSetGuid returns next available equipment set GUID. Thread unsafe.
+//
+//export TC9GetNextAvailableEquipmentSetGuid
+func TC9GetNextAvailableEquipmentSetGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return equipmentSetsGuidsIterator.Next(uint32(realmID))
+}
+
+// TC9GetNextAvailableGroupGuid returns next available group GUID. Thread unsafe.
+//
+//export TC9GetNextAvailableGroupGuid
+func TC9GetNextAvailableGroupGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return groupsGuidsIterator.Next(uint32(realmID))
+}
+
+// TC9GetNextAvailableArenaTeamGuid returns next available arena team GUID. Thread unsafe.
+//
+//export TC9GetNextAvailableArenaTeamGuid
+func TC9GetNextAvailableArenaTeamGuid(realmID int) uint64 {
+	if realmID == 0 {
+		realmID = int(RealmID)
+	}
+	return arenaTeamsGuidsIterator.Next(uint32(realmID))
+}
+
 var charactersGuidsIterator *guids.CrossrealmMgr
 
 var itemsGuidsIterator *guids.CrossrealmMgr
 
 var instancesGuidsIterator *guids.CrossrealmMgr
 
+var petsGuidsIterator *guids.CrossrealmMgr
+
+var mailsGuidsIterator *guids.CrossrealmMgr
+
+var corpsesGuidsIterator *guids.CrossrealmMgr
+
+var equipmentSetsGuidsIterator *guids.CrossrealmMgr
+
+var groupsGuidsIterator *guids.CrossrealmMgr
+
+var arenaTeamsGuidsIterator *guids.CrossrealmMgr
+
 func SetupGuidProviders(realmID uint32, cfg *config.Config) {
 	// pctToTriggerUpdate is percent of used guids to trigger
 	// request to add new guids to the pool.
 	const pctToTriggerUpdate float32 = 65
 
+	guids.LibVer = libVer
+
 	charactersGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Character, uint64(cfg.CharacterGuidsBufferSize), pctToTriggerUpdate, realmID)
 	itemsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Item, uint64(cfg.ItemGuidsBufferSize), pctToTriggerUpdate, realmID)
 	instancesGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Instance, uint64(cfg.InstanceGuidsBufferSize), pctToTriggerUpdate, realmID)
+	petsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Pet, uint64(cfg.PetGuidsBufferSize), pctToTriggerUpdate, realmID)
+	mailsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_MailID, uint64(cfg.MailGuidsBufferSize), pctToTriggerUpdate, realmID)
+	corpsesGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Corpse, uint64(cfg.CorpseGuidsBufferSize), pctToTriggerUpdate, realmID)
+	equipmentSetsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_EquipmentSet, uint64(cfg.EquipmentSetGuidsBufferSize), pctToTriggerUpdate, realmID)
+	groupsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_Group, uint64(cfg.GroupGuidsBufferSize), pctToTriggerUpdate, realmID)
+	arenaTeamsGuidsIterator = guids.NewCrossRealmMgr(guidServiceClient, guidPB.GuidType_ArenaTeam, uint64(cfg.ArenaTeamGuidsBufferSize), pctToTriggerUpdate, realmID)
+}
+
+// ReturnUnusedGuids returns guids that were prefetched, but not used, back to the guid service.
+// Guid providers shouldn't be used after this call.
+func ReturnUnusedGuids(ctx context.Context) error {
+	mgrs := []*guids.CrossrealmMgr{
+		charactersGuidsIterator,
+		itemsGuidsIterator,
+		instancesGuidsIterator,
+		petsGuidsIterator,
+		mailsGuidsIterator,
+		corpsesGuidsIterator,
+		equipmentSetsGuidsIterator,
+		groupsGuidsIterator,
+		arenaTeamsGuidsIterator,
+	}
+
+	var errs []error
+	for _, mgr := range mgrs {
+		// Providers are not set up if the lib is closed before the TC9InitLib finished.
+		if mgr == nil {
+			continue
+		}
+
+		if err := mgr.ReturnUnused(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
 var guidServiceClient guidPB.GuidServiceClient
@@ -7,6 +7,9 @@ import (
 	"github.com/walkline/ToCloud9/gen/guid/pb"
 )
 
+// LibVer is the api version that is sent to the guid server.
+var LibVer string
+
 type diapason struct {
 	start uint64
 	end   uint64
@@ -31,7 +34,7 @@ func NewInstancesGRPCDiapasonsProvider(client pb.GuidServiceClient, realmID uint
 func NewGRPCDiapasonsProvider(client pb.GuidServiceClient, realmID uint32, guidType pb.GuidType, desiredPoolSize uint64) DiapasonsProvider {
 	f := func(ctx context.Context) ([]diapason, error) {
 		resp, err := client.GetGUIDPool(ctx, &pb.GetGUIDPoolRequest{
-			Api:             "", // TODO: add supported version here.
+			Api:             LibVer,
 			RealmID:         realmID,
 			GuidType:        guidType,
 			DesiredPoolSize: desiredPoolSize,
@@ -51,6 +54,43 @@ func NewGRPCDiapasonsProvider(client pb.GuidServiceClient, realmID uint32, guidT
 	return diapasonsProviderFunc(f)
 }
 
+// DiapasonsReturner returns unused diapasons back to the pool.
+type DiapasonsReturner interface {
+	ReturnDiapasons(ctx context.Context, diapasons []diapason) error
+}
+
+func NewGRPCDiapasonsReturner(client pb.GuidServiceClient, realmID uint32, guidType pb.GuidType) DiapasonsReturner {
+	f := func(ctx context.Context, diapasons []diapason) error {
+		req := &pb.ReturnGUIDPoolRequest{
+			Api:       LibVer,
+			RealmID:   realmID,
+			GuidType:  guidType,
+			Diapasons: make([]*pb.GetGUIDPoolRequestResponse_GuidDiapason, len(diapasons)),
+		}
+		for i := range diapasons {
+			req.Diapasons[i] = &pb.GetGUIDPoolRequestResponse_GuidDiapason{
+				Start: diapasons[i].start,
+				End:   diapasons[i].end,
+			}
+		}
+
+		_, err := client.ReturnGUIDPool(ctx, req)
+		if err != nil {
+			return fmt.Errorf("can't return guid diapasons for type %d, err: %w", guidType, err)
+		}
+
+		return nil
+	}
+
+	return diapasonsReturnerFunc(f)
+}
+
+type diapasonsReturnerFunc func(ctx context.Context, diapasons []diapason) error
+
+func (f diapasonsReturnerFunc) ReturnDiapasons(ctx context.Context, diapasons []diapason) error {
+	return f(ctx, diapasons)
+}
+
 type diapasonsProviderFunc func(ctx context.Context) ([]diapason, error)
 
 func (f diapasonsProviderFunc) NewDiapasons(ctx context.Context) ([]diapason, error) {
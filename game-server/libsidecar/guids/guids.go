@@ -12,6 +12,10 @@ import (
 type GuidProvider interface {
 	// Next provides cluster unique safe to use guid.
 	Next() uint64
+
+	// Unused returns diapasons that were received, but not used yet.
+	// Provider shouldn't be used after this call.
+	Unused() []diapason
 }
 
 // threadUnsafeGuidProvider provides next guids. Thread unsafe.
@@ -69,6 +73,29 @@ func (p *threadUnsafeGuidProvider) Next() (guid uint64) {
 	return
 }
 
+// Unused returns diapasons that were received, but not used yet.
+// Provider shouldn't be used after this call.
+func (p *threadUnsafeGuidProvider) Unused() []diapason {
+	var res []diapason
+	if p.iterator <= p.iteratorMax {
+		res = append(res, diapason{start: p.iterator, end: p.iteratorMax})
+	}
+
+	res = append(res, p.nextDiapasons...)
+
+	// Takes diapasons that were already retrieved by triggerDiapasonsRequest, but not consumed yet.
+	select {
+	case d := <-p.diapasonsChan:
+		res = append(res, d...)
+	default:
+	}
+
+	p.iterator = p.iteratorMax + 1
+	p.nextDiapasons = nil
+
+	return res
+}
+
 // reloadIterator reloads p.iterator by consuming p.nextDiapasons item.
 func (p *threadUnsafeGuidProvider) reloadIterator() {
 	if len(p.nextDiapasons) == 0 {
@@ -91,3 +91,20 @@ func Test_threadUnsafeGuidProvider_Next_TwoDiapasons(t *testing.T) {
 		assert.Equal(t, uint64(i+1), provider.Next())
 	}
 }
+
+func Test_threadUnsafeGuidProvider_Unused(t *testing.T) {
+	diapasonsProvider := &diapasonsProviderMock{
+		diapasonSize:      10,
+		diapasonsToReturn: 2,
+	}
+
+	provider, err := NewThreadUnsafeGuidProvider(context.Background(), diapasonsProvider, 90)
+	assert.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		provider.Next()
+	}
+
+	assert.Equal(t, []diapason{{start: 5, end: 10}, {start: 11, end: 20}}, provider.Unused())
+	assert.Empty(t, provider.Unused())
+}
@@ -2,6 +2,7 @@ package guids
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rs/zerolog/log"
 
@@ -26,7 +27,7 @@ func NewCrossRealmMgr(client pb.GuidServiceClient, guidType pb.GuidType, desired
 	}
 
 	mgr.InitForRealm(defaultRealmID)
-	
+
 	return mgr
 }
 
@@ -51,3 +52,21 @@ func (m *CrossrealmMgr) InitForRealm(realmID uint32) GuidProvider {
 	}
 	return m.realmsContainer[realmID]
 }
+
+// ReturnUnused returns unused guids of every realm back to the guid service.
+// Manager shouldn't be used after this call.
+func (m *CrossrealmMgr) ReturnUnused(ctx context.Context) error {
+	var errs []error
+	for realmID, provider := range m.realmsContainer {
+		unused := provider.Unused()
+		if len(unused) == 0 {
+			continue
+		}
+
+		err := NewGRPCDiapasonsReturner(m.client, realmID, m.guidType).ReturnDiapasons(ctx, unused)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -99,6 +99,10 @@ func initLib(realmID uint32) (*config.Config, healthandmetrics.Server, ShutdownF
 			log.Fatal().Err(err).Msg("failed to close servers registry connection")
 		}
 
+		if err = ReturnUnusedGuids(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to return unused guids")
+		}
+
 		if err = guidConn.Close(); err != nil {
 			log.Fatal().Err(err).Msg("failed to close guid service connection")
 		}
@@ -126,6 +130,9 @@ var shutdownFunc ShutdownFunc
 // AssignedGameServerID is ID assigned by servers registry to this game server.
 var AssignedGameServerID string
 
+// RegisteredMaps is maps that servers registry assigned to this game server on registration, they are loaded on startup.
+var RegisteredMaps []uint32
+
 // TC9InitLib inits lib by starting services like grpc and healthcheck.
 // Adds game server to the servers registry that will make this server visible for gateway.
 //
@@ -163,6 +170,9 @@ func TC9InitLib(port uint16, realmID uint32, isCrossRealm bool, availableMaps *C
 	}
 
 	AssignedGameServerID = res.Id
+	RegisteredMaps = res.AssignedMaps
+
+	notifyRegisteredRegions(res.Regions)
 
 	if len(res.AssignedMaps) > 0 {
 		*assignedMaps = (*C.uint32_t)(C.malloc(C.size_t(len(res.AssignedMaps)) * C.size_t(unsafe.Sizeof(C.uint32_t(0)))))
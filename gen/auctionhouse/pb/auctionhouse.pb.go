@@ -1556,6 +1556,398 @@ func (x *AuctionListPendingSalesResponse) GetCount() uint32 {
 	return 0
 }
 
+type AuctionMarketStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RealmID uint32 `protobuf:"varint,1,opt,name=realmID,proto3" json:"realmID,omitempty"`
+	// houseID is the auction house to get stats for, 0 means all houses.
+	HouseID   uint32 `protobuf:"varint,2,opt,name=houseID,proto3" json:"houseID,omitempty"`
+	ItemEntry uint32 `protobuf:"varint,3,opt,name=itemEntry,proto3" json:"itemEntry,omitempty"`
+	// fromTime and toTime are unix timestamps of the period, 0 toTime means now.
+	FromTime int64 `protobuf:"varint,4,opt,name=fromTime,proto3" json:"fromTime,omitempty"`
+	ToTime   int64 `protobuf:"varint,5,opt,name=toTime,proto3" json:"toTime,omitempty"`
+	// bucketSecs is the size of the time series bucket, 0 means one day.
+	BucketSecs uint32 `protobuf:"varint,6,opt,name=bucketSecs,proto3" json:"bucketSecs,omitempty"`
+}
+
+func (x *AuctionMarketStatsRequest) Reset() {
+	*x = AuctionMarketStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionMarketStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionMarketStatsRequest) ProtoMessage() {}
+
+func (x *AuctionMarketStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionMarketStatsRequest.ProtoReflect.Descriptor instead.
+func (*AuctionMarketStatsRequest) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuctionMarketStatsRequest) GetRealmID() uint32 {
+	if x != nil {
+		return x.RealmID
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsRequest) GetHouseID() uint32 {
+	if x != nil {
+		return x.HouseID
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsRequest) GetItemEntry() uint32 {
+	if x != nil {
+		return x.ItemEntry
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsRequest) GetFromTime() int64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsRequest) GetToTime() int64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsRequest) GetBucketSecs() uint32 {
+	if x != nil {
+		return x.BucketSecs
+	}
+	return 0
+}
+
+// AuctionMarketStats is the sales stats of the item, all prices are per one item in copper.
+type AuctionMarketStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SalesCount  uint32 `protobuf:"varint,1,opt,name=salesCount,proto3" json:"salesCount,omitempty"`
+	Volume      uint64 `protobuf:"varint,2,opt,name=volume,proto3" json:"volume,omitempty"`
+	MinPrice    uint32 `protobuf:"varint,3,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MedianPrice uint32 `protobuf:"varint,4,opt,name=medianPrice,proto3" json:"medianPrice,omitempty"`
+	MeanPrice   uint32 `protobuf:"varint,5,opt,name=meanPrice,proto3" json:"meanPrice,omitempty"`
+}
+
+func (x *AuctionMarketStats) Reset() {
+	*x = AuctionMarketStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionMarketStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionMarketStats) ProtoMessage() {}
+
+func (x *AuctionMarketStats) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionMarketStats.ProtoReflect.Descriptor instead.
+func (*AuctionMarketStats) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuctionMarketStats) GetSalesCount() uint32 {
+	if x != nil {
+		return x.SalesCount
+	}
+	return 0
+}
+
+func (x *AuctionMarketStats) GetVolume() uint64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *AuctionMarketStats) GetMinPrice() uint32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *AuctionMarketStats) GetMedianPrice() uint32 {
+	if x != nil {
+		return x.MedianPrice
+	}
+	return 0
+}
+
+func (x *AuctionMarketStats) GetMeanPrice() uint32 {
+	if x != nil {
+		return x.MeanPrice
+	}
+	return 0
+}
+
+type AuctionMarketStatsPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time  int64               `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Stats *AuctionMarketStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *AuctionMarketStatsPoint) Reset() {
+	*x = AuctionMarketStatsPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionMarketStatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionMarketStatsPoint) ProtoMessage() {}
+
+func (x *AuctionMarketStatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionMarketStatsPoint.ProtoReflect.Descriptor instead.
+func (*AuctionMarketStatsPoint) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AuctionMarketStatsPoint) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsPoint) GetStats() *AuctionMarketStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type AuctionMarketStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemEntry uint32                     `protobuf:"varint,1,opt,name=itemEntry,proto3" json:"itemEntry,omitempty"`
+	HouseID   uint32                     `protobuf:"varint,2,opt,name=houseID,proto3" json:"houseID,omitempty"`
+	Total     *AuctionMarketStats        `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Series    []*AuctionMarketStatsPoint `protobuf:"bytes,4,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *AuctionMarketStatsResponse) Reset() {
+	*x = AuctionMarketStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionMarketStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionMarketStatsResponse) ProtoMessage() {}
+
+func (x *AuctionMarketStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionMarketStatsResponse.ProtoReflect.Descriptor instead.
+func (*AuctionMarketStatsResponse) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AuctionMarketStatsResponse) GetItemEntry() uint32 {
+	if x != nil {
+		return x.ItemEntry
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsResponse) GetHouseID() uint32 {
+	if x != nil {
+		return x.HouseID
+	}
+	return 0
+}
+
+func (x *AuctionMarketStatsResponse) GetTotal() *AuctionMarketStats {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *AuctionMarketStatsResponse) GetSeries() []*AuctionMarketStatsPoint {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type AuctionRealmOwnerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RealmID uint32 `protobuf:"varint,1,opt,name=realmID,proto3" json:"realmID,omitempty"`
+}
+
+func (x *AuctionRealmOwnerRequest) Reset() {
+	*x = AuctionRealmOwnerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionRealmOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionRealmOwnerRequest) ProtoMessage() {}
+
+func (x *AuctionRealmOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionRealmOwnerRequest.ProtoReflect.Descriptor instead.
+func (*AuctionRealmOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuctionRealmOwnerRequest) GetRealmID() uint32 {
+	if x != nil {
+		return x.RealmID
+	}
+	return 0
+}
+
+// AuctionRealmOwnerResponse contains address of the instance that owns auctions of the realm.
+// Requests of the realm sent to other instances fail with FAILED_PRECONDITION code.
+type AuctionRealmOwnerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AuctionRealmOwnerResponse) Reset() {
+	*x = AuctionRealmOwnerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_auctionhouse_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuctionRealmOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuctionRealmOwnerResponse) ProtoMessage() {}
+
+func (x *AuctionRealmOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auctionhouse_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuctionRealmOwnerResponse.ProtoReflect.Descriptor instead.
+func (*AuctionRealmOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_auctionhouse_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AuctionRealmOwnerResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
 var File_auctionhouse_proto protoreflect.FileDescriptor
 
 var file_auctionhouse_proto_rawDesc = []byte{
@@ -1769,61 +2161,118 @@ var file_auctionhouse_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
 	0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x2a, 0xcb, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f,
-	0x75, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x48, 0x5f, 0x4f,
-	0x4b, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54,
-	0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x48, 0x5f, 0x44, 0x41, 0x54, 0x41,
-	0x42, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
-	0x41, 0x48, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55, 0x47, 0x48, 0x5f, 0x4d, 0x4f,
-	0x4e, 0x45, 0x59, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x48, 0x5f, 0x49, 0x54, 0x45, 0x4d,
-	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
-	0x41, 0x48, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x45, 0x52, 0x5f, 0x42, 0x49, 0x44, 0x10, 0x05, 0x12,
-	0x14, 0x0a, 0x10, 0x41, 0x48, 0x5f, 0x42, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x4d,
-	0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x48, 0x5f, 0x42, 0x49, 0x44, 0x5f,
-	0x4f, 0x57, 0x4e, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x54,
-	0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x0d,
-	0x32, 0xf7, 0x04, 0x0a, 0x13, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x75, 0x73,
-	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c,
-	0x6f, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
-	0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c,
-	0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x42, 0x69, 0x64, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
-	0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0e,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x20,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64, 0x65,
-	0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x19, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f, 0x75,
+	0x73, 0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x65, 0x63, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x65, 0x63, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x73, 0x61, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x22, 0x5b, 0x0a, 0x17, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xb7,
+	0x01, 0x0a, 0x1a, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x6f, 0x75, 0x73, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x6f,
+	0x75, 0x73, 0x65, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x41, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x22, 0x35,
+	0x0a, 0x19, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x2a, 0xcb, 0x01, 0x0a, 0x11, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x6f, 0x75, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x09, 0x0a, 0x05, 0x41,
+	0x48, 0x5f, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x48, 0x5f, 0x49, 0x4e, 0x56,
+	0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x48, 0x5f, 0x44,
+	0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x41, 0x48, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x4f, 0x55, 0x47, 0x48,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x45, 0x59, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x48, 0x5f, 0x49,
+	0x54, 0x45, 0x4d, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x04, 0x12,
+	0x11, 0x0a, 0x0d, 0x41, 0x48, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x45, 0x52, 0x5f, 0x42, 0x49, 0x44,
+	0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x48, 0x5f, 0x42, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x43,
+	0x52, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x48, 0x5f, 0x42,
+	0x49, 0x44, 0x5f, 0x4f, 0x57, 0x4e, 0x10, 0x0a, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x48, 0x5f, 0x52,
+	0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x10, 0x0d, 0x32, 0x90, 0x06, 0x0a, 0x13, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x6f, 0x75, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x05, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x65, 0x6c, 0x6c, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x6c,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x08,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x55, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69,
+	0x64, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x2e, 0x41,
 	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64, 0x64, 0x65, 0x72,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
-	0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6c, 0x65,
-	0x73, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65,
-	0x6e, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x69, 0x64,
+	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x61, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x61, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x52,
+	0x65, 0x61, 0x6c, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x6c, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1839,7 +2288,7 @@ func file_auctionhouse_proto_rawDescGZIP() []byte {
 }
 
 var file_auctionhouse_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_auctionhouse_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_auctionhouse_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_auctionhouse_proto_goTypes = []interface{}{
 	(AuctionHouseError)(0),                  // 0: v1.AuctionHouseError
 	(*AuctionItem)(nil),                     // 1: v1.AuctionItem
@@ -1861,6 +2310,12 @@ var file_auctionhouse_proto_goTypes = []interface{}{
 	(*AuctionListBidderItemsResponse)(nil),  // 17: v1.AuctionListBidderItemsResponse
 	(*AuctionListPendingSalesRequest)(nil),  // 18: v1.AuctionListPendingSalesRequest
 	(*AuctionListPendingSalesResponse)(nil), // 19: v1.AuctionListPendingSalesResponse
+	(*AuctionMarketStatsRequest)(nil),       // 20: v1.AuctionMarketStatsRequest
+	(*AuctionMarketStats)(nil),              // 21: v1.AuctionMarketStats
+	(*AuctionMarketStatsPoint)(nil),         // 22: v1.AuctionMarketStatsPoint
+	(*AuctionMarketStatsResponse)(nil),      // 23: v1.AuctionMarketStatsResponse
+	(*AuctionRealmOwnerRequest)(nil),        // 24: v1.AuctionRealmOwnerRequest
+	(*AuctionRealmOwnerResponse)(nil),       // 25: v1.AuctionRealmOwnerResponse
 }
 var file_auctionhouse_proto_depIdxs = []int32{
 	2,  // 0: v1.AuctionItem.enchantments:type_name -> v1.AuctionEnchantment
@@ -1872,27 +2327,34 @@ var file_auctionhouse_proto_depIdxs = []int32{
 	1,  // 6: v1.AuctionListItemsResponse.items:type_name -> v1.AuctionItem
 	1,  // 7: v1.AuctionListOwnerItemsResponse.items:type_name -> v1.AuctionItem
 	1,  // 8: v1.AuctionListBidderItemsResponse.items:type_name -> v1.AuctionItem
-	4,  // 9: v1.AuctionHouseService.Hello:input_type -> v1.AuctionHelloRequest
-	6,  // 10: v1.AuctionHouseService.SellItem:input_type -> v1.AuctionSellItemRequest
-	8,  // 11: v1.AuctionHouseService.PlaceBid:input_type -> v1.AuctionPlaceBidRequest
-	10, // 12: v1.AuctionHouseService.CancelAuction:input_type -> v1.AuctionCancelRequest
-	12, // 13: v1.AuctionHouseService.ListItems:input_type -> v1.AuctionListItemsRequest
-	14, // 14: v1.AuctionHouseService.ListOwnerItems:input_type -> v1.AuctionListOwnerItemsRequest
-	16, // 15: v1.AuctionHouseService.ListBidderItems:input_type -> v1.AuctionListBidderItemsRequest
-	18, // 16: v1.AuctionHouseService.ListPendingSales:input_type -> v1.AuctionListPendingSalesRequest
-	5,  // 17: v1.AuctionHouseService.Hello:output_type -> v1.AuctionHelloResponse
-	7,  // 18: v1.AuctionHouseService.SellItem:output_type -> v1.AuctionSellItemResponse
-	9,  // 19: v1.AuctionHouseService.PlaceBid:output_type -> v1.AuctionPlaceBidResponse
-	11, // 20: v1.AuctionHouseService.CancelAuction:output_type -> v1.AuctionCancelResponse
-	13, // 21: v1.AuctionHouseService.ListItems:output_type -> v1.AuctionListItemsResponse
-	15, // 22: v1.AuctionHouseService.ListOwnerItems:output_type -> v1.AuctionListOwnerItemsResponse
-	17, // 23: v1.AuctionHouseService.ListBidderItems:output_type -> v1.AuctionListBidderItemsResponse
-	19, // 24: v1.AuctionHouseService.ListPendingSales:output_type -> v1.AuctionListPendingSalesResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	21, // 9: v1.AuctionMarketStatsPoint.stats:type_name -> v1.AuctionMarketStats
+	21, // 10: v1.AuctionMarketStatsResponse.total:type_name -> v1.AuctionMarketStats
+	22, // 11: v1.AuctionMarketStatsResponse.series:type_name -> v1.AuctionMarketStatsPoint
+	4,  // 12: v1.AuctionHouseService.Hello:input_type -> v1.AuctionHelloRequest
+	6,  // 13: v1.AuctionHouseService.SellItem:input_type -> v1.AuctionSellItemRequest
+	8,  // 14: v1.AuctionHouseService.PlaceBid:input_type -> v1.AuctionPlaceBidRequest
+	10, // 15: v1.AuctionHouseService.CancelAuction:input_type -> v1.AuctionCancelRequest
+	12, // 16: v1.AuctionHouseService.ListItems:input_type -> v1.AuctionListItemsRequest
+	14, // 17: v1.AuctionHouseService.ListOwnerItems:input_type -> v1.AuctionListOwnerItemsRequest
+	16, // 18: v1.AuctionHouseService.ListBidderItems:input_type -> v1.AuctionListBidderItemsRequest
+	18, // 19: v1.AuctionHouseService.ListPendingSales:input_type -> v1.AuctionListPendingSalesRequest
+	20, // 20: v1.AuctionHouseService.MarketStats:input_type -> v1.AuctionMarketStatsRequest
+	24, // 21: v1.AuctionHouseService.RealmOwner:input_type -> v1.AuctionRealmOwnerRequest
+	5,  // 22: v1.AuctionHouseService.Hello:output_type -> v1.AuctionHelloResponse
+	7,  // 23: v1.AuctionHouseService.SellItem:output_type -> v1.AuctionSellItemResponse
+	9,  // 24: v1.AuctionHouseService.PlaceBid:output_type -> v1.AuctionPlaceBidResponse
+	11, // 25: v1.AuctionHouseService.CancelAuction:output_type -> v1.AuctionCancelResponse
+	13, // 26: v1.AuctionHouseService.ListItems:output_type -> v1.AuctionListItemsResponse
+	15, // 27: v1.AuctionHouseService.ListOwnerItems:output_type -> v1.AuctionListOwnerItemsResponse
+	17, // 28: v1.AuctionHouseService.ListBidderItems:output_type -> v1.AuctionListBidderItemsResponse
+	19, // 29: v1.AuctionHouseService.ListPendingSales:output_type -> v1.AuctionListPendingSalesResponse
+	23, // 30: v1.AuctionHouseService.MarketStats:output_type -> v1.AuctionMarketStatsResponse
+	25, // 31: v1.AuctionHouseService.RealmOwner:output_type -> v1.AuctionRealmOwnerResponse
+	22, // [22:32] is the sub-list for method output_type
+	12, // [12:22] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_auctionhouse_proto_init() }
@@ -2129,6 +2591,78 @@ func file_auctionhouse_proto_init() {
 				return nil
 			}
 		}
+		file_auctionhouse_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionMarketStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auctionhouse_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionMarketStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auctionhouse_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionMarketStatsPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auctionhouse_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionMarketStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auctionhouse_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionRealmOwnerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_auctionhouse_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuctionRealmOwnerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2136,7 +2670,7 @@ func file_auctionhouse_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_auctionhouse_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
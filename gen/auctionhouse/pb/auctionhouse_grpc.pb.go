@@ -27,6 +27,8 @@ const (
 	AuctionHouseService_ListOwnerItems_FullMethodName   = "/v1.AuctionHouseService/ListOwnerItems"
 	AuctionHouseService_ListBidderItems_FullMethodName  = "/v1.AuctionHouseService/ListBidderItems"
 	AuctionHouseService_ListPendingSales_FullMethodName = "/v1.AuctionHouseService/ListPendingSales"
+	AuctionHouseService_MarketStats_FullMethodName      = "/v1.AuctionHouseService/MarketStats"
+	AuctionHouseService_RealmOwner_FullMethodName       = "/v1.AuctionHouseService/RealmOwner"
 )
 
 // AuctionHouseServiceClient is the client API for AuctionHouseService service.
@@ -41,6 +43,8 @@ type AuctionHouseServiceClient interface {
 	ListOwnerItems(ctx context.Context, in *AuctionListOwnerItemsRequest, opts ...grpc.CallOption) (*AuctionListOwnerItemsResponse, error)
 	ListBidderItems(ctx context.Context, in *AuctionListBidderItemsRequest, opts ...grpc.CallOption) (*AuctionListBidderItemsResponse, error)
 	ListPendingSales(ctx context.Context, in *AuctionListPendingSalesRequest, opts ...grpc.CallOption) (*AuctionListPendingSalesResponse, error)
+	MarketStats(ctx context.Context, in *AuctionMarketStatsRequest, opts ...grpc.CallOption) (*AuctionMarketStatsResponse, error)
+	RealmOwner(ctx context.Context, in *AuctionRealmOwnerRequest, opts ...grpc.CallOption) (*AuctionRealmOwnerResponse, error)
 }
 
 type auctionHouseServiceClient struct {
@@ -123,6 +127,24 @@ func (c *auctionHouseServiceClient) ListPendingSales(ctx context.Context, in *Au
 	return out, nil
 }
 
+func (c *auctionHouseServiceClient) MarketStats(ctx context.Context, in *AuctionMarketStatsRequest, opts ...grpc.CallOption) (*AuctionMarketStatsResponse, error) {
+	out := new(AuctionMarketStatsResponse)
+	err := c.cc.Invoke(ctx, AuctionHouseService_MarketStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auctionHouseServiceClient) RealmOwner(ctx context.Context, in *AuctionRealmOwnerRequest, opts ...grpc.CallOption) (*AuctionRealmOwnerResponse, error) {
+	out := new(AuctionRealmOwnerResponse)
+	err := c.cc.Invoke(ctx, AuctionHouseService_RealmOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuctionHouseServiceServer is the server API for AuctionHouseService service.
 // All implementations must embed UnimplementedAuctionHouseServiceServer
 // for forward compatibility
@@ -135,6 +157,8 @@ type AuctionHouseServiceServer interface {
 	ListOwnerItems(context.Context, *AuctionListOwnerItemsRequest) (*AuctionListOwnerItemsResponse, error)
 	ListBidderItems(context.Context, *AuctionListBidderItemsRequest) (*AuctionListBidderItemsResponse, error)
 	ListPendingSales(context.Context, *AuctionListPendingSalesRequest) (*AuctionListPendingSalesResponse, error)
+	MarketStats(context.Context, *AuctionMarketStatsRequest) (*AuctionMarketStatsResponse, error)
+	RealmOwner(context.Context, *AuctionRealmOwnerRequest) (*AuctionRealmOwnerResponse, error)
 	mustEmbedUnimplementedAuctionHouseServiceServer()
 }
 
@@ -166,6 +190,12 @@ func (UnimplementedAuctionHouseServiceServer) ListBidderItems(context.Context, *
 func (UnimplementedAuctionHouseServiceServer) ListPendingSales(context.Context, *AuctionListPendingSalesRequest) (*AuctionListPendingSalesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPendingSales not implemented")
 }
+func (UnimplementedAuctionHouseServiceServer) MarketStats(context.Context, *AuctionMarketStatsRequest) (*AuctionMarketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketStats not implemented")
+}
+func (UnimplementedAuctionHouseServiceServer) RealmOwner(context.Context, *AuctionRealmOwnerRequest) (*AuctionRealmOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RealmOwner not implemented")
+}
 func (UnimplementedAuctionHouseServiceServer) mustEmbedUnimplementedAuctionHouseServiceServer() {}
 
 // UnsafeAuctionHouseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -323,6 +353,42 @@ func _AuctionHouseService_ListPendingSales_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuctionHouseService_MarketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuctionMarketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionHouseServiceServer).MarketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionHouseService_MarketStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionHouseServiceServer).MarketStats(ctx, req.(*AuctionMarketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuctionHouseService_RealmOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuctionRealmOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuctionHouseServiceServer).RealmOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuctionHouseService_RealmOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuctionHouseServiceServer).RealmOwner(ctx, req.(*AuctionRealmOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuctionHouseService_ServiceDesc is the grpc.ServiceDesc for AuctionHouseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +428,14 @@ var AuctionHouseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPendingSales",
 			Handler:    _AuctionHouseService_ListPendingSales_Handler,
 		},
+		{
+			MethodName: "MarketStats",
+			Handler:    _AuctionHouseService_MarketStats_Handler,
+		},
+		{
+			MethodName: "RealmOwner",
+			Handler:    _AuctionHouseService_RealmOwner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auctionhouse.proto",
@@ -36,6 +36,8 @@ const (
 	ChatService_ToggleChannelModeration_FullMethodName    = "/v1.ChatService/ToggleChannelModeration"
 	ChatService_ToggleChannelAnnouncements_FullMethodName = "/v1.ChatService/ToggleChannelAnnouncements"
 	ChatService_InviteToChannel_FullMethodName            = "/v1.ChatService/InviteToChannel"
+	ChatService_SearchChatLog_FullMethodName              = "/v1.ChatService/SearchChatLog"
+	ChatService_FilterChatMessage_FullMethodName          = "/v1.ChatService/FilterChatMessage"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -62,6 +64,11 @@ type ChatServiceClient interface {
 	ToggleChannelModeration(ctx context.Context, in *ToggleChannelModerationRequest, opts ...grpc.CallOption) (*ToggleChannelModerationResponse, error)
 	ToggleChannelAnnouncements(ctx context.Context, in *ToggleChannelAnnouncementsRequest, opts ...grpc.CallOption) (*ToggleChannelAnnouncementsResponse, error)
 	InviteToChannel(ctx context.Context, in *InviteToChannelRequest, opts ...grpc.CallOption) (*InviteToChannelResponse, error)
+	// Chat log for moderation
+	SearchChatLog(ctx context.Context, in *SearchChatLogRequest, opts ...grpc.CallOption) (*SearchChatLogResponse, error)
+	// Chat filter for the messages that don't go through chat service (say, yell, guild, group, etc.).
+	// Whispers and channel messages are filtered by SendWhisperMessage and SendChannelMessage.
+	FilterChatMessage(ctx context.Context, in *FilterChatMessageRequest, opts ...grpc.CallOption) (*FilterChatMessageResponse, error)
 }
 
 type chatServiceClient struct {
@@ -225,6 +232,24 @@ func (c *chatServiceClient) InviteToChannel(ctx context.Context, in *InviteToCha
 	return out, nil
 }
 
+func (c *chatServiceClient) SearchChatLog(ctx context.Context, in *SearchChatLogRequest, opts ...grpc.CallOption) (*SearchChatLogResponse, error) {
+	out := new(SearchChatLogResponse)
+	err := c.cc.Invoke(ctx, ChatService_SearchChatLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) FilterChatMessage(ctx context.Context, in *FilterChatMessageRequest, opts ...grpc.CallOption) (*FilterChatMessageResponse, error) {
+	out := new(FilterChatMessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_FilterChatMessage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility
@@ -249,6 +274,11 @@ type ChatServiceServer interface {
 	ToggleChannelModeration(context.Context, *ToggleChannelModerationRequest) (*ToggleChannelModerationResponse, error)
 	ToggleChannelAnnouncements(context.Context, *ToggleChannelAnnouncementsRequest) (*ToggleChannelAnnouncementsResponse, error)
 	InviteToChannel(context.Context, *InviteToChannelRequest) (*InviteToChannelResponse, error)
+	// Chat log for moderation
+	SearchChatLog(context.Context, *SearchChatLogRequest) (*SearchChatLogResponse, error)
+	// Chat filter for the messages that don't go through chat service (say, yell, guild, group, etc.).
+	// Whispers and channel messages are filtered by SendWhisperMessage and SendChannelMessage.
+	FilterChatMessage(context.Context, *FilterChatMessageRequest) (*FilterChatMessageResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -307,6 +337,12 @@ func (UnimplementedChatServiceServer) ToggleChannelAnnouncements(context.Context
 func (UnimplementedChatServiceServer) InviteToChannel(context.Context, *InviteToChannelRequest) (*InviteToChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InviteToChannel not implemented")
 }
+func (UnimplementedChatServiceServer) SearchChatLog(context.Context, *SearchChatLogRequest) (*SearchChatLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchChatLog not implemented")
+}
+func (UnimplementedChatServiceServer) FilterChatMessage(context.Context, *FilterChatMessageRequest) (*FilterChatMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterChatMessage not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 
 // UnsafeChatServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -626,6 +662,42 @@ func _ChatService_InviteToChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_SearchChatLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchChatLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SearchChatLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_SearchChatLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SearchChatLog(ctx, req.(*SearchChatLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_FilterChatMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).FilterChatMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_FilterChatMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).FilterChatMessage(ctx, req.(*FilterChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -701,6 +773,14 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InviteToChannel",
 			Handler:    _ChatService_InviteToChannel_Handler,
 		},
+		{
+			MethodName: "SearchChatLog",
+			Handler:    _ChatService_SearchChatLog_Handler,
+		},
+		{
+			MethodName: "FilterChatMessage",
+			Handler:    _ChatService_FilterChatMessage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat.proto",
@@ -50,6 +50,39 @@ func (_m *ChatServiceClient) BanFromChannel(ctx context.Context, in *pb.BanFromC
 	return r0, r1
 }
 
+// FilterChatMessage provides a mock function with given fields: ctx, in, opts
+func (_m *ChatServiceClient) FilterChatMessage(ctx context.Context, in *pb.FilterChatMessageRequest, opts ...grpc.CallOption) (*pb.FilterChatMessageResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.FilterChatMessageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FilterChatMessageRequest, ...grpc.CallOption) (*pb.FilterChatMessageResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.FilterChatMessageRequest, ...grpc.CallOption) *pb.FilterChatMessageResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.FilterChatMessageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.FilterChatMessageRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetChannelList provides a mock function with given fields: ctx, in, opts
 func (_m *ChatServiceClient) GetChannelList(ctx context.Context, in *pb.GetChannelListRequest, opts ...grpc.CallOption) (*pb.GetChannelListResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -215,6 +248,39 @@ func (_m *ChatServiceClient) LeaveChannel(ctx context.Context, in *pb.LeaveChann
 	return r0, r1
 }
 
+// SearchChatLog provides a mock function with given fields: ctx, in, opts
+func (_m *ChatServiceClient) SearchChatLog(ctx context.Context, in *pb.SearchChatLogRequest, opts ...grpc.CallOption) (*pb.SearchChatLogResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.SearchChatLogResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SearchChatLogRequest, ...grpc.CallOption) (*pb.SearchChatLogResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SearchChatLogRequest, ...grpc.CallOption) *pb.SearchChatLogResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.SearchChatLogResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.SearchChatLogRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendChannelMessage provides a mock function with given fields: ctx, in, opts
 func (_m *ChatServiceClient) SendChannelMessage(ctx context.Context, in *pb.SendChannelMessageRequest, opts ...grpc.CallOption) (*pb.SendChannelMessageResponse, error) {
 	_va := make([]interface{}, len(opts))
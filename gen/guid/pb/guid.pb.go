@@ -26,6 +26,13 @@ const (
 	GuidType_Character GuidType = 0
 	GuidType_Item      GuidType = 1
 	GuidType_Instance  GuidType = 2
+	GuidType_Pet       GuidType = 3
+	// Named MailID to avoid clash with v1.Mail message.
+	GuidType_MailID       GuidType = 4
+	GuidType_Corpse       GuidType = 5
+	GuidType_EquipmentSet GuidType = 6
+	GuidType_Group        GuidType = 7
+	GuidType_ArenaTeam    GuidType = 8
 )
 
 // Enum value maps for GuidType.
@@ -34,11 +41,23 @@ var (
 		0: "Character",
 		1: "Item",
 		2: "Instance",
+		3: "Pet",
+		4: "MailID",
+		5: "Corpse",
+		6: "EquipmentSet",
+		7: "Group",
+		8: "ArenaTeam",
 	}
 	GuidType_value = map[string]int32{
-		"Character": 0,
-		"Item":      1,
-		"Instance":  2,
+		"Character":    0,
+		"Item":         1,
+		"Instance":     2,
+		"Pet":          3,
+		"MailID":       4,
+		"Corpse":       5,
+		"EquipmentSet": 6,
+		"Group":        7,
+		"ArenaTeam":    8,
 	}
 )
 
@@ -195,6 +214,132 @@ func (x *GetGUIDPoolRequestResponse) GetReceiverGUID() []*GetGUIDPoolRequestResp
 	return nil
 }
 
+type ReturnGUIDPoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Api       string                                     `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	RealmID   uint32                                     `protobuf:"varint,2,opt,name=realmID,proto3" json:"realmID,omitempty"`
+	GuidType  GuidType                                   `protobuf:"varint,3,opt,name=guidType,proto3,enum=v1.GuidType" json:"guidType,omitempty"`
+	Diapasons []*GetGUIDPoolRequestResponse_GuidDiapason `protobuf:"bytes,4,rep,name=diapasons,proto3" json:"diapasons,omitempty"`
+}
+
+func (x *ReturnGUIDPoolRequest) Reset() {
+	*x = ReturnGUIDPoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_guid_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnGUIDPoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnGUIDPoolRequest) ProtoMessage() {}
+
+func (x *ReturnGUIDPoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_guid_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnGUIDPoolRequest.ProtoReflect.Descriptor instead.
+func (*ReturnGUIDPoolRequest) Descriptor() ([]byte, []int) {
+	return file_guid_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReturnGUIDPoolRequest) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *ReturnGUIDPoolRequest) GetRealmID() uint32 {
+	if x != nil {
+		return x.RealmID
+	}
+	return 0
+}
+
+func (x *ReturnGUIDPoolRequest) GetGuidType() GuidType {
+	if x != nil {
+		return x.GuidType
+	}
+	return GuidType_Character
+}
+
+func (x *ReturnGUIDPoolRequest) GetDiapasons() []*GetGUIDPoolRequestResponse_GuidDiapason {
+	if x != nil {
+		return x.Diapasons
+	}
+	return nil
+}
+
+type ReturnGUIDPoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Api           string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	GuidsReturned uint64 `protobuf:"varint,2,opt,name=guidsReturned,proto3" json:"guidsReturned,omitempty"`
+}
+
+func (x *ReturnGUIDPoolResponse) Reset() {
+	*x = ReturnGUIDPoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_guid_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnGUIDPoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnGUIDPoolResponse) ProtoMessage() {}
+
+func (x *ReturnGUIDPoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_guid_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnGUIDPoolResponse.ProtoReflect.Descriptor instead.
+func (*ReturnGUIDPoolResponse) Descriptor() ([]byte, []int) {
+	return file_guid_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReturnGUIDPoolResponse) GetApi() string {
+	if x != nil {
+		return x.Api
+	}
+	return ""
+}
+
+func (x *ReturnGUIDPoolResponse) GetGuidsReturned() uint64 {
+	if x != nil {
+		return x.GuidsReturned
+	}
+	return 0
+}
+
 type GetGUIDPoolRequestResponse_GuidDiapason struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,7 +352,7 @@ type GetGUIDPoolRequestResponse_GuidDiapason struct {
 func (x *GetGUIDPoolRequestResponse_GuidDiapason) Reset() {
 	*x = GetGUIDPoolRequestResponse_GuidDiapason{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_guid_proto_msgTypes[2]
+		mi := &file_guid_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -220,7 +365,7 @@ func (x *GetGUIDPoolRequestResponse_GuidDiapason) String() string {
 func (*GetGUIDPoolRequestResponse_GuidDiapason) ProtoMessage() {}
 
 func (x *GetGUIDPoolRequestResponse_GuidDiapason) ProtoReflect() protoreflect.Message {
-	mi := &file_guid_proto_msgTypes[2]
+	mi := &file_guid_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,17 +420,43 @@ var file_guid_proto_rawDesc = []byte{
 	0x64, 0x44, 0x69, 0x61, 0x70, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
 	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
 	0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x65, 0x6e,
-	0x64, 0x2a, 0x31, 0x0a, 0x08, 0x47, 0x75, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x74, 0x65, 0x6d, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x10, 0x02, 0x32, 0x54, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f,
-	0x6f, 0x6c, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x50,
-	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x67, 0x65,
-	0x6e, 0x2f, 0x67, 0x75, 0x69, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x64, 0x22, 0xb8, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x47, 0x55, 0x49, 0x44,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x70, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
+	0x72, 0x65, 0x61, 0x6c, 0x6d, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x08, 0x67, 0x75, 0x69, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x75, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x67, 0x75, 0x69, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x49, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x70, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49,
+	0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x47, 0x75, 0x69, 0x64, 0x44, 0x69, 0x61, 0x70, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x09, 0x64, 0x69, 0x61, 0x70, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x50, 0x0a, 0x16,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x69, 0x12, 0x24, 0x0a, 0x0d, 0x67, 0x75, 0x69, 0x64,
+	0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x67, 0x75, 0x69, 0x64, 0x73, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x2a, 0x7e,
+	0x0a, 0x08, 0x47, 0x75, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x74, 0x65,
+	0x6d, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x10,
+	0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x65, 0x74, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x61,
+	0x69, 0x6c, 0x49, 0x44, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6f, 0x72, 0x70, 0x73, 0x65,
+	0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x74, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x10, 0x07, 0x12,
+	0x0d, 0x0a, 0x09, 0x41, 0x72, 0x65, 0x6e, 0x61, 0x54, 0x65, 0x61, 0x6d, 0x10, 0x08, 0x32, 0x9d,
+	0x01, 0x0a, 0x0b, 0x47, 0x75, 0x69, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x55,
+	0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x47,
+	0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x47, 0x55, 0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x47, 0x55,
+	0x49, 0x44, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0d,
+	0x5a, 0x0b, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x75, 0x69, 0x64, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -301,23 +472,29 @@ func file_guid_proto_rawDescGZIP() []byte {
 }
 
 var file_guid_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_guid_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_guid_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_guid_proto_goTypes = []interface{}{
 	(GuidType)(0),                                   // 0: v1.GuidType
 	(*GetGUIDPoolRequest)(nil),                      // 1: v1.GetGUIDPoolRequest
 	(*GetGUIDPoolRequestResponse)(nil),              // 2: v1.GetGUIDPoolRequestResponse
-	(*GetGUIDPoolRequestResponse_GuidDiapason)(nil), // 3: v1.GetGUIDPoolRequestResponse.GuidDiapason
+	(*ReturnGUIDPoolRequest)(nil),                   // 3: v1.ReturnGUIDPoolRequest
+	(*ReturnGUIDPoolResponse)(nil),                  // 4: v1.ReturnGUIDPoolResponse
+	(*GetGUIDPoolRequestResponse_GuidDiapason)(nil), // 5: v1.GetGUIDPoolRequestResponse.GuidDiapason
 }
 var file_guid_proto_depIdxs = []int32{
 	0, // 0: v1.GetGUIDPoolRequest.guidType:type_name -> v1.GuidType
-	3, // 1: v1.GetGUIDPoolRequestResponse.receiverGUID:type_name -> v1.GetGUIDPoolRequestResponse.GuidDiapason
-	1, // 2: v1.GuidService.GetGUIDPool:input_type -> v1.GetGUIDPoolRequest
-	2, // 3: v1.GuidService.GetGUIDPool:output_type -> v1.GetGUIDPoolRequestResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: v1.GetGUIDPoolRequestResponse.receiverGUID:type_name -> v1.GetGUIDPoolRequestResponse.GuidDiapason
+	0, // 2: v1.ReturnGUIDPoolRequest.guidType:type_name -> v1.GuidType
+	5, // 3: v1.ReturnGUIDPoolRequest.diapasons:type_name -> v1.GetGUIDPoolRequestResponse.GuidDiapason
+	1, // 4: v1.GuidService.GetGUIDPool:input_type -> v1.GetGUIDPoolRequest
+	3, // 5: v1.GuidService.ReturnGUIDPool:input_type -> v1.ReturnGUIDPoolRequest
+	2, // 6: v1.GuidService.GetGUIDPool:output_type -> v1.GetGUIDPoolRequestResponse
+	4, // 7: v1.GuidService.ReturnGUIDPool:output_type -> v1.ReturnGUIDPoolResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_guid_proto_init() }
@@ -351,6 +528,30 @@ func file_guid_proto_init() {
 			}
 		}
 		file_guid_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnGUIDPoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_guid_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnGUIDPoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_guid_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetGUIDPoolRequestResponse_GuidDiapason); i {
 			case 0:
 				return &v.state
@@ -369,7 +570,7 @@ func file_guid_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_guid_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
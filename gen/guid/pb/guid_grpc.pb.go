@@ -19,7 +19,8 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GuidService_GetGUIDPool_FullMethodName = "/v1.GuidService/GetGUIDPool"
+	GuidService_GetGUIDPool_FullMethodName    = "/v1.GuidService/GetGUIDPool"
+	GuidService_ReturnGUIDPool_FullMethodName = "/v1.GuidService/ReturnGUIDPool"
 )
 
 // GuidServiceClient is the client API for GuidService service.
@@ -27,6 +28,8 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GuidServiceClient interface {
 	GetGUIDPool(ctx context.Context, in *GetGUIDPoolRequest, opts ...grpc.CallOption) (*GetGUIDPoolRequestResponse, error)
+	// ReturnGUIDPool returns unused guids back to the pool, so they can be reused by other game servers.
+	ReturnGUIDPool(ctx context.Context, in *ReturnGUIDPoolRequest, opts ...grpc.CallOption) (*ReturnGUIDPoolResponse, error)
 }
 
 type guidServiceClient struct {
@@ -46,11 +49,22 @@ func (c *guidServiceClient) GetGUIDPool(ctx context.Context, in *GetGUIDPoolRequ
 	return out, nil
 }
 
+func (c *guidServiceClient) ReturnGUIDPool(ctx context.Context, in *ReturnGUIDPoolRequest, opts ...grpc.CallOption) (*ReturnGUIDPoolResponse, error) {
+	out := new(ReturnGUIDPoolResponse)
+	err := c.cc.Invoke(ctx, GuidService_ReturnGUIDPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GuidServiceServer is the server API for GuidService service.
 // All implementations must embed UnimplementedGuidServiceServer
 // for forward compatibility
 type GuidServiceServer interface {
 	GetGUIDPool(context.Context, *GetGUIDPoolRequest) (*GetGUIDPoolRequestResponse, error)
+	// ReturnGUIDPool returns unused guids back to the pool, so they can be reused by other game servers.
+	ReturnGUIDPool(context.Context, *ReturnGUIDPoolRequest) (*ReturnGUIDPoolResponse, error)
 	mustEmbedUnimplementedGuidServiceServer()
 }
 
@@ -61,6 +75,9 @@ type UnimplementedGuidServiceServer struct {
 func (UnimplementedGuidServiceServer) GetGUIDPool(context.Context, *GetGUIDPoolRequest) (*GetGUIDPoolRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGUIDPool not implemented")
 }
+func (UnimplementedGuidServiceServer) ReturnGUIDPool(context.Context, *ReturnGUIDPoolRequest) (*ReturnGUIDPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnGUIDPool not implemented")
+}
 func (UnimplementedGuidServiceServer) mustEmbedUnimplementedGuidServiceServer() {}
 
 // UnsafeGuidServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +109,24 @@ func _GuidService_GetGUIDPool_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GuidService_ReturnGUIDPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnGUIDPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GuidServiceServer).ReturnGUIDPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GuidService_ReturnGUIDPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GuidServiceServer).ReturnGUIDPool(ctx, req.(*ReturnGUIDPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GuidService_ServiceDesc is the grpc.ServiceDesc for GuidService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,6 +138,10 @@ var GuidService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGUIDPool",
 			Handler:    _GuidService_GetGUIDPool_Handler,
 		},
+		{
+			MethodName: "ReturnGUIDPool",
+			Handler:    _GuidService_ReturnGUIDPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "guid.proto",
@@ -26,6 +26,9 @@ const (
 	MailService_MailByID_FullMethodName            = "/v1.MailService/MailByID"
 	MailService_MailsForPlayer_FullMethodName      = "/v1.MailService/MailsForPlayer"
 	MailService_DeleteMail_FullMethodName          = "/v1.MailService/DeleteMail"
+	MailService_ReturnMailToSender_FullMethodName  = "/v1.MailService/ReturnMailToSender"
+	MailService_SendSystemMail_FullMethodName      = "/v1.MailService/SendSystemMail"
+	MailService_SystemMailProgress_FullMethodName  = "/v1.MailService/SystemMailProgress"
 )
 
 // MailServiceClient is the client API for MailService service.
@@ -39,6 +42,12 @@ type MailServiceClient interface {
 	MailByID(ctx context.Context, in *MailByIDRequest, opts ...grpc.CallOption) (*MailByIDResponse, error)
 	MailsForPlayer(ctx context.Context, in *MailsForPlayerRequest, opts ...grpc.CallOption) (*MailsForPlayerResponse, error)
 	DeleteMail(ctx context.Context, in *DeleteMailRequest, opts ...grpc.CallOption) (*DeleteMailResponse, error)
+	// ReturnMailToSender returns player mail with its money and items to the sender, cash on delivery is dropped.
+	ReturnMailToSender(ctx context.Context, in *ReturnMailToSenderRequest, opts ...grpc.CallOption) (*ReturnMailToSenderResponse, error)
+	// SendSystemMail sends mail with newly created items to the characters matching the target.
+	// Mails are sent in background, progress can be checked with SystemMailProgress.
+	SendSystemMail(ctx context.Context, in *SendSystemMailRequest, opts ...grpc.CallOption) (*SendSystemMailResponse, error)
+	SystemMailProgress(ctx context.Context, in *SystemMailProgressRequest, opts ...grpc.CallOption) (*SystemMailProgressResponse, error)
 }
 
 type mailServiceClient struct {
@@ -112,6 +121,33 @@ func (c *mailServiceClient) DeleteMail(ctx context.Context, in *DeleteMailReques
 	return out, nil
 }
 
+func (c *mailServiceClient) ReturnMailToSender(ctx context.Context, in *ReturnMailToSenderRequest, opts ...grpc.CallOption) (*ReturnMailToSenderResponse, error) {
+	out := new(ReturnMailToSenderResponse)
+	err := c.cc.Invoke(ctx, MailService_ReturnMailToSender_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SendSystemMail(ctx context.Context, in *SendSystemMailRequest, opts ...grpc.CallOption) (*SendSystemMailResponse, error) {
+	out := new(SendSystemMailResponse)
+	err := c.cc.Invoke(ctx, MailService_SendSystemMail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailServiceClient) SystemMailProgress(ctx context.Context, in *SystemMailProgressRequest, opts ...grpc.CallOption) (*SystemMailProgressResponse, error) {
+	out := new(SystemMailProgressResponse)
+	err := c.cc.Invoke(ctx, MailService_SystemMailProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailServiceServer is the server API for MailService service.
 // All implementations must embed UnimplementedMailServiceServer
 // for forward compatibility
@@ -123,6 +159,12 @@ type MailServiceServer interface {
 	MailByID(context.Context, *MailByIDRequest) (*MailByIDResponse, error)
 	MailsForPlayer(context.Context, *MailsForPlayerRequest) (*MailsForPlayerResponse, error)
 	DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error)
+	// ReturnMailToSender returns player mail with its money and items to the sender, cash on delivery is dropped.
+	ReturnMailToSender(context.Context, *ReturnMailToSenderRequest) (*ReturnMailToSenderResponse, error)
+	// SendSystemMail sends mail with newly created items to the characters matching the target.
+	// Mails are sent in background, progress can be checked with SystemMailProgress.
+	SendSystemMail(context.Context, *SendSystemMailRequest) (*SendSystemMailResponse, error)
+	SystemMailProgress(context.Context, *SystemMailProgressRequest) (*SystemMailProgressResponse, error)
 	mustEmbedUnimplementedMailServiceServer()
 }
 
@@ -151,6 +193,15 @@ func (UnimplementedMailServiceServer) MailsForPlayer(context.Context, *MailsForP
 func (UnimplementedMailServiceServer) DeleteMail(context.Context, *DeleteMailRequest) (*DeleteMailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMail not implemented")
 }
+func (UnimplementedMailServiceServer) ReturnMailToSender(context.Context, *ReturnMailToSenderRequest) (*ReturnMailToSenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnMailToSender not implemented")
+}
+func (UnimplementedMailServiceServer) SendSystemMail(context.Context, *SendSystemMailRequest) (*SendSystemMailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSystemMail not implemented")
+}
+func (UnimplementedMailServiceServer) SystemMailProgress(context.Context, *SystemMailProgressRequest) (*SystemMailProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemMailProgress not implemented")
+}
 func (UnimplementedMailServiceServer) mustEmbedUnimplementedMailServiceServer() {}
 
 // UnsafeMailServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -290,6 +341,60 @@ func _MailService_DeleteMail_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailService_ReturnMailToSender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnMailToSenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).ReturnMailToSender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_ReturnMailToSender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).ReturnMailToSender(ctx, req.(*ReturnMailToSenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SendSystemMail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendSystemMailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SendSystemMail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_SendSystemMail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SendSystemMail(ctx, req.(*SendSystemMailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailService_SystemMailProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemMailProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailServiceServer).SystemMailProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MailService_SystemMailProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailServiceServer).SystemMailProgress(ctx, req.(*SystemMailProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MailService_ServiceDesc is the grpc.ServiceDesc for MailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +430,18 @@ var MailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMail",
 			Handler:    _MailService_DeleteMail_Handler,
 		},
+		{
+			MethodName: "ReturnMailToSender",
+			Handler:    _MailService_ReturnMailToSender_Handler,
+		},
+		{
+			MethodName: "SendSystemMail",
+			Handler:    _MailService_SendSystemMail_Handler,
+		},
+		{
+			MethodName: "SystemMailProgress",
+			Handler:    _MailService_SystemMailProgress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mail.proto",
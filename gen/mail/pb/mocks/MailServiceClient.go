@@ -215,6 +215,39 @@ func (_m *MailServiceClient) RemoveMailMoney(ctx context.Context, in *pb.RemoveM
 	return r0, r1
 }
 
+// ReturnMailToSender provides a mock function with given fields: ctx, in, opts
+func (_m *MailServiceClient) ReturnMailToSender(ctx context.Context, in *pb.ReturnMailToSenderRequest, opts ...grpc.CallOption) (*pb.ReturnMailToSenderResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ReturnMailToSenderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReturnMailToSenderRequest, ...grpc.CallOption) (*pb.ReturnMailToSenderResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ReturnMailToSenderRequest, ...grpc.CallOption) *pb.ReturnMailToSenderResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ReturnMailToSenderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ReturnMailToSenderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Send provides a mock function with given fields: ctx, in, opts
 func (_m *MailServiceClient) Send(ctx context.Context, in *pb.SendRequest, opts ...grpc.CallOption) (*pb.SendResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -248,6 +281,72 @@ func (_m *MailServiceClient) Send(ctx context.Context, in *pb.SendRequest, opts
 	return r0, r1
 }
 
+// SendSystemMail provides a mock function with given fields: ctx, in, opts
+func (_m *MailServiceClient) SendSystemMail(ctx context.Context, in *pb.SendSystemMailRequest, opts ...grpc.CallOption) (*pb.SendSystemMailResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.SendSystemMailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SendSystemMailRequest, ...grpc.CallOption) (*pb.SendSystemMailResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SendSystemMailRequest, ...grpc.CallOption) *pb.SendSystemMailResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.SendSystemMailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.SendSystemMailRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemMailProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MailServiceClient) SystemMailProgress(ctx context.Context, in *pb.SystemMailProgressRequest, opts ...grpc.CallOption) (*pb.SystemMailProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.SystemMailProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SystemMailProgressRequest, ...grpc.CallOption) (*pb.SystemMailProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SystemMailProgressRequest, ...grpc.CallOption) *pb.SystemMailProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.SystemMailProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.SystemMailProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewMailServiceClient interface {
 	mock.TestingT
 	Cleanup(func())
@@ -239,6 +239,39 @@ func (_m *ServersRegistryServiceClient) GetMapLayerConfiguration(ctx context.Con
 	return r0, r1
 }
 
+// GetMapRegions provides a mock function with given fields: ctx, in, opts
+func (_m *ServersRegistryServiceClient) GetMapRegions(ctx context.Context, in *pb.GetMapRegionsRequest, opts ...grpc.CallOption) (*pb.GetMapRegionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.GetMapRegionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMapRegionsRequest, ...grpc.CallOption) (*pb.GetMapRegionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.GetMapRegionsRequest, ...grpc.CallOption) *pb.GetMapRegionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.GetMapRegionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.GetMapRegionsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllGameServers provides a mock function with given fields: ctx, in, opts
 func (_m *ServersRegistryServiceClient) ListAllGameServers(ctx context.Context, in *pb.ListAllGameServersRequest, opts ...grpc.CallOption) (*pb.ListGameServersResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -350,6 +383,72 @@ func (_m *ServersRegistryServiceClient) ListGatewaysForRealm(ctx context.Context
 	return r0, r1
 }
 
+// ListMapMigrations provides a mock function with given fields: ctx, in, opts
+func (_m *ServersRegistryServiceClient) ListMapMigrations(ctx context.Context, in *pb.ListMapMigrationsRequest, opts ...grpc.CallOption) (*pb.ListMapMigrationsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.ListMapMigrationsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMapMigrationsRequest, ...grpc.CallOption) (*pb.ListMapMigrationsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.ListMapMigrationsRequest, ...grpc.CallOption) *pb.ListMapMigrationsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.ListMapMigrationsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.ListMapMigrationsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PlanMapsRebalance provides a mock function with given fields: ctx, in, opts
+func (_m *ServersRegistryServiceClient) PlanMapsRebalance(ctx context.Context, in *pb.PlanMapsRebalanceRequest, opts ...grpc.CallOption) (*pb.PlanMapsRebalanceResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.PlanMapsRebalanceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PlanMapsRebalanceRequest, ...grpc.CallOption) (*pb.PlanMapsRebalanceResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.PlanMapsRebalanceRequest, ...grpc.CallOption) *pb.PlanMapsRebalanceResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.PlanMapsRebalanceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.PlanMapsRebalanceRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RandomGameServerForRealm provides a mock function with given fields: ctx, in, opts
 func (_m *ServersRegistryServiceClient) RandomGameServerForRealm(ctx context.Context, in *pb.RandomGameServerForRealmRequest, opts ...grpc.CallOption) (*pb.RandomGameServerForRealmResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -461,6 +560,39 @@ func (_m *ServersRegistryServiceClient) RegisterGateway(ctx context.Context, in
 	return r0, r1
 }
 
+// SetGatewayDraining provides a mock function with given fields: ctx, in, opts
+func (_m *ServersRegistryServiceClient) SetGatewayDraining(ctx context.Context, in *pb.SetGatewayDrainingRequest, opts ...grpc.CallOption) (*pb.SetGatewayDrainingResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *pb.SetGatewayDrainingResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SetGatewayDrainingRequest, ...grpc.CallOption) (*pb.SetGatewayDrainingResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *pb.SetGatewayDrainingRequest, ...grpc.CallOption) *pb.SetGatewayDrainingResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pb.SetGatewayDrainingResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *pb.SetGatewayDrainingRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMapLayerConfiguration provides a mock function with given fields: ctx, in, opts
 func (_m *ServersRegistryServiceClient) UpdateMapLayerConfiguration(ctx context.Context, in *pb.UpdateMapLayerConfigurationRequest, opts ...grpc.CallOption) (*pb.UpdateMapLayerConfigurationResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -29,9 +29,13 @@ const (
 	ServersRegistryService_GetMapLayerConfiguration_FullMethodName           = "/v1.ServersRegistryService/GetMapLayerConfiguration"
 	ServersRegistryService_UpdateMapLayerConfiguration_FullMethodName        = "/v1.ServersRegistryService/UpdateMapLayerConfiguration"
 	ServersRegistryService_GetLayerStats_FullMethodName                      = "/v1.ServersRegistryService/GetLayerStats"
+	ServersRegistryService_PlanMapsRebalance_FullMethodName                  = "/v1.ServersRegistryService/PlanMapsRebalance"
+	ServersRegistryService_ListMapMigrations_FullMethodName                  = "/v1.ServersRegistryService/ListMapMigrations"
+	ServersRegistryService_GetMapRegions_FullMethodName                      = "/v1.ServersRegistryService/GetMapRegions"
 	ServersRegistryService_RegisterGateway_FullMethodName                    = "/v1.ServersRegistryService/RegisterGateway"
 	ServersRegistryService_GatewaysForRealms_FullMethodName                  = "/v1.ServersRegistryService/GatewaysForRealms"
 	ServersRegistryService_ListGatewaysForRealm_FullMethodName               = "/v1.ServersRegistryService/ListGatewaysForRealm"
+	ServersRegistryService_SetGatewayDraining_FullMethodName                 = "/v1.ServersRegistryService/SetGatewayDraining"
 )
 
 // ServersRegistryServiceClient is the client API for ServersRegistryService service.
@@ -48,9 +52,13 @@ type ServersRegistryServiceClient interface {
 	GetMapLayerConfiguration(ctx context.Context, in *GetMapLayerConfigurationRequest, opts ...grpc.CallOption) (*GetMapLayerConfigurationResponse, error)
 	UpdateMapLayerConfiguration(ctx context.Context, in *UpdateMapLayerConfigurationRequest, opts ...grpc.CallOption) (*UpdateMapLayerConfigurationResponse, error)
 	GetLayerStats(ctx context.Context, in *GetLayerStatsRequest, opts ...grpc.CallOption) (*GetLayerStatsResponse, error)
+	PlanMapsRebalance(ctx context.Context, in *PlanMapsRebalanceRequest, opts ...grpc.CallOption) (*PlanMapsRebalanceResponse, error)
+	ListMapMigrations(ctx context.Context, in *ListMapMigrationsRequest, opts ...grpc.CallOption) (*ListMapMigrationsResponse, error)
+	GetMapRegions(ctx context.Context, in *GetMapRegionsRequest, opts ...grpc.CallOption) (*GetMapRegionsResponse, error)
 	RegisterGateway(ctx context.Context, in *RegisterGatewayRequest, opts ...grpc.CallOption) (*RegisterGatewayResponse, error)
 	GatewaysForRealms(ctx context.Context, in *GatewaysForRealmsRequest, opts ...grpc.CallOption) (*GatewaysForRealmsResponse, error)
 	ListGatewaysForRealm(ctx context.Context, in *ListGatewaysForRealmRequest, opts ...grpc.CallOption) (*ListGatewaysForRealmResponse, error)
+	SetGatewayDraining(ctx context.Context, in *SetGatewayDrainingRequest, opts ...grpc.CallOption) (*SetGatewayDrainingResponse, error)
 }
 
 type serversRegistryServiceClient struct {
@@ -151,6 +159,33 @@ func (c *serversRegistryServiceClient) GetLayerStats(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *serversRegistryServiceClient) PlanMapsRebalance(ctx context.Context, in *PlanMapsRebalanceRequest, opts ...grpc.CallOption) (*PlanMapsRebalanceResponse, error) {
+	out := new(PlanMapsRebalanceResponse)
+	err := c.cc.Invoke(ctx, ServersRegistryService_PlanMapsRebalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serversRegistryServiceClient) ListMapMigrations(ctx context.Context, in *ListMapMigrationsRequest, opts ...grpc.CallOption) (*ListMapMigrationsResponse, error) {
+	out := new(ListMapMigrationsResponse)
+	err := c.cc.Invoke(ctx, ServersRegistryService_ListMapMigrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serversRegistryServiceClient) GetMapRegions(ctx context.Context, in *GetMapRegionsRequest, opts ...grpc.CallOption) (*GetMapRegionsResponse, error) {
+	out := new(GetMapRegionsResponse)
+	err := c.cc.Invoke(ctx, ServersRegistryService_GetMapRegions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serversRegistryServiceClient) RegisterGateway(ctx context.Context, in *RegisterGatewayRequest, opts ...grpc.CallOption) (*RegisterGatewayResponse, error) {
 	out := new(RegisterGatewayResponse)
 	err := c.cc.Invoke(ctx, ServersRegistryService_RegisterGateway_FullMethodName, in, out, opts...)
@@ -178,6 +213,15 @@ func (c *serversRegistryServiceClient) ListGatewaysForRealm(ctx context.Context,
 	return out, nil
 }
 
+func (c *serversRegistryServiceClient) SetGatewayDraining(ctx context.Context, in *SetGatewayDrainingRequest, opts ...grpc.CallOption) (*SetGatewayDrainingResponse, error) {
+	out := new(SetGatewayDrainingResponse)
+	err := c.cc.Invoke(ctx, ServersRegistryService_SetGatewayDraining_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServersRegistryServiceServer is the server API for ServersRegistryService service.
 // All implementations must embed UnimplementedServersRegistryServiceServer
 // for forward compatibility
@@ -192,9 +236,13 @@ type ServersRegistryServiceServer interface {
 	GetMapLayerConfiguration(context.Context, *GetMapLayerConfigurationRequest) (*GetMapLayerConfigurationResponse, error)
 	UpdateMapLayerConfiguration(context.Context, *UpdateMapLayerConfigurationRequest) (*UpdateMapLayerConfigurationResponse, error)
 	GetLayerStats(context.Context, *GetLayerStatsRequest) (*GetLayerStatsResponse, error)
+	PlanMapsRebalance(context.Context, *PlanMapsRebalanceRequest) (*PlanMapsRebalanceResponse, error)
+	ListMapMigrations(context.Context, *ListMapMigrationsRequest) (*ListMapMigrationsResponse, error)
+	GetMapRegions(context.Context, *GetMapRegionsRequest) (*GetMapRegionsResponse, error)
 	RegisterGateway(context.Context, *RegisterGatewayRequest) (*RegisterGatewayResponse, error)
 	GatewaysForRealms(context.Context, *GatewaysForRealmsRequest) (*GatewaysForRealmsResponse, error)
 	ListGatewaysForRealm(context.Context, *ListGatewaysForRealmRequest) (*ListGatewaysForRealmResponse, error)
+	SetGatewayDraining(context.Context, *SetGatewayDrainingRequest) (*SetGatewayDrainingResponse, error)
 	mustEmbedUnimplementedServersRegistryServiceServer()
 }
 
@@ -232,6 +280,15 @@ func (UnimplementedServersRegistryServiceServer) UpdateMapLayerConfiguration(con
 func (UnimplementedServersRegistryServiceServer) GetLayerStats(context.Context, *GetLayerStatsRequest) (*GetLayerStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLayerStats not implemented")
 }
+func (UnimplementedServersRegistryServiceServer) PlanMapsRebalance(context.Context, *PlanMapsRebalanceRequest) (*PlanMapsRebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanMapsRebalance not implemented")
+}
+func (UnimplementedServersRegistryServiceServer) ListMapMigrations(context.Context, *ListMapMigrationsRequest) (*ListMapMigrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMapMigrations not implemented")
+}
+func (UnimplementedServersRegistryServiceServer) GetMapRegions(context.Context, *GetMapRegionsRequest) (*GetMapRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMapRegions not implemented")
+}
 func (UnimplementedServersRegistryServiceServer) RegisterGateway(context.Context, *RegisterGatewayRequest) (*RegisterGatewayResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterGateway not implemented")
 }
@@ -241,6 +298,9 @@ func (UnimplementedServersRegistryServiceServer) GatewaysForRealms(context.Conte
 func (UnimplementedServersRegistryServiceServer) ListGatewaysForRealm(context.Context, *ListGatewaysForRealmRequest) (*ListGatewaysForRealmResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListGatewaysForRealm not implemented")
 }
+func (UnimplementedServersRegistryServiceServer) SetGatewayDraining(context.Context, *SetGatewayDrainingRequest) (*SetGatewayDrainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGatewayDraining not implemented")
+}
 func (UnimplementedServersRegistryServiceServer) mustEmbedUnimplementedServersRegistryServiceServer() {
 }
 
@@ -435,6 +495,60 @@ func _ServersRegistryService_GetLayerStats_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServersRegistryService_PlanMapsRebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanMapsRebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServersRegistryServiceServer).PlanMapsRebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServersRegistryService_PlanMapsRebalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServersRegistryServiceServer).PlanMapsRebalance(ctx, req.(*PlanMapsRebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServersRegistryService_ListMapMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMapMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServersRegistryServiceServer).ListMapMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServersRegistryService_ListMapMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServersRegistryServiceServer).ListMapMigrations(ctx, req.(*ListMapMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServersRegistryService_GetMapRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMapRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServersRegistryServiceServer).GetMapRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServersRegistryService_GetMapRegions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServersRegistryServiceServer).GetMapRegions(ctx, req.(*GetMapRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ServersRegistryService_RegisterGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterGatewayRequest)
 	if err := dec(in); err != nil {
@@ -489,6 +603,24 @@ func _ServersRegistryService_ListGatewaysForRealm_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ServersRegistryService_SetGatewayDraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGatewayDrainingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServersRegistryServiceServer).SetGatewayDraining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServersRegistryService_SetGatewayDraining_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServersRegistryServiceServer).SetGatewayDraining(ctx, req.(*SetGatewayDrainingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ServersRegistryService_ServiceDesc is the grpc.ServiceDesc for ServersRegistryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -536,6 +668,18 @@ var ServersRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLayerStats",
 			Handler:    _ServersRegistryService_GetLayerStats_Handler,
 		},
+		{
+			MethodName: "PlanMapsRebalance",
+			Handler:    _ServersRegistryService_PlanMapsRebalance_Handler,
+		},
+		{
+			MethodName: "ListMapMigrations",
+			Handler:    _ServersRegistryService_ListMapMigrations_Handler,
+		},
+		{
+			MethodName: "GetMapRegions",
+			Handler:    _ServersRegistryService_GetMapRegions_Handler,
+		},
 		{
 			MethodName: "RegisterGateway",
 			Handler:    _ServersRegistryService_RegisterGateway_Handler,
@@ -548,6 +692,10 @@ var ServersRegistryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGatewaysForRealm",
 			Handler:    _ServersRegistryService_ListGatewaysForRealm_Handler,
 		},
+		{
+			MethodName: "SetGatewayDraining",
+			Handler:    _ServersRegistryService_SetGatewayDraining_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "registry.proto",
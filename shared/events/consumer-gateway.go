@@ -20,6 +20,11 @@ type GWCharactersUpdatesHandler interface {
 	HandleCharactersUpdates(payload GWEventCharactersUpdatesPayload) error
 }
 
+type GWOnlineCharactersHandler interface {
+	// HandleOnlineCharacters handles snapshot of characters online on the gateway.
+	HandleOnlineCharacters(payload GWEventOnlineCharactersPayload) error
+}
+
 // GatewayConsumer listens to gateway events and handles events if there are handlers.
 type GatewayConsumer interface {
 	// Listen is non-blocking operation that listens to the gateway events.
@@ -41,6 +46,8 @@ func NewGatewayConsumer(nc *nats.Conn, options ...GatewayConsumerOption) Gateway
 		loggedInHandler:     params.loggedInHandler,
 		loggedOutHandler:    params.loggedOutHandler,
 		charsUpdatesHandler: params.charsUpdatesHandler,
+		onlineCharsHandler:  params.onlineCharsHandler,
+		queueGroup:          params.queueGroup,
 	}
 }
 
@@ -73,6 +80,22 @@ func WithGWConsumerCharsUpdatesHandler(h GWCharactersUpdatesHandler) GatewayCons
 	})
 }
 
+// WithGWConsumerOnlineCharactersHandler creates gateway consumer option with online characters handler.
+// If not specified, listener will ignore this kind of events.
+func WithGWConsumerOnlineCharactersHandler(h GWOnlineCharactersHandler) GatewayConsumerOption {
+	return newFuncGatewayConsumerOption(func(params *gatewayConsumerParams) {
+		params.onlineCharsHandler = h
+	})
+}
+
+// WithGWConsumerQueueGroup creates gateway consumer option that makes consumers with the same
+// queue group share events, so every event is handled by only one of them.
+func WithGWConsumerQueueGroup(group string) GatewayConsumerOption {
+	return newFuncGatewayConsumerOption(func(params *gatewayConsumerParams) {
+		params.queueGroup = group
+	})
+}
+
 // funcGatewayConsumerOption wraps a function that modifies funcGatewayConsumerOption into an
 // implementation of the GatewayConsumerOption interface.
 type funcGatewayConsumerOption struct {
@@ -94,6 +117,8 @@ type gatewayConsumerParams struct {
 	loggedInHandler     GWCharacterLoggedInHandler
 	loggedOutHandler    GWCharacterLoggedOutHandler
 	charsUpdatesHandler GWCharactersUpdatesHandler
+	onlineCharsHandler  GWOnlineCharactersHandler
+	queueGroup          string
 }
 
 // gatewayConsumerImpl implementation of GatewayConsumer.
@@ -105,12 +130,16 @@ type gatewayConsumerImpl struct {
 	loggedInHandler     GWCharacterLoggedInHandler
 	loggedOutHandler    GWCharacterLoggedOutHandler
 	charsUpdatesHandler GWCharactersUpdatesHandler
+	onlineCharsHandler  GWOnlineCharactersHandler
+
+	// queueGroup is nats queue group, empty if every consumer should handle all events.
+	queueGroup string
 }
 
 // Listen is non-blocking operation that listens to gateway events.
 func (c *gatewayConsumerImpl) Listen() error {
 	if c.loggedInHandler != nil {
-		sub, err := c.nc.Subscribe(GWEventCharacterLoggedIn.SubjectName(), func(msg *nats.Msg) {
+		sub, err := c.subscribe(GWEventCharacterLoggedIn.SubjectName(), func(msg *nats.Msg) {
 			loggedInP := GWEventCharacterLoggedInPayload{}
 			_, err := Unmarshal(msg.Data, &loggedInP)
 			if err != nil {
@@ -132,7 +161,7 @@ func (c *gatewayConsumerImpl) Listen() error {
 	}
 
 	if c.loggedOutHandler != nil {
-		sub, err := c.nc.Subscribe(GWEventCharacterLoggedOut.SubjectName(), func(msg *nats.Msg) {
+		sub, err := c.subscribe(GWEventCharacterLoggedOut.SubjectName(), func(msg *nats.Msg) {
 			loggedOutP := GWEventCharacterLoggedOutPayload{}
 			_, err := Unmarshal(msg.Data, &loggedOutP)
 			if err != nil {
@@ -154,7 +183,7 @@ func (c *gatewayConsumerImpl) Listen() error {
 	}
 
 	if c.charsUpdatesHandler != nil {
-		sub, err := c.nc.Subscribe(GWEventCharactersUpdates.SubjectName(), func(msg *nats.Msg) {
+		sub, err := c.subscribe(GWEventCharactersUpdates.SubjectName(), func(msg *nats.Msg) {
 			charsUpdtsP := GWEventCharactersUpdatesPayload{}
 			_, err := Unmarshal(msg.Data, &charsUpdtsP)
 			if err != nil {
@@ -175,9 +204,38 @@ func (c *gatewayConsumerImpl) Listen() error {
 		c.subs = append(c.subs, sub)
 	}
 
+	if c.onlineCharsHandler != nil {
+		sub, err := c.subscribe(GWEventOnlineCharacters.SubjectName(), func(msg *nats.Msg) {
+			onlineCharsP := GWEventOnlineCharactersPayload{}
+			_, err := Unmarshal(msg.Data, &onlineCharsP)
+			if err != nil {
+				log.Error().Err(err).Msg("can't read GWEventOnlineCharacters (payload part) event")
+				return
+			}
+
+			err = c.onlineCharsHandler.HandleOnlineCharacters(onlineCharsP)
+			if err != nil {
+				log.Error().Err(err).Msg("can't handle GWEventOnlineCharacters event")
+				return
+			}
+		})
+		if err != nil {
+			return err
+		}
+
+		c.subs = append(c.subs, sub)
+	}
+
 	return nil
 }
 
+func (c *gatewayConsumerImpl) subscribe(subject string, handler nats.MsgHandler) (*nats.Subscription, error) {
+	if c.queueGroup != "" {
+		return c.nc.QueueSubscribe(subject, c.queueGroup, handler)
+	}
+	return c.nc.Subscribe(subject, handler)
+}
+
 // Stop stops listening to events.
 func (c *gatewayConsumerImpl) Stop() error {
 	return c.unsubscribe()
@@ -8,6 +8,10 @@ type CharactersServiceEvent int
 const (
 	// CharEventCharsDisconnectedUnhealthyGW event that contains players that were connected to unhealthy gateway
 	CharEventCharsDisconnectedUnhealthyGW CharactersServiceEvent = iota + 1
+
+	// CharEventOnlineCharactersResyncRequested event that asks gateways to send GWEventOnlineCharacters,
+	// characters service sends it on start to rebuild online characters index.
+	CharEventOnlineCharactersResyncRequested
 )
 
 // SubjectName is key that nats uses
@@ -15,6 +19,8 @@ func (e CharactersServiceEvent) SubjectName() string {
 	switch e {
 	case CharEventCharsDisconnectedUnhealthyGW:
 		return "char.chars.unhealthy.gw"
+	case CharEventOnlineCharactersResyncRequested:
+		return "char.chars.online.resync"
 	}
 	panic(fmt.Errorf("unk event %d", e))
 }
@@ -25,3 +31,9 @@ type CharEventCharsDisconnectedUnhealthyGWPayload struct {
 	GatewayID      string
 	CharactersGUID []uint64
 }
+
+// CharEventOnlineCharactersResyncRequestedPayload represents payload of CharEventOnlineCharactersResyncRequested event
+type CharEventOnlineCharactersResyncRequestedPayload struct {
+	// RealmIDs is list of realms which gateways should send online characters.
+	RealmIDs []uint32
+}
@@ -16,6 +16,8 @@ const (
 	ChatEventChannelLeft
 	// ChatEventChannelNotification general channel notification (kick, ban, mode change, etc.)
 	ChatEventChannelNotification
+	// ChatEventPlayerMuted event when the chat filter auto-muted a player
+	ChatEventPlayerMuted
 )
 
 // SubjectName is key that nats uses
@@ -31,6 +33,8 @@ func (e ChatServiceEvent) SubjectName(gatewayID string) string {
 		return fmt.Sprintf("chat.gw.%s.channel.left", gatewayID)
 	case ChatEventChannelNotification:
 		return fmt.Sprintf("chat.gw.%s.channel.notify", gatewayID)
+	case ChatEventPlayerMuted:
+		return fmt.Sprintf("chat.gw.%s.player.muted", gatewayID)
 	}
 	panic(fmt.Errorf("unk event %d", e))
 }
@@ -44,6 +48,12 @@ type ChatEventIncomingWhisperPayload struct {
 	ReceiverName string
 	Language     uint32
 	Msg          string
+
+	// SenderRealmID is realm of the sender, differs from the receiver realm for cross-realm whispers.
+	SenderRealmID uint32
+
+	// SenderChatFlags is AFK/DND status of the sender, used as chat tag of the message.
+	SenderChatFlags uint8
 }
 
 // ChatEventChannelMessagePayload represents payload of ChatEventChannelMessage event
@@ -92,3 +102,16 @@ type ChatEventChannelNotificationPayload struct {
 	ExtraData     string // For additional text data
 	AffectsPlayer uint64 // GUID of player who should receive this notification (0 = all)
 }
+
+// ChatEventPlayerMutedPayload represents payload of ChatEventPlayerMuted event
+type ChatEventPlayerMutedPayload struct {
+	// ServiceID is identifier of chat service instance that muted the player
+	ServiceID  string
+	RealmID    uint32
+	PlayerGUID uint64
+	PlayerName string
+
+	// MutedUntil is unix time when mute expires
+	MutedUntil int64
+	Reason     string
+}
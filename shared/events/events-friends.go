@@ -29,10 +29,12 @@ type FriendEventStatusChangePayload struct {
 	ServiceID  string
 	RealmID    uint32
 	PlayerGUID uint64
-	Status     uint8  // 0=offline, 1=online
-	Area       uint32
-	Level      uint32
-	ClassID    uint32
+	// Realm of the player, differs from RealmID if player is cross-realm friend of players to notify
+	PlayerRealmID uint32
+	Status        uint8 // 0=offline, 1=online
+	Area          uint32
+	Level         uint32
+	ClassID       uint32
 	// List of players who have this player as friend
 	NotifyPlayers []uint64
 }
@@ -14,6 +14,10 @@ const (
 
 	// GWEventCharactersUpdates pack of characters update that occurs every N seconds.
 	GWEventCharactersUpdates
+
+	// GWEventOnlineCharacters is snapshot of characters online on the gateway,
+	// sent in response to CharEventOnlineCharactersResyncRequested.
+	GWEventOnlineCharacters
 )
 
 // SubjectName is key that nats uses.
@@ -25,6 +29,8 @@ func (e GatewayEvent) SubjectName() string {
 		return "gw.char.logged-out"
 	case GWEventCharactersUpdates:
 		return "gw.char.chars-updates"
+	case GWEventOnlineCharacters:
+		return "gw.char.online"
 	}
 	panic(fmt.Errorf("unk event %d", e))
 }
@@ -46,6 +52,10 @@ type GWEventCharacterLoggedInPayload struct {
 	CharPosZ    float32
 	CharGuildID uint32
 	AccountID   uint32
+
+	// CharChatFlags and CharAwayMessage are set in the online characters snapshot.
+	CharChatFlags   uint8
+	CharAwayMessage string
 }
 
 // GWEventCharacterLoggedOutPayload represents payload of GWEventCharacterLoggedOut event.
@@ -64,6 +74,13 @@ type GWEventCharactersUpdatesPayload struct {
 	Updates   []*CharacterUpdate
 }
 
+// GWEventOnlineCharactersPayload represents payload of GWEventOnlineCharacters event.
+type GWEventOnlineCharactersPayload struct {
+	RealmID    uint32
+	GatewayID  string
+	Characters []GWEventCharacterLoggedInPayload
+}
+
 // CharacterUpdate represents new values of fields for the character.
 type CharacterUpdate struct {
 	ID        uint64  `json:"i"`
@@ -76,4 +93,13 @@ type CharacterUpdate struct {
 	PowerType *uint8  `json:"pt,omitempty"`
 	CurPower  *uint32 `json:"p,omitempty"`
 	MaxPower  *uint32 `json:"pm,omitempty"`
+	GuildID   *uint32 `json:"g,omitempty"`
+	ChatFlags *uint8  `json:"cf,omitempty"`
+	AwayMsg   *string `json:"am,omitempty"`
 }
+
+// Chat flags of the character, values match chat tags of the client.
+const (
+	CharacterChatFlagAFK uint8 = 0x01
+	CharacterChatFlagDND uint8 = 0x02
+)
@@ -20,6 +20,16 @@ const (
 
 	// ServerRegistryEventGSRemoved is event that occurs when server registry removes game server (unhealthy or shutdown).
 	ServerRegistryEventGSRemoved
+
+	// ServerRegistryEventLayersScaled is event that occurs when layers autoscaler changes layers count of the map.
+	ServerRegistryEventLayersScaled
+
+	// ServerRegistryEventMapPlayersRedirect is event that occurs when servers registry migrates map to another
+	// game server and asks gateways to redirect batch of the map players.
+	ServerRegistryEventMapPlayersRedirect
+
+	// ServerRegistryEventGWDrainingChanged is event that occurs when gateway is marked as draining or back as active.
+	ServerRegistryEventGWDrainingChanged
 )
 
 // SubjectName is key that nats uses.
@@ -35,6 +45,12 @@ func (e ServerRegistryEvent) SubjectName() string {
 		return "sr.gs.added"
 	case ServerRegistryEventGSRemoved:
 		return "sr.gs.removed"
+	case ServerRegistryEventLayersScaled:
+		return "sr.layers.scaled"
+	case ServerRegistryEventMapPlayersRedirect:
+		return "sr.gs.map.players.redirect"
+	case ServerRegistryEventGWDrainingChanged:
+		return "sr.gw.draining.changed"
 	}
 	panic(fmt.Errorf("unk event %d", e))
 }
@@ -55,6 +71,13 @@ type ServerRegistryEventGWRemovedUnhealthyPayload struct {
 	RealmID         uint32
 }
 
+// ServerRegistryEventGWDrainingChangedPayload represents payload of ServerRegistryEventGWDrainingChanged event.
+type ServerRegistryEventGWDrainingChangedPayload struct {
+	ID       string
+	RealmID  uint32
+	Draining bool
+}
+
 type GameServer struct {
 	ID           string
 	Address      string
@@ -65,6 +88,61 @@ type GameServer struct {
 
 	OldAssignedMapsToHandle []uint32
 	NewAssignedMapsToHandle []uint32
+
+	// OldRegions and NewRegions are regions of the partitioned maps that game server simulates.
+	OldRegions []MapRegion
+	NewRegions []MapRegion
+}
+
+// MapRegion is range of grids by X axis of the partitioned map.
+type MapRegion struct {
+	MapID    uint32
+	GridXMin uint32
+	GridXMax uint32
+}
+
+// MapsWithChangedRegions returns partitioned maps which regions differ between old and new regions.
+func (s GameServer) MapsWithChangedRegions() []uint32 {
+	regions := func(list []MapRegion) map[uint32][]MapRegion {
+		res := map[uint32][]MapRegion{}
+		for _, region := range list {
+			res[region.MapID] = append(res[region.MapID], region)
+		}
+		return res
+	}
+	oldRegions, newRegions := regions(s.OldRegions), regions(s.NewRegions)
+
+	res := []uint32{}
+	for _, region := range append(append([]MapRegion{}, s.OldRegions...), s.NewRegions...) {
+		if containsUint32(res, region.MapID) {
+			continue
+		}
+		if !sameRegions(oldRegions[region.MapID], newRegions[region.MapID]) {
+			res = append(res, region.MapID)
+		}
+	}
+	return res
+}
+
+func sameRegions(a, b []MapRegion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsUint32(list []uint32, v uint32) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
 }
 
 func (s GameServer) OnlyNewMaps() []uint32 {
@@ -112,3 +190,35 @@ type ServerRegistryEventGSAddedPayload struct {
 type ServerRegistryEventGSRemovedPayload struct {
 	GameServer GameServer
 }
+
+// ServerRegistryEventLayersScaledPayload represents payload of ServerRegistryEventLayersScaled event.
+type ServerRegistryEventLayersScaledPayload struct {
+	RealmID   uint32
+	MapID     uint32
+	OldLayers uint32
+	NewLayers uint32
+
+	// Players is population of the map at the moment of decision.
+	Players uint32
+	Reason  string
+}
+
+// ServerRegistryEventMapPlayersRedirectPayload represents payload of ServerRegistryEventMapPlayersRedirect event.
+type ServerRegistryEventMapPlayersRedirectPayload struct {
+	MigrationID string
+
+	// If it's cross-realm then RealmID should be 0.
+	RealmID      uint32
+	IsCrossRealm bool
+
+	MapID            uint32
+	FromGameServerID string
+
+	ToGameServerID          string
+	ToGameServerAddress     string
+	ToGameServerGRPCAddress string
+	ToGameServerAlias       string
+
+	// BatchSize is max players count that every gateway redirects for this event.
+	BatchSize uint32
+}
@@ -74,3 +74,14 @@ func TestGameServer_OnlyRemovedMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestGameServer_MapsWithChangedRegions(t *testing.T) {
+	server := GameServer{
+		OldRegions: []MapRegion{{MapID: 0, GridXMin: 0, GridXMax: 31}, {MapID: 1, GridXMin: 32, GridXMax: 63}, {MapID: 571, GridXMin: 0, GridXMax: 31}},
+		NewRegions: []MapRegion{{MapID: 0, GridXMin: 0, GridXMax: 31}, {MapID: 1, GridXMin: 0, GridXMax: 31}, {MapID: 530, GridXMin: 0, GridXMax: 15}},
+	}
+
+	if got := server.MapsWithChangedRegions(); !reflect.DeepEqual(got, []uint32{1, 571, 530}) {
+		t.Errorf("MapsWithChangedRegions() = %v, want [1 571 530]", got)
+	}
+}
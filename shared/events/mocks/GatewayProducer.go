@@ -54,6 +54,20 @@ func (_m *GatewayProducer) CharactersUpdates(payload *events.GWEventCharactersUp
 	return r0
 }
 
+// OnlineCharacters provides a mock function with given fields: payload
+func (_m *GatewayProducer) OnlineCharacters(payload *events.GWEventOnlineCharactersPayload) error {
+	ret := _m.Called(payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*events.GWEventOnlineCharactersPayload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewGatewayProducer interface {
 	mock.TestingT
 	Cleanup(func())
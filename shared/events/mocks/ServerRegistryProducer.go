@@ -68,6 +68,20 @@ func (_m *ServerRegistryProducer) GatewayAdded(payload *events.ServerRegistryEve
 	return r0
 }
 
+// GatewayDrainingChanged provides a mock function with given fields: payload
+func (_m *ServerRegistryProducer) GatewayDrainingChanged(payload *events.ServerRegistryEventGWDrainingChangedPayload) error {
+	ret := _m.Called(payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*events.ServerRegistryEventGWDrainingChangedPayload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GatewayRemovedUnhealthy provides a mock function with given fields: payload
 func (_m *ServerRegistryProducer) GatewayRemovedUnhealthy(payload *events.ServerRegistryEventGWRemovedUnhealthyPayload) error {
 	ret := _m.Called(payload)
@@ -82,6 +96,34 @@ func (_m *ServerRegistryProducer) GatewayRemovedUnhealthy(payload *events.Server
 	return r0
 }
 
+// LayersScaled provides a mock function with given fields: payload
+func (_m *ServerRegistryProducer) LayersScaled(payload *events.ServerRegistryEventLayersScaledPayload) error {
+	ret := _m.Called(payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*events.ServerRegistryEventLayersScaledPayload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MapPlayersRedirect provides a mock function with given fields: payload
+func (_m *ServerRegistryProducer) MapPlayersRedirect(payload *events.ServerRegistryEventMapPlayersRedirectPayload) error {
+	ret := _m.Called(payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*events.ServerRegistryEventMapPlayersRedirectPayload) error); ok {
+		r0 = rf(payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewServerRegistryProducer interface {
 	mock.TestingT
 	Cleanup(func())
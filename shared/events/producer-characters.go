@@ -8,6 +8,7 @@ import (
 
 type CharactersServiceProducer interface {
 	CharsDisconnectedUnhealthyLB(payload *CharEventCharsDisconnectedUnhealthyGWPayload) error
+	OnlineCharactersResyncRequested(payload *CharEventOnlineCharactersResyncRequestedPayload) error
 }
 
 type charactersServiceProducerNatsJSON struct {
@@ -27,6 +28,10 @@ func (c *charactersServiceProducerNatsJSON) CharsDisconnectedUnhealthyLB(payload
 	return c.publish(CharEventCharsDisconnectedUnhealthyGW, payload)
 }
 
+func (c *charactersServiceProducerNatsJSON) OnlineCharactersResyncRequested(payload *CharEventOnlineCharactersResyncRequestedPayload) error {
+	return c.publish(CharEventOnlineCharactersResyncRequested, payload)
+}
+
 func (c *charactersServiceProducerNatsJSON) publish(e CharactersServiceEvent, payload interface{}) error {
 	msg := EventToSendGenericPayload{
 		Version:   c.ver,
@@ -13,6 +13,7 @@ type ChatServiceProducer interface {
 	ChannelJoined(payload *ChatEventChannelJoinedPayload) error
 	ChannelLeft(payload *ChatEventChannelLeftPayload) error
 	ChannelNotification(payload *ChatEventChannelNotificationPayload) error
+	PlayerMuted(payload *ChatEventPlayerMutedPayload) error
 }
 
 type chatServiceProducerNatsJSON struct {
@@ -55,6 +56,10 @@ func (c *chatServiceProducerNatsJSON) ChannelNotification(payload *ChatEventChan
 	return c.publish(ChatEventChannelNotification, payload)
 }
 
+func (c *chatServiceProducerNatsJSON) PlayerMuted(payload *ChatEventPlayerMutedPayload) error {
+	return c.publish(ChatEventPlayerMuted, payload)
+}
+
 func (c *chatServiceProducerNatsJSON) publish(e ChatServiceEvent, payload interface{}) error {
 	msg := EventToSendGenericPayload{
 		Version:   c.ver,
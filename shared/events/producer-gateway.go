@@ -11,6 +11,7 @@ type GatewayProducer interface {
 	CharacterLoggedIn(payload *GWEventCharacterLoggedInPayload) error
 	CharacterLoggedOut(payload *GWEventCharacterLoggedOutPayload) error
 	CharactersUpdates(payload *GWEventCharactersUpdatesPayload) error
+	OnlineCharacters(payload *GWEventOnlineCharactersPayload) error
 }
 
 type gatewayProducerNatsJSON struct {
@@ -48,6 +49,12 @@ func (p *gatewayProducerNatsJSON) CharactersUpdates(payload *GWEventCharactersUp
 	return p.publish(GWEventCharactersUpdates, payload)
 }
 
+func (p *gatewayProducerNatsJSON) OnlineCharacters(payload *GWEventOnlineCharactersPayload) error {
+	payload.RealmID = p.RealmID
+	payload.GatewayID = p.ID
+	return p.publish(GWEventOnlineCharacters, payload)
+}
+
 func (p *gatewayProducerNatsJSON) publish(e GatewayEvent, payload interface{}) error {
 	msg := EventToSendGenericPayload{
 		Version:   p.ver,
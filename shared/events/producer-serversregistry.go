@@ -10,9 +10,12 @@ import (
 type ServerRegistryProducer interface {
 	GatewayAdded(payload *ServerRegistryEventGWAddedPayload) error
 	GatewayRemovedUnhealthy(payload *ServerRegistryEventGWRemovedUnhealthyPayload) error
+	GatewayDrainingChanged(payload *ServerRegistryEventGWDrainingChangedPayload) error
 	GSMapsReassigned(payload *ServerRegistryEventGSMapsReassignedPayload) error
 	GSAdded(payload *ServerRegistryEventGSAddedPayload) error
 	GSRemoved(payload *ServerRegistryEventGSRemovedPayload) error
+	LayersScaled(payload *ServerRegistryEventLayersScaledPayload) error
+	MapPlayersRedirect(payload *ServerRegistryEventMapPlayersRedirectPayload) error
 }
 
 type serverRegistryProducerNatsJSON struct {
@@ -35,6 +38,10 @@ func (s serverRegistryProducerNatsJSON) GatewayRemovedUnhealthy(payload *ServerR
 	return s.publish(ServerRegistryEventGWRemovedUnhealthy, payload)
 }
 
+func (s serverRegistryProducerNatsJSON) GatewayDrainingChanged(payload *ServerRegistryEventGWDrainingChangedPayload) error {
+	return s.publish(ServerRegistryEventGWDrainingChanged, payload)
+}
+
 func (s serverRegistryProducerNatsJSON) GSMapsReassigned(payload *ServerRegistryEventGSMapsReassignedPayload) error {
 	return s.publish(ServerRegistryEventGSMapsReassigned, payload)
 }
@@ -47,6 +54,14 @@ func (s serverRegistryProducerNatsJSON) GSRemoved(payload *ServerRegistryEventGS
 	return s.publish(ServerRegistryEventGSRemoved, payload)
 }
 
+func (s serverRegistryProducerNatsJSON) LayersScaled(payload *ServerRegistryEventLayersScaledPayload) error {
+	return s.publish(ServerRegistryEventLayersScaled, payload)
+}
+
+func (s serverRegistryProducerNatsJSON) MapPlayersRedirect(payload *ServerRegistryEventMapPlayersRedirectPayload) error {
+	return s.publish(ServerRegistryEventMapPlayersRedirect, payload)
+}
+
 func (s *serverRegistryProducerNatsJSON) publish(e ServerRegistryEvent, payload interface{}) error {
 	msg := EventToSendGenericPayload{
 		Version:   s.ver,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -19,12 +20,18 @@ type MetricsObservable interface {
 type MetricsRead struct {
 	ActiveConnections int
 
+	// Sessions is count of the game sessions, sessions left to hand off if gateway is draining.
+	Sessions int
+
 	DelayMean         int
 	DelayMedian       int
 	Delay95Percentile int
 	Delay99Percentile int
 	DelayMax          int
 
+	// MapPlayers is players count per game server ID and map ID.
+	MapPlayers map[string]map[uint32]int
+
 	Raw []dto.MetricFamily
 }
 
@@ -218,6 +225,8 @@ func (h httpPrometheusMetricsReader) Read(observable MetricsObservable) (*Metric
 		switch *result.Name {
 		case activeConnectionMetricsName:
 			results.ActiveConnections = int(*result.Metric[0].Gauge.Value)
+		case sessionsMetricsName:
+			results.Sessions = int(*result.Metric[0].Gauge.Value)
 		case delayMeanMetricsName:
 			results.DelayMean = int(*result.Metric[0].Gauge.Value)
 		case delayMedianMetricsName:
@@ -228,8 +237,40 @@ func (h httpPrometheusMetricsReader) Read(observable MetricsObservable) (*Metric
 			results.Delay99Percentile = int(*result.Metric[0].Gauge.Value)
 		case delayMaxMetricsName:
 			results.DelayMax = int(*result.Metric[0].Gauge.Value)
+		case mapPlayersMetricsName:
+			results.MapPlayers = readMapPlayers(result.Metric)
 		}
 	}
 
 	return &results, nil
 }
+
+func readMapPlayers(metrics []*dto.Metric) map[string]map[uint32]int {
+	res := map[string]map[uint32]int{}
+	for _, metric := range metrics {
+		if metric.Gauge == nil {
+			continue
+		}
+
+		var serverID, mapLabel string
+		for _, label := range metric.Label {
+			switch label.GetName() {
+			case MapPlayersGameServerLabel:
+				serverID = label.GetValue()
+			case MapPlayersMapLabel:
+				mapLabel = label.GetValue()
+			}
+		}
+
+		mapID, err := strconv.ParseUint(mapLabel, 10, 32)
+		if serverID == "" || err != nil {
+			continue
+		}
+
+		if res[serverID] == nil {
+			res[serverID] = map[uint32]int{}
+		}
+		res[serverID][uint32(mapID)] = int(metric.Gauge.GetValue())
+	}
+	return res
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type observable string
@@ -15,6 +16,18 @@ func (o observable) MetricsAddress() string {
 	return string(o)
 }
 
+type mapPlayersCounter map[string]map[uint32]int
+
+func (c mapPlayersCounter) MapPlayers() map[string]map[uint32]int {
+	return c
+}
+
+type sessionsCounter int
+
+func (c sessionsCounter) Sessions() int {
+	return int(c)
+}
+
 func Test_httpPrometheusMetricsReader_Read(t *testing.T) {
 	server := NewServer("9132", promhttp.Handler())
 	go server.ListenAndServe()
@@ -23,8 +36,20 @@ func Test_httpPrometheusMetricsReader_Read(t *testing.T) {
 	EnableActiveConnectionsMetrics()
 	ActiveConnectionsMetrics.Inc()
 
+	mapPlayers := mapPlayersCounter{"server-1": {0: 3, 571: 1}, "server-2": {1: 2}}
+	EnableMapPlayersMetrics(mapPlayers)
+	EnableSessionsMetrics(sessionsCounter(2))
+
 	reader := NewHttpPrometheusMetricsReader(time.Second)
-	res, err := reader.Read(observable("localhost:9132"))
-	assert.NoError(t, err)
+
+	// Server is started in background, so the first reads can fail with connection refused.
+	var res *MetricsRead
+	require.Eventually(t, func() bool {
+		var err error
+		res, err = reader.Read(observable("localhost:9132"))
+		return err == nil
+	}, time.Second*2, time.Millisecond*20)
 	assert.Equal(t, 1, res.ActiveConnections)
+	assert.Equal(t, 2, res.Sessions)
+	assert.Equal(t, map[string]map[uint32]int(mapPlayers), res.MapPlayers)
 }
@@ -1,6 +1,8 @@
 package healthandmetrics
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
@@ -16,6 +18,24 @@ func EnableActiveConnectionsMetrics() {
 	})
 }
 
+const sessionsMetricsName = "sessions"
+
+// SessionsCounter provides count of the game sessions.
+type SessionsCounter interface {
+	Sessions() int
+}
+
+// EnableSessionsMetrics registers metrics with count of the game sessions, values are taken from the counter on every scrape.
+// While gateway is draining it's count of the sessions left to hand off.
+func EnableSessionsMetrics(counter SessionsCounter) {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: sessionsMetricsName,
+		Help: "The number of game sessions",
+	}, func() float64 {
+		return float64(counter.Sessions())
+	})
+}
+
 const delayMeanMetricsName = "delay_mean"
 
 const delayMedianMetricsName = "delay_median"
@@ -58,3 +78,47 @@ func EnableDelayMetrics() {
 		Help: "The max delay in ms",
 	})
 }
+
+const mapPlayersMetricsName = "map_players"
+
+// Labels of the map players metrics.
+const (
+	MapPlayersGameServerLabel = "game_server"
+	MapPlayersMapLabel        = "map"
+)
+
+// MapPlayersCounter provides players count per game server ID and map ID.
+type MapPlayersCounter interface {
+	MapPlayers() map[string]map[uint32]int
+}
+
+// EnableMapPlayersMetrics registers metrics with players count per game server and map,
+// values are taken from the counter on every scrape.
+func EnableMapPlayersMetrics(counter MapPlayersCounter) {
+	prometheus.MustRegister(&mapPlayersCollector{
+		counter: counter,
+		desc: prometheus.NewDesc(
+			mapPlayersMetricsName,
+			"The number of players per game server and map",
+			[]string{MapPlayersGameServerLabel, MapPlayersMapLabel},
+			nil,
+		),
+	})
+}
+
+type mapPlayersCollector struct {
+	counter MapPlayersCounter
+	desc    *prometheus.Desc
+}
+
+func (c *mapPlayersCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *mapPlayersCollector) Collect(ch chan<- prometheus.Metric) {
+	for serverID, maps := range c.counter.MapPlayers() {
+		for mapID, players := range maps {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(players), serverID, strconv.FormatUint(uint64(mapID), 10))
+		}
+	}
+}
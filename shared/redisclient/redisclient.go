@@ -0,0 +1,267 @@
+package redisclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	redis "github.com/redis/go-redis/v9"
+)
+
+// Redis deployment modes.
+const (
+	ModeStandalone = "standalone"
+	ModeSentinel   = "sentinel"
+	ModeCluster    = "cluster"
+)
+
+// Config is config of the redis connection in addition to the redis URL.
+type Config struct {
+	// Mode is one of ModeStandalone, ModeSentinel and ModeCluster.
+	Mode string `yaml:"mode" env:"REDIS_MODE" env-default:"standalone"`
+
+	// Addrs is addresses of the sentinels in sentinel mode or seed nodes in cluster mode.
+	// If empty, address of the redis URL is used.
+	Addrs []string `yaml:"addrs" env:"REDIS_ADDRS" env-separator:","`
+
+	// SentinelMasterName is name of the master that sentinels monitor.
+	SentinelMasterName string `yaml:"sentinelMasterName" env:"REDIS_SENTINEL_MASTER_NAME" env-default:"mymaster"`
+
+	// SentinelPassword is password of the sentinels, the password of the redis URL is used for the master.
+	SentinelPassword string `yaml:"sentinelPassword" env:"REDIS_SENTINEL_PASSWORD"`
+
+	// MaxRetries is count of the command retries on network errors and failovers.
+	MaxRetries int `yaml:"maxRetries" env:"REDIS_MAX_RETRIES" env-default:"5"`
+
+	// MinRetryBackoffMs and MaxRetryBackoffMs are bounds of the exponential backoff between retries.
+	MinRetryBackoffMs int `yaml:"minRetryBackoffMs" env:"REDIS_MIN_RETRY_BACKOFF_MS" env-default:"8"`
+	MaxRetryBackoffMs int `yaml:"maxRetryBackoffMs" env:"REDIS_MAX_RETRY_BACKOFF_MS" env-default:"1000"`
+}
+
+// NewClient creates redis client of the configured mode.
+// Credentials and DB are taken from the URL in every mode, cluster supports only DB 0.
+func NewClient(url string, c Config) (redis.UniversalClient, error) {
+	opt, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := c.Addrs
+	if len(addrs) == 0 {
+		addrs = []string{opt.Addr}
+	}
+
+	minBackoff := time.Duration(c.MinRetryBackoffMs) * time.Millisecond
+	maxBackoff := time.Duration(c.MaxRetryBackoffMs) * time.Millisecond
+
+	switch c.Mode {
+	case ModeStandalone, "":
+		opt.MaxRetries = c.MaxRetries
+		opt.MinRetryBackoff = minBackoff
+		opt.MaxRetryBackoff = maxBackoff
+		return redis.NewClient(opt), nil
+	case ModeSentinel:
+		if c.SentinelMasterName == "" {
+			return nil, errors.New("sentinel master name is required in sentinel mode")
+		}
+		return redis.NewFailoverClient(&redis.FailoverOptions{
+			MasterName:       c.SentinelMasterName,
+			SentinelAddrs:    addrs,
+			SentinelPassword: c.SentinelPassword,
+			Username:         opt.Username,
+			Password:         opt.Password,
+			DB:               opt.DB,
+			TLSConfig:        opt.TLSConfig,
+			MaxRetries:       c.MaxRetries,
+			MinRetryBackoff:  minBackoff,
+			MaxRetryBackoff:  maxBackoff,
+		}), nil
+	case ModeCluster:
+		if opt.DB != 0 {
+			return nil, fmt.Errorf("redis cluster supports only DB 0, got %d", opt.DB)
+		}
+		return redis.NewClusterClient(&redis.ClusterOptions{
+			Addrs:           addrs,
+			Username:        opt.Username,
+			Password:        opt.Password,
+			TLSConfig:       opt.TLSConfig,
+			MaxRedirects:    c.MaxRetries,
+			MaxRetries:      c.MaxRetries,
+			MinRetryBackoff: minBackoff,
+			MaxRetryBackoff: maxBackoff,
+		}), nil
+	}
+
+	return nil, fmt.Errorf("unknown redis mode %q", c.Mode)
+}
+
+// WaitReady pings redis with exponential backoff until it answers or timeout is reached.
+// Allows to start while redis master is elected or cluster is forming.
+func WaitReady(ctx context.Context, rdb redis.UniversalClient, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := 100 * time.Millisecond
+	for {
+		err := rdb.Ping(ctx).Err()
+		if err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("redis is not ready: %w", err)
+		case <-time.After(backoff):
+		}
+
+		if backoff < 5*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// HashTag wraps the key part into the hash tag, keys with the same hash tag are stored in the same cluster slot.
+// Keys that are used together in multi-key commands, transactions and scripts should share the hash tag.
+func HashTag(part string) string {
+	return "{" + part + "}"
+}
+
+// GetMany returns values of the keys, nil for the missing keys.
+// Unlike MGET it works with keys from different cluster slots.
+func GetMany(ctx context.Context, rdb redis.UniversalClient, keys ...string) ([]interface{}, error) {
+	if _, isCluster := rdb.(*redis.ClusterClient); !isCluster {
+		return rdb.MGet(ctx, keys...).Result()
+	}
+
+	cmds := make([]*redis.StringCmd, len(keys))
+	_, err := rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			cmds[i] = pipe.Get(ctx, key)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	res := make([]interface{}, len(keys))
+	for i, cmd := range cmds {
+		value, err := cmd.Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		res[i] = value
+	}
+	return res, nil
+}
+
+// ScanKeys returns all keys that match the pattern, on every master node in cluster mode.
+func ScanKeys(ctx context.Context, rdb redis.UniversalClient, pattern string) ([]string, error) {
+	cluster, isCluster := rdb.(*redis.ClusterClient)
+	if !isCluster {
+		return scanKeys(ctx, rdb, pattern)
+	}
+
+	var (
+		mu   sync.Mutex
+		keys []string
+	)
+	err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		nodeKeys, err := scanKeys(ctx, client, pattern)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		keys = append(keys, nodeKeys...)
+		mu.Unlock()
+		return nil
+	})
+	return keys, err
+}
+
+func scanKeys(ctx context.Context, rdb redis.Cmdable, pattern string) ([]string, error) {
+	var (
+		cursor uint64
+		keys   []string
+	)
+	for {
+		var (
+			newKeys []string
+			err     error
+		)
+		newKeys, cursor, err = rdb.Scan(ctx, cursor, pattern, 100).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, newKeys...)
+
+		if cursor == 0 {
+			return keys, nil
+		}
+	}
+}
+
+// Bounds of the backoff between RetryOnFailover calls, sentinel usually promotes new master within few seconds.
+const (
+	failoverMinBackoff = 100 * time.Millisecond
+	failoverMaxBackoff = 2 * time.Second
+)
+
+// failoverErrPrefixes are prefixes of the redis errors that are returned while master is switched or cluster slots are moved.
+var failoverErrPrefixes = []string{"READONLY", "LOADING", "MASTERDOWN", "TRYAGAIN", "CLUSTERDOWN"}
+
+// IsFailoverError returns true if the error is caused by failover and the operation can be retried.
+func IsFailoverError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	for _, prefix := range failoverErrPrefixes {
+		if strings.HasPrefix(err.Error(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryOnFailover calls f until it returns nil or not failover error, with exponential backoff between calls.
+// go-redis retries single commands by itself, this one is for transactions that can't be retried by the client.
+func RetryOnFailover(ctx context.Context, maxRetries int, f func() error) error {
+	backoff := failoverMinBackoff
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if attempt >= maxRetries || !IsFailoverError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > failoverMaxBackoff {
+			backoff = failoverMaxBackoff
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package redisclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	redis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func testClients(t *testing.T) map[string]redis.UniversalClient {
+	mr := miniredis.RunT(t)
+
+	clients := map[string]redis.UniversalClient{}
+	for _, mode := range []string{ModeStandalone, ModeCluster} {
+		rdb, err := NewClient("redis://"+mr.Addr()+"/0", Config{Mode: mode, MaxRetries: 1})
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = rdb.Close() })
+		clients[mode] = rdb
+	}
+	return clients
+}
+
+func TestNewClientModes(t *testing.T) {
+	rdb, err := NewClient("redis://localhost:6379/0", Config{Mode: ModeStandalone})
+	require.NoError(t, err)
+	require.IsType(t, &redis.Client{}, rdb)
+
+	rdb, err = NewClient("redis://localhost:6379/0", Config{Mode: ModeSentinel, SentinelMasterName: "mymaster", Addrs: []string{"sentinel:26379"}})
+	require.NoError(t, err)
+	require.IsType(t, &redis.Client{}, rdb)
+
+	rdb, err = NewClient("redis://localhost:6379/0", Config{Mode: ModeCluster})
+	require.NoError(t, err)
+	require.IsType(t, &redis.ClusterClient{}, rdb)
+
+	_, err = NewClient("redis://localhost:6379/0", Config{Mode: ModeSentinel})
+	require.Error(t, err, "sentinel without master name")
+
+	_, err = NewClient("redis://localhost:6379/1", Config{Mode: ModeCluster})
+	require.Error(t, err, "cluster with not default DB")
+
+	_, err = NewClient("redis://localhost:6379/0", Config{Mode: "unknown"})
+	require.Error(t, err)
+}
+
+func TestGetManyAndScanKeys(t *testing.T) {
+	for mode, rdb := range testClients(t) {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			require.NoError(t, rdb.Set(ctx, "gw:"+mode+":1", "first", 0).Err())
+			require.NoError(t, rdb.Set(ctx, "gw:"+mode+":2", "second", 0).Err())
+
+			values, err := GetMany(ctx, rdb, "gw:"+mode+":1", "gw:"+mode+":missing", "gw:"+mode+":2")
+			require.NoError(t, err)
+			require.Equal(t, []interface{}{"first", nil, "second"}, values)
+
+			keys, err := ScanKeys(ctx, rdb, "gw:"+mode+":*")
+			require.NoError(t, err)
+			sort.Strings(keys)
+			require.Equal(t, []string{"gw:" + mode + ":1", "gw:" + mode + ":2"}, keys)
+		})
+	}
+}
+
+func TestRetryOnFailover(t *testing.T) {
+	calls := 0
+	err := RetryOnFailover(context.Background(), 3, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("READONLY You can't write against a read only replica.")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = RetryOnFailover(context.Background(), 3, func() error {
+		calls++
+		return redis.TxFailedErr
+	})
+	require.ErrorIs(t, err, redis.TxFailedErr)
+	require.Equal(t, 1, calls, "not failover errors are not retried")
+
+	calls = 0
+	err = RetryOnFailover(context.Background(), 1, func() error {
+		calls++
+		return io.EOF
+	})
+	require.ErrorIs(t, err, io.EOF)
+	require.Equal(t, 2, calls)
+}
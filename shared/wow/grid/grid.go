@@ -0,0 +1,82 @@
+package grid
+
+const (
+	// MaxNumberOfGrids is number of grids by one axis of the map.
+	MaxNumberOfGrids = 64
+
+	// SizeOfGrids is size of one grid in yards.
+	SizeOfGrids float32 = 533.33333
+
+	// CenterGridID is ID of the grid with zero coordinates.
+	CenterGridID = 32
+)
+
+// XByPosition returns X index of the grid that contains the X world coordinate,
+// calculated the same way as game server calculates grid coordinates.
+func XByPosition(x float32) uint32 {
+	gridX := MaxNumberOfGrids - 1 - int(x/SizeOfGrids+CenterGridID)
+	if gridX < 0 {
+		return 0
+	}
+	if gridX >= MaxNumberOfGrids {
+		return MaxNumberOfGrids - 1
+	}
+	return uint32(gridX)
+}
+
+// Range is inclusive range of the grids X indexes.
+type Range struct {
+	Min uint32
+	Max uint32
+}
+
+// Split splits all grids of the map into the given count of ranges with the same size.
+func Split(count uint32) []Range {
+	if count == 0 {
+		return nil
+	}
+	if count > MaxNumberOfGrids {
+		count = MaxNumberOfGrids
+	}
+
+	res := make([]Range, 0, count)
+	for i := uint32(0); i < count; i++ {
+		res = append(res, Range{
+			Min: i * MaxNumberOfGrids / count,
+			Max: (i+1)*MaxNumberOfGrids/count - 1,
+		})
+	}
+	return res
+}
+
+// Contains returns true if the grid X index is within the range.
+func (r Range) Contains(gridX uint32) bool {
+	return gridX >= r.Min && gridX <= r.Max
+}
+
+// Bounds returns min and max X world coordinates covered by the range.
+// Grids indexes grow in opposite direction to the coordinates.
+func (r Range) Bounds() (float32, float32) {
+	return float32(MaxNumberOfGrids-1-CenterGridID-int(r.Max)) * SizeOfGrids,
+		float32(MaxNumberOfGrids-CenterGridID-int(r.Min)) * SizeOfGrids
+}
+
+// Distance returns distance in yards from the X world coordinate to the range, 0 if coordinate is within the range.
+func (r Range) Distance(x float32) float32 {
+	min, max := r.Bounds()
+	if r.Max == MaxNumberOfGrids-1 {
+		min = x
+	}
+	if r.Min == 0 {
+		max = x
+	}
+
+	switch {
+	case x < min:
+		return min - x
+	case x > max:
+		return x - max
+	default:
+		return 0
+	}
+}
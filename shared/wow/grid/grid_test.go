@@ -0,0 +1,40 @@
+package grid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXByPosition(t *testing.T) {
+	assert.Equal(t, uint32(31), XByPosition(0))
+	assert.Equal(t, uint32(31), XByPosition(533))
+	assert.Equal(t, uint32(30), XByPosition(534))
+	assert.Equal(t, uint32(32), XByPosition(-1))
+	assert.Equal(t, uint32(0), XByPosition(17100))
+	assert.Equal(t, uint32(63), XByPosition(-17100))
+}
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, []Range{{Min: 0, Max: 31}, {Min: 32, Max: 63}}, Split(2))
+	assert.Equal(t, []Range{{Min: 0, Max: 20}, {Min: 21, Max: 41}, {Min: 42, Max: 63}}, Split(3))
+	assert.Len(t, Split(100), MaxNumberOfGrids)
+	assert.Empty(t, Split(0))
+}
+
+func TestRangeDistance(t *testing.T) {
+	// Grids 32-63 are negative X coordinates.
+	west := Range{Min: 32, Max: 63}
+	assert.Equal(t, uint32(32), XByPosition(-10))
+	assert.Zero(t, west.Distance(-10))
+	assert.Zero(t, west.Distance(-20000), "edge range covers coordinates out of the map")
+	assert.InDelta(t, 10, west.Distance(10), 0.001)
+
+	east := Range{Min: 0, Max: 31}
+	assert.Zero(t, east.Distance(10))
+	assert.InDelta(t, 10, east.Distance(-10), 0.001)
+
+	middle := Range{Min: 30, Max: 31}
+	assert.InDelta(t, 100, middle.Distance(2*SizeOfGrids+100), 0.01)
+	assert.InDelta(t, 50, middle.Distance(-50), 0.01)
+}
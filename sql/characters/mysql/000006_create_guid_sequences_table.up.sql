@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS `guid_sequences` (
+  `guidType` TINYINT UNSIGNED NOT NULL,
+  `maxGuid` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  PRIMARY KEY (`guidType`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `auctionhouse_sales` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `houseId` TINYINT UNSIGNED NOT NULL,
+  `itemEntry` INT UNSIGNED NOT NULL,
+  `itemCount` INT UNSIGNED NOT NULL,
+  `price` INT UNSIGNED NOT NULL,
+  `isBuyout` TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  `soldAt` BIGINT NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_item_sold_at` (`itemEntry`, `soldAt`),
+  INDEX `idx_sold_at` (`soldAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
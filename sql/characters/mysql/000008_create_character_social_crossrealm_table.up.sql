@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS `character_social_crossrealm` (
+  `guid` INT UNSIGNED NOT NULL DEFAULT '0',
+  `friendRealm` INT UNSIGNED NOT NULL DEFAULT '0',
+  `friend` INT UNSIGNED NOT NULL DEFAULT '0',
+  `note` VARCHAR(48) NOT NULL DEFAULT '',
+  PRIMARY KEY (`guid`, `friendRealm`, `friend`),
+  INDEX `idx_friend` (`friendRealm`, `friend`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS `chat_log` (
+  `id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `realmId` INT UNSIGNED NOT NULL,
+  `type` TINYINT UNSIGNED NOT NULL,
+  `senderGuid` BIGINT UNSIGNED NOT NULL,
+  `senderName` VARCHAR(12) NOT NULL DEFAULT '',
+  `receiverGuid` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  `receiverName` VARCHAR(12) NOT NULL DEFAULT '',
+  `receiverRealmId` INT UNSIGNED NOT NULL DEFAULT 0,
+  `channelName` VARCHAR(128) NOT NULL DEFAULT '',
+  `guildId` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  `groupId` BIGINT UNSIGNED NOT NULL DEFAULT 0,
+  `language` INT UNSIGNED NOT NULL DEFAULT 0,
+  `message` VARCHAR(1024) NOT NULL,
+  `createdAt` BIGINT NOT NULL,
+  PRIMARY KEY (`id`),
+  INDEX `idx_sender_created_at` (`senderGuid`, `createdAt`),
+  INDEX `idx_receiver_created_at` (`receiverGuid`, `createdAt`),
+  INDEX `idx_channel_created_at` (`channelName`, `createdAt`),
+  INDEX `idx_created_at` (`createdAt`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
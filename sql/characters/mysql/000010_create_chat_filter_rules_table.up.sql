@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS `chat_filter_rules` (
+  `id` INT UNSIGNED NOT NULL AUTO_INCREMENT,
+  `ruleType` ENUM('block_word', 'replace_word', 'regex', 'allowed_domain') NOT NULL,
+  `pattern` VARCHAR(255) NOT NULL,
+  `action` VARCHAR(16) NOT NULL DEFAULT '',
+  `replacement` VARCHAR(255) NOT NULL DEFAULT '',
+  `reason` VARCHAR(255) NOT NULL DEFAULT '',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `mail_system_job_receivers`;
+DROP TABLE IF EXISTS `mail_system_jobs`;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `mail_system_jobs` (
+  `idempotencyKey` VARCHAR(64) NOT NULL,
+  `status` TINYINT UNSIGNED NOT NULL DEFAULT 0,
+  `target` TEXT NOT NULL,
+  `mail` TEXT NOT NULL,
+  `total` INT UNSIGNED NOT NULL DEFAULT 0,
+  `sent` INT UNSIGNED NOT NULL DEFAULT 0,
+  `failed` INT UNSIGNED NOT NULL DEFAULT 0,
+  `error` VARCHAR(255) NOT NULL DEFAULT '',
+  `createdAt` BIGINT NOT NULL,
+  PRIMARY KEY (`idempotencyKey`),
+  INDEX `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE IF NOT EXISTS `mail_system_job_receivers` (
+  `idempotencyKey` VARCHAR(64) NOT NULL,
+  `receiver` INT UNSIGNED NOT NULL,
+  `mailId` INT UNSIGNED NOT NULL,
+  PRIMARY KEY (`idempotencyKey`, `receiver`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1 @@
+ALTER TABLE `guid_sequences` DROP COLUMN `pool`;
@@ -0,0 +1 @@
+ALTER TABLE `guid_sequences` ADD COLUMN `pool` MEDIUMTEXT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE `mail_system_jobs`
+  DROP COLUMN `owner`,
+  DROP COLUMN `leaseExpiresAt`;
@@ -0,0 +1,3 @@
+ALTER TABLE `mail_system_jobs`
+  ADD COLUMN `owner` VARCHAR(64) NULL DEFAULT NULL,
+  ADD COLUMN `leaseExpiresAt` BIGINT NOT NULL DEFAULT 0;
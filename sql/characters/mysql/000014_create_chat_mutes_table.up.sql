@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS `chat_mutes` (
+  `playerGuid` BIGINT UNSIGNED NOT NULL,
+  `mutedUntil` BIGINT NOT NULL,
+  `reason` VARCHAR(255) NOT NULL DEFAULT '',
+  PRIMARY KEY (`playerGuid`),
+  INDEX `idx_muted_until` (`mutedUntil`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;